@@ -0,0 +1,97 @@
+package sqldef
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"time"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/schema"
+)
+
+// The advisory lock of --apply-lock. Advisory locks of PostgreSQL and applocks of SQL Server are scoped to the
+// database, while the locks of MySQL are global, so the name is prefixed to the database name for MySQL.
+const applyLockName = "sqldef"
+
+// Take the advisory lock of --apply-lock on a connection dedicated to it, waiting for the other runs holding it up to
+// the timeout, or without limit when it's 0. The returned function releases the lock, which is released by the database
+// anyway when the process exits without calling it.
+func acquireApplyLock(ctx context.Context, db database.Database, mode schema.GeneratorMode, timeout time.Duration) (func(), error) {
+	conn, err := db.DB().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var unlock string
+	switch mode {
+	case schema.GeneratorModeMysql:
+		seconds := -1 // no limit
+		if timeout > 0 {
+			seconds = int(math.Ceil(timeout.Seconds()))
+		}
+		var acquired sql.NullInt64
+		err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(CONCAT(?, ':', DATABASE()), ?)", applyLockName, seconds).Scan(&acquired)
+		if err == nil && acquired.Int64 != 1 {
+			err = fmt.Errorf("timed out after %s", timeout)
+		}
+		unlock = fmt.Sprintf("DO RELEASE_LOCK(CONCAT('%s', ':', DATABASE()))", applyLockName)
+	case schema.GeneratorModePostgres:
+		// lock_timeout limits the wait for advisory locks as well as for the locks of tables.
+		_, err = conn.ExecContext(ctx, fmt.Sprintf("SET lock_timeout = %d", timeout.Milliseconds()))
+		if err == nil {
+			_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", applyLockName)
+		}
+		if _, resetErr := conn.ExecContext(ctx, "RESET lock_timeout"); err == nil {
+			err = resetErr
+		}
+		unlock = fmt.Sprintf("SELECT pg_advisory_unlock(hashtext('%s'))", applyLockName)
+	case schema.GeneratorModeMssql:
+		milliseconds := int64(-1) // no limit
+		if timeout > 0 {
+			milliseconds = timeout.Milliseconds()
+		}
+		var result int
+		err = conn.QueryRowContext(ctx, fmt.Sprintf("DECLARE @result int; EXEC @result = sp_getapplock @Resource = N'%s', @LockMode = 'Exclusive', @LockOwner = 'Session', @LockTimeout = %d; SELECT @result", applyLockName, milliseconds)).Scan(&result)
+		if err == nil && result == -1 {
+			err = fmt.Errorf("timed out after %s", timeout)
+		} else if err == nil && result < 0 {
+			err = fmt.Errorf("sp_getapplock returned %d", result)
+		}
+		unlock = fmt.Sprintf("EXEC sp_releaseapplock @Resource = N'%s', @LockOwner = 'Session'", applyLockName)
+	default:
+		err = fmt.Errorf("not supported by sqlite3def")
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to take the lock of --apply-lock: %w", err)
+	}
+
+	return func() {
+		if _, err := conn.ExecContext(context.Background(), unlock); err != nil {
+			fmt.Fprintf(os.Stderr, "-- Failed to release the lock of --apply-lock: %s --\n", err)
+		}
+		conn.Close()
+	}, nil
+}
+
+// Return the checksum of a dumped schema, to tell whether another run changed it while waiting for --apply-lock. The
+// statements are normalized as --export does with export_strip_auto_increment, so that the AUTO_INCREMENT counters of
+// MySQL, which are advanced by every insert, don't count as changes.
+func schemaChecksum(mode schema.GeneratorMode, sqlParser database.Parser, ddls string, defaultSchema string) (string, error) {
+	parsed, err := schema.ParseDDLs(mode, sqlParser, ddls, defaultSchema)
+	if err != nil {
+		return "", err
+	}
+	config := database.GeneratorConfig{StripAutoIncrement: true}
+	digest := sha256.New()
+	for _, ddl := range parsed {
+		io.WriteString(digest, schema.NormalizeExport(ddl, ddl.Statement(), config)+"\n")
+	}
+	return hex.EncodeToString(digest.Sum(nil)), nil
+}
@@ -0,0 +1,92 @@
+package sqldef
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os/user"
+	"regexp"
+	"strings"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/schema"
+)
+
+// Return the statements to create the audit_table of --config if it's missing and to insert a row into it. The insert
+// takes the DDL and the checksum of the desired schema, and the executing user too for SQLite, which has no users.
+// For a contained database of SQL Server, the executing user is the user of the database instead of the login of the
+// server, which its users may not have.
+func auditStatements(mode schema.GeneratorMode, table string, containedDatabase bool) (string, string) {
+	switch mode {
+	case schema.GeneratorModeMysql:
+		table = quoteAuditTable(table, "`", "`")
+		return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (statement longtext NOT NULL, checksum char(64) NOT NULL, applied_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP, applied_by varchar(255) NOT NULL)", table),
+			fmt.Sprintf("INSERT INTO %s (statement, checksum, applied_at, applied_by) VALUES (?, ?, CURRENT_TIMESTAMP, CURRENT_USER())", table)
+	case schema.GeneratorModePostgres:
+		table = quoteAuditTable(table, `"`, `"`)
+		return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (statement text NOT NULL, checksum char(64) NOT NULL, applied_at timestamp with time zone NOT NULL DEFAULT CURRENT_TIMESTAMP, applied_by text NOT NULL)", table),
+			fmt.Sprintf("INSERT INTO %s (statement, checksum, applied_at, applied_by) VALUES ($1, $2, CURRENT_TIMESTAMP, current_user)", table)
+	case schema.GeneratorModeMssql:
+		quoted := quoteAuditTable(table, "[", "]")
+		user := "SUSER_SNAME()"
+		if containedDatabase {
+			user = "USER_NAME()"
+		}
+		return fmt.Sprintf("IF OBJECT_ID(N'%s', N'U') IS NULL CREATE TABLE %s (statement nvarchar(max) NOT NULL, checksum char(64) NOT NULL, applied_at datetime2 NOT NULL DEFAULT SYSDATETIME(), applied_by nvarchar(128) NOT NULL)", strings.ReplaceAll(quoted, "'", "''"), quoted),
+			fmt.Sprintf("INSERT INTO %s (statement, checksum, applied_at, applied_by) VALUES (@p1, @p2, SYSDATETIME(), %s)", quoted, user)
+	default: // SQLite3
+		table = quoteAuditTable(table, `"`, `"`)
+		return fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (statement text NOT NULL, checksum text NOT NULL, applied_at text NOT NULL DEFAULT CURRENT_TIMESTAMP, applied_by text NOT NULL)", table),
+			fmt.Sprintf("INSERT INTO %s (statement, checksum, applied_at, applied_by) VALUES (?, ?, CURRENT_TIMESTAMP, ?)", table)
+	}
+}
+
+// Quote each part of a possibly schema-qualified table name.
+func quoteAuditTable(table string, open string, close string) string {
+	parts := strings.Split(table, ".")
+	for i, part := range parts {
+		parts[i] = open + strings.ReplaceAll(part, close, close+close) + close
+	}
+	return strings.Join(parts, ".")
+}
+
+// Return the pattern of skip_tables to leave the audit table alone, which isn't in the desired schema.
+func auditTablePattern(mode schema.GeneratorMode, table string, defaultSchema string) string {
+	if (mode == schema.GeneratorModePostgres || mode == schema.GeneratorModeMssql) && !strings.Contains(table, ".") {
+		table = defaultSchema + "." + table
+	}
+	return regexp.QuoteMeta(table)
+}
+
+// Insert a row per applied DDL into the audit table in a transaction, creating the table if it's missing.
+func writeAuditLog(db database.Database, mode schema.GeneratorMode, config database.GeneratorConfig, ddls []string, desiredDDLs string) error {
+	containedDatabase := config.ContainedDatabase != nil && *config.ContainedDatabase
+	createTable, insert := auditStatements(mode, config.AuditTable, containedDatabase)
+	if _, err := db.DB().Exec(createTable); err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256([]byte(desiredDDLs))
+	checksum := hex.EncodeToString(digest[:])
+	args := []any{nil, checksum}
+	if mode == schema.GeneratorModeSQLite3 {
+		var name string
+		if current, err := user.Current(); err == nil {
+			name = current.Username
+		}
+		args = append(args, name)
+	}
+
+	transaction, err := db.DB().Begin()
+	if err != nil {
+		return err
+	}
+	for _, ddl := range ddls {
+		args[0] = ddl
+		if _, err := transaction.Exec(insert, args...); err != nil {
+			transaction.Rollback()
+			return err
+		}
+	}
+	return transaction.Commit()
+}
@@ -0,0 +1,258 @@
+// Package mysqldef implements the mysqldef command, which is also run as a subcommand of sqldef.
+package mysqldef
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sqldef/sqldef/database/file"
+	"github.com/sqldef/sqldef/parser"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/sqldef/sqldef"
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/database/mysql"
+	"github.com/sqldef/sqldef/schema"
+)
+
+// Return parsed options and schema filename
+// TODO: Support `sqldef schema.sql -opt val...`
+func parseOptions(name string, args []string, version string) (database.Config, *sqldef.Options) {
+	var opts struct {
+		User                  string        `short:"u" long:"user" description:"MySQL user name" value-name:"user_name" default:"root"`
+		Password              string        `short:"p" long:"password" description:"MySQL user password, overridden by $MYSQL_PWD" value-name:"password"`
+		Host                  string        `short:"h" long:"host" description:"Host to connect to the MySQL server" value-name:"host_name" default:"127.0.0.1"`
+		Port                  uint          `short:"P" long:"port" description:"Port used for the connection" value-name:"port_num" default:"3306"`
+		Socket                string        `short:"S" long:"socket" description:"The socket file to use for connection" value-name:"socket"`
+		SslMode               string        `long:"ssl-mode" description:"SSL connection mode(PREFERRED,REQUIRED,DISABLED,VERIFY_CA,VERIFY_IDENTITY,SKIP_VERIFY). (default: VERIFY_CA with --ssl-ca, otherwise PREFERRED)" value-name:"ssl_mode"`
+		SslCa                 string        `long:"ssl-ca" description:"File that contains list of trusted SSL Certificate Authorities" value-name:"ssl_ca"`
+		SslCert               string        `long:"ssl-cert" description:"File that contains the SSL client certificate" value-name:"ssl_cert"`
+		SslKey                string        `long:"ssl-key" description:"File that contains the private key of the SSL client certificate" value-name:"ssl_key"`
+		Prompt                bool          `long:"password-prompt" description:"Force MySQL user password prompt"`
+		PasswordFile          string        `long:"password-file" description:"Read MySQL user password from the first line of the file, overriding $MYSQL_PWD and --password" value-name:"filename"`
+		EnableCleartextPlugin bool          `long:"enable-cleartext-plugin" description:"Enable/disable the clear text authentication plugin"`
+		File                  []string      `long:"file" description:"Read desired SQL from the file, rather than stdin" value-name:"sql_file" default:"-"`
+		Overlay               string        `long:"overlay" description:"Read SQL from the file whose definitions override the same objects in the desired SQL" value-name:"sql_file"`
+		DryRun                bool          `long:"dry-run" description:"Don't run DDLs but just show them"`
+		Impact                bool          `long:"impact" description:"Annotate --dry-run DDLs with estimated locks and table rewrites"`
+		Pretty                bool          `long:"pretty" description:"Group --dry-run DDLs by object with colored marks of additions, modifications, and drops, and a summary"`
+		Export                bool          `long:"export" description:"Just dump the current schema to stdout"`
+		ChangedSince          string        `long:"changed-since" description:"With --export, export only the objects changed since the given previous export" value-name:"snapshot.sql"`
+		Fingerprint           bool          `long:"fingerprint" description:"With --export, pseudonymize the identifiers and strings to share the schema without its business names and values"`
+		EnableDropTable       bool          `long:"enable-drop-table" description:"Enable destructive changes such as DROP (enable only table drops)"`
+		MergeAlters           bool          `long:"merge-alters" description:"Combine the ALTER TABLEs of each table into one statement so that the table is rebuilt once"`
+		OnlyTable             []string      `long:"only-table" description:"Apply only the DDLs touching the tables matching the regexp, which can be given multiple times" value-name:"regexp"`
+		Destroy               bool          `long:"destroy" description:"Drop all the managed objects in the order of dependencies, instead of applying the desired SQL"`
+		SignPlan              string        `long:"sign-plan" description:"Sign the dry-run plan with the given private key and print it as a plan artifact" value-name:"key.pem"`
+		VerifyPlan            string        `long:"verify-plan" description:"Refuse to apply unless the generated plan matches the given signed plan artifact" value-name:"plan.sig"`
+		VerifyKey             string        `long:"verify-key" description:"Public key trusted to sign the artifact of --verify-plan" value-name:"key.pub"`
+		DownOutput            string        `long:"down-output" description:"Write DDLs to roll back the generated DDLs to the given file" value-name:"file.sql"`
+		SavePlan              string        `long:"save-plan" description:"Save the generated plan with its hash to the given file" value-name:"plan.json"`
+		ComparePlan           string        `long:"compare-plan" description:"Show how the generated plan changed since the plan saved with --save-plan" value-name:"plan.json"`
+		DocOutput             string        `long:"doc-output" description:"Write Markdown documentation of the desired schema to the given directory" value-name:"dir"`
+		SkipFailed            bool          `long:"skip-failed" description:"Continue past failing DDLs by running them outside a transaction, and report the failures"`
+		Timeout               time.Duration `long:"timeout" description:"Cancel each DDL running longer than the duration, e.g. 30s, and roll back the DDLs not committed yet (not a limit of the whole run)" value-name:"duration"`
+		ApplyLock             bool          `long:"apply-lock" description:"Take an advisory lock of the database while applying DDLs so that concurrent runs apply one after another"`
+		WaitTimeout           time.Duration `long:"wait-timeout" description:"With --apply-lock, give up waiting for the lock held by another run after the duration, e.g. 5m" value-name:"duration"`
+		Watch                 bool          `long:"watch" description:"Apply the desired SQL again every time the files of --file, --overlay, or --config change, until interrupted"`
+		Stats                 string        `long:"stats" description:"Report the time of each phase and the number of DDLs by category to stderr, in Prometheus text format or JSON" value-name:"text|json"`
+		Quiet                 bool          `short:"q" long:"quiet" description:"Don't show messages other than DDLs and errors, which are written to stderr"`
+		Verbose               bool          `short:"v" long:"verbose" description:"Also show what each phase did to stderr"`
+		SkipView              bool          `long:"skip-view" description:"Skip managing views (temporary feature, to be removed later)"`
+		BeforeApply           string        `long:"before-apply" description:"Execute the given string before applying the regular DDLs"`
+		Config                []string      `long:"config" description:"YAML file to specify: target_tables, skip_tables, algorithm, lock, renames, forbidden_ddl, max_batch_bytes, export_explicit_not_null, export_strip_auto_increment, export_strip_definer, export_definer, export_canonicalize_defaults, override_definer, keep_column_attributes, ignore_column_order, notify_webhook, audit_table, ssl_mode, ssl_ca, ssl_cert, ssl_key, ssh_tunnel"`
+		Help                  bool          `long:"help" description:"Show this help"`
+		Version               bool          `long:"version" description:"Show this version"`
+	}
+
+	parser := flags.NewParser(&opts, flags.None)
+	parser.Name = name
+	parser.Usage = "[OPTIONS] [database|current.sql] < desired.sql"
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if opts.Help {
+		parser.WriteHelp(os.Stdout)
+		os.Exit(0)
+	}
+
+	if opts.Version {
+		fmt.Println(version)
+		os.Exit(0)
+	}
+
+	desiredFiles := sqldef.ParseFiles(opts.File)
+	if opts.Watch {
+		if opts.Export || opts.Destroy {
+			log.Fatal("--watch can't be used with --export or --destroy")
+		}
+		watched := append(append([]string{}, desiredFiles...), opts.Config...)
+		if len(opts.Overlay) > 0 {
+			watched = append(watched, opts.Overlay)
+		}
+		sqldef.Watch(watched)
+	}
+
+	var desiredDDLs, overlayDDLs string
+	if !opts.Export && !opts.Destroy {
+		desiredDDLs, err = sqldef.ReadDesiredFiles(desiredFiles, schema.GeneratorModeMysql)
+		if err != nil {
+			log.Fatalf("Failed to read '%v': %s", desiredFiles, err)
+		}
+		if len(opts.Overlay) > 0 {
+			overlayDDLs, err = sqldef.ReadFile(opts.Overlay)
+			if err != nil {
+				log.Fatalf("Failed to read '%s': %s", opts.Overlay, err)
+			}
+		}
+	}
+
+	options := sqldef.Options{
+		DesiredDDLs:     desiredDDLs,
+		OverlayDDLs:     overlayDDLs,
+		DryRun:          opts.DryRun,
+		Impact:          opts.Impact,
+		Pretty:          opts.Pretty,
+		Export:          opts.Export,
+		ChangedSince:    opts.ChangedSince,
+		Fingerprint:     opts.Fingerprint,
+		EnableDropTable: opts.EnableDropTable,
+		MergeAlters:     opts.MergeAlters,
+		OnlyTables:      opts.OnlyTable,
+		Destroy:         opts.Destroy,
+		SignPlan:        opts.SignPlan,
+		VerifyPlan:      opts.VerifyPlan,
+		VerifyKey:       opts.VerifyKey,
+		DownOutput:      opts.DownOutput,
+		SavePlan:        opts.SavePlan,
+		ComparePlan:     opts.ComparePlan,
+		DocOutput:       opts.DocOutput,
+		SkipFailed:      opts.SkipFailed,
+		Timeout:         opts.Timeout,
+		ApplyLock:       opts.ApplyLock,
+		WaitTimeout:     opts.WaitTimeout,
+		Stats:           opts.Stats,
+		Quiet:           opts.Quiet,
+		Verbose:         opts.Verbose,
+		BeforeApply:     opts.BeforeApply,
+		Config:          database.ParseGeneratorConfig(opts.Config),
+	}
+
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, "No database is specified!\n\n")
+		parser.WriteHelp(os.Stderr)
+		os.Exit(1)
+	} else if len(args) > 1 {
+		fmt.Fprintf(os.Stderr, "Multiple databases are given: %v\n\n", args)
+		parser.WriteHelp(os.Stderr)
+		os.Exit(1)
+	}
+	var databaseName string
+	if strings.HasSuffix(args[0], ".sql") {
+		options.CurrentFile = args[0]
+	} else {
+		databaseName = args[0]
+	}
+	options.DatabaseName = args[0]
+
+	// The flags take precedence over the keys of --config
+	if !parser.FindOptionByLongName("ssl-ca").IsSet() {
+		opts.SslCa = options.Config.SslCa
+	}
+	if !parser.FindOptionByLongName("ssl-cert").IsSet() {
+		opts.SslCert = options.Config.SslCert
+	}
+	if !parser.FindOptionByLongName("ssl-key").IsSet() {
+		opts.SslKey = options.Config.SslKey
+	}
+	if !parser.FindOptionByLongName("ssl-mode").IsSet() {
+		opts.SslMode = options.Config.SslMode
+	}
+	if opts.SslMode == "" {
+		if len(opts.SslCa) > 0 {
+			opts.SslMode = "VERIFY_CA"
+		} else {
+			opts.SslMode = "PREFERRED"
+		}
+	}
+
+	switch strings.ToLower(opts.SslMode) {
+	case "disabled":
+		opts.SslMode = "false"
+	case "preferred":
+		opts.SslMode = "preferred"
+	case "required":
+		opts.SslMode = "true"
+	case "verify_ca":
+		opts.SslMode = "verify-ca"
+	case "verify_identity":
+		opts.SslMode = "true"
+	case "skip_verify":
+		opts.SslMode = "skip-verify"
+	case "custom":
+		opts.SslMode = "custom"
+	default:
+		fmt.Fprintf(os.Stderr, "Wrong value for ssl-mode is given: %v\n\n", opts.SslMode)
+		parser.WriteHelp(os.Stderr)
+		os.Exit(1)
+	}
+
+	password, err := sqldef.ReadPassword(opts.Password, opts.PasswordFile, opts.Prompt, "MYSQL_PWD")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config := database.Config{
+		DbName:                     databaseName,
+		User:                       opts.User,
+		Password:                   password,
+		Host:                       opts.Host,
+		Port:                       int(opts.Port),
+		Socket:                     opts.Socket,
+		MySQLEnableCleartextPlugin: opts.EnableCleartextPlugin,
+		SkipView:                   opts.SkipView,
+		SslMode:                    opts.SslMode,
+		SslCa:                      opts.SslCa,
+		SslCert:                    opts.SslCert,
+		SslKey:                     opts.SslKey,
+		DumpConcurrency:            options.Config.DumpConcurrency,
+		SSHTunnel:                  options.Config.SSHTunnel,
+	}
+	return config, &options
+}
+
+// Main runs the command with the arguments excluding the program name. The name is shown in the help.
+// The configure functions can change the parsed options, e.g. for the subcommands of sqldef.
+func Main(name string, args []string, version string, configure ...func(*sqldef.Options)) {
+	config, options := parseOptions(name, args, version)
+	for _, f := range configure {
+		f(options)
+	}
+
+	var db database.Database
+	if len(options.CurrentFile) > 0 {
+		db = file.NewDatabase(options.CurrentFile, config.DefaultSchema)
+	} else {
+		var err error
+		db, err = mysql.NewDatabase(config)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer db.Close()
+
+		if options.Config.MaxBatchBytes == 0 {
+			options.Config.MaxBatchBytes, err = mysql.MaxAllowedPacket(db)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+
+	sqlParser := database.NewParser(parser.ParserModeMysql)
+	sqldef.Run(schema.GeneratorModeMysql, db, sqlParser, options)
+}
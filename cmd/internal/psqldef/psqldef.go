@@ -0,0 +1,287 @@
+// Package psqldef implements the psqldef command, which is also run as a subcommand of sqldef.
+package psqldef
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sqldef/sqldef/database/file"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/sqldef/sqldef"
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/database/postgres"
+	"github.com/sqldef/sqldef/schema"
+)
+
+// Return parsed options and schema filename
+// TODO: Support `sqldef schema.sql -opt val...`
+func parseOptions(name string, args []string, version string) (database.Config, *sqldef.Options, []string, string) {
+	var opts struct {
+		User             string        `short:"U" long:"user" description:"PostgreSQL user name" value-name:"username" default:"postgres"`
+		Password         string        `short:"W" long:"password" description:"PostgreSQL user password, overridden by $PGPASSWORD" value-name:"password"`
+		Host             string        `short:"h" long:"host" description:"Host or socket directory to connect to the PostgreSQL server" value-name:"hostname" default:"127.0.0.1"`
+		Port             uint          `short:"p" long:"port" description:"Port used for the connection" value-name:"port" default:"5432"`
+		Prompt           bool          `long:"password-prompt" description:"Force PostgreSQL user password prompt"`
+		PasswordFile     string        `long:"password-file" description:"Read PostgreSQL user password from the first line of the file, overriding $PGPASSWORD and --password" value-name:"filename"`
+		File             []string      `short:"f" long:"file" description:"Read desired SQL from the file, rather than stdin" value-name:"filename" default:"-"`
+		Overlay          string        `long:"overlay" description:"Read SQL from the file whose definitions override the same objects in the desired SQL" value-name:"filename"`
+		DryRun           bool          `long:"dry-run" description:"Don't run DDLs but just show them"`
+		Impact           bool          `long:"impact" description:"Annotate --dry-run DDLs with estimated locks and table rewrites"`
+		Pretty           bool          `long:"pretty" description:"Group --dry-run DDLs by object with colored marks of additions, modifications, and drops, and a summary"`
+		Export           bool          `long:"export" description:"Just dump the current schema to stdout"`
+		ChangedSince     string        `long:"changed-since" description:"With --export, export only the objects changed since the given previous export" value-name:"snapshot.sql"`
+		Fingerprint      bool          `long:"fingerprint" description:"With --export, pseudonymize the identifiers and strings to share the schema without its business names and values"`
+		EnableDropTable  bool          `long:"enable-drop-table" description:"Enable destructive changes such as DROP (enable only table drops)"`
+		OnlyTable        []string      `long:"only-table" description:"Apply only the DDLs touching the tables matching the regexp, which can be given multiple times" value-name:"regexp"`
+		Destroy          bool          `long:"destroy" description:"Drop all the managed objects in the order of dependencies, instead of applying the desired SQL"`
+		ReportPrivileges bool          `long:"report-privileges" description:"Print the current and desired table privileges of each role, without running DDLs"`
+		SignPlan         string        `long:"sign-plan" description:"Sign the dry-run plan with the given private key and print it as a plan artifact" value-name:"key.pem"`
+		VerifyPlan       string        `long:"verify-plan" description:"Refuse to apply unless the generated plan matches the given signed plan artifact" value-name:"plan.sig"`
+		VerifyKey        string        `long:"verify-key" description:"Public key trusted to sign the artifact of --verify-plan" value-name:"key.pub"`
+		DownOutput       string        `long:"down-output" description:"Write DDLs to roll back the generated DDLs to the given file" value-name:"file.sql"`
+		SavePlan         string        `long:"save-plan" description:"Save the generated plan with its hash to the given file" value-name:"plan.json"`
+		ComparePlan      string        `long:"compare-plan" description:"Show how the generated plan changed since the plan saved with --save-plan" value-name:"plan.json"`
+		DocOutput        string        `long:"doc-output" description:"Write Markdown documentation of the desired schema to the given directory" value-name:"dir"`
+		SkipFailed       bool          `long:"skip-failed" description:"Continue past failing DDLs by running them outside a transaction, and report the failures"`
+		Timeout          time.Duration `long:"timeout" description:"Cancel each DDL running longer than the duration, e.g. 30s, and roll back the DDLs not committed yet (not a limit of the whole run)" value-name:"duration"`
+		ApplyLock        bool          `long:"apply-lock" description:"Take an advisory lock of the database while applying DDLs so that concurrent runs apply one after another"`
+		WaitTimeout      time.Duration `long:"wait-timeout" description:"With --apply-lock, give up waiting for the lock held by another run after the duration, e.g. 5m" value-name:"duration"`
+		Watch            bool          `long:"watch" description:"Apply the desired SQL again every time the files of --file, --overlay, or --config change, until interrupted"`
+		Stats            string        `long:"stats" description:"Report the time of each phase and the number of DDLs by category to stderr, in Prometheus text format or JSON" value-name:"text|json"`
+		Quiet            bool          `short:"q" long:"quiet" description:"Don't show messages other than DDLs and errors, which are written to stderr"`
+		Verbose          bool          `short:"v" long:"verbose" description:"Also show what each phase did to stderr"`
+		SkipView         bool          `long:"skip-view" description:"Skip managing views/materialized views"`
+		SkipExtension    bool          `long:"skip-extension" description:"Skip managing extensions"`
+		DatabaseQuery    string        `long:"database-query" description:"Apply the desired SQL to each database listed by the query, which is run on the given database" value-name:"sql"`
+		DefaultSchema    string        `long:"default-schema" description:"Schema of unqualified objects in the desired SQL, instead of the first schema of search_path" value-name:"schema"`
+		BeforeApply      string        `long:"before-apply" description:"Execute the given string before applying the regular DDLs"`
+		Config           []string      `long:"config" description:"YAML file to specify: target_tables, skip_tables, target_schema, managed_roles, renames, type_conversions, safe_not_null, restart_identity, auto_create_schema, auth, forbidden_ddl, timeouts, reference_schemas, max_batch_bytes, transaction_mode, export_explicit_not_null, notify_webhook, audit_table, ssh_tunnel"`
+		Help             bool          `long:"help" description:"Show this help"`
+		Version          bool          `long:"version" description:"Show this version"`
+	}
+
+	parser := flags.NewParser(&opts, flags.None)
+	parser.Name = name
+	parser.Usage = "[OPTION]... [DBNAME...|current.sql] < desired.sql"
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if opts.Help {
+		parser.WriteHelp(os.Stdout)
+		os.Exit(0)
+	}
+
+	if opts.Version {
+		fmt.Println(version)
+		os.Exit(0)
+	}
+
+	desiredFiles := sqldef.ParseFiles(opts.File)
+	if opts.Watch {
+		if opts.Export || opts.Destroy {
+			log.Fatal("--watch can't be used with --export or --destroy")
+		}
+		watched := append(append([]string{}, desiredFiles...), opts.Config...)
+		if len(opts.Overlay) > 0 {
+			watched = append(watched, opts.Overlay)
+		}
+		sqldef.Watch(watched)
+	}
+
+	var desiredDDLs, overlayDDLs string
+	if !opts.Export && !opts.Destroy {
+		desiredDDLs, err = sqldef.ReadDesiredFiles(desiredFiles, schema.GeneratorModePostgres)
+		if err != nil {
+			log.Fatalf("Failed to read '%v': %s", desiredFiles, err)
+		}
+		if len(opts.Overlay) > 0 {
+			overlayDDLs, err = sqldef.ReadFile(opts.Overlay)
+			if err != nil {
+				log.Fatalf("Failed to read '%s': %s", opts.Overlay, err)
+			}
+		}
+	}
+
+	options := sqldef.Options{
+		DesiredDDLs:      desiredDDLs,
+		OverlayDDLs:      overlayDDLs,
+		DryRun:           opts.DryRun,
+		Impact:           opts.Impact,
+		Pretty:           opts.Pretty,
+		Export:           opts.Export,
+		ChangedSince:     opts.ChangedSince,
+		Fingerprint:      opts.Fingerprint,
+		EnableDropTable:  opts.EnableDropTable,
+		OnlyTables:       opts.OnlyTable,
+		Destroy:          opts.Destroy,
+		ReportPrivileges: opts.ReportPrivileges,
+		SignPlan:         opts.SignPlan,
+		VerifyPlan:       opts.VerifyPlan,
+		VerifyKey:        opts.VerifyKey,
+		DownOutput:       opts.DownOutput,
+		SavePlan:         opts.SavePlan,
+		ComparePlan:      opts.ComparePlan,
+		DocOutput:        opts.DocOutput,
+		SkipFailed:       opts.SkipFailed,
+		Timeout:          opts.Timeout,
+		ApplyLock:        opts.ApplyLock,
+		WaitTimeout:      opts.WaitTimeout,
+		Stats:            opts.Stats,
+		Quiet:            opts.Quiet,
+		Verbose:          opts.Verbose,
+		BeforeApply:      opts.BeforeApply,
+		Config:           database.ParseGeneratorConfig(opts.Config),
+	}
+
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, "No database is specified!\n\n")
+		parser.WriteHelp(os.Stderr)
+		os.Exit(1)
+	} else if len(args) > 1 || len(opts.DatabaseQuery) > 0 {
+		for _, arg := range args {
+			if strings.HasSuffix(arg, ".sql") {
+				fmt.Fprintf(os.Stderr, "current.sql can't be given with multiple databases: %v\n\n", args)
+				parser.WriteHelp(os.Stderr)
+				os.Exit(1)
+			}
+		}
+		if opts.Export || opts.Destroy {
+			log.Fatal("multiple databases can't be used with --export or --destroy")
+		}
+	}
+	var databaseName string
+	if strings.HasSuffix(args[0], ".sql") {
+		options.CurrentFile = args[0]
+	} else {
+		databaseName = args[0]
+	}
+	options.DatabaseName = args[0]
+
+	password, err := sqldef.ReadPassword(opts.Password, opts.PasswordFile, opts.Prompt, "PGPASSWORD")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	config := database.Config{
+		DbName:          databaseName,
+		User:            opts.User,
+		Password:        password,
+		Host:            opts.Host,
+		Port:            int(opts.Port),
+		SkipView:        opts.SkipView,
+		SkipExtension:   opts.SkipExtension,
+		TargetSchema:    options.Config.TargetSchema,
+		ManagedRoles:    options.Config.ManagedRoles,
+		DefaultSchema:   opts.DefaultSchema,
+		Auth:            options.Config.Auth,
+		DumpConcurrency: options.Config.DumpConcurrency,
+		SSHTunnel:       options.Config.SSHTunnel,
+	}
+	if config.TargetSchema != nil {
+		// Objects in reference_schemas are dumped to resolve the references to them
+		config.TargetSchema = append(config.TargetSchema, options.Config.ReferenceSchemas...)
+	}
+	if _, err := os.Stat(config.Host); !os.IsNotExist(err) {
+		config.Socket = config.Host
+	}
+	return config, &options, args, opts.DatabaseQuery
+}
+
+// Main runs the command with the arguments excluding the program name. The name is shown in the help.
+// The configure functions can change the parsed options, e.g. for the subcommands of sqldef.
+func Main(name string, args []string, version string, configure ...func(*sqldef.Options)) {
+	config, options, databases, databaseQuery := parseOptions(name, args, version)
+	for _, f := range configure {
+		f(options)
+	}
+	sqlParser := postgres.NewParser()
+
+	if len(options.CurrentFile) > 0 {
+		db := file.NewDatabase(options.CurrentFile, config.DefaultSchema)
+		sqldef.Run(schema.GeneratorModePostgres, db, sqlParser, options)
+		return
+	}
+
+	if len(databaseQuery) > 0 {
+		var err error
+		databases, err = queryDatabases(config, databaseQuery)
+		if err != nil {
+			log.Fatalf("Error on --database-query: %s", err)
+		}
+	}
+	if len(databases) == 1 && len(databaseQuery) == 0 {
+		db := connect(config)
+		defer db.Close()
+		sqldef.Run(schema.GeneratorModePostgres, db, sqlParser, options)
+		return
+	}
+
+	// Apply the same desired SQL to each database in turn, showing which database the output is for.
+	// The first failure stops the rest, like a failing DDL stops the rest of the DDLs.
+	for i, databaseName := range databases {
+		fmt.Printf("-- Database: %s (%d/%d) --\n", databaseName, i+1, len(databases))
+		config.DbName = databaseName
+		databaseOptions := *options
+		databaseOptions.DatabaseName = databaseName
+		func() {
+			db := connect(config)
+			defer db.Close()
+			sqldef.Run(schema.GeneratorModePostgres, db, sqlParser, &databaseOptions)
+		}()
+	}
+	database.Infof("-- Done with %d databases --\n", len(databases))
+}
+
+func connect(config database.Config) database.Database {
+	db, err := postgres.NewDatabase(config)
+
+	// Emulate the default behavior (sslmode=prefer) of psql when PGSSLMODE is not set,
+	// which is not supported by Go's lib/pq.
+	if _, ok := os.LookupEnv("PGSSLMODE"); !ok && err == nil {
+		e := db.DB().Ping()
+		if e != nil && strings.Contains(fmt.Sprintf("%s", e), "SSL is not enabled") {
+			db.Close()
+			os.Setenv("PGSSLMODE", "disable")
+			db, err = postgres.NewDatabase(config)
+		}
+	}
+
+	if err != nil {
+		log.Fatal(err)
+	}
+	return db
+}
+
+// Run the query of --database-query on the given database and return the names of the databases in its first column.
+func queryDatabases(config database.Config, query string) ([]string, error) {
+	db := connect(config)
+	defer db.Close()
+
+	rows, err := db.DB().Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var databases []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		databases = append(databases, name)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(databases) == 0 {
+		return nil, fmt.Errorf("no database is returned by the query: %s", query)
+	}
+	return databases, nil
+}
@@ -0,0 +1,172 @@
+// Package sqlite3def implements the sqlite3def command, which is also run as a subcommand of sqldef.
+package sqlite3def
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/sqldef/sqldef"
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/database/file"
+	"github.com/sqldef/sqldef/database/sqlite3"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/sqldef/sqldef/schema"
+)
+
+// Return parsed options and schema filename
+// TODO: Support `sqldef schema.sql -opt val...`
+func parseOptions(name string, args []string, version string) (database.Config, *sqldef.Options) {
+	var opts struct {
+		File            []string      `short:"f" long:"file" description:"Read desired SQL from the file, rather than stdin" value-name:"filename" default:"-"`
+		Overlay         string        `long:"overlay" description:"Read SQL from the file whose definitions override the same objects in the desired SQL" value-name:"filename"`
+		DryRun          bool          `long:"dry-run" description:"Don't run DDLs but just show them"`
+		Impact          bool          `long:"impact" description:"Annotate --dry-run DDLs with estimated locks and table rewrites"`
+		Pretty          bool          `long:"pretty" description:"Group --dry-run DDLs by object with colored marks of additions, modifications, and drops, and a summary"`
+		Export          bool          `long:"export" description:"Just dump the current schema to stdout"`
+		ChangedSince    string        `long:"changed-since" description:"With --export, export only the objects changed since the given previous export" value-name:"snapshot.sql"`
+		Fingerprint     bool          `long:"fingerprint" description:"With --export, pseudonymize the identifiers and strings to share the schema without its business names and values"`
+		EnableDropTable bool          `long:"enable-drop-table" description:"Enable destructive changes such as DROP (enable only table drops)"`
+		OnlyTable       []string      `long:"only-table" description:"Apply only the DDLs touching the tables matching the regexp, which can be given multiple times" value-name:"regexp"`
+		Destroy         bool          `long:"destroy" description:"Drop all the managed objects in the order of dependencies, instead of applying the desired SQL"`
+		SignPlan        string        `long:"sign-plan" description:"Sign the dry-run plan with the given private key and print it as a plan artifact" value-name:"key.pem"`
+		VerifyPlan      string        `long:"verify-plan" description:"Refuse to apply unless the generated plan matches the given signed plan artifact" value-name:"plan.sig"`
+		VerifyKey       string        `long:"verify-key" description:"Public key trusted to sign the artifact of --verify-plan" value-name:"key.pub"`
+		DownOutput      string        `long:"down-output" description:"Write DDLs to roll back the generated DDLs to the given file" value-name:"file.sql"`
+		SavePlan        string        `long:"save-plan" description:"Save the generated plan with its hash to the given file" value-name:"plan.json"`
+		ComparePlan     string        `long:"compare-plan" description:"Show how the generated plan changed since the plan saved with --save-plan" value-name:"plan.json"`
+		DocOutput       string        `long:"doc-output" description:"Write Markdown documentation of the desired schema to the given directory" value-name:"dir"`
+		SkipFailed      bool          `long:"skip-failed" description:"Continue past failing DDLs by running them outside a transaction, and report the failures"`
+		Timeout         time.Duration `long:"timeout" description:"Cancel each DDL running longer than the duration, e.g. 30s, and roll back the DDLs not committed yet (not a limit of the whole run)" value-name:"duration"`
+		Watch           bool          `long:"watch" description:"Apply the desired SQL again every time the files of --file, --overlay, or --config change, until interrupted"`
+		Stats           string        `long:"stats" description:"Report the time of each phase and the number of DDLs by category to stderr, in Prometheus text format or JSON" value-name:"text|json"`
+		Quiet           bool          `short:"q" long:"quiet" description:"Don't show messages other than DDLs and errors, which are written to stderr"`
+		Verbose         bool          `short:"v" long:"verbose" description:"Also show what each phase did to stderr"`
+		Config          []string      `long:"config" description:"YAML file to specify: target_tables, skip_tables, renames, forbidden_ddl, max_batch_bytes, export_explicit_not_null, notify_webhook, audit_table"`
+		Help            bool          `long:"help" description:"Show this help"`
+		Version         bool          `long:"version" description:"Show this version"`
+	}
+
+	parser := flags.NewParser(&opts, flags.None)
+	parser.Name = name
+	parser.Usage = "[OPTIONS] [FILENAME|current.sql] < desired.sql"
+	args, err := parser.ParseArgs(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if opts.Help {
+		parser.WriteHelp(os.Stdout)
+		os.Exit(0)
+	}
+
+	if opts.Version {
+		fmt.Println(version)
+		os.Exit(0)
+	}
+
+	desiredFiles := sqldef.ParseFiles(opts.File)
+	if opts.Watch {
+		if opts.Export || opts.Destroy {
+			log.Fatal("--watch can't be used with --export or --destroy")
+		}
+		watched := append(append([]string{}, desiredFiles...), opts.Config...)
+		if len(opts.Overlay) > 0 {
+			watched = append(watched, opts.Overlay)
+		}
+		sqldef.Watch(watched)
+	}
+
+	var desiredDDLs, overlayDDLs string
+	if !opts.Export && !opts.Destroy {
+		desiredDDLs, err = sqldef.ReadDesiredFiles(desiredFiles, schema.GeneratorModeSQLite3)
+		if err != nil {
+			log.Fatalf("Failed to read '%v': %s", desiredFiles, err)
+		}
+		if len(opts.Overlay) > 0 {
+			overlayDDLs, err = sqldef.ReadFile(opts.Overlay)
+			if err != nil {
+				log.Fatalf("Failed to read '%s': %s", opts.Overlay, err)
+			}
+		}
+	}
+
+	options := sqldef.Options{
+		DesiredDDLs:     desiredDDLs,
+		OverlayDDLs:     overlayDDLs,
+		DryRun:          opts.DryRun,
+		Impact:          opts.Impact,
+		Pretty:          opts.Pretty,
+		Export:          opts.Export,
+		ChangedSince:    opts.ChangedSince,
+		Fingerprint:     opts.Fingerprint,
+		EnableDropTable: opts.EnableDropTable,
+		OnlyTables:      opts.OnlyTable,
+		Destroy:         opts.Destroy,
+		SignPlan:        opts.SignPlan,
+		VerifyPlan:      opts.VerifyPlan,
+		VerifyKey:       opts.VerifyKey,
+		DownOutput:      opts.DownOutput,
+		SavePlan:        opts.SavePlan,
+		ComparePlan:     opts.ComparePlan,
+		DocOutput:       opts.DocOutput,
+		SkipFailed:      opts.SkipFailed,
+		Timeout:         opts.Timeout,
+		Stats:           opts.Stats,
+		Quiet:           opts.Quiet,
+		Verbose:         opts.Verbose,
+		Config:          database.ParseGeneratorConfig(opts.Config),
+	}
+
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, "No database is specified!\n\n")
+		parser.WriteHelp(os.Stderr)
+		os.Exit(1)
+	} else if len(args) > 1 {
+		fmt.Fprintf(os.Stderr, "Multiple databases are given: %v\n\n", args)
+		parser.WriteHelp(os.Stderr)
+		os.Exit(1)
+	}
+	var databaseName string
+	if strings.HasSuffix(args[0], ".sql") {
+		options.CurrentFile = args[0]
+	} else {
+		databaseName = args[0]
+	}
+	options.DatabaseName = args[0]
+
+	config := database.Config{
+		DbName: databaseName,
+	}
+	if _, err := os.Stat(config.Host); !os.IsNotExist(err) {
+		config.Socket = config.Host
+	}
+	return config, &options
+}
+
+// Main runs the command with the arguments excluding the program name. The name is shown in the help.
+// The configure functions can change the parsed options, e.g. for the subcommands of sqldef.
+func Main(name string, args []string, version string, configure ...func(*sqldef.Options)) {
+	config, options := parseOptions(name, args, version)
+	for _, f := range configure {
+		f(options)
+	}
+
+	var db database.Database
+	if len(options.CurrentFile) > 0 {
+		db = file.NewDatabase(options.CurrentFile, config.DefaultSchema)
+	} else {
+		var err error
+		db, err = sqlite3.NewDatabase(config)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer db.Close()
+	}
+
+	sqlParser := database.NewParser(parser.ParserModeSQLite3)
+	sqldef.Run(schema.GeneratorModeSQLite3, db, sqlParser, options)
+}
@@ -882,6 +882,23 @@ func TestMssqldefCreateTableForeignKey(t *testing.T) {
 	assertApplyOutput(t, createUsers+createPosts, nothingModified)
 }
 
+func TestMssqldefForeignKeyToAnotherSchema(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE [FOO].[users] (id BIGINT PRIMARY KEY);\nGO\n"
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  content text,
+		  user_id bigint,
+		  CONSTRAINT posts_ibfk_1 FOREIGN KEY (user_id) REFERENCES [FOO].[users] (id)
+		);
+		GO
+		`,
+	)
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
 func TestMssqldefCreateTableWithCheck(t *testing.T) {
 	resetTestDatabase()
 
@@ -1258,6 +1275,161 @@ func TestMssqldefExportConstraint(t *testing.T) {
 	assertEquals(t, out, sql+"GO\n")
 }
 
+func TestMssqldefComment(t *testing.T) {
+	resetTestDatabase()
+
+	testutils.MustExecute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-Q", stripHeredoc(`
+		CREATE TABLE dbo.users (
+		    id int,
+		    name nvarchar(30)
+		);
+		EXEC sp_addextendedproperty @name = N'MS_Description', @value = N'Users', @level0type = N'SCHEMA', @level0name = N'dbo', @level1type = N'TABLE', @level1name = N'users';
+		EXEC sp_addextendedproperty @name = N'MS_Description', @value = N'Name', @level0type = N'SCHEMA', @level0name = N'dbo', @level1type = N'TABLE', @level1name = N'users', @level2type = N'COLUMN', @level2name = N'name';
+		`,
+	))
+	out := assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--export")
+	assertEquals(t, out, stripHeredoc(`
+		CREATE TABLE dbo.users (
+		    [id] int,
+		    [name] nvarchar(30)
+		);
+		GO
+
+		COMMENT ON TABLE [dbo].[users] IS N'Users';
+		GO
+
+		COMMENT ON COLUMN [dbo].[users].[name] IS N'Name';
+		GO
+		`,
+	))
+
+	createTable := "CREATE TABLE dbo.users (\n  id int,\n  name nvarchar(30)\n);\n"
+	comments := "COMMENT ON TABLE users IS N'All users';\nCOMMENT ON COLUMN users.id IS N'ID';\n"
+	assertApplyOutput(t, createTable+comments, applyPrefix+
+		"EXEC sp_updateextendedproperty @name = N'MS_Description', @value = N'All users', @level0type = N'SCHEMA', @level0name = N'dbo', @level1type = N'TABLE', @level1name = N'users';\nGO\n"+
+		"EXEC sp_addextendedproperty @name = N'MS_Description', @value = N'ID', @level0type = N'SCHEMA', @level0name = N'dbo', @level1type = N'TABLE', @level1name = N'users', @level2type = N'COLUMN', @level2name = N'id';\nGO\n",
+	)
+	assertApplyOutput(t, createTable+comments, nothingModified)
+
+	// The obsoleted MS_Description is dropped only with --enable-drop-table
+	writeFile("schema.sql", createTable+comments)
+	out = assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+
+		"EXEC sp_dropextendedproperty @name = N'MS_Description', @level0type = N'SCHEMA', @level0name = N'dbo', @level1type = N'TABLE', @level1name = N'users', @level2type = N'COLUMN', @level2name = N'name';\nGO\n",
+	)
+	assertApplyOutput(t, createTable+comments, nothingModified)
+}
+
+func TestMssqldefConfigIncludesDisableDDLTriggers(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-Q", stripHeredoc(`
+		CREATE TRIGGER reject_create_table ON DATABASE FOR CREATE_TABLE AS
+		BEGIN
+		    ROLLBACK;
+		END;
+		`,
+	))
+	defer os.Remove("config.yml")
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id int NOT NULL
+		);
+		GO
+		`,
+	)
+	writeFile("schema.sql", createTable)
+
+	// The DDL trigger rolls back the apply, and it's not dumped as a part of the current schema
+	out, err := testutils.Execute("./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("expected the DDL trigger to reject the apply but it succeeded with: %s", out)
+	}
+	out = assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--export")
+	assertEquals(t, out, "-- No table exists --\n")
+
+	writeFile("config.yml", "disable_ddl_triggers: true\n")
+	out = assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql", "--config", "config.yml")
+	assertEquals(t, out, applyPrefix+
+		"DISABLE TRIGGER [reject_create_table] ON DATABASE;\nGO\n"+
+		createTable+
+		"ENABLE TRIGGER [reject_create_table] ON DATABASE;\nGO\n",
+	)
+	out = assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql", "--config", "config.yml")
+	assertEquals(t, out, nothingModified)
+
+	out = testutils.MustExecute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-h", "-1", "-Q", "SET NOCOUNT ON; SELECT is_disabled FROM sys.triggers WHERE name = 'reject_create_table';")
+	assertEquals(t, strings.TrimSpace(out), "0")
+}
+
+func TestMssqldefConfigIncludesMaxBatchBytes(t *testing.T) {
+	resetTestDatabase()
+	defer os.Remove("config.yml")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id int NOT NULL
+		);
+		CREATE TABLE posts (
+		  id int NOT NULL
+		);
+		`,
+	))
+	// max_batch_bytes of the config is kept instead of the batch size limit of SQL Server
+	writeFile("config.yml", "max_batch_bytes: 64\n")
+	out := assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql", "--config", "config.yml")
+	if !strings.Contains(out, "-- Committed a batch of ") {
+		t.Errorf("expected the apply to be split by max_batch_bytes, but got: %s", out)
+	}
+	out = assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql", "--config", "config.yml")
+	assertEquals(t, out, nothingModified)
+}
+
+func TestMssqldefConfigIncludesRenames(t *testing.T) {
+	resetTestDatabase()
+	defer os.Remove("config.yml")
+
+	writeFile("schema.sql", "CREATE TABLE old_users (id int NOT NULL, old_name nvarchar(40));\n")
+	assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql")
+
+	writeFile("schema.sql", "CREATE TABLE users (id int NOT NULL, name nvarchar(40));\n")
+	writeFile("config.yml", stripHeredoc(`
+		renames:
+		  tables: |
+		    old_users -> users
+		  columns: |
+		    users.old_name -> users.name
+	`))
+	apply := assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		EXEC sp_rename N'[dbo].[old_users]', N'users';
+		GO
+		EXEC sp_rename N'[dbo].[users].[old_name]', N'name', 'COLUMN';
+		GO
+	`))
+	apply = assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestMssqldefConfigIncludesContainedDatabase(t *testing.T) {
+	resetTestDatabase()
+	defer os.Remove("config.yml")
+
+	// The test database isn't contained, so the login of the server is recorded
+	writeFile("schema.sql", "CREATE TABLE users (id int NOT NULL);\n")
+	writeFile("config.yml", "audit_table: schema_migrations_log\n")
+	assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql", "--config", "config.yml")
+	out := testutils.MustExecute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-h", "-1", "-Q", "SET NOCOUNT ON; SELECT applied_by FROM schema_migrations_log;")
+	assertEquals(t, strings.TrimSpace(out), "sa")
+
+	// The user of the database is recorded for a contained database, whose users may have no login
+	writeFile("schema.sql", "CREATE TABLE users (id int NOT NULL, name nvarchar(40));\n")
+	writeFile("config.yml", "audit_table: schema_migrations_log\ncontained_database: true\n")
+	assertedExecute(t, "./mssqldef", "-Usa", "-PPassw0rd", "mssqldef_test", "--file", "schema.sql", "--config", "config.yml")
+	out = testutils.MustExecute("sqlcmd", "-Usa", "-PPassw0rd", "-dmssqldef_test", "-h", "-1", "-Q", "SET NOCOUNT ON; SELECT applied_by FROM schema_migrations_log WHERE statement LIKE 'ALTER TABLE%';")
+	assertEquals(t, strings.TrimSpace(out), "dbo")
+}
+
 func TestMssqldefHelp(t *testing.T) {
 	_, err := testutils.Execute("./mssqldef", "--help")
 	if err != nil {
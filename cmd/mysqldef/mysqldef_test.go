@@ -1002,6 +1002,18 @@ func TestMysqldefCreateTableSyntaxError(t *testing.T) {
 	assertApplyFailure(t, "CREATE TABLE users (id bigint,);", `found syntax error when parsing DDL "CREATE TABLE users (id bigint,)": syntax error at position 32`+"\n")
 }
 
+func TestMysqldefForeignKeyNameCollision(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id bigint PRIMARY KEY);\n"
+	createPosts := "CREATE TABLE posts (user_id bigint, CONSTRAINT user_fk FOREIGN KEY (user_id) REFERENCES users (id));\n"
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+
+	createComments := "CREATE TABLE comments (user_id bigint, CONSTRAINT user_fk FOREIGN KEY (user_id) REFERENCES users (id));"
+	assertApplyFailure(t, createUsers+createPosts+createComments,
+		"foreign key 'user_fk' of table 'comments' collides with the foreign key of table 'posts' in the same database: '"+createComments[:len(createComments)-1]+"'\n")
+}
+
 // Both `AUTO_INCREMENT NOT NULL` and `NOT NULL AUTO_INCREMENT` should work
 func TestMysqldefAutoIncrementNotNull(t *testing.T) {
 	resetTestDatabase()
@@ -1346,6 +1358,34 @@ func TestMysqldefTriggerInsert(t *testing.T) {
 	assertApplyOutput(t, createTable+createTriggerForBeforeUpdate, nothingModified)
 }
 
+func TestMysqldefEvent(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE logs (id bigint NOT NULL, dt datetime);\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	createEvent := "CREATE EVENT purge_logs ON SCHEDULE EVERY 1 DAY DO DELETE FROM logs WHERE dt < NOW() - INTERVAL 30 DAY;\n"
+	assertApplyOutput(t, createTable+createEvent, applyPrefix+createEvent)
+	assertApplyOutput(t, createTable+createEvent, nothingModified)
+
+	createEvent = "CREATE EVENT purge_logs ON SCHEDULE EVERY 1 HOUR COMMENT 'hourly' DO DELETE FROM logs WHERE dt < NOW() - INTERVAL 30 DAY;\n"
+	assertApplyOutput(t, createTable+createEvent, applyPrefix+
+		"ALTER EVENT `purge_logs` ON SCHEDULE EVERY 1 HOUR ON COMPLETION NOT PRESERVE ENABLE COMMENT 'hourly' DO delete from logs where dt < now() - interval 30 DAY;\n")
+	assertApplyOutput(t, createTable+createEvent, nothingModified)
+
+	// Events are dropped only with --enable-drop-table
+	assertApplyOutput(t, createTable, nothingModified)
+	writeFile("schema.sql", createTable)
+	out := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+"DROP EVENT `purge_logs`;\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	// The name is quoted in the dumped schema even with a backtick
+	createEvent = "CREATE EVENT `purge``logs` ON SCHEDULE EVERY 1 DAY DO DELETE FROM logs WHERE dt < NOW() - INTERVAL 30 DAY;\n"
+	assertApplyOutput(t, createTable+createEvent, applyPrefix+createEvent)
+	assertApplyOutput(t, createTable+createEvent, nothingModified)
+}
+
 func TestMysqldefTriggerSetNew(t *testing.T) {
 	resetTestDatabase()
 
@@ -1604,6 +1644,46 @@ func TestMysqldefExport(t *testing.T) {
 	assertEquals(t, out, ddls)
 }
 
+func TestMysqldefOverrideDefiner(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id bigint NOT NULL, name varchar(50));\n"
+	createView := "CREATE DEFINER=`mysqldef_definer`@`%` VIEW foo AS select u.id as id from mysqldef_test.users as u;\n"
+	testutils.MustExecute("mysql", "-uroot", "mysqldef_test", "-e", createTable+createView)
+
+	// The definer of the current view differs, but it's overridden in both schemas
+	writeFile("schema.sql", createTable+"CREATE DEFINER=`app`@`%` VIEW foo AS select u.id as id from mysqldef_test.users as u;\n")
+	out := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--dry-run", "--file", "schema.sql")
+	if !strings.Contains(out, "CREATE OR REPLACE DEFINER=`app`@`%` VIEW") {
+		t.Errorf("expected the view to be replaced without override_definer:\n%s", out)
+	}
+	writeFile("config.yml", "override_definer: mysqldef_definer@%\n")
+	out = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--dry-run", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+
+	out = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--export", "--config", "config.yml")
+	if !strings.Contains(out, "CREATE DEFINER=`mysqldef_definer`@`%` SQL SECURITY DEFINER VIEW") {
+		t.Errorf("expected the overridden definer in the export:\n%s", out)
+	}
+
+	out = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--export")
+	if strings.Contains(out, "DEFINER=") {
+		t.Errorf("expected no definer in the export by default:\n%s", out)
+	}
+
+	writeFile("config.yml", "export_definer: true\n")
+	out = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--export", "--config", "config.yml")
+	if !strings.Contains(out, "CREATE DEFINER=`mysqldef_definer`@`%` SQL SECURITY DEFINER VIEW") {
+		t.Errorf("expected the current definer in the export:\n%s", out)
+	}
+
+	writeFile("config.yml", "export_definer: true\nexport_strip_definer: true\n")
+	out = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--export", "--config", "config.yml")
+	if strings.Contains(out, "DEFINER=") {
+		t.Errorf("expected no definer in the export:\n%s", out)
+	}
+}
+
 func TestMysqldefExportConcurrently(t *testing.T) {
 	resetTestDatabase()
 
@@ -1671,6 +1751,35 @@ func TestMysqldefSkipView(t *testing.T) {
 	assertEquals(t, output, nothingModified)
 }
 
+func TestMysqldefPasswordFile(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("mysql", "-uroot", "-e", stripHeredoc(`
+		DROP USER IF EXISTS 'mysqldef_password_user'@'%';
+		CREATE USER 'mysqldef_password_user'@'%' IDENTIFIED BY 'Passw0rd';
+		GRANT ALL ON mysqldef_test.* TO 'mysqldef_password_user'@'%';`,
+	))
+	defer testutils.MustExecute("mysql", "-uroot", "-e", "DROP USER IF EXISTS 'mysqldef_password_user'@'%';")
+	defer os.Remove("password.txt")
+
+	createTable := "CREATE TABLE users (\n  id bigint NOT NULL\n);\n"
+	writeFile("schema.sql", createTable)
+
+	// A CRLF line ending of a file written on Windows is not a part of the password
+	writeFile("password.txt", "Passw0rd\r\n")
+	out := assertedExecute(t, "./mysqldef", "-umysqldef_password_user", "--password-file", "password.txt", "mysqldef_test", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+createTable)
+
+	// --password-file overrides $MYSQL_PWD
+	t.Setenv("MYSQL_PWD", "wrong")
+	out = assertedExecute(t, "./mysqldef", "-umysqldef_password_user", "--password-file", "password.txt", "mysqldef_test", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+
+	_, err := testutils.Execute("./mysqldef", "-umysqldef_password_user", "mysqldef_test", "--file", "schema.sql")
+	if err == nil {
+		t.Error("expected mysqldef with the wrong $MYSQL_PWD to fail")
+	}
+}
+
 func TestMysqldefBeforeApply(t *testing.T) {
 	resetTestDatabase()
 
@@ -1756,6 +1865,95 @@ func TestMysqldefConfigIncludesAlgorithm(t *testing.T) {
 	))
 }
 
+func TestMysqldefConfigIncludesAlgorithmPolicy(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id int NOT NULL,
+		  age int
+		);
+		CREATE TABLE logs (
+		  id int NOT NULL,
+		  message varchar(255)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id int NOT NULL,
+		  age bigint
+		);
+		CREATE TABLE logs (
+		  id int NOT NULL,
+		  message varchar(255),
+		  level int
+		);
+		`,
+	))
+	writeFile("config.yml", stripHeredoc(`
+		algorithm:
+		  default: INPLACE
+		  fallbacks: [COPY]
+		  tables:
+		    logs: COPY
+		lock:
+		  tables:
+		    logs: SHARED
+		`,
+	))
+
+	dryRun := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--dry-run", "--file", "schema.sql")
+	assertEquals(t, dryRun, "-- dry run --\n"+stripHeredoc(`
+		ALTER TABLE `+"`users`"+` CHANGE COLUMN `+"`age` `age`"+` bigint, ALGORITHM=INPLACE;
+		ALTER TABLE `+"`logs`"+` ADD COLUMN `+"`level`"+` int AFTER `+"`message`"+`, ALGORITHM=COPY, LOCK=SHARED;
+		`,
+	))
+
+	// Changing the column type is rejected by INPLACE, and retried with COPY
+	stdout, _, err := testutils.ExecuteSeparately("./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, stdout, applyPrefix+stripHeredoc(`
+		ALTER TABLE `+"`users`"+` CHANGE COLUMN `+"`age` `age`"+` bigint, ALGORITHM=INPLACE;
+		ALTER TABLE `+"`users`"+` CHANGE COLUMN `+"`age` `age`"+` bigint, ALGORITHM=COPY;
+		ALTER TABLE `+"`logs`"+` ADD COLUMN `+"`level`"+` int AFTER `+"`message`"+`, ALGORITHM=COPY, LOCK=SHARED;
+		`,
+	))
+	apply := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestMysqldefMergeAlters(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id int NOT NULL,
+		  age int
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id int NOT NULL,
+		  name varchar(20),
+		  KEY index_name (name)
+		);
+		`,
+	))
+	writeFile("config.yml", "algorithm: |\n  inplace\n")
+	apply := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--merge-alters", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+"ALTER TABLE `users` ADD COLUMN `name` varchar(20) AFTER `id`, ADD KEY `index_name` (`name`), DROP COLUMN `age`, ALGORITHM=INPLACE;\n")
+	apply = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--merge-alters", "--file", "schema.sql")
+	assertEquals(t, apply, nothingModified)
+}
+
 func TestMysqldefConfigIncludesLock(t *testing.T) {
 	resetTestDatabase()
 
@@ -1805,6 +2003,113 @@ func TestMysqldefConfigIncludesLock(t *testing.T) {
 
 }
 
+func TestMysqldefConfigIncludesKeepColumnAttributes(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id int NOT NULL,
+		  name varchar(255) COMMENT 'full name'
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  name varchar(255),
+		  id int NOT NULL
+		);
+		`,
+	)
+
+	writeFile("schema.sql", createTable)
+	writeFile("config.yml", "keep_column_attributes: true\n")
+
+	apply := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+	ALTER TABLE `+"`users`"+` CHANGE COLUMN `+"`name` `name`"+` varchar(255) COMMENT 'full name' FIRST;
+	`,
+	))
+	apply = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestMysqldefConfigIncludesIgnoreColumnOrder(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id int NOT NULL,
+		  name varchar(255)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+
+	createTable = stripHeredoc(`
+		CREATE TABLE users (
+		  name varchar(255),
+		  email varchar(255),
+		  id int NOT NULL
+		);
+		`,
+	)
+
+	writeFile("schema.sql", createTable)
+	writeFile("config.yml", "ignore_column_order: true\n")
+
+	apply := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+	ALTER TABLE `+"`users`"+` ADD COLUMN `+"`email`"+` varchar(255);
+	`,
+	))
+	apply = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--config", "config.yml", "--file", "schema.sql")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestMysqldefConfigIncludesExportNormalization(t *testing.T) {
+	resetTestDatabase()
+
+	testutils.MustExecute("mysql", "-uroot", "mysqldef_test", "-e", stripHeredoc(`
+		CREATE TABLE users (
+		  id int NOT NULL AUTO_INCREMENT PRIMARY KEY,
+		  name varchar(40)
+		) ENGINE=InnoDB DEFAULT CHARSET=latin1 ROW_FORMAT=DYNAMIC;
+		INSERT INTO users (name) VALUES ('a');
+		`,
+	))
+
+	out := assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--export")
+	if !strings.Contains(out, "AUTO_INCREMENT=2") || !strings.Contains(out, "ENGINE=InnoDB") {
+		t.Errorf("expected the table options in the export:\n%s", out)
+	}
+
+	writeFile("config.yml", "export_strip_auto_increment: true\nexport_canonicalize_defaults: true\n")
+	out = assertedExecute(t, "./mysqldef", "-uroot", "mysqldef_test", "--export", "--config", "config.yml")
+	for _, option := range []string{"AUTO_INCREMENT=", "ENGINE=", "ROW_FORMAT="} {
+		if strings.Contains(out, option) {
+			t.Errorf("expected no %s in the export:\n%s", option, out)
+		}
+	}
+	if !strings.HasSuffix(out, ") DEFAULT CHARSET=latin1;\n") {
+		t.Errorf("expected the other table options to be kept:\n%s", out)
+	}
+}
+
+func TestMysqldefGeneratedInvisiblePrimaryKey(t *testing.T) {
+	resetTestDatabase()
+	if _, err := testutils.Execute("mysql", "-uroot", "-e", "SET GLOBAL sql_generate_invisible_primary_key = ON;"); err != nil {
+		t.Skip("sql_generate_invisible_primary_key is not supported")
+	}
+	defer testutils.MustExecute("mysql", "-uroot", "-e", "SET GLOBAL sql_generate_invisible_primary_key = OFF;")
+
+	createTable := "CREATE TABLE users (\n  name varchar(20)\n);\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestMysqldefHelp(t *testing.T) {
 	_, err := testutils.Execute("./mysqldef", "--help")
 	if err != nil {
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
@@ -491,6 +492,42 @@ func TestPsqldefMultiColumnCheck(t *testing.T) {
 	assertApplyOutput(t, createTable, nothingModified)
 }
 
+func TestPsqldefCheckNotValid(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL PRIMARY KEY,
+		  amount integer
+		);
+		`)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	mustExecuteSQL("INSERT INTO orders VALUES (1, -1);")
+
+	createTable = stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL PRIMARY KEY,
+		  amount integer,
+		  CONSTRAINT amount_check CHECK (amount >= 0) NOT VALID
+		);
+		`)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."orders" ADD CONSTRAINT "amount_check" CHECK (amount >= 0) NOT VALID;`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+
+	mustExecuteSQL("UPDATE orders SET amount = 1;")
+	createTable = stripHeredoc(`
+		CREATE TABLE orders (
+		  id integer NOT NULL PRIMARY KEY,
+		  amount integer,
+		  CONSTRAINT amount_check CHECK (amount >= 0)
+		);
+		`)
+	assertApplyOutput(t, createTable, applyPrefix+
+		`ALTER TABLE "public"."orders" VALIDATE CONSTRAINT "amount_check";`+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
 func TestPsqlddefCreatePolicy(t *testing.T) {
 	resetTestDatabase()
 
@@ -1068,7 +1105,8 @@ func TestPsqldefCreateIdentityColumnWithSequenceOption(t *testing.T) {
 		`,
 	)
 
-	// not support changing sequence option
+	alter := `ALTER TABLE "public"."voltages" ALTER COLUMN "volt" SET START WITH -100 SET INCREMENT BY 5 SET MINVALUE -100 SET MAXVALUE 100;`
+	assertApplyOutput(t, createTableWithSequence2, applyPrefix+alter+"\n")
 	assertApplyOutput(t, createTableWithSequence2, nothingModified)
 }
 
@@ -1139,6 +1177,83 @@ func TestPsqldefAddIdentityColumnWithSequenceOption(t *testing.T) {
 	assertApplyOutput(t, createTableWithoutSequence, nothingModified)
 }
 
+func TestPsqldefChangeIdentityColumnSequenceOption(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := stripHeredoc(`
+		CREATE TABLE voltages (
+		  volt bigint GENERATED BY DEFAULT AS IDENTITY (START WITH 10 CACHE 5)
+		);
+		`,
+	)
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	assertApplyOutput(t, createTable, nothingModified)
+	assertExportOutput(t, stripHeredoc(`
+		CREATE TABLE "public"."voltages" (
+		    "volt" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY (START WITH 10 CACHE 5)
+		);
+		`,
+	))
+
+	createTable = stripHeredoc(`
+		CREATE TABLE voltages (
+		  volt bigint GENERATED ALWAYS AS IDENTITY (START WITH 10 CACHE 10 CYCLE)
+		);
+		`,
+	)
+	alter := `ALTER TABLE "public"."voltages" ALTER COLUMN "volt" SET GENERATED ALWAYS SET CACHE 10 SET CYCLE;`
+	assertApplyOutput(t, createTable, applyPrefix+alter+"\n")
+	assertApplyOutput(t, createTable, nothingModified)
+}
+
+func TestPsqldefConfigIncludesRestartIdentity(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE voltages (volt bigint GENERATED BY DEFAULT AS IDENTITY (START WITH 10));\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	mustExecuteSQL("INSERT INTO voltages DEFAULT VALUES;")
+
+	writeFile("schema.sql", "CREATE TABLE voltages (volt bigint GENERATED BY DEFAULT AS IDENTITY (START WITH 100));\n")
+	writeFile("config.yml", "restart_identity: true\n")
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+`ALTER TABLE "public"."voltages" ALTER COLUMN "volt" SET START WITH 100 RESTART WITH 100;`+"\n")
+	mustExecuteSQL("INSERT INTO voltages DEFAULT VALUES;")
+	volts, err := executeSQL("SELECT string_agg(volt::text, ',' ORDER BY volt) FROM voltages;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(volts, "10,100") {
+		t.Errorf("expected the sequence to be restarted, but got: %s", volts)
+	}
+}
+
+func TestPsqldefSerialToIdentity(t *testing.T) {
+	resetTestDatabase()
+
+	createTable := "CREATE TABLE users (id serial NOT NULL);\n"
+	assertApplyOutput(t, createTable, applyPrefix+createTable)
+	mustExecuteSQL("INSERT INTO users DEFAULT VALUES; INSERT INTO users DEFAULT VALUES;")
+
+	// The sequence of the identity takes over the value of the sequence owned by the serial column
+	createTable = "CREATE TABLE users (id int GENERATED BY DEFAULT AS IDENTITY);\n"
+	assertApplyOutput(t, createTable, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" ALTER COLUMN "id" DROP DEFAULT;
+		ALTER SEQUENCE "public"."users_id_seq" RENAME TO "users_id_serial_seq";
+		ALTER TABLE "public"."users" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY;
+		SELECT setval('"public"."users_id_seq"', last_value, is_called) FROM "public"."users_id_serial_seq";
+		DROP SEQUENCE "public"."users_id_serial_seq";
+	`))
+	assertApplyOutput(t, createTable, nothingModified)
+	mustExecuteSQL("INSERT INTO users DEFAULT VALUES;")
+	ids, err := executeSQL("SELECT string_agg(id::text, ',' ORDER BY id) FROM users;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(ids, "1,2,3") {
+		t.Errorf("expected the identity to continue from the serial, but got: %s", ids)
+	}
+}
+
 func TestPsqldefAddUniqueConstraintToTableInNonpublicSchema(t *testing.T) {
 	resetTestDatabase()
 	mustExecuteSQL("CREATE SCHEMA test;")
@@ -1209,6 +1324,45 @@ func TestPsqldefFunctionAsDefault(t *testing.T) {
 	}
 }
 
+func TestPsqldefCreateTrigger(t *testing.T) {
+	resetTestDatabase()
+	mustExecuteSQL(stripHeredoc(`
+		CREATE FUNCTION public.log_users()
+		RETURNS trigger
+		AS $$
+		BEGIN
+		  RETURN NULL;
+		END
+		$$
+		LANGUAGE plpgsql;`))
+
+	createTable := stripHeredoc(`
+		CREATE TABLE users (
+		  id integer,
+		  name text
+		);
+		`)
+	createTrigger := stripHeredoc(`
+		CREATE TRIGGER users_row AFTER INSERT OR UPDATE ON users FOR EACH ROW WHEN (NEW.name <> 'admin') EXECUTE FUNCTION log_users();
+		CREATE TRIGGER users_statement AFTER DELETE ON users FOR EACH STATEMENT EXECUTE FUNCTION log_users();
+		`)
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+createTable+
+		`CREATE TRIGGER "users_row" after insert OR update ON "public"."users" FOR EACH ROW WHEN (NEW.name != 'admin') EXECUTE FUNCTION public.log_users();`+"\n"+
+		`CREATE TRIGGER "users_statement" after delete ON "public"."users" FOR EACH STATEMENT EXECUTE FUNCTION public.log_users();`+"\n")
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+
+	createTrigger = stripHeredoc(`
+		CREATE TRIGGER users_row AFTER INSERT OR UPDATE ON users FOR EACH ROW WHEN (NEW.name <> 'root') EXECUTE FUNCTION log_users();
+		CREATE TRIGGER users_statement AFTER DELETE ON users FOR EACH ROW EXECUTE FUNCTION log_users();
+		`)
+	assertApplyOutput(t, createTable+createTrigger, applyPrefix+
+		`DROP TRIGGER "users_row" ON "public"."users";`+"\n"+
+		`CREATE TRIGGER "users_row" after insert OR update ON "public"."users" FOR EACH ROW WHEN (NEW.name != 'root') EXECUTE FUNCTION public.log_users();`+"\n"+
+		`DROP TRIGGER "users_statement" ON "public"."users";`+"\n"+
+		`CREATE TRIGGER "users_statement" after delete ON "public"."users" FOR EACH ROW EXECUTE FUNCTION public.log_users();`+"\n")
+	assertApplyOutput(t, createTable+createTrigger, nothingModified)
+}
+
 //
 // ----------------------- following tests are for CLI -----------------------
 //
@@ -1293,6 +1447,63 @@ func TestPsqldefExport(t *testing.T) {
 	))
 }
 
+func TestPsqldefExportPartitionIndexes(t *testing.T) {
+	resetTestDatabase()
+	mustExecuteSQL(stripHeredoc(`
+		CREATE TABLE events (id bigint, created_at date) PARTITION BY RANGE (created_at);
+		CREATE TABLE events_2024 PARTITION OF events FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');
+		CREATE INDEX events_created_at_idx ON events (created_at);`,
+	))
+
+	// The index of the partition is attached to the one of the partitioned table, which is exported instead.
+	out := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "--export")
+	if !strings.Contains(out, `CREATE INDEX events_created_at_idx ON ONLY public.events USING btree (created_at);`) {
+		t.Errorf("expected the index of the partitioned table to be exported: %s", out)
+	}
+	if strings.Contains(out, "events_2024_created_at_idx") {
+		t.Errorf("expected the index of the partition not to be exported: %s", out)
+	}
+
+	writeFile("schema.sql", out)
+	assertEquals(t, assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "--enable-drop-table", "--file", "schema.sql"), nothingModified)
+}
+
+func TestPsqldefInherits(t *testing.T) {
+	resetTestDatabase()
+
+	createTables := stripHeredoc(`
+		CREATE TABLE cities (
+		    name text,
+		    population integer
+		);
+		CREATE TABLE capitals (
+		    state text
+		) INHERITS (cities);
+		`,
+	)
+	assertApplyOutput(t, createTables, applyPrefix+createTables)
+	assertApplyOutput(t, createTables, nothingModified)
+
+	// The columns inherited from cities are managed only by cities
+	assertExportOutput(t, stripHeredoc(`
+		CREATE TABLE "public"."cities" (
+		    "name" text,
+		    "population" integer
+		);
+
+		CREATE TABLE "public"."capitals" (
+		    "state" text
+		) INHERITS ("public"."cities");
+		`,
+	))
+	createTables = strings.Replace(createTables, "population integer", "population integer,\n    altitude integer", 1)
+	assertApplyOutput(t, createTables, applyPrefix+`ALTER TABLE "public"."cities" ADD COLUMN "altitude" integer;`+"\n")
+	assertApplyOutput(t, createTables, nothingModified)
+
+	withoutInherits := strings.Replace(createTables, " INHERITS (cities)", "", 1)
+	assertApplyOutput(t, withoutInherits, applyPrefix+`ALTER TABLE "public"."capitals" NO INHERIT "public"."cities";`+"\n")
+}
+
 func TestPsqldefExportCompositePrimaryKey(t *testing.T) {
 	resetTestDatabase()
 
@@ -1451,6 +1662,92 @@ func TestPsqldefConfigIncludesTargetTables(t *testing.T) {
 	assertEquals(t, apply, nothingModified)
 }
 
+func TestPsqldefDefaultSchema(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecuteSQL("CREATE SCHEMA app;")
+	createTable := "CREATE TABLE users (id bigint);\n"
+	writeFile("schema.sql", createTable)
+
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--default-schema", "app")
+	assertEquals(t, apply, applyPrefix+createTable)
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--default-schema", "app")
+	assertEquals(t, apply, nothingModified)
+
+	// The table is created in the given schema rather than public
+	assertExportOutput(t, stripHeredoc(`
+		CREATE SCHEMA "app";
+
+		CREATE TABLE "app"."users" (
+		    "id" bigint
+		);
+		`))
+}
+
+func TestPsqldefConfigIncludesTimeouts(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecuteSQL("CREATE TABLE users (id bigint PRIMARY KEY);")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (id bigint PRIMARY KEY, name text);
+		CREATE INDEX index_name ON users (name);
+	`))
+	writeFile("config.yml", stripHeredoc(`
+		timeouts:
+		  index_build:
+		    statement_timeout: 1h
+		  default:
+		    statement_timeout: 5m
+		    lock_timeout: 10s
+	`))
+
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		SET LOCAL statement_timeout = '5m';
+		SET LOCAL lock_timeout = '10s';
+		ALTER TABLE "public"."users" ADD COLUMN "name" text;
+		SET LOCAL statement_timeout = '1h';
+		SET LOCAL lock_timeout TO DEFAULT;
+		CREATE INDEX index_name ON users (name);
+	`))
+
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestPsqldefConfigIncludesReferenceSchemas(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecuteSQL(`
+		CREATE SCHEMA auth;
+		CREATE TABLE auth.users (id bigint PRIMARY KEY, email text);
+	`)
+
+	createPosts := stripHeredoc(`
+		CREATE TABLE posts (
+		  id bigint PRIMARY KEY,
+		  user_id bigint,
+		  CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES auth.users (id)
+		);
+	`)
+	// auth.users in the desired SQL is different from the actual one, but it's never altered
+	writeFile("schema.sql", createPosts+"CREATE TABLE auth.users (id bigint PRIMARY KEY);\n")
+	writeFile("config.yml", "reference_schemas: [auth]\n")
+
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--enable-drop-table", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+createPosts)
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--enable-drop-table", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+
+	writeFile("schema.sql", "CREATE TABLE posts (id bigint PRIMARY KEY, user_id bigint REFERENCES auth.accounts (id));\n")
+	out, err := testutils.Execute("./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	if err == nil {
+		t.Errorf("expected a reference to a missing table in reference_schemas to fail, but got: %s", out)
+	}
+	assertEquals(t, out, "foreign key posts_user_id_fkey on public.posts refers to auth.accounts, which doesn't exist in the reference schema auth\n")
+}
+
 func TestPsqldefConfigIncludesTargetSchema(t *testing.T) {
 	resetTestDatabase()
 
@@ -1516,6 +1813,411 @@ func TestPsqldefConfigIncludesSkipTables(t *testing.T) {
 	assertEquals(t, apply, nothingModified)
 }
 
+func TestPsqldefConfigIncludesRenamedSequences(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecuteSQL("CREATE TABLE old_users (id bigserial PRIMARY KEY, name text);")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigserial PRIMARY KEY,
+		  name text
+		);
+		`,
+	))
+	writeFile("config.yml", stripHeredoc(`
+		renames:
+		  tables: |
+		    old_users -> users
+		  sequences: true
+		`,
+	))
+
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."old_users" RENAME TO "users";
+		ALTER SEQUENCE "public"."old_users_id_seq" RENAME TO "users_id_seq";
+		`,
+	))
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+
+	sequence := testutils.MustExecute("psql", "-Upostgres", databaseName, "-tAc", "SELECT pg_get_serial_sequence('users', 'id');")
+	assertEquals(t, sequence, "public.users_id_seq\n")
+}
+
+func TestPsqldefConfigIncludesTypeConversions(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecuteSQL("CREATE TABLE users (id bigint PRIMARY KEY, age text);")
+	mustExecuteSQL("INSERT INTO users VALUES (1, '20');")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint PRIMARY KEY,
+		  age integer
+		);
+		`,
+	))
+	writeFile("config.yml", stripHeredoc(`
+		type_conversions:
+		  users.age: age::integer
+		`,
+	))
+
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" ALTER COLUMN "age" TYPE integer USING age::integer;
+		`,
+	))
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+
+	age := testutils.MustExecute("psql", "-Upostgres", databaseName, "-tAc", "SELECT age + 1 FROM users;")
+	assertEquals(t, age, "21\n")
+}
+
+func TestPsqldefConfigIncludesTransactionMode(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecuteSQL("CREATE TABLE users (id bigint PRIMARY KEY);")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (id bigint PRIMARY KEY, name text);
+		CREATE INDEX CONCURRENTLY index_name ON users (name);
+	`))
+	writeFile("config.yml", "transaction_mode: all\n")
+	out, err := testutils.Execute("./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	if err == nil {
+		t.Errorf("expected CREATE INDEX CONCURRENTLY to fail with transaction_mode 'all', but got: %s", out)
+	}
+
+	writeFile("config.yml", "transaction_mode: per-statement\n")
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" ADD COLUMN "name" text;
+		CREATE INDEX CONCURRENTLY index_name ON users (name);
+	`))
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+
+	writeFile("config.yml", "transaction_mode: none\n")
+	out, err = testutils.Execute("./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	if err == nil {
+		t.Errorf("expected an unknown transaction_mode to fail, but got: %s", out)
+	}
+}
+
+func TestPsqldefConfigIncludesSafeNotNull(t *testing.T) {
+	resetTestDatabase()
+
+	mustExecuteSQL("CREATE TABLE users (id bigint PRIMARY KEY, name text);")
+	mustExecuteSQL("INSERT INTO users VALUES (1, 'alice');")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (
+		  id bigint PRIMARY KEY,
+		  name text NOT NULL
+		);
+		`,
+	))
+	writeFile("config.yml", "safe_not_null: true\n")
+
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		ALTER TABLE "public"."users" ADD CONSTRAINT "users_name_not_null" CHECK ("name" IS NOT NULL) NOT VALID;
+		-- Committed before validating the constraint --
+		ALTER TABLE "public"."users" VALIDATE CONSTRAINT "users_name_not_null";
+		ALTER TABLE "public"."users" ALTER COLUMN "name" SET NOT NULL;
+		ALTER TABLE "public"."users" DROP CONSTRAINT "users_name_not_null";
+		`,
+	))
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestPsqldefConfigIncludesAutoCreateSchema(t *testing.T) {
+	resetTestDatabase()
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE xyz.users (
+		    id bigint NOT NULL
+		);
+		CREATE VIEW xyz.user_ids AS SELECT id FROM xyz.users;
+		`,
+	))
+	writeFile("config.yml", "auto_create_schema: true\n")
+
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		CREATE SCHEMA IF NOT EXISTS "xyz";
+		CREATE TABLE xyz.users (
+		    id bigint NOT NULL
+		);
+		CREATE VIEW xyz.user_ids AS SELECT id FROM xyz.users;
+		`,
+	))
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestPsqldefConfigIncludesAuth(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", "CREATE TABLE users (id bigint);\n")
+
+	writeFile("config.yml", "auth: password\n")
+	out, err := testutils.Execute("./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	if err == nil || !strings.Contains(out, "unknown auth 'password'") {
+		t.Errorf("expected an unknown auth to fail, but got: %s", out)
+	}
+
+	// The token is sent as the password, so SSL is required
+	writeFile("config.yml", "auth: rds-iam\n")
+	t.Setenv("PGSSLMODE", "disable")
+	out, err = testutils.Execute("./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	if err == nil || !strings.Contains(out, "auth 'rds-iam' can't be used with PGSSLMODE=disable") {
+		t.Errorf("expected auth without SSL to fail, but got: %s", out)
+	}
+
+	// The token is generated when connecting, so the missing credentials fail there
+	t.Setenv("PGSSLMODE", "require")
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "")
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv("AWS_REGION", "us-east-1")
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	out, err = testutils.Execute("./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	if err == nil || !strings.Contains(out, "auth 'rds-iam' failed to get the AWS credentials") {
+		t.Errorf("expected auth without AWS credentials to fail, but got: %s", out)
+	}
+}
+
+func TestPsqldefAlterColumnTypeUsedByFunction(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", "CREATE TABLE users (id bigint, name varchar(40));\n")
+	assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql")
+
+	db, err := connectDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	var version int
+	if err := db.DB().QueryRow("SHOW server_version_num").Scan(&version); err != nil {
+		t.Fatal(err)
+	}
+	if version < 140000 {
+		t.Skip("functions with a body of SQL standard need PostgreSQL 14 or later")
+	}
+	if _, err := db.DB().Exec("CREATE FUNCTION user_name(user_id bigint) RETURNS varchar BEGIN ATOMIC SELECT name FROM users WHERE id = user_id; END"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Fail before applying any DDL, and even with --dry-run
+	writeFile("schema.sql", "CREATE TABLE users (id bigint, name text);\n")
+	for _, args := range [][]string{{"--dry-run"}, {}} {
+		out, err := testutils.Execute("./psqldef", append([]string{"-Upostgres", databaseName, "-f", "schema.sql"}, args...)...)
+		if err == nil || !strings.Contains(out, `user_name(bigint) uses "public"."users"."name"`) {
+			t.Errorf("expected altering the type of a column used by a function to fail, but got: %s", out)
+		}
+	}
+}
+
+func TestPsqldefApplyLock(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", "CREATE TABLE users (id bigint);\n")
+
+	// Hold the lock like another run applying to the database
+	db, err := connectDatabase()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	transaction, err := db.DB().Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := transaction.Exec("SELECT pg_advisory_xact_lock(hashtext('sqldef'))"); err != nil {
+		t.Fatal(err)
+	}
+	out, err := testutils.Execute("./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--apply-lock", "--wait-timeout", "1s")
+	if err == nil || !strings.Contains(out, "failed to take the lock of --apply-lock") {
+		t.Errorf("expected waiting for the lock to time out, but got: %s", out)
+	}
+	transaction.Rollback()
+
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--apply-lock", "--wait-timeout", "1s")
+	assertEquals(t, apply, applyPrefix+"CREATE TABLE users (id bigint);\n")
+}
+
+func TestPsqldefReportPrivileges(t *testing.T) {
+	resetTestDatabase()
+	for _, role := range []string{"sqldef_app", "sqldef_readonly"} {
+		testutils.MustExecute("psql", "-Upostgres", "-c", fmt.Sprintf("DO $$ BEGIN IF NOT EXISTS (SELECT * FROM pg_roles WHERE rolname = '%s') THEN CREATE ROLE %s; END IF; END $$;", role, role))
+	}
+	mustExecuteSQL("CREATE TABLE users (id bigint); CREATE TABLE logs (id bigint);")
+	mustExecuteSQL("GRANT SELECT, INSERT ON users TO sqldef_app; GRANT INSERT ON logs TO sqldef_app; GRANT SELECT, DELETE ON users TO sqldef_readonly;")
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (id bigint);
+		CREATE TABLE logs (id bigint);
+		GRANT SELECT, INSERT ON users, logs TO sqldef_app;
+		GRANT SELECT ON users TO sqldef_readonly;
+		`,
+	))
+
+	report := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--report-privileges")
+	assertEquals(t, report, stripHeredoc(`
+		ROLE             TABLE         CURRENT         DESIRED         DRIFT
+		sqldef_app       public.logs   INSERT          INSERT, SELECT  +SELECT
+		sqldef_app       public.users  INSERT, SELECT  INSERT, SELECT
+		sqldef_readonly  public.users  DELETE, SELECT  SELECT          -DELETE
+		-- 2 of 3 role and table pairs have drifted privileges --
+		-- GRANTs and REVOKEs to resolve the drift, which are not run --
+		GRANT SELECT ON TABLE "public"."logs" TO "sqldef_app";
+		REVOKE DELETE ON TABLE "public"."users" FROM "sqldef_readonly";
+		`,
+	))
+
+	// The GRANTs are never applied
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql")
+	assertEquals(t, apply, nothingModified)
+
+	// WITH GRANT OPTION is compared as well
+	mustExecuteSQL("GRANT DELETE ON users TO sqldef_readonly WITH GRANT OPTION;")
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (id bigint);
+		CREATE TABLE logs (id bigint);
+		GRANT SELECT, INSERT ON users, logs TO sqldef_app;
+		GRANT SELECT ON users TO sqldef_readonly WITH GRANT OPTION;
+		`,
+	))
+	report = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--report-privileges")
+	assertEquals(t, report, stripHeredoc(`
+		ROLE             TABLE         CURRENT          DESIRED         DRIFT
+		sqldef_app       public.logs   INSERT           INSERT, SELECT  +SELECT
+		sqldef_app       public.users  INSERT, SELECT   INSERT, SELECT
+		sqldef_readonly  public.users  DELETE*, SELECT  SELECT*         +SELECT* -DELETE*
+		-- 2 of 3 role and table pairs have drifted privileges --
+		-- GRANTs and REVOKEs to resolve the drift, which are not run --
+		GRANT SELECT ON TABLE "public"."logs" TO "sqldef_app";
+		GRANT SELECT ON TABLE "public"."users" TO "sqldef_readonly" WITH GRANT OPTION;
+		REVOKE DELETE ON TABLE "public"."users" FROM "sqldef_readonly";
+		`,
+	))
+}
+
+func TestPsqldefConfigIncludesManagedRoles(t *testing.T) {
+	resetTestDatabase()
+	for _, role := range []string{"sqldef_owner_a", "sqldef_owner_b"} {
+		testutils.MustExecute("psql", "-Upostgres", "-c", fmt.Sprintf("DO $$ BEGIN IF NOT EXISTS (SELECT * FROM pg_roles WHERE rolname = '%s') THEN CREATE ROLE %s; END IF; END $$;", role, role))
+	}
+
+	writeFile("config.yml", "managed_roles: |\n  sqldef_owner_a\n  sqldef_owner_b\n")
+
+	createTable := "CREATE TABLE users (id bigint);\n"
+	createView := "CREATE VIEW user_views AS SELECT id FROM users;\n"
+
+	// Owners of unmanaged roles are ignored
+	writeFile("schema.sql", createTable+"ALTER TABLE users OWNER TO postgres;\n")
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+createTable)
+
+	writeFile("schema.sql", createTable+"ALTER TABLE users OWNER TO sqldef_owner_a;\n"+createView+"ALTER VIEW user_views OWNER TO sqldef_owner_b;\n")
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+
+		"ALTER TABLE \"public\".\"users\" OWNER TO \"sqldef_owner_a\";\n"+
+		createView+
+		"ALTER VIEW \"public\".\"user_views\" OWNER TO \"sqldef_owner_b\";\n",
+	)
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+
+	writeFile("schema.sql", createTable+"ALTER TABLE users OWNER TO sqldef_owner_b;\n"+createView+"ALTER VIEW user_views OWNER TO sqldef_owner_b;\n")
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+"ALTER TABLE \"public\".\"users\" OWNER TO \"sqldef_owner_b\";\n")
+
+	// Without managed_roles, owners are neither dumped nor changed
+	export := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "--export")
+	if strings.Contains(export, "OWNER TO") {
+		t.Errorf("expected no OWNER TO in export but got '%s'", export)
+	}
+}
+
+func TestPsqldefConfigIncludesManagedRolesForRoles(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("psql", "-Upostgres", "-c", "DROP ROLE IF EXISTS sqldef_role_app, sqldef_role_reader, sqldef_role_unmanaged;")
+	defer testutils.MustExecute("psql", "-Upostgres", "-c", "DROP ROLE IF EXISTS sqldef_role_app, sqldef_role_reader, sqldef_role_unmanaged;")
+
+	writeFile("config.yml", "managed_roles: |\n  sqldef_role_app\n  sqldef_role_reader\n")
+
+	// Passwords are never managed, and roles which are not listed in managed_roles are ignored
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE ROLE sqldef_role_reader;
+		CREATE ROLE sqldef_role_app WITH LOGIN PASSWORD 'secret' CONNECTION LIMIT 10 IN ROLE sqldef_role_reader;
+		CREATE ROLE sqldef_role_unmanaged;
+		`,
+	))
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		CREATE ROLE "sqldef_role_reader";
+		CREATE ROLE "sqldef_role_app" WITH LOGIN CONNECTION LIMIT 10;
+		GRANT "sqldef_role_reader" TO "sqldef_role_app";
+		`,
+	))
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, nothingModified)
+
+	export := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "--export", "--config", "config.yml")
+	if !strings.Contains(export, `CREATE ROLE "sqldef_role_app" WITH LOGIN CONNECTION LIMIT 10 IN ROLE "sqldef_role_reader";`) {
+		t.Errorf("expected CREATE ROLE in export but got '%s'", export)
+	}
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE ROLE sqldef_role_reader;
+		CREATE ROLE sqldef_role_app WITH NOINHERIT CREATEDB;
+		`,
+	))
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		ALTER ROLE "sqldef_role_app" WITH NOLOGIN CREATEDB NOINHERIT CONNECTION LIMIT -1;
+		REVOKE "sqldef_role_reader" FROM "sqldef_role_app";
+		`,
+	))
+
+	// Roles are never dropped
+	writeFile("schema.sql", "")
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "-f", "schema.sql", "--config", "config.yml", "--enable-drop-table")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestPsqldefMultipleDatabases(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("psql", "-Upostgres", "-c", "DROP DATABASE IF EXISTS psqldef_test2;")
+	testutils.MustExecute("psql", "-Upostgres", "-c", "CREATE DATABASE psqldef_test2;")
+	defer testutils.MustExecute("psql", "-Upostgres", "-c", "DROP DATABASE IF EXISTS psqldef_test2;")
+
+	createTable := "CREATE TABLE users (\n  id bigint PRIMARY KEY\n);\n"
+	writeFile("schema.sql", createTable)
+	apply := assertedExecute(t, "./psqldef", "-Upostgres", databaseName, "psqldef_test2", "--file", "schema.sql")
+	assertEquals(t, apply, "-- Database: psqldef_test (1/2) --\n"+applyPrefix+createTable+
+		"-- Database: psqldef_test2 (2/2) --\n"+applyPrefix+createTable+
+		"-- Done with 2 databases --\n")
+
+	query := "SELECT datname FROM pg_database WHERE datname LIKE 'psqldef_test%' ORDER BY datname"
+	apply = assertedExecute(t, "./psqldef", "-Upostgres", "postgres", "--file", "schema.sql", "--database-query", query)
+	assertEquals(t, apply, "-- Database: psqldef_test (1/2) --\n"+nothingModified+
+		"-- Database: psqldef_test2 (2/2) --\n"+nothingModified+
+		"-- Done with 2 databases --\n")
+
+	out, err := testutils.Execute("./psqldef", "-Upostgres", databaseName, "psqldef_test2", "--export")
+	if err == nil {
+		t.Errorf("--export with multiple databases must be error, but successfully got: %s", out)
+	}
+}
+
 func TestPsqldefHelp(t *testing.T) {
 	_, err := testutils.Execute("./psqldef", "--help")
 	if err != nil {
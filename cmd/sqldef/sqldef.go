@@ -0,0 +1,291 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/sqldef/sqldef"
+	"github.com/sqldef/sqldef/cmd/internal/mssqldef"
+	"github.com/sqldef/sqldef/cmd/internal/mysqldef"
+	"github.com/sqldef/sqldef/cmd/internal/psqldef"
+	"github.com/sqldef/sqldef/cmd/internal/sqlite3def"
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/database/mssql"
+	"github.com/sqldef/sqldef/database/postgres"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/sqldef/sqldef/schema"
+)
+
+var version string
+
+type dialect struct {
+	name          string
+	command       string
+	main          func(name string, args []string, version string, configure ...func(*sqldef.Options))
+	mode          schema.GeneratorMode
+	newParser     func() database.Parser
+	defaultSchema string
+}
+
+// Dialect subcommands, which take the same options as the command of each dialect, e.g. `sqldef mysql` as `mysqldef`.
+var commands = []dialect{
+	{"mysql", "mysqldef", mysqldef.Main, schema.GeneratorModeMysql, func() database.Parser { return database.NewParser(parser.ParserModeMysql) }, ""},
+	{"postgres", "psqldef", psqldef.Main, schema.GeneratorModePostgres, func() database.Parser { return postgres.NewParser() }, "public"},
+	{"sqlite3", "sqlite3def", sqlite3def.Main, schema.GeneratorModeSQLite3, func() database.Parser { return database.NewParser(parser.ParserModeSQLite3) }, ""},
+	{"mssql", "mssqldef", mssqldef.Main, schema.GeneratorModeMssql, func() database.Parser { return mssql.NewParser() }, "dbo"},
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprint(w, "Usage:\n  sqldef DIALECT [OPTIONS] [database|current.sql] < desired.sql\n"+
+		"  sqldef snapshot DIALECT --out FILE [OPTIONS] database\n"+
+		"  sqldef restore DIALECT --from FILE [OPTIONS] database\n"+
+		"  sqldef convert FROM TO [--file FILE] < schema.sql\n"+
+		"  sqldef fmt DIALECT [--file FILE] [--write] < schema.sql\n"+
+		"  sqldef docs DIALECT [OPTIONS] database\n"+
+		"  sqldef docs DIALECT --file FILE\n"+
+		"  sqldef inspect DIALECT [--format json] [OPTIONS] database\n"+
+		"  sqldef inspect DIALECT [--format json] --file FILE\n\nDialects:\n")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s the same as %s\n", c.name, c.command)
+	}
+	fmt.Fprint(w, "\nCommands:\n"+
+		"  snapshot   export the schema (without data) to FILE in the order of dependencies\n"+
+		"  restore    create the schema in FILE on an empty database in the order of dependencies\n"+
+		"  convert    convert the schema from a dialect into another on a best-effort basis\n"+
+		"  fmt        print the schema in the style sqldef prints DDLs, or rewrite FILE with --write\n"+
+		"  docs       print Markdown documentation of the schema of the database, or of FILE with --file\n"+
+		"  inspect    print the tables, views, and types of the database, or of FILE with --file, as JSON\n")
+	fmt.Fprint(w, "\nRun `sqldef DIALECT --help` to show the options of the dialect.\n")
+}
+
+// Remove the option with a value, e.g. `--out FILE` or `--out=FILE`, from the arguments and return its value.
+func extractOption(args []string, option string) (string, []string) {
+	var value string
+	var rest []string
+	for i := 0; i < len(args); i++ {
+		if args[i] == option && i+1 < len(args) {
+			value = args[i+1]
+			i++
+		} else if strings.HasPrefix(args[i], option+"=") {
+			value = strings.TrimPrefix(args[i], option+"=")
+		} else {
+			rest = append(rest, args[i])
+		}
+	}
+	return value, rest
+}
+
+// Return the dialect given as the first argument of the subcommand and the rest of the arguments, or exit with the
+// usage when it's missing or unknown.
+func findDialect(command string, args []string) (dialect, []string) {
+	if len(args) < 1 {
+		fmt.Fprintf(os.Stderr, "No dialect is specified for %s!\n\n", command)
+		printUsage(os.Stderr)
+		os.Exit(1)
+	}
+	for _, c := range commands {
+		if args[0] == c.name {
+			return c, args[1:]
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Unknown dialect is given: %s\n\n", args[0])
+	printUsage(os.Stderr)
+	os.Exit(1)
+	return dialect{}, nil
+}
+
+// Run `sqldef snapshot DIALECT --out FILE` or `sqldef restore DIALECT --from FILE` as the command of the dialect.
+func runSnapshotCommand(command string, args []string) {
+	c, args := findDialect(command, args)
+	name := "sqldef " + command + " " + c.name
+	if command == "snapshot" {
+		out, rest := extractOption(args, "--out")
+		if len(out) == 0 {
+			log.Fatal("--out FILE is required for snapshot")
+		}
+		c.main(name, append(rest, "--export"), version, func(options *sqldef.Options) {
+			options.Snapshot = out
+		})
+	} else {
+		from, rest := extractOption(args, "--from")
+		if len(from) == 0 {
+			log.Fatal("--from FILE is required for restore")
+		}
+		c.main(name, append(rest, "--file", from), version, func(options *sqldef.Options) {
+			options.Restore = true
+		})
+	}
+}
+
+// Run `sqldef convert FROM TO`, which prints the schema in the FROM dialect as DDLs of the TO dialect. What can't be
+// converted as is, e.g. dropped options, is reported to stderr.
+func runConvertCommand(args []string) {
+	file, rest := extractOption(args, "--file")
+	if len(rest) != 2 {
+		fmt.Fprint(os.Stderr, "FROM and TO dialects are required for convert!\n\n")
+		printUsage(os.Stderr)
+		os.Exit(1)
+	}
+	if len(file) == 0 {
+		file = "-"
+	}
+
+	dialects := map[string]int{}
+	for i, c := range commands {
+		dialects[c.name] = i
+	}
+	for _, name := range rest {
+		if _, ok := dialects[name]; !ok {
+			fmt.Fprintf(os.Stderr, "Unknown dialect is given: %s\n\n", name)
+			printUsage(os.Stderr)
+			os.Exit(1)
+		}
+	}
+	from, to := commands[dialects[rest[0]]], commands[dialects[rest[1]]]
+
+	ddls, err := schema.ParseDDLs(from.mode, from.newParser(), readSchemaFile(file), from.defaultSchema)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	converted, notes := schema.ConvertDDLs(from.mode, to.mode, ddls, from.defaultSchema, to.defaultSchema)
+	for _, ddl := range converted {
+		fmt.Printf("%s;\n", ddl)
+		if to.mode == schema.GeneratorModeMssql {
+			fmt.Print("GO\n")
+		}
+	}
+	for _, note := range notes {
+		fmt.Fprintf(os.Stderr, "-- Note: %s --\n", note)
+	}
+}
+
+// Run `sqldef fmt DIALECT`, which prints the schema re-printed in the canonical style, or rewrites the file with --write.
+func runFmtCommand(args []string) {
+	file, rest := extractOption(args, "--file")
+	write := false
+	var dialects []string
+	for _, arg := range rest {
+		if arg == "--write" {
+			write = true
+		} else {
+			dialects = append(dialects, arg)
+		}
+	}
+	c, extra := findDialect("fmt", dialects)
+	if len(extra) > 0 {
+		log.Fatalf("only a dialect can be given for fmt, but got: %s", strings.Join(extra, " "))
+	}
+	if len(file) == 0 {
+		file = "-"
+	}
+	if write && file == "-" {
+		log.Fatal("--write can't rewrite stdin, so give the file by --file")
+	}
+
+	sql := readSchemaFile(file)
+	formatted, err := schema.FormatSQL(c.mode, c.newParser(), sql, c.defaultSchema)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if !write {
+		fmt.Print(formatted)
+	} else if formatted != sql {
+		if err := os.WriteFile(file, []byte(formatted), 0644); err != nil {
+			log.Fatalf("Failed to write '%s': %s", file, err)
+		}
+	}
+}
+
+// Run `sqldef docs DIALECT`, which prints Markdown documentation of the tables, their indexes, and foreign keys.
+func runDocsCommand(args []string) {
+	c, args := findDialect("docs", args)
+	runReportCommand(c, "docs", args, schema.GenerateDocument, func(options *sqldef.Options) {
+		options.Docs = true
+	})
+}
+
+// Run `sqldef inspect DIALECT`, which prints the tables with their columns, indexes, and foreign keys, the views, and the
+// types as a JSON document.
+func runInspectCommand(args []string) {
+	c, args := findDialect("inspect", args)
+	format, rest := extractOption(args, "--format")
+	if len(format) > 0 && format != "json" {
+		log.Fatalf("unknown --format '%s' for inspect (expected: json)", format)
+	}
+	runReportCommand(c, "inspect", rest, schema.GenerateInspection, func(options *sqldef.Options) {
+		options.Inspect = true
+	})
+}
+
+// Run a subcommand printing a report of the schema, which is exported from the database as the command of the dialect
+// does with the options configured by configure, or read from the file given by --file.
+func runReportCommand(c dialect, command string, args []string, generate func(schema.GeneratorMode, database.Parser, string, database.GeneratorConfig, string) (string, error), configure func(*sqldef.Options)) {
+	file, rest := extractOption(args, "--file")
+	if len(file) == 0 {
+		c.main("sqldef "+command+" "+c.name, append(rest, "--export"), version, configure)
+		return
+	}
+	if len(rest) > 0 {
+		log.Fatalf("no option or database can be given with --file for %s, but got: %s", command, strings.Join(rest, " "))
+	}
+
+	report, err := generate(c.mode, c.newParser(), readSchemaFile(file), database.GeneratorConfig{}, c.defaultSchema)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Print(report)
+}
+
+func readSchemaFile(file string) string {
+	sql, err := sqldef.ReadFile(file)
+	if err != nil {
+		log.Fatalf("Failed to read '%s': %s", file, err)
+	}
+	return sql
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, "No dialect is specified!\n\n")
+		printUsage(os.Stderr)
+		os.Exit(1)
+	}
+
+	switch os.Args[1] {
+	case "--help", "-h":
+		printUsage(os.Stdout)
+		os.Exit(0)
+	case "--version":
+		fmt.Println(version)
+		os.Exit(0)
+	case "snapshot", "restore":
+		runSnapshotCommand(os.Args[1], os.Args[2:])
+		return
+	case "convert":
+		runConvertCommand(os.Args[2:])
+		return
+	case "fmt":
+		runFmtCommand(os.Args[2:])
+		return
+	case "docs":
+		runDocsCommand(os.Args[2:])
+		return
+	case "inspect":
+		runInspectCommand(os.Args[2:])
+		return
+	}
+
+	for _, c := range commands {
+		if os.Args[1] == c.name {
+			c.main("sqldef "+c.name, os.Args[2:], version)
+			return
+		}
+	}
+	fmt.Fprintf(os.Stderr, "Unknown dialect is given: %s\n\n", os.Args[1])
+	printUsage(os.Stderr)
+	os.Exit(1)
+}
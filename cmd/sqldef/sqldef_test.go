@@ -0,0 +1,271 @@
+// Integration test of sqldef command.
+//
+// Test requirement:
+//   - go command
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/sqldef/sqldef/cmd/testutils"
+)
+
+const (
+	applyPrefix     = "-- Apply --\n"
+	nothingModified = "-- Nothing is modified --\n"
+)
+
+func TestSqldefSqlite3(t *testing.T) {
+	createTable := "CREATE TABLE users (\n  id integer NOT NULL PRIMARY KEY,\n  name text\n);\n"
+	writeFile("schema.sql", createTable)
+
+	out := assertedExecute(t, "./sqldef", "sqlite3", "sqldef_test", "--file", "schema.sql")
+	assertEquals(t, out, applyPrefix+createTable)
+	out = assertedExecute(t, "./sqldef", "sqlite3", "sqldef_test", "--file", "schema.sql")
+	assertEquals(t, out, nothingModified)
+	out = assertedExecute(t, "./sqldef", "sqlite3", "sqldef_test", "--export")
+	assertEquals(t, out, createTable)
+}
+
+func TestSqldefSnapshotRestore(t *testing.T) {
+	_ = os.Remove("sqldef_test")
+	_ = os.Remove("sqldef_restored")
+	defer os.Remove("sqldef_restored")
+	defer os.Remove("snapshot.sql")
+
+	// posts is created before users, which it references.
+	createPosts := "CREATE TABLE posts (\n  id integer NOT NULL PRIMARY KEY,\n  user_id integer,\n  FOREIGN KEY (user_id) REFERENCES users (id)\n);\n"
+	createUsers := "CREATE TABLE users (\n  id integer NOT NULL PRIMARY KEY\n);\n"
+	writeFile("schema.sql", createPosts+createUsers)
+	assertedExecute(t, "./sqldef", "sqlite3", "sqldef_test", "--file", "schema.sql")
+
+	out := assertedExecute(t, "./sqldef", "snapshot", "sqlite3", "--out", "snapshot.sql", "sqldef_test")
+	assertEquals(t, out, "-- Saved 2 statements to snapshot.sql --\n")
+	snapshot, err := os.ReadFile("snapshot.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, string(snapshot), createUsers+createPosts)
+
+	out = assertedExecute(t, "./sqldef", "restore", "sqlite3", "--from", "snapshot.sql", "sqldef_restored")
+	assertEquals(t, out, applyPrefix+createUsers+createPosts)
+	out = assertedExecute(t, "./sqldef", "sqlite3", "sqldef_restored", "--export")
+	assertEquals(t, out, createUsers+"\n"+createPosts)
+
+	out, err = testutils.Execute("./sqldef", "restore", "sqlite3", "--from", "snapshot.sql", "sqldef_restored")
+	if err == nil {
+		t.Errorf("restoring to a non-empty database must be error, but successfully got: %s", out)
+	}
+}
+
+func TestSqldefConvert(t *testing.T) {
+	writeFile("schema.sql", "CREATE TABLE users (\n  id integer PRIMARY KEY AUTOINCREMENT,\n  name text NOT NULL\n);\nCREATE INDEX index_name ON users (name);\n")
+
+	out := assertedExecute(t, "./sqldef", "convert", "sqlite3", "mysql", "--file", "schema.sql")
+	assertEquals(t, out, "CREATE TABLE `users` (\n"+
+		"  `id` int NOT NULL AUTO_INCREMENT,\n"+
+		"  `name` text NOT NULL,\n"+
+		"  PRIMARY KEY (`id`)\n"+
+		");\n"+
+		"CREATE INDEX `index_name` ON `users` (`name`);\n")
+
+	out, err := testutils.Execute("./sqldef", "convert", "sqlite3", "oracle", "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("unknown dialect must be error, but successfully got: %s", out)
+	}
+}
+
+func TestSqldefFmt(t *testing.T) {
+	schema := "-- users\ncreate table users (id integer primary key autoincrement, name text not null);\ncreate index index_name on users (name);\n"
+	formatted := "-- users\n" +
+		"CREATE TABLE `users` (\n" +
+		"  `id` integer PRIMARY KEY AUTOINCREMENT,\n" +
+		"  `name` text NOT NULL\n" +
+		");\n" +
+		"CREATE INDEX `index_name` ON `users` (`name`);\n"
+	writeFile("schema.sql", schema)
+
+	out := assertedExecute(t, "./sqldef", "fmt", "sqlite3", "--file", "schema.sql")
+	assertEquals(t, out, formatted)
+
+	assertedExecute(t, "./sqldef", "fmt", "sqlite3", "--file", "schema.sql", "--write")
+	written, err := os.ReadFile("schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, string(written), formatted)
+
+	out, err = testutils.Execute("./sqldef", "fmt", "sqlite3", "--write")
+	if err == nil {
+		t.Errorf("--write without --file must be error, but successfully got: %s", out)
+	}
+}
+
+func TestSqldefDocs(t *testing.T) {
+	_ = os.Remove("sqldef_test")
+	schema := "CREATE TABLE users (\n  id integer NOT NULL PRIMARY KEY,\n  name text NOT NULL\n);\n" +
+		"CREATE TABLE posts (\n  id integer NOT NULL PRIMARY KEY,\n  user_id integer,\n  FOREIGN KEY (user_id) REFERENCES users (id)\n);\n" +
+		"CREATE INDEX index_posts_user_id ON posts (user_id);\n"
+	writeFile("schema.sql", schema)
+
+	out := assertedExecute(t, "./sqldef", "docs", "sqlite3", "--file", "schema.sql")
+	assertEquals(t, out, "# Schema\n"+
+		"\n"+
+		"```mermaid\n"+
+		"erDiagram\n"+
+		"    users {\n"+
+		"        integer id PK\n"+
+		"        text name\n"+
+		"    }\n"+
+		"    posts {\n"+
+		"        integer id PK\n"+
+		"        integer user_id FK\n"+
+		"    }\n"+
+		"    users ||--o{ posts : \"user_id\"\n"+
+		"```\n"+
+		"\n"+
+		"## users\n"+
+		"\n"+
+		"| Column | Type | Nullable | Default | Comment |\n"+
+		"| --- | --- | --- | --- | --- |\n"+
+		"| id | integer | NO |  |  |\n"+
+		"| name | text | NO |  |  |\n"+
+		"\n"+
+		"## posts\n"+
+		"\n"+
+		"| Column | Type | Nullable | Default | Comment |\n"+
+		"| --- | --- | --- | --- | --- |\n"+
+		"| id | integer | NO |  |  |\n"+
+		"| user_id | integer | YES |  |  |\n"+
+		"\n"+
+		"| Index | Columns | Unique |\n"+
+		"| --- | --- | --- |\n"+
+		"| index_posts_user_id | user_id | NO |\n"+
+		"\n"+
+		"| Foreign key | Columns | References |\n"+
+		"| --- | --- | --- |\n"+
+		"|  | user_id | users (id) |\n")
+
+	assertedExecute(t, "./sqldef", "sqlite3", "sqldef_test", "--file", "schema.sql")
+	fromDatabase := assertedExecute(t, "./sqldef", "docs", "sqlite3", "sqldef_test")
+	assertEquals(t, fromDatabase, out)
+}
+
+func TestSqldefInspect(t *testing.T) {
+	_ = os.Remove("sqldef_test")
+	writeFile("schema.sql", "CREATE TABLE users (\n  id integer NOT NULL PRIMARY KEY,\n  name text NOT NULL DEFAULT 'anonymous'\n);\n"+
+		"CREATE TABLE posts (\n  id integer NOT NULL PRIMARY KEY,\n  user_id integer,\n  FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE\n);\n"+
+		"CREATE INDEX index_posts_user_id ON posts (user_id);\n"+
+		"CREATE VIEW user_names AS SELECT name FROM users;\n")
+
+	out := assertedExecute(t, "./sqldef", "inspect", "sqlite3", "--format", "json", "--file", "schema.sql")
+	var inspection struct {
+		Tables []struct {
+			Name    string
+			Columns []struct {
+				Name     string
+				Type     string
+				Nullable bool
+				Default  *string
+			}
+			PrimaryKey []string `json:"primary_key"`
+			Indexes    []struct {
+				Name    string
+				Columns []string
+			}
+			ForeignKeys []struct {
+				Columns           []string
+				ReferencedTable   string   `json:"referenced_table"`
+				ReferencedColumns []string `json:"referenced_columns"`
+				OnDelete          string   `json:"on_delete"`
+			} `json:"foreign_keys"`
+		}
+		Views []string
+	}
+	if err := json.Unmarshal([]byte(out), &inspection); err != nil {
+		t.Fatalf("failed to parse the output of inspect as JSON: %s\n%s", err, out)
+	}
+	if len(inspection.Tables) != 2 || inspection.Tables[0].Name != "users" || inspection.Tables[1].Name != "posts" {
+		t.Fatalf("unexpected tables: %s", out)
+	}
+	users, posts := inspection.Tables[0], inspection.Tables[1]
+	assertEquals(t, strings.Join(users.PrimaryKey, ","), "id")
+	if name := users.Columns[1]; name.Name != "name" || name.Type != "text" || name.Nullable || name.Default == nil || *name.Default != "'anonymous'" {
+		t.Errorf("unexpected column: %+v", name)
+	}
+	if len(posts.Indexes) != 1 || posts.Indexes[0].Name != "index_posts_user_id" {
+		t.Errorf("unexpected indexes: %+v", posts.Indexes)
+	}
+	if len(posts.ForeignKeys) != 1 || posts.ForeignKeys[0].ReferencedTable != "users" || posts.ForeignKeys[0].OnDelete != "CASCADE" {
+		t.Errorf("unexpected foreign keys: %+v", posts.ForeignKeys)
+	}
+	assertEquals(t, strings.Join(inspection.Views, ","), "user_names")
+
+	assertedExecute(t, "./sqldef", "sqlite3", "sqldef_test", "--file", "schema.sql")
+	fromDatabase := assertedExecute(t, "./sqldef", "inspect", "sqlite3", "sqldef_test")
+	assertEquals(t, fromDatabase, out)
+
+	out, err := testutils.Execute("./sqldef", "inspect", "sqlite3", "--format", "yaml", "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("unknown --format must be error, but successfully got: %s", out)
+	}
+}
+
+func TestSqldefHelp(t *testing.T) {
+	out := assertedExecute(t, "./sqldef", "--help")
+	if !strings.Contains(out, "postgres") {
+		t.Errorf("dialects must be shown in --help, but got: %s", out)
+	}
+
+	out = assertedExecute(t, "./sqldef", "mysql", "--help")
+	if !strings.Contains(out, "sqldef mysql [OPTIONS]") {
+		t.Errorf("the subcommand must be shown in its --help, but got: %s", out)
+	}
+
+	out, err := testutils.Execute("./sqldef")
+	if err == nil {
+		t.Errorf("no dialect must be error, but successfully got: %s", out)
+	}
+
+	out, err = testutils.Execute("./sqldef", "oracle", "sqldef_test")
+	if err == nil {
+		t.Errorf("unknown dialect must be error, but successfully got: %s", out)
+	}
+}
+
+func TestMain(m *testing.M) {
+	_ = os.Remove("sqldef_test")
+	testutils.MustExecute("go", "build")
+	status := m.Run()
+	_ = os.Remove("sqldef")
+	_ = os.Remove("sqldef_test")
+	_ = os.Remove("schema.sql")
+	os.Exit(status)
+}
+
+func assertedExecute(t *testing.T, command string, args ...string) string {
+	t.Helper()
+	out, err := testutils.Execute(command, args...)
+	if err != nil {
+		t.Errorf("failed to execute '%s %s' (error: '%s'): `%s`", command, strings.Join(args, " "), err, out)
+	}
+	return out
+}
+
+func assertEquals(t *testing.T, actual string, expected string) {
+	t.Helper()
+
+	if expected != actual {
+		t.Errorf("expected '%s' but got '%s'", expected, actual)
+	}
+}
+
+func writeFile(path string, content string) {
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -6,12 +6,26 @@
 package main
 
 import (
+	"bufio"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
 	"log"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/sqldef/sqldef"
 	"github.com/sqldef/sqldef/cmd/testutils"
 	"github.com/sqldef/sqldef/database"
 	"github.com/sqldef/sqldef/database/sqlite3"
@@ -21,6 +35,7 @@ import (
 
 const (
 	applyPrefix     = "-- Apply --\n"
+	dryRunPrefix    = "-- dry run --\n"
 	nothingModified = "-- Nothing is modified --\n"
 )
 
@@ -113,6 +128,94 @@ func TestSQLite3defExport(t *testing.T) {
 	))
 }
 
+func TestSQLite3defExportChangedSince(t *testing.T) {
+	resetTestDatabase()
+
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id integer);\nCREATE TABLE posts (id integer);\nCREATE INDEX index_id ON posts (id);")
+	writeFile("snapshot.sql", assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--export"))
+
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "ALTER TABLE posts ADD COLUMN title text;\nCREATE TABLE comments (id integer);")
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--export", "--changed-since", "snapshot.sql")
+	assertEquals(t, out, stripHeredoc(`
+		CREATE TABLE posts (id integer, title text);
+
+		CREATE TABLE comments (id integer);
+
+		-- Unchanged since snapshot.sql: 2 --
+		-- table users
+		-- index index_id on posts
+		`,
+	))
+}
+
+func TestSQLite3defExportExplicitNotNull(t *testing.T) {
+	resetTestDatabase()
+
+	testutils.MustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE users (
+		    id integer PRIMARY KEY AUTOINCREMENT,
+		    name text
+		);
+		CREATE TABLE user_roles (
+		    user_id integer,
+		    "role" varchar(10) NOT NULL,
+		    PRIMARY KEY (user_id, "role")
+		);`,
+	))
+	writeFile("config.yml", "export_explicit_not_null: true\n")
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--export", "--config", "config.yml")
+	assertEquals(t, out, stripHeredoc(`
+		CREATE TABLE users (
+		    id integer NOT NULL PRIMARY KEY AUTOINCREMENT,
+		    name text
+		);
+
+		CREATE TABLE user_roles (
+		    user_id integer NOT NULL,
+		    "role" varchar(10) NOT NULL,
+		    PRIMARY KEY (user_id, "role")
+		);
+		`,
+	))
+
+	writeFile("schema.sql", out)
+	apply := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--file", "schema.sql")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestSQLite3defExportFingerprint(t *testing.T) {
+	resetTestDatabase()
+
+	testutils.MustExecute("sqlite3", "sqlite3def_test", stripHeredoc(`
+		CREATE TABLE customers (
+		    id integer NOT NULL PRIMARY KEY,
+		    email text NOT NULL DEFAULT 'unknown'
+		);
+		CREATE TABLE invoices (
+		    id integer NOT NULL PRIMARY KEY,
+		    customer_id integer NOT NULL REFERENCES customers (id),
+		    amount integer CHECK (amount > 0)
+		);
+		CREATE INDEX index_customer_id ON invoices (customer_id);`,
+	))
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--export", "--fingerprint")
+	assertEquals(t, out, stripHeredoc(`
+		CREATE TABLE table1 (
+		    col1 integer NOT NULL PRIMARY KEY,
+		    col2 text NOT NULL DEFAULT 'str1'
+		);
+
+		CREATE TABLE table2 (
+		    col1 integer NOT NULL PRIMARY KEY,
+		    col3 integer NOT NULL REFERENCES table1 (col1),
+		    col4 integer CHECK (col4 > 0)
+		);
+
+		CREATE INDEX index1 ON table2 (col3);
+		`,
+	))
+}
+
 func TestSQLite3defConfigIncludesTargetTables(t *testing.T) {
 	resetTestDatabase()
 
@@ -143,6 +246,113 @@ func TestSQLite3defConfigIncludesSkipTables(t *testing.T) {
 	assertEquals(t, apply, nothingModified)
 }
 
+func TestSQLite3defConfigIncludesMaxBatchBytes(t *testing.T) {
+	resetTestDatabase()
+
+	writeFile("schema.sql", "CREATE TABLE users (id bigint);\nCREATE TABLE posts (id bigint);\nCREATE TABLE comments (id bigint);\n")
+	writeFile("config.yml", "max_batch_bytes: 64\n")
+
+	apply := assertedExecute(t, "./sqlite3def", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, apply, stripHeredoc(`
+		-- Apply --
+		CREATE TABLE users (id bigint);
+		CREATE TABLE posts (id bigint);
+		-- Committed a batch of 60 bytes --
+		CREATE TABLE comments (id bigint);
+		`,
+	))
+	apply = assertedExecute(t, "./sqlite3def", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, apply, nothingModified)
+
+	writeFile("config.yml", "max_batch_bytes: 16\n")
+	writeFile("schema.sql", "CREATE TABLE users (id bigint, name text);\n")
+	out, err := testutils.Execute("./sqlite3def", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	if err == nil {
+		t.Errorf("expected an error for a DDL exceeding max_batch_bytes, but got: %s", out)
+	}
+}
+
+func TestSQLite3defTimeout(t *testing.T) {
+	resetTestDatabase()
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id bigint);")
+
+	writeFile("schema.sql", "CREATE TABLE users (id bigint);\nCREATE TABLE posts (id bigint);\nCREATE TABLE comments (id bigint);\n")
+	out, err := testutils.Execute("./sqlite3def", "--timeout", "1ns", "--file", "schema.sql", "sqlite3def_test")
+	if err == nil || !strings.Contains(out, "canceled a DDL running longer than 1ns: CREATE TABLE posts (id bigint)") {
+		t.Errorf("expected the DDL to be canceled by --timeout, but got: %s", out)
+	}
+	assertEquals(t, assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--export"), "CREATE TABLE users (id bigint);\n")
+
+	apply := assertedExecute(t, "./sqlite3def", "--timeout", "1m", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, apply, "-- Apply --\nCREATE TABLE posts (id bigint);\nCREATE TABLE comments (id bigint);\n")
+}
+
+func TestSQLite3defConfigIncludesRenames(t *testing.T) {
+	resetTestDatabase()
+
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE old_users (id bigint, old_name text);")
+
+	createTable := "CREATE TABLE users (id bigint, name text);\n"
+	writeFile("schema.sql", createTable)
+	writeFile("config.yml", stripHeredoc(`
+		renames:
+		  tables: |
+		    old_users -> users
+		  columns: |
+		    users.old_name -> users.name
+	`))
+
+	apply := assertedExecute(t, "./sqlite3def", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, apply, applyPrefix+stripHeredoc(`
+		ALTER TABLE `+"`old_users`"+` RENAME TO `+"`users`"+`;
+		ALTER TABLE `+"`users`"+` RENAME COLUMN `+"`old_name`"+` TO `+"`name`"+`;
+	`))
+	apply = assertedExecute(t, "./sqlite3def", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, apply, nothingModified)
+}
+
+func TestSQLite3defConfigOverlay(t *testing.T) {
+	resetTestDatabase()
+
+	usersTable := "CREATE TABLE users (id bigint);"
+	users1Table := "CREATE TABLE users_1 (id bigint);"
+	users10Table := "CREATE TABLE users_10 (id bigint);"
+	testutils.MustExecute("sqlite3", "sqlite3def_test", usersTable+users1Table+users10Table)
+
+	writeFile("schema.sql", usersTable)
+	writeFile("config.yml", "target_tables: |\n  users\n  users_\\d+\n\nskip_tables: |\n  users_10\n")
+	writeFile("prod.yml", "skip_tables: |\n  users_1\n  users_10\n")
+
+	dryRun := assertedExecute(t, "./sqlite3def", "--dry-run", "--enable-drop-table", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, dryRun, dryRunPrefix+"DROP TABLE `users_1`;\n")
+
+	// skip_tables of prod.yml overrides the base one, while target_tables is kept
+	dryRun = assertedExecute(t, "./sqlite3def", "--dry-run", "--enable-drop-table", "--config", "config.yml", "--config", "prod.yml", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, dryRun, nothingModified)
+}
+
+func TestSQLite3defConfigIncludesForbiddenDDL(t *testing.T) {
+	resetTestDatabase()
+
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "CREATE TABLE users (id bigint, name text);")
+
+	writeFile("schema.sql", "CREATE TABLE users (id bigint);\n")
+	writeFile("config.yml", "forbidden_ddl: [drop_table, drop_column]\n")
+
+	out, err := testutils.Execute("./sqlite3def", "--config", "config.yml", "--file", "schema.sql", "sqlite3def_test")
+	if err == nil {
+		t.Errorf("expected forbidden_ddl to fail, but got: %s", out)
+	}
+	assertEquals(t, out, stripHeredoc(`
+		-- Forbidden DDLs: 1 --
+		drop_column: ALTER TABLE `+"`users`"+` DROP COLUMN `+"`name`"+`;
+	`))
+
+	// Nothing is applied
+	apply := assertedExecute(t, "./sqlite3def", "--dry-run", "--file", "schema.sql", "sqlite3def_test")
+	assertEquals(t, apply, dryRunPrefix+"ALTER TABLE `users` DROP COLUMN `name`;\n")
+}
+
 func TestSQLite3defVirtualTable(t *testing.T) {
 	resetTestDatabase()
 
@@ -353,6 +563,566 @@ func TestSQLite3defChangeTrigger(t *testing.T) {
 	assertApplyOutput(t, createTable+changeTrigger, nothingModified)
 }
 
+func TestSQLite3defSignPlan(t *testing.T) {
+	resetTestDatabase()
+	// Keep the keys and the artifacts out of the package directory.
+	dir := t.TempDir()
+	keyPem := filepath.Join(dir, "key.pem")
+	keyPub := filepath.Join(dir, "key.pub")
+	otherPem := filepath.Join(dir, "other.pem")
+	planSig := filepath.Join(dir, "plan.sig")
+
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(keyPem, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+	publicDer, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(keyPub, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDer})))
+
+	createTable := "CREATE TABLE users (id integer);\n"
+	writeFile("schema.sql", createTable)
+	plan := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--sign-plan", keyPem, "--file", "schema.sql")
+	if !strings.HasPrefix(plan, createTable+"-----BEGIN PUBLIC KEY-----\n") {
+		t.Errorf("unexpected signed plan: %s", plan)
+	}
+	writeFile(planSig, plan)
+
+	// A plan which is not the signed one should be refused
+	writeFile("schema.sql", "CREATE TABLE users (id integer, name text);\n")
+	out, err := testutils.Execute("./sqlite3def", "sqlite3def_test", "--verify-plan", planSig, "--verify-key", keyPub, "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("expected --verify-plan to fail, but got: %s", out)
+	}
+
+	// A tampered plan should be refused
+	writeFile(planSig, strings.Replace(plan, "id integer", "id text", 1))
+	writeFile("schema.sql", "CREATE TABLE users (id text);\n")
+	out, err = testutils.Execute("./sqlite3def", "sqlite3def_test", "--verify-plan", planSig, "--verify-key", keyPub, "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("expected --verify-plan to fail, but got: %s", out)
+	}
+
+	// A tampered plan re-signed by another key should be refused
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err = x509.MarshalPKCS8PrivateKey(otherKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(otherPem, string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})))
+	resigned := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--sign-plan", otherPem, "--file", "schema.sql")
+	writeFile(planSig, resigned)
+	out, err = testutils.Execute("./sqlite3def", "sqlite3def_test", "--verify-plan", planSig, "--verify-key", keyPub, "--file", "schema.sql")
+	if err == nil || !strings.Contains(out, "is signed by a key other than '"+keyPub+"'") {
+		t.Errorf("expected --verify-plan to refuse another key, but got: %s", out)
+	}
+
+	// The key embedded in the artifact isn't trusted by itself
+	out, err = testutils.Execute("./sqlite3def", "sqlite3def_test", "--verify-plan", planSig, "--file", "schema.sql")
+	if err == nil || !strings.Contains(out, "--verify-plan needs --verify-key") {
+		t.Errorf("expected --verify-plan without --verify-key to fail, but got: %s", out)
+	}
+
+	writeFile(planSig, plan)
+	writeFile("schema.sql", createTable)
+	apply := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--verify-plan", planSig, "--verify-key", keyPub, "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+createTable)
+
+	// A plan containing the beginning of a PEM block is still verified by its trailer
+	alterTable := "ALTER TABLE `users` ADD COLUMN `note` text DEFAULT '-----BEGIN PUBLIC KEY-----';\n"
+	writeFile("schema.sql", "CREATE TABLE users (id integer, note text DEFAULT '-----BEGIN PUBLIC KEY-----');\n")
+	writeFile(planSig, assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--sign-plan", keyPem, "--file", "schema.sql"))
+	apply = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--verify-plan", planSig, "--verify-key", keyPub, "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+alterTable)
+}
+
+func TestSQLite3defDownOutput(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer);\n"
+	createPosts := "CREATE TABLE posts (id integer);\n"
+	assertApplyOutput(t, createUsers, applyPrefix+createUsers)
+
+	writeFile("schema.sql", createUsers+createPosts)
+	apply := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--down-output", "down.sql", "--file", "schema.sql")
+	assertEquals(t, apply, applyPrefix+createPosts)
+	down, err := os.ReadFile("down.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, string(down), "DROP TABLE `posts`;\n")
+
+	// Applying the down migration restores the previous schema
+	testutils.MustExecute("sqlite3", "sqlite3def_test", string(down))
+	assertApplyOutput(t, createUsers, nothingModified)
+}
+
+func TestSQLite3defComparePlan(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer);\n"
+	createPosts := "CREATE TABLE posts (id integer);\n"
+	createComments := "CREATE TABLE comments (id integer);\n"
+
+	writeFile("schema.sql", createUsers+createPosts)
+	dryRun := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--save-plan", "plan.json", "--file", "schema.sql")
+	assertEquals(t, dryRun, dryRunPrefix+createUsers+createPosts)
+
+	dryRun = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--compare-plan", "plan.json", "--file", "schema.sql")
+	assertEquals(t, dryRun, "-- Plan is unchanged since plan.json --\n"+dryRunPrefix+createUsers+createPosts)
+
+	writeFile("schema.sql", createUsers+createComments)
+	dryRun = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--compare-plan", "plan.json", "--save-plan", "plan.json", "--file", "schema.sql")
+	assertEquals(t, dryRun, stripHeredoc(`
+		-- Plan changed since plan.json --
+		  CREATE TABLE users (id integer);
+		- CREATE TABLE posts (id integer);
+		+ CREATE TABLE comments (id integer);
+	`)+dryRunPrefix+createUsers+createComments)
+
+	// The plan was updated by --save-plan after the comparison
+	dryRun = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--compare-plan", "plan.json", "--file", "schema.sql")
+	assertEquals(t, dryRun, "-- Plan is unchanged since plan.json --\n"+dryRunPrefix+createUsers+createComments)
+}
+
+func TestSQLite3defImpact(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer);\n"
+	createPosts := "CREATE TABLE posts (id integer);\n"
+	testutils.MustExecute("sqlite3", "sqlite3def_test", createUsers+createPosts)
+
+	writeFile("schema.sql", "CREATE TABLE users (id integer, name text);\n")
+	dryRun := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--impact", "--enable-drop-table", "--file", "schema.sql")
+	assertEquals(t, dryRun, stripHeredoc(`
+		-- dry run --
+		-- Impact: EXCLUSIVE lock
+		ALTER TABLE `+"`users`"+` ADD COLUMN `+"`name`"+` text;
+		-- Impact: EXCLUSIVE lock
+		DROP TABLE `+"`posts`"+`;
+		-- Warning: 2 statement(s) take an exclusive lock, 0 statement(s) rewrite a table --
+	`))
+}
+
+func TestSQLite3defPretty(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer);\n"
+	createPosts := "CREATE TABLE posts (id integer);\n"
+	testutils.MustExecute("sqlite3", "sqlite3def_test", createUsers+createPosts)
+
+	writeFile("schema.sql", "CREATE TABLE users (id integer, name text);\nCREATE VIEW user_names AS SELECT name FROM users;\n")
+	dryRun := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--pretty", "--file", "schema.sql")
+	assertEquals(t, dryRun, stripHeredoc(`
+		-- dry run --
+		-- table users --
+		+ ALTER TABLE `+"`users`"+` ADD COLUMN `+"`name`"+` text;
+		-- view user_names --
+		+ CREATE VIEW user_names AS SELECT name FROM users;
+		-- table posts --
+		-- Skipped: DROP TABLE `+"`posts`"+`;
+		-- 2 to add, 0 to change, 0 to drop, 1 skipped in 3 objects --
+	`))
+}
+
+func TestSQLite3defDocOutput(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer PRIMARY KEY, name text NOT NULL DEFAULT 'none');\n"
+	createPosts := "CREATE TABLE posts (id integer PRIMARY KEY, user_id integer, FOREIGN KEY (user_id) REFERENCES users (id));\n"
+	createIndex := "CREATE INDEX index_user_id ON posts (user_id);\n"
+	writeFile("schema.sql", createUsers+createPosts+createIndex)
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--doc-output", "doc", "--file", "schema.sql")
+
+	doc, err := os.ReadFile("doc/schema.md")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, string(doc), stripHeredoc(`
+		# Schema
+
+		`+"```mermaid"+`
+		erDiagram
+		    users {
+		        integer id PK
+		        text name
+		    }
+		    posts {
+		        integer id PK
+		        integer user_id FK
+		    }
+		    users ||--o{ posts : "user_id"
+		`+"```"+`
+
+		## users
+
+		| Column | Type | Nullable | Default | Comment |
+		| --- | --- | --- | --- | --- |
+		| id | integer | NO |  |  |
+		| name | text | NO | 'none' |  |
+
+		## posts
+
+		| Column | Type | Nullable | Default | Comment |
+		| --- | --- | --- | --- | --- |
+		| id | integer | NO |  |  |
+		| user_id | integer | YES |  |  |
+
+		| Index | Columns | Unique |
+		| --- | --- | --- |
+		| index_user_id | user_id | NO |
+
+		| Foreign key | Columns | References |
+		| --- | --- | --- |
+		|  | user_id | users (id) |
+	`))
+}
+
+func TestSQLite3defSkipFailed(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer, name text);\n"
+	assertApplyOutput(t, createUsers, applyPrefix+createUsers)
+	testutils.MustExecute("sqlite3", "sqlite3def_test", "INSERT INTO users (id, name) VALUES (1, 'a'), (2, 'a');")
+
+	createPosts := "CREATE TABLE posts (id integer);\n"
+	createIndex := "CREATE UNIQUE INDEX index_name ON users (name);\n"
+	writeFile("schema.sql", createUsers+createPosts+createIndex)
+	out, err := testutils.Execute("./sqlite3def", "sqlite3def_test", "--skip-failed", "--file", "schema.sql")
+	if err == nil {
+		t.Errorf("expected --skip-failed to fail with the failed DDL, but got: %s", out)
+	}
+	assertEquals(t, out, applyPrefix+createPosts+createIndex+
+		"-- Failed: constraint failed: UNIQUE constraint failed: users.name (2067)\n"+
+		"-- Failed DDLs: 1 --\n"+
+		"DDL: "+createIndex+
+		"Error: constraint failed: UNIQUE constraint failed: users.name (2067)\n"+
+		"Remediation: Existing rows violate the new unique constraint. Remove the duplicated rows first.\n",
+	)
+
+	// The succeeded DDL is applied in spite of the failure
+	assertApplyOutput(t, createUsers+createPosts, nothingModified)
+}
+
+func TestSQLite3defOverlay(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer, name text);\n"
+	createPosts := "CREATE TABLE posts (id integer);\n"
+	writeFile("schema.sql", createUsers+createPosts)
+	writeFile("prod.sql", "CREATE TABLE users (id integer, name text, email text);\nCREATE INDEX index_name ON users (name);\n")
+
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+
+	// users of prod.sql replaces the base one, and the index is added
+	dryRun := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--dry-run", "--file", "schema.sql", "--overlay", "prod.sql")
+	assertEquals(t, dryRun, dryRunPrefix+
+		"ALTER TABLE `users` ADD COLUMN `email` text;\n"+
+		"CREATE INDEX index_name ON users (name);\n",
+	)
+}
+
+func TestSQLite3defStats(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer, name text);\n"
+	createIndex := "CREATE INDEX index_name ON users (name);\n"
+	writeFile("schema.sql", createUsers+createIndex)
+
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--stats", "text", "--file", "schema.sql")
+	for _, phase := range []string{"dump", "parse", "diff", "execute"} {
+		if !regexp.MustCompile(`(?m)^sqldef_phase_duration_seconds\{phase="` + phase + `"\} \S+$`).MatchString(out) {
+			t.Errorf("expected the duration of %s in the stats, but got: %s", phase, out)
+		}
+	}
+	for _, metric := range []string{
+		`sqldef_objects{schema="desired"} 2`,
+		`sqldef_objects{schema="current"} 0`,
+		`sqldef_ddls{category="create_table"} 1`,
+		`sqldef_ddls{category="create_index"} 1`,
+	} {
+		if !strings.Contains(out, metric+"\n") {
+			t.Errorf("expected '%s' in the stats, but got: %s", metric, out)
+		}
+	}
+
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--stats", "json", "--dry-run", "--file", "schema.sql")
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	var stats struct {
+		Phases  map[string]float64 `json:"phase_duration_seconds"`
+		Objects map[string]int     `json:"objects"`
+		DDLs    map[string]int     `json:"ddls"`
+	}
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &stats); err != nil {
+		t.Fatalf("failed to parse the stats as JSON: %s: %s", err, out)
+	}
+	if _, ok := stats.Phases["execute"]; ok {
+		t.Errorf("expected no execute phase with --dry-run, but got: %s", out)
+	}
+	assertEquals(t, fmt.Sprint(stats.Objects), "map[current:2 desired:2]")
+	assertEquals(t, fmt.Sprint(stats.DDLs), "map[]")
+
+	// The stats are written even when the run fails.
+	writeFile("schema.sql", "CREATE TABLE users (id integer,;\n")
+	out, err := testutils.Execute("./sqlite3def", "sqlite3def_test", "--stats", "text", "--file", "schema.sql")
+	if err == nil {
+		t.Error("expected an invalid schema to fail")
+	}
+	if !strings.Contains(out, `sqldef_phase_duration_seconds{phase="dump"}`) {
+		t.Errorf("expected the stats of the failed run, but got: %s", out)
+	}
+
+	writeFile("schema.sql", createUsers+createIndex)
+	_, err = testutils.Execute("./sqlite3def", "sqlite3def_test", "--stats", "xml", "--file", "schema.sql")
+	if err == nil {
+		t.Error("expected an unknown format of --stats to fail")
+	}
+}
+
+func TestSQLite3defQuietAndVerbose(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer, name text);\n"
+	writeFile("schema.sql", createUsers)
+
+	stdout, stderr, err := testutils.ExecuteSeparately("./sqlite3def", "sqlite3def_test", "--verbose", "--file", "schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, stdout, applyPrefix+createUsers)
+	for _, message := range []string{"-- Dumped the current schema in ", "-- Parsed 1 desired and 0 current objects --", "-- Generated 1 DDLs in ", "-- Applied 1 DDLs in "} {
+		if !strings.Contains(stderr, message) {
+			t.Errorf("expected '%s' in stderr with --verbose, but got: %s", message, stderr)
+		}
+	}
+
+	stdout, stderr, err = testutils.ExecuteSeparately("./sqlite3def", "sqlite3def_test", "--file", "schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, stdout, "")
+	assertEquals(t, stderr, nothingModified)
+
+	stdout, stderr, err = testutils.ExecuteSeparately("./sqlite3def", "sqlite3def_test", "-q", "--file", "schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, stdout, "")
+	assertEquals(t, stderr, "")
+
+	_, err = testutils.Execute("./sqlite3def", "sqlite3def_test", "--quiet", "--verbose", "--file", "schema.sql")
+	if err == nil {
+		t.Error("expected --quiet and --verbose to fail together")
+	}
+}
+
+func TestSQLite3defNotifyWebhook(t *testing.T) {
+	resetTestDatabase()
+
+	notifications := make(chan sqldef.Notification, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var notification sqldef.Notification
+		if err := json.NewDecoder(r.Body).Decode(&notification); err != nil {
+			t.Errorf("failed to parse the notification as JSON: %s", err)
+		}
+		notifications <- notification
+	}))
+	defer server.Close()
+	writeFile("config.yml", "notify_webhook: "+server.URL+"\n")
+
+	createUsers := "CREATE TABLE users (id integer, name text);\n"
+	writeFile("schema.sql", createUsers)
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--config", "config.yml", "--file", "schema.sql")
+	notification := <-notifications
+	assertEquals(t, notification.Database, "sqlite3def_test")
+	assertEquals(t, fmt.Sprint(notification.DryRun, notification.DDLs, notification.Destructive, notification.Success), "false 1 [] true")
+
+	writeFile("schema.sql", "CREATE TABLE users (id integer);\n")
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--config", "config.yml", "--dry-run", "--file", "schema.sql")
+	notification = <-notifications
+	assertEquals(t, fmt.Sprint(notification.DryRun, notification.DDLs, notification.Destructive), "true 1 [ALTER TABLE `users` DROP COLUMN `name`]")
+	if !strings.HasPrefix(notification.Text, "sqldef: Planned 1 DDLs to sqlite3def_test in ") {
+		t.Errorf("unexpected text of the notification: %s", notification.Text)
+	}
+
+	// A failed run is notified as well
+	writeFile("config.yml", "notify_webhook: "+server.URL+"\nforbidden_ddl: [drop_column]\n")
+	if _, err := testutils.Execute("./sqlite3def", "sqlite3def_test", "--config", "config.yml", "--file", "schema.sql"); err == nil {
+		t.Error("expected a forbidden DDL to fail the run")
+	}
+	notification = <-notifications
+	assertEquals(t, fmt.Sprint(notification.Success, " ", notification.Error), "false exited with status 1")
+	if !strings.HasPrefix(notification.Text, "sqldef: Failed to apply 1 DDLs to sqlite3def_test in ") {
+		t.Errorf("unexpected text of the notification: %s", notification.Text)
+	}
+
+	writeFile("config.yml", "notify_webhook: "+server.URL+"\n")
+	server.Close()
+	_, stderr, err := testutils.ExecuteSeparately("./sqlite3def", "sqlite3def_test", "--config", "config.yml", "--dry-run", "--file", "schema.sql")
+	if err != nil {
+		t.Errorf("expected a failure to notify not to fail the run: %s", err)
+	}
+	if !strings.Contains(stderr, "-- Failed to notify the webhook: ") {
+		t.Errorf("expected the failure to notify in stderr, but got: %s", stderr)
+	}
+}
+
+func TestSQLite3defWatch(t *testing.T) {
+	resetTestDatabase()
+	writeFile("schema.sql", "CREATE TABLE users (id integer);\n")
+
+	output, writer := io.Pipe()
+	cmd := exec.Command("./sqlite3def", "sqlite3def_test", "--file", "schema.sql", "--watch")
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	lines := make(chan string)
+	go func() {
+		scanner := bufio.NewScanner(output)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	waitForLine := func(expected string) {
+		t.Helper()
+		timeout := time.After(10 * time.Second)
+		for {
+			select {
+			case line := <-lines:
+				if line == expected {
+					return
+				}
+			case <-timeout:
+				t.Fatalf("timed out waiting for '%s'", expected)
+			}
+		}
+	}
+
+	waitForLine("CREATE TABLE users (id integer);")
+	waitForLine("-- Watching 1 files for changes --")
+	writeFile("schema.sql", "CREATE TABLE users (id integer, name text);\n")
+	waitForLine("ALTER TABLE `users` ADD COLUMN `name` text;")
+	writeFile("schema.sql", "CREATE TABLE users (id integer, name text,);\n")
+	waitForLine("-- Failed: exit status 1 --")
+	writeFile("schema.sql", "CREATE TABLE users (id integer, name text, age integer);\n")
+	waitForLine("ALTER TABLE `users` ADD COLUMN `age` integer;")
+}
+
+func TestSQLite3defAuditTable(t *testing.T) {
+	resetTestDatabase()
+	writeFile("config.yml", "audit_table: schema_migrations_log\n")
+
+	createUsers := "CREATE TABLE users (id integer, name text);\n"
+	writeFile("schema.sql", createUsers)
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--config", "config.yml", "--file", "schema.sql")
+	writeFile("schema.sql", "CREATE TABLE users (id integer);\n")
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--config", "config.yml", "--file", "schema.sql")
+
+	out := testutils.MustExecute("sqlite3", "sqlite3def_test", "SELECT statement, length(checksum), applied_at <> '' FROM schema_migrations_log ORDER BY rowid;")
+	assertEquals(t, out, "CREATE TABLE users (id integer, name text)|64|1\nALTER TABLE `users` DROP COLUMN `name`|64|1\n")
+
+	// The audit table is neither exported nor dropped
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--config", "config.yml", "--enable-drop-table", "--file", "schema.sql")
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--config", "config.yml", "--export")
+	assertEquals(t, out, "CREATE TABLE users (id integer);\n")
+}
+
+func TestSQLite3defManifest(t *testing.T) {
+	resetTestDatabase()
+
+	writeFile("schema.yml", stripHeredoc(`
+		tables:
+		  - name: users
+		    columns:
+		      - {name: id, type: integer, not_null: true}
+		      - {name: name, type: text}
+		    primary_key: [id]
+		    indexes:
+		      - {name: index_users_name, columns: [name], unique: true}
+		`))
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--file", "schema.yml")
+	assertEquals(t, out, applyPrefix+
+		"CREATE TABLE `users` (\n  `id` integer NOT NULL,\n  `name` text,\n  PRIMARY KEY (`id`)\n);\n"+
+		"CREATE UNIQUE INDEX `index_users_name` ON `users` (`name`);\n")
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--file", "schema.yml")
+	assertEquals(t, out, nothingModified)
+
+	writeFile("schema.json", `{"tables": [{"name": "users", "columns": [{"name": "id", "type": "integer", "not_null": true}, {"name": "name", "type": "text"}, {"name": "age", "type": "integer"}], "primary_key": ["id"], "indexes": [{"name": "index_users_name", "columns": ["name"], "unique": true}]}]}`)
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--file", "schema.json")
+	assertEquals(t, out, applyPrefix+"ALTER TABLE `users` ADD COLUMN `age` integer;\n")
+}
+
+func TestSQLite3defOnlyTable(t *testing.T) {
+	resetTestDatabase()
+
+	createUsers := "CREATE TABLE users (id integer, name text);\n"
+	createPosts := "CREATE TABLE posts (id integer);\n"
+	assertApplyOutput(t, createUsers+createPosts, applyPrefix+createUsers+createPosts)
+
+	writeFile("schema.sql", stripHeredoc(`
+		CREATE TABLE users (id integer, name text, age integer);
+		CREATE INDEX index_users_age ON users (age);
+		CREATE TABLE posts (id integer, title text);
+		CREATE TABLE comments (id integer);
+		`,
+	))
+	stdout, stderr, err := testutils.ExecuteSeparately("./sqlite3def", "sqlite3def_test", "--only-table", "users", "--file", "schema.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertEquals(t, stdout, applyPrefix+
+		"ALTER TABLE `users` ADD COLUMN `age` integer;\n"+
+		"CREATE INDEX index_users_age ON users (age);\n")
+	assertEquals(t, stderr, "-- Skipped 2 DDLs not touching --only-table --\n")
+
+	apply := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--only-table", "users", "--file", "schema.sql")
+	assertEquals(t, apply, "-- Skipped 2 DDLs not touching --only-table --\n"+nothingModified)
+}
+
+func TestSQLite3defDestroy(t *testing.T) {
+	resetTestDatabase()
+
+	createTables := stripHeredoc(`
+		CREATE TABLE users (id integer PRIMARY KEY);
+		CREATE TABLE posts (id integer PRIMARY KEY, user_id integer REFERENCES users (id));
+		CREATE TABLE logs (id integer);
+		CREATE VIEW user_ids AS SELECT id FROM users;
+		`,
+	)
+	writeFile("schema.sql", createTables)
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--file", "schema.sql")
+
+	writeFile("config.yml", "skip_tables: logs\n")
+	out := assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--destroy", "--dry-run", "--config", "config.yml")
+	assertEquals(t, out, dryRunPrefix+stripHeredoc(`
+		DROP VIEW `+"`user_ids`"+`;
+		DROP TABLE `+"`posts`"+`;
+		DROP TABLE `+"`users`"+`;
+		`,
+	))
+
+	assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--destroy", "--config", "config.yml")
+	out = assertedExecute(t, "./sqlite3def", "sqlite3def_test", "--export")
+	assertEquals(t, out, "CREATE TABLE logs (id integer);\n")
+}
+
 func TestSQLite3defHelp(t *testing.T) {
 	_, err := testutils.Execute("./sqlite3def", "--help")
 	if err != nil {
@@ -373,6 +1143,14 @@ func TestMain(m *testing.M) {
 	_ = os.Remove("sqlite3def_test")
 	_ = os.Remove("schema.sql")
 	_ = os.Remove("config.yml")
+	_ = os.Remove("prod.yml")
+	_ = os.Remove("prod.sql")
+	_ = os.Remove("down.sql")
+	_ = os.Remove("plan.json")
+	_ = os.RemoveAll("doc")
+	_ = os.Remove("snapshot.sql")
+	_ = os.Remove("schema.yml")
+	_ = os.Remove("schema.json")
 	os.Exit(status)
 }
 
@@ -24,6 +24,7 @@ type TestCase struct {
 	MinVersion string  `yaml:"min_version"`
 	MaxVersion string  `yaml:"max_version"`
 	User       string
+	EnableDrop bool `yaml:"enable_drop"`
 }
 
 func ReadTests(pattern string) (map[string]TestCase, error) {
@@ -100,7 +101,7 @@ func RunTest(t *testing.T, db database.Database, test TestCase, mode schema.Gene
 	if err != nil {
 		log.Fatal(err)
 	}
-	ddls, err = schema.GenerateIdempotentDDLs(mode, sqlParser, test.Desired, dumpDDLs, database.GeneratorConfig{}, db.GetDefaultSchema())
+	ddls, err = schema.GenerateIdempotentDDLs(mode, sqlParser, test.Desired, dumpDDLs, database.GeneratorConfig{EnableDrop: test.EnableDrop}, db.GetDefaultSchema())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -119,7 +120,7 @@ func RunTest(t *testing.T, db database.Database, test TestCase, mode schema.Gene
 	if err != nil {
 		log.Fatal(err)
 	}
-	ddls, err = schema.GenerateIdempotentDDLs(mode, sqlParser, test.Desired, dumpDDLs, database.GeneratorConfig{}, db.GetDefaultSchema())
+	ddls, err = schema.GenerateIdempotentDDLs(mode, sqlParser, test.Desired, dumpDDLs, database.GeneratorConfig{EnableDrop: test.EnableDrop}, db.GetDefaultSchema())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -174,6 +175,13 @@ func splitDDLs(mode schema.GeneratorMode, sqlParser database.Parser, str string,
 }
 
 func runDDLs(db database.Database, ddls []string) error {
+	preTransactionDDLs, ddls := database.SplitPreTransactionDDLs(ddls)
+	for _, ddl := range preTransactionDDLs {
+		if _, err := db.DB().Exec(ddl); err != nil {
+			return err
+		}
+	}
+
 	transaction, err := db.DB().Begin()
 	if err != nil {
 		return err
@@ -219,3 +227,13 @@ func Execute(command string, args ...string) (string, error) {
 	out, err := cmd.CombinedOutput()
 	return strings.ReplaceAll(string(out), "\r\n", "\n"), err
 }
+
+// Same as Execute, but return stdout and stderr separately.
+func ExecuteSeparately(command string, args ...string) (string, string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(command, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	return strings.ReplaceAll(stdout.String(), "\r\n", "\n"), strings.ReplaceAll(stderr.String(), "\r\n", "\n"), err
+}
@@ -0,0 +1,51 @@
+package sqldef
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/sqldef/sqldef/database"
+)
+
+var alterColumnTypePattern = regexp.MustCompile(`^ALTER TABLE ("[^"]*"\."[^"]*") ALTER COLUMN "([^"]*)" TYPE `)
+
+// Find the functions and procedures using a column whose type is altered by the DDLs. PostgreSQL can't alter the type
+// of a column used by a function with a body of SQL standard, e.g. BEGIN ATOMIC ... END, and sqldef doesn't manage
+// functions to drop and recreate them as it does for views, so they're reported before applying instead of failing in
+// the middle of the DDLs. Functions in the other languages don't depend on the columns they use.
+func findFunctionsUsingAlteredColumns(db database.Database, ddls []string) ([]string, error) {
+	var dependencies []string
+	for _, ddl := range ddls {
+		match := alterColumnTypePattern.FindStringSubmatch(ddl)
+		if match == nil {
+			continue
+		}
+		rows, err := db.DB().Query(`
+			SELECT p.oid::regprocedure::text
+			FROM pg_catalog.pg_depend d
+			JOIN pg_catalog.pg_proc p ON p.oid = d.objid
+			JOIN pg_catalog.pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+			WHERE d.classid = 'pg_catalog.pg_proc'::regclass
+			  AND d.refclassid = 'pg_catalog.pg_class'::regclass
+			  AND d.refobjid = to_regclass($1)
+			  AND a.attname = $2
+			ORDER BY 1`, match[1], match[2])
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var function string
+			if err := rows.Scan(&function); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			dependencies = append(dependencies, fmt.Sprintf("%s uses %s.%s", function, match[1], quoteIdentifier(match[2])))
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return dependencies, nil
+}
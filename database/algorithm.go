@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// AlterPolicy is algorithm or lock of --config for the ALTER TABLE of MySQL. It's either a string of the value, or a
+// mapping of the default value, the values of specific tables, and the fallbacks retried when the server rejects it:
+//
+//	algorithm:
+//	  default: INPLACE
+//	  fallbacks: [COPY]
+//	  tables:
+//	    logs: INSTANT
+type AlterPolicy struct {
+	Default   string            `yaml:"default"`
+	Fallbacks []string          `yaml:"fallbacks"`
+	Tables    map[string]string `yaml:"tables"`
+}
+
+func (p *AlterPolicy) UnmarshalYAML(value *yaml.Node) error {
+	// A later config file replaces the whole policy instead of merging into it
+	*p = AlterPolicy{}
+	if value.Kind == yaml.ScalarNode {
+		p.Default = strings.Trim(value.Value, "\n")
+		return nil
+	}
+	type plain AlterPolicy
+	return value.Decode((*plain)(p))
+}
+
+func (p AlterPolicy) validate(name string, validValues []string) {
+	values := append([]string{}, p.Fallbacks...)
+	for _, value := range p.Tables {
+		values = append(values, value)
+	}
+	for _, value := range values {
+		if !containsFold(validValues, value) {
+			log.Fatalf("unknown %s '%s' (expected one of: %s)", name, value, strings.Join(validValues, ", "))
+		}
+	}
+}
+
+var (
+	validAlgorithms = []string{"INPLACE", "COPY", "INSTANT"}
+	validLocks      = []string{"DEFAULT", "NONE", "SHARED", "EXCLUSIVE"}
+)
+
+// AlterFallbacks are the ALGORITHM and LOCK values that RunDDLs retries an ALTER TABLE of MySQL with, in order, when
+// the server rejects the ones of the DDL, e.g. "ALGORITHM=INPLACE is not supported. Reason: ... Try ALGORITHM=COPY."
+type AlterFallbacks struct {
+	Algorithms []string
+	Locks      []string
+}
+
+var rejectedAlterClausePattern = regexp.MustCompile(`\b(ALGORITHM|LOCK)=(\w+) is not supported`)
+
+// Run a DDL like execDDL, and retry it with the next fallback while the server rejects its ALGORITHM or LOCK.
+// It returns the DDL that was run last.
+func execDDLWithFallbacks(ctx context.Context, e execer, ddl string, statementTimeout time.Duration, fallbacks AlterFallbacks) (string, error) {
+	tried := map[string]bool{ddl: true}
+	for {
+		err := execDDL(ctx, e, ddl, statementTimeout)
+		if err == nil || ctx.Err() != nil {
+			return ddl, err
+		}
+		match := rejectedAlterClausePattern.FindStringSubmatch(err.Error())
+		if match == nil {
+			return ddl, err
+		}
+		clause, value := match[1], match[2]
+		candidates := fallbacks.Algorithms
+		if clause == "LOCK" {
+			candidates = fallbacks.Locks
+		}
+		next := nextFallback(candidates, value)
+		if next == "" {
+			return ddl, err
+		}
+		retried := strings.Replace(ddl, ", "+clause+"="+value, ", "+clause+"="+next, 1)
+		if tried[retried] {
+			return ddl, err
+		}
+		tried[retried] = true
+
+		Infof("-- %s=%s is rejected, retrying with %s=%s --\n", clause, value, clause, next)
+		fmt.Printf("%s;\n", retried)
+		ddl = retried
+	}
+}
+
+// Return the fallback after the rejected value, or the first one when the value isn't a fallback.
+func nextFallback(fallbacks []string, rejected string) string {
+	for i, fallback := range fallbacks {
+		if strings.EqualFold(fallback, rejected) {
+			if i+1 < len(fallbacks) {
+				return strings.ToUpper(fallbacks[i+1])
+			}
+			return ""
+		}
+	}
+	if len(fallbacks) > 0 {
+		return strings.ToUpper(fallbacks[0])
+	}
+	return ""
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
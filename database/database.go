@@ -3,11 +3,15 @@ package database
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"regexp"
 	"strings"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
@@ -21,26 +25,85 @@ type Config struct {
 	Socket        string
 	SkipView      bool
 	SkipExtension bool
+	SSHTunnel     *SSHTunnel // dial the connections through the SSH server, except for SQLite3
 
 	// Only MySQL
 	MySQLEnableCleartextPlugin bool
 	SslMode                    string
 	SslCa                      string
+	SslCert                    string
+	SslKey                     string
 
 	// Only PostgreSQL
-	TargetSchema []string
+	TargetSchema  []string
+	ManagedRoles  []string
+	DefaultSchema string // overrides the first schema of search_path
+	Auth          string // one of Auth*, to log in with a token generated for each connection instead of the password
 
 	// Only MySQL and PostgreSQL
 	DumpConcurrency int
+
+	// Only SQL Server
+	FedAuth     string // Azure AD authentication method of go-mssqldb, e.g. ActiveDirectoryDefault
+	AccessToken string // Azure AD access token to authenticate with instead of the user and the password
 }
 
+// How RunDDLs groups the DDLs into transactions.
+const (
+	TransactionModeAuto         = "auto"          // one transaction, except the DDLs which can't run in it
+	TransactionModeAll          = "all"           // exactly one transaction, which fails before applying anything if a DDL can't run in it
+	TransactionModePerStatement = "per-statement" // a transaction per DDL to limit how long its locks are held
+)
+
+// How psqldef logs in with auth of --config, instead of the password.
+const (
+	AuthRDSIAM      = "rds-iam"      // the token of IAM database authentication of Amazon RDS
+	AuthCloudSQLIAM = "cloudsql-iam" // the OAuth 2.0 access token of IAM database authentication of Cloud SQL
+)
+
 type GeneratorConfig struct {
-	TargetTables    []string
-	SkipTables      []string
-	TargetSchema    []string
-	Algorithm       string
-	Lock            string
-	DumpConcurrency int
+	TargetTables         []string
+	SkipTables           []string
+	TargetSchema         []string
+	ManagedRoles         []string                     // for PostgreSQL, the roles whose owners, attributes, and memberships are managed and exported
+	RenamedTables        map[string]string            // new table name -> old table name
+	RenamedColumns       map[string]map[string]string // table name -> new column name -> old column name
+	RenameSequences      bool                         // for PostgreSQL, rename the sequences of serial columns with their renamed tables
+	TypeConversions      map[string]map[string]string // for PostgreSQL, table name -> column name -> USING expression of its type change
+	Algorithm            string
+	Lock                 string
+	AlgorithmTables      map[string]string // for MySQL, table name -> ALGORITHM overriding Algorithm
+	LockTables           map[string]string // for MySQL, table name -> LOCK overriding Lock
+	AlterFallbacks       AlterFallbacks    // for MySQL, ALGORITHM and LOCK to retry a rejected ALTER TABLE with
+	DumpConcurrency      int
+	ForbiddenDDL         []string
+	ReferenceSchemas     []string              // schemas whose objects can be referred to but are never modified
+	Timeouts             map[string]DDLTimeout // DDL category -> timeouts, only for PostgreSQL
+	MaxBatchBytes        int                   // the maximum size of DDLs applied in a transaction, 0 for no limit
+	TransactionMode      string                // for PostgreSQL, one of TransactionMode*, "" for TransactionModeAuto
+	ExplicitNotNull      bool                  // write NOT NULL implied by PRIMARY KEY explicitly in --export
+	StripAutoIncrement   bool                  // for MySQL, omit the AUTO_INCREMENT counters of tables in --export
+	StripDefiner         bool                  // for MySQL, omit DEFINER clauses in --export
+	ExportDefiner        bool                  // for MySQL, write the DEFINER clauses of views, triggers, and events in --export
+	OverrideDefiner      string                // for MySQL, replace DEFINER clauses of the current and desired schemas
+	CanonicalizeDefaults bool                  // for MySQL, omit the table options that only restate the defaults in --export
+	KeepColumnAttributes bool                  // for MySQL, keep the current comment, charset, and collation that a desired column doesn't specify
+	IgnoreColumnOrder    bool                  // for MySQL, neither reorder the columns nor place an added column by AFTER or FIRST
+	SafeNotNull          bool                  // for PostgreSQL, set NOT NULL after validating a NOT VALID CHECK instead of scanning under ACCESS EXCLUSIVE
+	RestartIdentity      bool                  // for PostgreSQL, restart the sequence of an identity column whose START WITH is changed
+	NotifyWebhook        string                // URL to post a JSON summary of each run to
+	AuditTable           string                // table to record the applied DDLs in
+	DisableDDLTriggers   bool                  // for SQL Server, disable the enabled DDL triggers of the database during the apply
+	ContainedDatabase    *bool                 // for SQL Server, whether the database is contained, e.g. Azure SQL Database, detected if nil
+	AutoCreateSchema     bool                  // for PostgreSQL, create the schemas of the desired objects that the desired SQL doesn't create
+	Auth                 string                // for PostgreSQL, one of Auth*, set to Config.Auth
+	SslMode              string                // for MySQL, the default of --ssl-mode
+	SslCa                string                // for MySQL, the default of --ssl-ca
+	SslCert              string                // for MySQL, the default of --ssl-cert
+	SslKey               string                // for MySQL, the default of --ssl-key
+	SSHTunnel            *SSHTunnel            // set to Config.SSHTunnel
+	EnableDrop           bool                  // set by --enable-drop-table, not by --config
+	MergeAlters          bool                  // for MySQL, set by --merge-alters, not by --config
 }
 
 // Abstraction layer for multiple kinds of databases
@@ -51,69 +114,349 @@ type Database interface {
 	GetDefaultSchema() string
 }
 
-func RunDDLs(d Database, ddls []string, enableDropTable bool, beforeApply string, ddlSuffix string) error {
-	transaction, err := d.DB().Begin()
+// Options of RunDDLs and RunDDLsSkippingFailures
+type RunDDLsOptions struct {
+	EnableDropTable  bool           // apply DROP TABLE, which is skipped otherwise
+	BeforeApply      string         // run before the DDLs in the same session
+	DDLSuffix        string         // printed after each DDL, e.g. GO of SQL Server
+	MaxBatchBytes    int            // for RunDDLs, the limit of the DDLs committed in a transaction if positive
+	TransactionMode  string         // for RunDDLs, one of TransactionMode*
+	StatementTimeout time.Duration  // cancel a DDL running longer than it if positive
+	AlterFallbacks   AlterFallbacks // retried when the ALGORITHM or LOCK of an ALTER TABLE is rejected
+}
+
+// Run the DDLs in a transaction. When MaxBatchBytes is positive, the transaction is committed and a new one is begun
+// before the DDLs in it exceed MaxBatchBytes, so that a huge apply doesn't hit the limits of the database. The DDLs are
+// applied in the given order, and BeforeApply and SET LOCAL of the committed transaction are run again in the new one.
+// TransactionMode is one of TransactionMode*, and commits every DDL separately with TransactionModePerStatement.
+// Canceling ctx cancels the running DDL and rolls back the transaction, and a DDL running longer than StatementTimeout
+// is canceled as well when it's positive. An ALTER TABLE whose ALGORITHM or LOCK is rejected is retried with AlterFallbacks.
+func RunDDLs(ctx context.Context, d Database, ddls []string, options RunDDLsOptions) error {
+	if options.MaxBatchBytes > 0 {
+		for _, ddl := range ddls {
+			if len(ddl) > options.MaxBatchBytes {
+				return fmt.Errorf("a DDL of %d bytes exceeds the batch limit of %d bytes: %.80s...", len(ddl), options.MaxBatchBytes, ddl)
+			}
+		}
+	}
+	singleTransaction := options.TransactionMode == TransactionModeAll
+	perStatement := options.TransactionMode == TransactionModePerStatement
+	if singleTransaction {
+		if err := checkSingleTransaction(ddls); err != nil {
+			return err
+		}
+	}
+
+	fmt.Println("-- Apply --")
+	preTransactionDDLs, ddls := SplitPreTransactionDDLs(ddls)
+	for _, ddl := range preTransactionDDLs {
+		fmt.Printf("%s;\n", ddl)
+		fmt.Print(options.DDLSuffix)
+		if err := execDDL(ctx, d.DB(), ddl, options.StatementTimeout); err != nil {
+			return err
+		}
+	}
+
+	transaction, err := d.DB().BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
-	fmt.Println("-- Apply --")
-	if len(beforeApply) > 0 {
-		fmt.Println(beforeApply)
-		if _, err := transaction.Exec(beforeApply); err != nil {
+	if len(options.BeforeApply) > 0 {
+		fmt.Println(options.BeforeApply)
+		if _, err := transaction.ExecContext(ctx, options.BeforeApply); err != nil {
 			transaction.Rollback()
 			return err
 		}
 	}
+	var setLocals []string
+	batchBytes := 0
+	previous := ""
 	for _, ddl := range ddls {
-		if !enableDropTable && strings.Contains(ddl, "DROP TABLE") {
+		if !options.EnableDropTable && strings.Contains(ddl, "DROP TABLE") {
 			fmt.Printf("-- Skipped: %s;\n", ddl)
 			continue
 		}
+		validation := validatesNotNullCheck(previous, ddl) && !singleTransaction
+		previous = ddl
+		if validation && batchBytes > 0 {
+			// Commit the lock taken by the ADD CONSTRAINT ... NOT VALID of safe_not_null before the validation scans the table.
+			if err := transaction.Commit(); err != nil {
+				return err
+			}
+			Infof("-- Committed before validating the constraint --\n")
+			if transaction, err = beginBatch(ctx, d, options.BeforeApply, setLocals); err != nil {
+				return err
+			}
+			batchBytes = 0
+		}
+		if options.MaxBatchBytes > 0 && batchBytes > 0 && batchBytes+len(ddl) > options.MaxBatchBytes && TransactionSupported(ddl) {
+			if err := transaction.Commit(); err != nil {
+				return err
+			}
+			Infof("-- Committed a batch of %d bytes --\n", batchBytes)
+			if transaction, err = beginBatch(ctx, d, options.BeforeApply, setLocals); err != nil {
+				return err
+			}
+			batchBytes = 0
+		}
 		fmt.Printf("%s;\n", ddl)
-		fmt.Print(ddlSuffix)
+		fmt.Print(options.DDLSuffix)
 		var err error
-		if TransactionSupported(ddl) {
-			_, err = transaction.Exec(ddl)
+		// Committed alone anyway, ADD VALUE runs outside a transaction, which PostgreSQL before 12 requires.
+		transactional := TransactionSupported(ddl) && !(perStatement && addEnumValuePattern.MatchString(strings.TrimSpace(ddl)))
+		if transactional {
+			_, err = execDDLWithFallbacks(ctx, transaction, ddl, options.StatementTimeout, options.AlterFallbacks)
+			batchBytes += len(ddl)
 		} else {
-			_, err = d.DB().Exec(ddl)
+			_, err = execDDLWithFallbacks(ctx, d.DB(), ddl, options.StatementTimeout, options.AlterFallbacks)
 		}
 		if err != nil {
 			transaction.Rollback()
 			return err
 		}
+		setLocal := strings.HasPrefix(ddl, "SET LOCAL ")
+		if setLocal {
+			setLocals = append(setLocals, ddl)
+		}
+		if validation || (perStatement && transactional && !setLocal) {
+			// Commit the validation alone, so that the following DDLs don't wait for the scan to take their locks.
+			// With TransactionModePerStatement, every DDL is committed alone for the same reason.
+			if err := transaction.Commit(); err != nil {
+				return err
+			}
+			if transaction, err = beginBatch(ctx, d, options.BeforeApply, setLocals); err != nil {
+				return err
+			}
+			batchBytes = 0
+		}
+	}
+	return transaction.Commit()
+}
+
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Run a DDL on a connection or a transaction, canceling it when it runs longer than statementTimeout.
+func execDDL(ctx context.Context, e execer, ddl string, statementTimeout time.Duration) error {
+	if statementTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, statementTimeout)
+		defer cancel()
+	}
+	_, err := e.ExecContext(ctx, ddl)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("canceled a DDL running longer than %s: %s", statementTimeout, ddl)
+	}
+	return err
+}
+
+// Return an error if a DDL can't run in the single transaction of TransactionModeAll, before anything is applied.
+func checkSingleTransaction(ddls []string) error {
+	preTransactionDDLs, ddls := SplitPreTransactionDDLs(ddls)
+	if len(preTransactionDDLs) > 0 {
+		return fmt.Errorf("transaction_mode 'all' can't apply a new enum value used in the same transaction: %s", preTransactionDDLs[0])
+	}
+	for _, ddl := range ddls {
+		if !TransactionSupported(ddl) {
+			return fmt.Errorf("transaction_mode 'all' can't apply a DDL which can't run in a transaction: %s", ddl)
+		}
 	}
-	transaction.Commit()
 	return nil
 }
 
+// Begin the transaction of the next batch, which runs what the previous transactions ran to set up the session.
+func beginBatch(ctx context.Context, d Database, beforeApply string, setLocals []string) (*sql.Tx, error) {
+	transaction, err := d.DB().BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	statements := setLocals
+	if len(beforeApply) > 0 {
+		statements = append([]string{beforeApply}, setLocals...)
+	}
+	for _, statement := range statements {
+		if _, err := transaction.ExecContext(ctx, statement); err != nil {
+			transaction.Rollback()
+			return nil, err
+		}
+	}
+	return transaction, nil
+}
+
+type DDLFailure struct {
+	DDL         string
+	Err         error
+	Remediation string
+}
+
+// Unlike RunDDLs, this runs each DDL outside a transaction and continues past failing DDLs,
+// so that a legacy schema can be adopted as much as possible in a single run.
+// Canceling ctx stops the run, and the failure of a DDL running longer than StatementTimeout is reported like the others.
+// BeforeApply and the DDLs run on a single connection, so that the session set up by BeforeApply applies to all of them.
+// MaxBatchBytes and TransactionMode of options are ignored.
+func RunDDLsSkippingFailures(ctx context.Context, d Database, ddls []string, options RunDDLsOptions) ([]DDLFailure, error) {
+	conn, err := d.DB().Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	fmt.Println("-- Apply --")
+	if len(options.BeforeApply) > 0 {
+		fmt.Println(options.BeforeApply)
+		if _, err := conn.ExecContext(ctx, options.BeforeApply); err != nil {
+			return nil, err
+		}
+	}
+	var failures []DDLFailure
+	for _, ddl := range ddls {
+		if !options.EnableDropTable && strings.Contains(ddl, "DROP TABLE") {
+			fmt.Printf("-- Skipped: %s;\n", ddl)
+			continue
+		}
+		fmt.Printf("%s;\n", ddl)
+		fmt.Print(options.DDLSuffix)
+		if applied, err := execDDLWithFallbacks(ctx, conn, ddl, options.StatementTimeout, options.AlterFallbacks); err != nil {
+			if ctx.Err() != nil {
+				return failures, err
+			}
+			Infof("-- Failed: %s\n", err)
+			failures = append(failures, DDLFailure{
+				DDL:         applied,
+				Err:         err,
+				Remediation: suggestRemediation(err),
+			})
+		}
+	}
+	return failures, nil
+}
+
+func suggestRemediation(err error) string {
+	message := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(message, "already exists") || strings.Contains(message, "duplicate column") || strings.Contains(message, "duplicate key name"):
+		return "The object already exists in the database. Make the desired schema match it, or drop it manually."
+	case strings.Contains(message, "does not exist") || strings.Contains(message, "doesn't exist") || strings.Contains(message, "no such") || strings.Contains(message, "invalid object name"):
+		return "A referenced object is missing. Define it in the desired schema, or fix the reference."
+	case strings.Contains(message, "duplicate entry") || strings.Contains(message, "could not create unique index") || strings.Contains(message, "unique constraint failed"):
+		return "Existing rows violate the new unique constraint. Remove the duplicated rows first."
+	case strings.Contains(message, "null value") || strings.Contains(message, "contains null values") || strings.Contains(message, "invalid use of null") || strings.Contains(message, "not null constraint failed"):
+		return "Existing rows have NULL in the column. Fill them in before adding NOT NULL."
+	case strings.Contains(message, "foreign key") || strings.Contains(message, "violates"):
+		return "Existing rows violate the constraint. Fix the data before applying it."
+	case strings.Contains(message, "permission denied") || strings.Contains(message, "access denied") || strings.Contains(message, "command denied"):
+		return "The user lacks the privilege for this DDL. Grant it, or run the DDL as a privileged user."
+	default:
+		return "Fix the DDL or the database manually, and run sqldef again."
+	}
+}
+
 func TransactionSupported(ddl string) bool {
 	return !strings.Contains(strings.ToLower(ddl), "concurrently")
 }
 
-func ParseGeneratorConfig(configFile string) GeneratorConfig {
-	if configFile == "" {
-		return GeneratorConfig{}
+var addNotNullCheckPattern = regexp.MustCompile(`(?is)^ALTER TABLE\s+(.+)\s+ADD CONSTRAINT\s+(\S+)\s+CHECK \(.+ IS NOT NULL\) NOT VALID$`)
+
+// Report whether the DDL validates the NOT VALID CHECK that safe_not_null added by the previous DDL. It runs in its own
+// transaction, because it scans the table, which should happen without holding the locks of the other DDLs. The other
+// VALIDATE CONSTRAINTs stay in the transaction of transaction_mode.
+func validatesNotNullCheck(previous string, ddl string) bool {
+	match := addNotNullCheckPattern.FindStringSubmatch(strings.TrimSpace(previous))
+	if match == nil {
+		return false
+	}
+	return strings.TrimSpace(ddl) == fmt.Sprintf("ALTER TABLE %s VALIDATE CONSTRAINT %s", match[1], match[2])
+}
+
+var addEnumValuePattern = regexp.MustCompile(`(?is)^ALTER TYPE\s+\S+\s+ADD VALUE\s+(?:IF NOT EXISTS\s+)?('(?:[^']|'')*')`)
+
+// SplitPreTransactionDDLs splits out ALTER TYPE ... ADD VALUE whose new value is used by a later DDL, e.g. as a column
+// default, from the others. PostgreSQL doesn't allow a new enum value to be used in the transaction adding it, so such
+// DDLs need to be committed before the transaction, like CREATE INDEX CONCURRENTLY runs outside of it.
+func SplitPreTransactionDDLs(ddls []string) ([]string, []string) {
+	var preTransaction, others []string
+	for i, ddl := range ddls {
+		if match := addEnumValuePattern.FindStringSubmatch(strings.TrimSpace(ddl)); match != nil && usedByLaterDDLs(match[1], ddls[i+1:]) {
+			preTransaction = append(preTransaction, ddl)
+		} else {
+			others = append(others, ddl)
+		}
 	}
+	return preTransaction, others
+}
 
-	buf, err := os.ReadFile(configFile)
-	if err != nil {
-		log.Fatal(err)
+func usedByLaterDDLs(value string, ddls []string) bool {
+	for _, ddl := range ddls {
+		if strings.Contains(ddl, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Parse the YAML files given by --config. When multiple files are given, e.g. a base config and an environment-specific
+// one, they are overlaid in order: a key in a later file overrides the same key in the earlier ones.
+func ParseGeneratorConfig(configFiles []string) GeneratorConfig {
+	if len(configFiles) == 0 {
+		return GeneratorConfig{}
 	}
 
 	var config struct {
-		TargetTables    string `yaml:"target_tables"`
-		SkipTables      string `yaml:"skip_tables"`
-		TargetSchema    string `yaml:"target_schema"`
-		Algorithm       string `yaml:"algorithm"`
-		Lock            string `yaml:"lock"`
-		DumpConcurrency int    `yaml:"dump_concurrency"`
+		TargetTables    string      `yaml:"target_tables"`
+		SkipTables      string      `yaml:"skip_tables"`
+		TargetSchema    string      `yaml:"target_schema"`
+		ManagedRoles    string      `yaml:"managed_roles"`
+		Algorithm       AlterPolicy `yaml:"algorithm"`
+		Lock            AlterPolicy `yaml:"lock"`
+		DumpConcurrency int         `yaml:"dump_concurrency"`
+		Renames         struct {
+			Tables    string `yaml:"tables"`
+			Columns   string `yaml:"columns"`
+			Sequences bool   `yaml:"sequences"`
+		} `yaml:"renames"`
+		TypeConversions      map[string]string     `yaml:"type_conversions"`
+		ForbiddenDDL         []string              `yaml:"forbidden_ddl"`
+		ReferenceSchemas     []string              `yaml:"reference_schemas"`
+		Timeouts             map[string]DDLTimeout `yaml:"timeouts"`
+		MaxBatchBytes        int                   `yaml:"max_batch_bytes"`
+		TransactionMode      string                `yaml:"transaction_mode"`
+		ExplicitNotNull      bool                  `yaml:"export_explicit_not_null"`
+		StripAutoIncrement   bool                  `yaml:"export_strip_auto_increment"`
+		StripDefiner         bool                  `yaml:"export_strip_definer"`
+		ExportDefiner        bool                  `yaml:"export_definer"`
+		OverrideDefiner      string                `yaml:"override_definer"`
+		CanonicalizeDefaults bool                  `yaml:"export_canonicalize_defaults"`
+		KeepColumnAttributes bool                  `yaml:"keep_column_attributes"`
+		IgnoreColumnOrder    bool                  `yaml:"ignore_column_order"`
+		SafeNotNull          bool                  `yaml:"safe_not_null"`
+		RestartIdentity      bool                  `yaml:"restart_identity"`
+		NotifyWebhook        string                `yaml:"notify_webhook"`
+		AuditTable           string                `yaml:"audit_table"`
+		DisableDDLTriggers   bool                  `yaml:"disable_ddl_triggers"`
+		ContainedDatabase    *bool                 `yaml:"contained_database"`
+		AutoCreateSchema     bool                  `yaml:"auto_create_schema"`
+		Auth                 string                `yaml:"auth"`
+		SslMode              string                `yaml:"ssl_mode"`
+		SslCa                string                `yaml:"ssl_ca"`
+		SslCert              string                `yaml:"ssl_cert"`
+		SslKey               string                `yaml:"ssl_key"`
+		SSHTunnel            *SSHTunnel            `yaml:"ssh_tunnel"`
 	}
 
-	dec := yaml.NewDecoder(bytes.NewReader(buf))
-	dec.KnownFields(true)
-	err = dec.Decode(&config)
-	if err != nil {
-		log.Fatal(err)
+	for _, configFile := range configFiles {
+		buf, err := os.ReadFile(configFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		// Decoding into the same struct keeps the keys that the file doesn't specify
+		dec := yaml.NewDecoder(bytes.NewReader(buf))
+		dec.KnownFields(true)
+		err = dec.Decode(&config)
+		if err != nil {
+			log.Fatalf("%s: %s", configFile, err)
+		}
 	}
 
 	var targetTables []string
@@ -131,21 +474,133 @@ func ParseGeneratorConfig(configFile string) GeneratorConfig {
 		targetSchema = strings.Split(strings.Trim(config.TargetSchema, "\n"), "\n")
 	}
 
-	var algorithm string
-	if config.Algorithm != "" {
-		algorithm = strings.Trim(config.Algorithm, "\n")
+	var managedRoles []string
+	if config.ManagedRoles != "" {
+		managedRoles = strings.Split(strings.Trim(config.ManagedRoles, "\n"), "\n")
+	}
+
+	var renamedTables map[string]string
+	if config.Renames.Tables != "" {
+		renamedTables = map[string]string{}
+		for _, line := range strings.Split(strings.Trim(config.Renames.Tables, "\n"), "\n") {
+			oldName, newName := parseRename(line)
+			renamedTables[newName] = oldName
+		}
+	}
+
+	var renamedColumns map[string]map[string]string
+	if config.Renames.Columns != "" {
+		renamedColumns = map[string]map[string]string{}
+		for _, line := range strings.Split(strings.Trim(config.Renames.Columns, "\n"), "\n") {
+			oldName, newName := parseRename(line)
+			oldTable, oldColumn := splitColumnName(oldName)
+			newTable, newColumn := splitColumnName(newName)
+			if oldTable != newTable {
+				log.Fatalf("a column cannot be renamed across tables: %s", line)
+			}
+			if renamedColumns[newTable] == nil {
+				renamedColumns[newTable] = map[string]string{}
+			}
+			renamedColumns[newTable][newColumn] = oldColumn
+		}
+	}
+
+	var typeConversions map[string]map[string]string
+	for name, expr := range config.TypeConversions {
+		if typeConversions == nil {
+			typeConversions = map[string]map[string]string{}
+		}
+		table, column := splitColumnName(name)
+		if typeConversions[table] == nil {
+			typeConversions[table] = map[string]string{}
+		}
+		typeConversions[table][column] = strings.TrimSpace(expr)
 	}
 
-	var lock string
-	if config.Lock != "" {
-		lock = strings.Trim(config.Lock, "\n")
+	config.Algorithm.validate("algorithm", validAlgorithms)
+	config.Lock.validate("lock", validLocks)
+
+	for _, class := range config.ForbiddenDDL {
+		if !isValidDDLClass(class) {
+			log.Fatalf("unknown forbidden_ddl '%s' (expected one of: %s)", class, strings.Join(ddlClassNames(), ", "))
+		}
+	}
+	switch config.TransactionMode {
+	case "", TransactionModeAuto, TransactionModeAll, TransactionModePerStatement:
+	default:
+		log.Fatalf("unknown transaction_mode '%s' (expected one of: %s, %s, %s)", config.TransactionMode, TransactionModeAuto, TransactionModeAll, TransactionModePerStatement)
+	}
+	switch config.Auth {
+	case "", AuthRDSIAM, AuthCloudSQLIAM:
+	default:
+		log.Fatalf("unknown auth '%s' (expected one of: %s, %s)", config.Auth, AuthRDSIAM, AuthCloudSQLIAM)
+	}
+	if config.SSHTunnel != nil && config.SSHTunnel.Host == "" {
+		log.Fatal("ssh_tunnel needs its host")
+	}
+	for category := range config.Timeouts {
+		if !isValidDDLCategory(category) {
+			log.Fatalf("unknown category of timeouts '%s' (expected one of: %s)", category, strings.Join(ddlCategoryNames(), ", "))
+		}
 	}
 	return GeneratorConfig{
-		TargetTables:    targetTables,
-		SkipTables:      skipTables,
-		TargetSchema:    targetSchema,
-		Algorithm:       algorithm,
-		Lock:            lock,
-		DumpConcurrency: config.DumpConcurrency,
+		TargetTables:         targetTables,
+		SkipTables:           skipTables,
+		TargetSchema:         targetSchema,
+		ManagedRoles:         managedRoles,
+		RenamedTables:        renamedTables,
+		RenamedColumns:       renamedColumns,
+		RenameSequences:      config.Renames.Sequences,
+		TypeConversions:      typeConversions,
+		Algorithm:            config.Algorithm.Default,
+		Lock:                 config.Lock.Default,
+		AlgorithmTables:      config.Algorithm.Tables,
+		LockTables:           config.Lock.Tables,
+		AlterFallbacks:       AlterFallbacks{Algorithms: config.Algorithm.Fallbacks, Locks: config.Lock.Fallbacks},
+		DumpConcurrency:      config.DumpConcurrency,
+		ForbiddenDDL:         config.ForbiddenDDL,
+		ReferenceSchemas:     config.ReferenceSchemas,
+		Timeouts:             config.Timeouts,
+		MaxBatchBytes:        config.MaxBatchBytes,
+		TransactionMode:      config.TransactionMode,
+		ExplicitNotNull:      config.ExplicitNotNull,
+		StripAutoIncrement:   config.StripAutoIncrement,
+		StripDefiner:         config.StripDefiner,
+		ExportDefiner:        config.ExportDefiner,
+		OverrideDefiner:      config.OverrideDefiner,
+		CanonicalizeDefaults: config.CanonicalizeDefaults,
+		KeepColumnAttributes: config.KeepColumnAttributes,
+		IgnoreColumnOrder:    config.IgnoreColumnOrder,
+		SafeNotNull:          config.SafeNotNull,
+		RestartIdentity:      config.RestartIdentity,
+		NotifyWebhook:        config.NotifyWebhook,
+		AuditTable:           config.AuditTable,
+		DisableDDLTriggers:   config.DisableDDLTriggers,
+		ContainedDatabase:    config.ContainedDatabase,
+		AutoCreateSchema:     config.AutoCreateSchema,
+		Auth:                 config.Auth,
+		SslMode:              config.SslMode,
+		SslCa:                config.SslCa,
+		SslCert:              config.SslCert,
+		SslKey:               config.SslKey,
+		SSHTunnel:            config.SSHTunnel,
+	}
+}
+
+// Parse a line of the renames section, e.g. "old_name -> new_name".
+func parseRename(line string) (string, string) {
+	names := strings.Split(line, "->")
+	if len(names) != 2 || strings.TrimSpace(names[0]) == "" || strings.TrimSpace(names[1]) == "" {
+		log.Fatalf("invalid rename (expected 'old -> new'): %s", line)
+	}
+	return strings.TrimSpace(names[0]), strings.TrimSpace(names[1])
+}
+
+// Split "table.column" (or "schema.table.column") into its table and column parts.
+func splitColumnName(name string) (string, string) {
+	i := strings.LastIndex(name, ".")
+	if i <= 0 || i == len(name)-1 {
+		log.Fatalf("invalid column name (expected 'table.column'): %s", name)
 	}
+	return name[:i], name[i+1:]
 }
@@ -0,0 +1,16 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatesNotNullCheck(t *testing.T) {
+	addCheck := `ALTER TABLE "public"."users" ADD CONSTRAINT "users_name_not_null" CHECK ("name" IS NOT NULL) NOT VALID`
+	assert.True(t, validatesNotNullCheck(addCheck, `ALTER TABLE "public"."users" VALIDATE CONSTRAINT "users_name_not_null"`))
+	// VALIDATE CONSTRAINT of the user's own NOT VALID constraints stays in the transaction
+	assert.False(t, validatesNotNullCheck(addCheck, `ALTER TABLE "public"."users" VALIDATE CONSTRAINT "users_name_check"`))
+	assert.False(t, validatesNotNullCheck(`ALTER TABLE "public"."users" ADD COLUMN "age" integer`, `ALTER TABLE "public"."users" VALIDATE CONSTRAINT "users_name_not_null"`))
+	assert.False(t, validatesNotNullCheck("", `ALTER TABLE "public"."users" VALIDATE CONSTRAINT "users_name_not_null"`))
+}
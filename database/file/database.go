@@ -7,12 +7,14 @@ import (
 
 // Pseudo database for comparison between files
 type FileDatabase struct {
-	file string
+	file          string
+	defaultSchema string
 }
 
-func NewDatabase(file string) FileDatabase {
+func NewDatabase(file string, defaultSchema string) FileDatabase {
 	return FileDatabase{
-		file: file,
+		file:          file,
+		defaultSchema: defaultSchema,
 	}
 }
 
@@ -29,5 +31,5 @@ func (f FileDatabase) Close() error {
 }
 
 func (f FileDatabase) GetDefaultSchema() string {
-	return ""
+	return f.defaultSchema
 }
@@ -0,0 +1,56 @@
+package database
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Classes of DDLs that can be listed in forbidden_ddl of --config
+var ddlClasses = map[string]*regexp.Regexp{
+	"drop_table":      regexp.MustCompile(`(?is)^DROP TABLE `),
+	"drop_column":     regexp.MustCompile(`(?is)^ALTER TABLE .* DROP COLUMN `),
+	"drop_index":      regexp.MustCompile(`(?is)^(DROP INDEX |ALTER TABLE .* DROP (INDEX|KEY) )`),
+	"drop_constraint": regexp.MustCompile(`(?is)^ALTER TABLE .* DROP (CONSTRAINT|FOREIGN KEY|PRIMARY KEY|CHECK) `),
+	"drop_view":       regexp.MustCompile(`(?is)^DROP (MATERIALIZED )?VIEW `),
+	"drop_trigger":    regexp.MustCompile(`(?is)^DROP TRIGGER `),
+	"drop_policy":     regexp.MustCompile(`(?is)^DROP POLICY `),
+	"disable_trigger": regexp.MustCompile(`(?is)DISABLE TRIGGER `),
+	"change_column":   regexp.MustCompile(`(?is)^ALTER TABLE .* (ALTER COLUMN .* TYPE |MODIFY COLUMN |CHANGE COLUMN )`),
+}
+
+type ForbiddenDDL struct {
+	DDL   string
+	Class string
+}
+
+func isValidDDLClass(class string) bool {
+	_, ok := ddlClasses[class]
+	return ok
+}
+
+func ddlClassNames() []string {
+	names := make([]string, 0, len(ddlClasses))
+	for name := range ddlClasses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FindForbiddenDDLs returns the DDLs that would be executed and belong to one of the forbidden classes.
+func FindForbiddenDDLs(ddls []string, forbiddenClasses []string, enableDropTable bool) []ForbiddenDDL {
+	var forbidden []ForbiddenDDL
+	for _, ddl := range ddls {
+		if !enableDropTable && strings.Contains(ddl, "DROP TABLE") {
+			continue // skipped by RunDDLs
+		}
+		for _, class := range forbiddenClasses {
+			if ddlClasses[class].MatchString(strings.TrimSpace(ddl)) {
+				forbidden = append(forbidden, ForbiddenDDL{DDL: ddl, Class: class})
+				break
+			}
+		}
+	}
+	return forbidden
+}
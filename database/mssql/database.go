@@ -2,23 +2,31 @@ package mssql
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"net/url"
 	"regexp"
 	"strconv"
 	"strings"
 
-	_ "github.com/microsoft/go-mssqldb"
+	mssql "github.com/microsoft/go-mssqldb"
+	"github.com/microsoft/go-mssqldb/azuread"
 	"github.com/sqldef/sqldef/database"
 )
 
 const indent = "    "
 
+// The maximum size of a batch, which is 65,536 * the default network packet size of 4 KB.
+const MaxBatchBytes = 65536 * 4096
+
 type databaseInfo struct {
-	tableName   []string
-	columns     map[string][]column
-	indexDefs   map[string][]*indexDef
-	foreignDefs map[string][]string
+	tableName    []string
+	columns      map[string][]column
+	indexDefs    map[string][]*indexDef
+	foreignDefs  map[string][]string
+	tableOptions map[string][]string
+	comments     map[string][]string
 }
 
 type MssqlDatabase struct {
@@ -26,20 +34,55 @@ type MssqlDatabase struct {
 	db            *sql.DB
 	defaultSchema *string
 	info          databaseInfo
+	tunnel        *database.SSHDialer
 }
 
 func NewDatabase(config database.Config) (database.Database, error) {
-	db, err := sql.Open("sqlserver", mssqlBuildDSN(config))
+	var connector *mssql.Connector
+	var err error
+	switch {
+	case config.AccessToken != "":
+		var tokenConnector driver.Connector
+		tokenConnector, err = mssql.NewAccessTokenConnector(mssqlBuildDSN(config), func() (string, error) {
+			return config.AccessToken, nil
+		})
+		if err == nil {
+			connector = tokenConnector.(*mssql.Connector) // to set its Dialer
+		}
+	case config.FedAuth != "":
+		// The azuread connector gets a token of the fedauth method, e.g. from the managed identity of ActiveDirectoryDefault
+		connector, err = azuread.NewConnector(mssqlBuildDSN(config))
+	default:
+		connector, err = mssql.NewConnector(mssqlBuildDSN(config))
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	var tunnel *database.SSHDialer
+	if config.SSHTunnel != nil {
+		tunnel = database.NewSSHDialer(*config.SSHTunnel)
+		connector.Dialer = sshHostDialer{SSHDialer: tunnel, host: config.Host}
+	}
+
 	return &MssqlDatabase{
-		db:     db,
+		db:     sql.OpenDB(connector),
 		config: config,
+		tunnel: tunnel,
 	}, nil
 }
 
+// A dialer through ssh_tunnel of --config. As a HostDialer, it lets the SSH server resolve the host name, which may be
+// resolvable only behind the bastion host.
+type sshHostDialer struct {
+	*database.SSHDialer
+	host string
+}
+
+func (d sshHostDialer) HostName() string {
+	return d.host
+}
+
 func (d *MssqlDatabase) DumpDDLs() (string, error) {
 	var ddls []string
 
@@ -48,6 +91,12 @@ func (d *MssqlDatabase) DumpDDLs() (string, error) {
 		return "", err
 	}
 
+	tableTypeDDLs, err := d.tableTypes()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, tableTypeDDLs...)
+
 	tableNames := d.tableNames()
 	for _, tableName := range tableNames {
 		ddl, err := d.dumpTableDDL(tableName)
@@ -56,6 +105,7 @@ func (d *MssqlDatabase) DumpDDLs() (string, error) {
 		}
 
 		ddls = append(ddls, ddl)
+		ddls = append(ddls, d.getComments(tableName)...)
 	}
 
 	viewDDLs, err := d.views()
@@ -92,6 +142,14 @@ func (d *MssqlDatabase) updateDatabaesInfo() error {
 	if err != nil {
 		return err
 	}
+	err = d.updateTableOptions()
+	if err != nil {
+		return err
+	}
+	err = d.updateComments()
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -125,10 +183,11 @@ func (d *MssqlDatabase) dumpTableDDL(table string) (string, error) {
 	cols := d.getColumns(table)
 	indexDefs := d.getIndexDefs(table)
 	foreignDefs := d.getForeignDefs(table)
-	return buildDumpTableDDL(table, cols, indexDefs, foreignDefs), nil
+	tableOptions := d.getTableOptions(table)
+	return buildDumpTableDDL(table, cols, indexDefs, foreignDefs, tableOptions), nil
 }
 
-func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, foreignDefs []string) string {
+func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, foreignDefs []string, tableOptions []string) string {
 	var queryBuilder strings.Builder
 	fmt.Fprintf(&queryBuilder, "CREATE TABLE %s (", table)
 	for i, col := range columns {
@@ -189,7 +248,11 @@ func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, fo
 		fmt.Fprint(&queryBuilder, ",\n"+indent)
 		fmt.Fprint(&queryBuilder, v)
 	}
-	fmt.Fprintf(&queryBuilder, "\n);\n")
+	fmt.Fprint(&queryBuilder, "\n)")
+	if len(tableOptions) > 0 {
+		fmt.Fprintf(&queryBuilder, " WITH (%s)", strings.Join(tableOptions, ", "))
+	}
+	fmt.Fprint(&queryBuilder, ";\n")
 
 	for _, indexDef := range indexDefs {
 		if indexDef.primary {
@@ -205,7 +268,7 @@ func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, fo
 			fmt.Fprint(&queryBuilder, "CREATE")
 		}
 		switch indexDef.indexType {
-		case "CLUSTERED", "NONCLUSTERED", "NONCLUSTERED COLUMNSTORE":
+		case "CLUSTERED", "NONCLUSTERED", "CLUSTERED COLUMNSTORE", "NONCLUSTERED COLUMNSTORE":
 			fmt.Fprintf(&queryBuilder, " %s", indexDef.indexType)
 		}
 		if !indexDef.constraint {
@@ -213,7 +276,7 @@ func buildDumpTableDDL(table string, columns []column, indexDefs []*indexDef, fo
 		}
 		if indexDef.indexType == "NONCLUSTERED COLUMNSTORE" {
 			fmt.Fprintf(&queryBuilder, " (%s)", strings.Join(indexDef.included, ", "))
-		} else {
+		} else if indexDef.indexType != "CLUSTERED COLUMNSTORE" { // a clustered columnstore index has no column list
 			fmt.Fprintf(&queryBuilder, " (%s)", strings.Join(indexDef.columns, ", "))
 			if len(indexDef.included) > 0 {
 				fmt.Fprintf(&queryBuilder, " INCLUDE (%s)", strings.Join(indexDef.included, ", "))
@@ -536,12 +599,82 @@ func (d *MssqlDatabase) getIndexDefs(table string) []*indexDef {
 	}
 }
 
+// Options of memory-optimized tables, which are dumped as `WITH (MEMORY_OPTIMIZED = ON, DURABILITY = ...)`.
+func (d *MssqlDatabase) updateTableOptions() error {
+	// `sys.tables.is_memory_optimized` only exists SQL Server 2014 (12.x) and above.
+	rows, err := d.db.Query(`SELECT schema_name(schema_id), name, durability_desc FROM sys.tables WHERE is_memory_optimized = 1`)
+	if err != nil {
+		return nil
+	}
+	defer rows.Close()
+
+	tableOptions := make(map[string][]string)
+	for rows.Next() {
+		var schemaName, tableName, durability string
+		if err := rows.Scan(&schemaName, &tableName, &durability); err != nil {
+			return err
+		}
+		tableOptions[schemaName+"."+tableName] = []string{"MEMORY_OPTIMIZED = ON", "DURABILITY = " + durability}
+	}
+	d.info.tableOptions = tableOptions
+	return nil
+}
+
+func (d *MssqlDatabase) getTableOptions(table string) []string {
+	schema, table := splitTableName(table, d.GetDefaultSchema())
+	return d.info.tableOptions[schema+"."+table]
+}
+
+// MS_Description of tables and columns, which are dumped as COMMENT ON.
+func (d *MssqlDatabase) updateComments() error {
+	query := `SELECT
+	SCHEMA_NAME(obj.schema_id),
+	obj.name,
+	c.name,
+	CAST(ep.value AS nvarchar(max))
+FROM sys.extended_properties ep
+INNER JOIN sys.objects obj ON obj.object_id = ep.major_id
+LEFT JOIN sys.columns c ON c.object_id = ep.major_id AND c.column_id = ep.minor_id
+WHERE ep.class = 1 AND ep.name = 'MS_Description' AND obj.type = 'U'
+ORDER BY ep.minor_id`
+
+	rows, err := d.db.Query(query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	comments := make(map[string][]string)
+	for rows.Next() {
+		var schemaName, tableName, comment string
+		var columnName *string
+		if err := rows.Scan(&schemaName, &tableName, &columnName, &comment); err != nil {
+			return err
+		}
+		value := "N'" + strings.ReplaceAll(comment, "'", "''") + "'"
+		name := schemaName + "." + tableName
+		if columnName == nil {
+			comments[name] = append(comments[name], fmt.Sprintf("COMMENT ON TABLE %s.%s IS %s;", quoteName(schemaName), quoteName(tableName), value))
+		} else {
+			comments[name] = append(comments[name], fmt.Sprintf("COMMENT ON COLUMN %s.%s.%s IS %s;", quoteName(schemaName), quoteName(tableName), quoteName(*columnName), value))
+		}
+	}
+	d.info.comments = comments
+	return nil
+}
+
+func (d *MssqlDatabase) getComments(table string) []string {
+	schema, table := splitTableName(table, d.GetDefaultSchema())
+	return d.info.comments[schema+"."+table]
+}
+
 func (d *MssqlDatabase) updateForeignDefs() error {
 	query := `SELECT
 	SCHEMA_NAME(obj.schema_id),
     obj.name as table_name,
     f.name as constraint_name,
     COL_NAME(obj.object_id, fc.parent_column_id) as column_name,
+    OBJECT_SCHEMA_NAME(f.referenced_object_id) as ref_schema_name,
     OBJECT_NAME(f.referenced_object_id) as ref_table_name,
     COL_NAME(f.referenced_object_id, fc.referenced_column_id) as ref_column_name,
     f.update_referential_action_desc,
@@ -561,17 +694,17 @@ WHERE obj.type = 'U'`
 	defs := make(map[string][]string)
 
 	for rows.Next() {
-		var schemaName, tableName, constraintName, columnName, foreignTableName, foreignColumnName, foreignUpdateRule, foreignDeleteRule string
+		var schemaName, tableName, constraintName, columnName, foreignSchemaName, foreignTableName, foreignColumnName, foreignUpdateRule, foreignDeleteRule string
 		var notForReplication bool
 
-		err = rows.Scan(&schemaName, &tableName, &constraintName, &columnName, &foreignTableName, &foreignColumnName, &foreignUpdateRule, &foreignDeleteRule, &notForReplication)
+		err = rows.Scan(&schemaName, &tableName, &constraintName, &columnName, &foreignSchemaName, &foreignTableName, &foreignColumnName, &foreignUpdateRule, &foreignDeleteRule, &notForReplication)
 		if err != nil {
 			return err
 		}
 		foreignUpdateRule = strings.Replace(foreignUpdateRule, "_", " ", -1)
 		foreignDeleteRule = strings.Replace(foreignDeleteRule, "_", " ", -1)
 
-		def := fmt.Sprintf("CONSTRAINT [%s] FOREIGN KEY ([%s]) REFERENCES [%s] ([%s]) ON UPDATE %s ON DELETE %s", constraintName, columnName, foreignTableName, foreignColumnName, foreignUpdateRule, foreignDeleteRule)
+		def := fmt.Sprintf("CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s.%s (%s) ON UPDATE %s ON DELETE %s", quoteName(constraintName), quoteName(columnName), quoteName(foreignSchemaName), quoteName(foreignTableName), quoteName(foreignColumnName), foreignUpdateRule, foreignDeleteRule)
 		if notForReplication {
 			def += " NOT FOR REPLICATION"
 		}
@@ -641,11 +774,100 @@ INNER JOIN sys.sql_modules
 	return ddls, nil
 }
 
+// Only database-scoped catalog views are queried, so that contained users of Azure SQL Database, who can't access
+// master or server-level views, can export the schema as well.
+// Dump the user-defined table types, e.g. for table-valued parameters, with their columns and primary keys.
+func (d *MssqlDatabase) tableTypes() ([]string, error) {
+	query := `SELECT
+	SCHEMA_NAME(tt.schema_id),
+	tt.name,
+	c.name,
+	tp.name,
+	c.max_length,
+	c.precision,
+	c.scale,
+	c.is_nullable,
+	ic.key_ordinal
+FROM sys.table_types tt
+JOIN sys.columns c ON c.object_id = tt.type_table_object_id
+JOIN sys.types tp ON c.user_type_id = tp.user_type_id
+LEFT JOIN sys.indexes i ON i.object_id = tt.type_table_object_id AND i.is_primary_key = 1
+LEFT JOIN sys.index_columns ic ON ic.object_id = i.object_id AND ic.index_id = i.index_id AND ic.column_id = c.column_id
+WHERE tt.is_user_defined = 1
+ORDER BY SCHEMA_NAME(tt.schema_id), tt.name, c.column_id`
+
+	rows, err := d.db.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var typeNames []string
+	columns := map[string][]column{}
+	primaryKeys := map[string]map[int]string{}
+	for rows.Next() {
+		var schemaName, typeName, precision string
+		var keyOrdinal *int
+		col := column{}
+		err = rows.Scan(&schemaName, &typeName, &col.Name, &col.dataType, &col.MaxLength, &precision, &col.Scale, &col.Nullable, &keyOrdinal)
+		if err != nil {
+			return nil, err
+		}
+		switch col.dataType {
+		case "numeric", "decimal":
+			col.MaxLength = precision
+		}
+		name := schemaName + "." + typeName
+		if _, ok := columns[name]; !ok {
+			typeNames = append(typeNames, name)
+			primaryKeys[name] = map[int]string{}
+		}
+		columns[name] = append(columns[name], col)
+		if keyOrdinal != nil {
+			primaryKeys[name][*keyOrdinal] = quoteName(col.Name)
+		}
+	}
+
+	var ddls []string
+	for _, name := range typeNames {
+		var primaryKey []string
+		for i := 1; i <= len(primaryKeys[name]); i++ {
+			primaryKey = append(primaryKey, primaryKeys[name][i])
+		}
+		ddls = append(ddls, buildDumpTableTypeDDL(name, columns[name], primaryKey))
+	}
+	return ddls, nil
+}
+
+func buildDumpTableTypeDDL(typeName string, columns []column, primaryKey []string) string {
+	var queryBuilder strings.Builder
+	fmt.Fprintf(&queryBuilder, "CREATE TYPE %s AS TABLE (", typeName)
+	for i, col := range columns {
+		if i > 0 {
+			fmt.Fprint(&queryBuilder, ",")
+		}
+		fmt.Fprintf(&queryBuilder, "\n"+indent+"%s %s", quoteName(col.Name), col.dataType)
+		if length, ok := col.getLength(); ok {
+			fmt.Fprintf(&queryBuilder, "(%s)", length)
+		}
+		if !col.Nullable {
+			fmt.Fprint(&queryBuilder, " NOT NULL")
+		}
+	}
+	if len(primaryKey) > 0 {
+		fmt.Fprintf(&queryBuilder, ",\n"+indent+"PRIMARY KEY (%s)", strings.Join(primaryKey, ", "))
+	}
+	fmt.Fprint(&queryBuilder, "\n);")
+	return queryBuilder.String()
+}
+
+// DDL triggers of the database, whose parent_class is 0, are not managed, so only the triggers on tables are dumped.
 func (d *MssqlDatabase) triggers() ([]string, error) {
 	query := `SELECT
 	s.definition
 FROM sys.triggers tr
-INNER JOIN sys.all_sql_modules s ON s.object_id = tr.object_id`
+INNER JOIN sys.sql_modules s ON s.object_id = tr.object_id
+WHERE tr.parent_class = 1`
 
 	rows, err := d.db.Query(query)
 	if err != nil {
@@ -670,8 +892,26 @@ func (d *MssqlDatabase) DB() *sql.DB {
 	return d.db
 }
 
+// The EngineEdition of SERVERPROPERTY for Azure SQL Database, where a user of a database can't access master.
+const engineEditionAzureSQLDatabase = 5
+
+// Return whether the database is a contained database or an Azure SQL Database, whose users may have no login of the
+// server. Only SERVERPROPERTY and the row of the database in sys.databases are read, which its users can see.
+func IsContainedDatabase(db database.Database) (bool, error) {
+	var engineEdition, containment int
+	err := db.DB().QueryRow("SELECT CAST(SERVERPROPERTY('EngineEdition') AS int), containment FROM sys.databases WHERE database_id = DB_ID()").Scan(&engineEdition, &containment)
+	if err != nil {
+		return false, err
+	}
+	return isContainedDatabase(engineEdition, containment), nil
+}
+
+func isContainedDatabase(engineEdition int, containment int) bool {
+	return engineEdition == engineEditionAzureSQLDatabase || containment != 0
+}
+
 func (d *MssqlDatabase) Close() error {
-	return d.db.Close()
+	return errors.Join(d.db.Close(), d.tunnel.Close())
 }
 
 func (d *MssqlDatabase) GetDefaultSchema() string {
@@ -695,13 +935,19 @@ func (d *MssqlDatabase) GetDefaultSchema() string {
 func mssqlBuildDSN(config database.Config) string {
 	query := url.Values{}
 	query.Add("database", config.DbName)
+	if config.FedAuth != "" {
+		query.Add("fedauth", config.FedAuth)
+	}
 
 	u := &url.URL{
 		Scheme:   "sqlserver",
-		User:     url.UserPassword(config.User, config.Password),
 		Host:     fmt.Sprintf("%s:%d", config.Host, config.Port),
 		RawQuery: query.Encode(),
 	}
+	// The user and the password are optional for Azure AD, e.g. the client ID of a user-assigned managed identity
+	if config.User != "" || config.Password != "" {
+		u.User = url.UserPassword(config.User, config.Password)
+	}
 	return u.String()
 }
 
@@ -1,10 +1,13 @@
 package mysql
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"database/sql"
+	"errors"
 	"fmt"
+	"net"
 	"os"
 	"strings"
 
@@ -15,16 +18,28 @@ import (
 type MysqlDatabase struct {
 	config database.Config
 	db     *sql.DB
+	tunnel *database.SSHDialer
 }
 
 func NewDatabase(config database.Config) (database.Database, error) {
-	if config.SslMode == "custom" {
-		err := registerTLSConfig(config.SslCa)
+	if usesCustomTLS(config) {
+		err := registerTLSConfig(config)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	var tunnel *database.SSHDialer
+	if config.SSHTunnel != nil {
+		tunnel = database.NewSSHDialer(*config.SSHTunnel)
+		for _, network := range []string{"tcp", "unix"} {
+			network := network
+			driver.RegisterDialContext(sshNetworkPrefix+network, func(ctx context.Context, addr string) (net.Conn, error) {
+				return tunnel.DialContext(ctx, network, addr)
+			})
+		}
+	}
+
 	db, err := sql.Open("mysql", mysqlBuildDSN(config))
 	if err != nil {
 		return nil, err
@@ -33,6 +48,7 @@ func NewDatabase(config database.Config) (database.Database, error) {
 	return &MysqlDatabase{
 		db:     db,
 		config: config,
+		tunnel: tunnel,
 	}, nil
 }
 
@@ -66,6 +82,12 @@ func (d *MysqlDatabase) DumpDDLs() (string, error) {
 	}
 	ddls = append(ddls, triggerDDLs...)
 
+	eventDDLs, err := d.events()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, eventDDLs...)
+
 	return strings.Join(ddls, "\n\n"), nil
 }
 
@@ -97,7 +119,56 @@ func (d *MysqlDatabase) dumpTableDDL(table string) (string, error) {
 		return "", err
 	}
 
-	return ddl + ";", nil
+	return stripDefaultExpressionParens(ddl) + ";", nil
+}
+
+// SHOW CREATE TABLE shows an expression default value with double parentheses, e.g. DEFAULT ((`id` * 2)),
+// while it's written as DEFAULT (id * 2). Strip the inner pair so that both are parsed in the same way.
+func stripDefaultExpressionParens(ddl string) string {
+	var result strings.Builder
+	for {
+		i := strings.Index(ddl, " DEFAULT ((")
+		if i < 0 {
+			break
+		}
+		open := i + len(" DEFAULT (")
+		close := matchingParen(ddl, open)
+		result.WriteString(ddl[:open])
+		if close > 0 && close+1 < len(ddl) && ddl[close+1] == ')' {
+			result.WriteString(ddl[open+1 : close])
+			ddl = ddl[close+1:]
+		} else {
+			ddl = ddl[open:]
+		}
+	}
+	result.WriteString(ddl)
+	return result.String()
+}
+
+// Return the index of the parenthesis closing the one at open, skipping quoted strings and identifiers.
+func matchingParen(str string, open int) int {
+	depth := 0
+	var quote byte
+	for i := open; i < len(str); i++ {
+		switch {
+		case quote != 0:
+			if str[i] == '\\' && quote == '\'' {
+				i++
+			} else if str[i] == quote {
+				quote = 0
+			}
+		case str[i] == '\'' || str[i] == '`':
+			quote = str[i]
+		case str[i] == '(':
+			depth++
+		case str[i] == ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
 }
 
 func (d *MysqlDatabase) views() ([]string, error) {
@@ -113,15 +184,15 @@ func (d *MysqlDatabase) views() ([]string, error) {
 
 	var ddls []string
 	for rows.Next() {
-		var viewName, viewType, definition, security_type string
+		var viewName, viewType, definition, security_type, definer string
 		if err = rows.Scan(&viewName, &viewType); err != nil {
 			return nil, err
 		}
-		query := fmt.Sprintf("select VIEW_DEFINITION,SECURITY_TYPE from INFORMATION_SCHEMA.VIEWS where TABLE_SCHEMA = '%s' AND TABLE_NAME = '%s';", d.config.DbName, viewName)
-		if err = d.db.QueryRow(query).Scan(&definition, &security_type); err != nil {
+		query := fmt.Sprintf("select VIEW_DEFINITION,SECURITY_TYPE,DEFINER from INFORMATION_SCHEMA.VIEWS where TABLE_SCHEMA = '%s' AND TABLE_NAME = '%s';", d.config.DbName, viewName)
+		if err = d.db.QueryRow(query).Scan(&definition, &security_type, &definer); err != nil {
 			return nil, err
 		}
-		ddls = append(ddls, fmt.Sprintf("CREATE SQL SECURITY %s VIEW %s AS %s;", security_type, viewName, definition))
+		ddls = append(ddls, fmt.Sprintf("CREATE DEFINER=%s SQL SECURITY %s VIEW %s AS %s;", quoteDefiner(definer), security_type, viewName, definition))
 	}
 	return ddls, nil
 }
@@ -140,23 +211,93 @@ func (d *MysqlDatabase) triggers() ([]string, error) {
 		if err = rows.Scan(&trigger, &event, &table, &statement, &timing, &created, &sqlMode, &definer, &characterSetClient, &collationConnection, &databaseCollation); err != nil {
 			return nil, err
 		}
-		ddls = append(ddls, fmt.Sprintf("CREATE TRIGGER %s %s %s ON %s FOR EACH ROW %s;", trigger, timing, event, table, statement))
+		ddls = append(ddls, fmt.Sprintf("CREATE DEFINER=%s TRIGGER %s %s %s ON %s FOR EACH ROW %s;", quoteDefiner(definer), trigger, timing, event, table, statement))
 	}
 	return ddls, nil
 }
 
+func (d *MysqlDatabase) events() ([]string, error) {
+	rows, err := d.db.Query(`
+		select event_name, definer, event_definition, event_type, execute_at, interval_value, interval_field, starts, ends, on_completion, status, event_comment
+		from information_schema.events
+		where event_schema = database()
+		order by event_name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var name, definer, definition, eventType, onCompletion, status, comment string
+		var executeAt, intervalValue, intervalField, starts, ends sql.NullString
+		if err = rows.Scan(&name, &definer, &definition, &eventType, &executeAt, &intervalValue, &intervalField, &starts, &ends, &onCompletion, &status, &comment); err != nil {
+			return nil, err
+		}
+
+		var schedule string
+		if eventType == "ONE TIME" {
+			schedule = fmt.Sprintf("AT %s", quoteString(executeAt.String))
+		} else {
+			schedule = fmt.Sprintf("EVERY %s %s", quoteString(intervalValue.String), intervalField.String)
+			if starts.Valid {
+				schedule += fmt.Sprintf(" STARTS %s", quoteString(starts.String))
+			}
+			if ends.Valid {
+				schedule += fmt.Sprintf(" ENDS %s", quoteString(ends.String))
+			}
+		}
+		switch status {
+		case "ENABLED":
+			status = "ENABLE"
+		case "DISABLED":
+			status = "DISABLE"
+		default: // SLAVESIDE_DISABLED
+			status = "DISABLE ON SLAVE"
+		}
+		ddls = append(ddls, fmt.Sprintf("CREATE DEFINER=%s EVENT %s ON SCHEDULE %s ON COMPLETION %s %s COMMENT %s DO %s;",
+			quoteDefiner(definer), quoteIdentifier(name), schedule, onCompletion, status, quoteString(comment), definition))
+	}
+	return ddls, nil
+}
+
+// Quote user@host of a definer as `user`@`host`. A user name may contain @, but a host name doesn't.
+func quoteDefiner(definer string) string {
+	i := strings.LastIndex(definer, "@")
+	if i < 0 {
+		return quoteIdentifier(definer)
+	}
+	return quoteIdentifier(definer[:i]) + "@" + quoteIdentifier(definer[i+1:])
+}
+
+func quoteIdentifier(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+func quoteString(str string) string {
+	return "'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
+
 func (d *MysqlDatabase) DB() *sql.DB {
 	return d.db
 }
 
 func (d *MysqlDatabase) Close() error {
-	return d.db.Close()
+	return errors.Join(d.db.Close(), d.tunnel.Close())
 }
 
 func (d *MysqlDatabase) GetDefaultSchema() string {
 	return ""
 }
 
+// MaxAllowedPacket returns max_allowed_packet of the server, which limits the size of a DDL.
+func MaxAllowedPacket(d database.Database) (int, error) {
+	var maxAllowedPacket int
+	err := d.DB().QueryRow("SELECT @@max_allowed_packet").Scan(&maxAllowedPacket)
+	return maxAllowedPacket, err
+}
+
 func mysqlBuildDSN(config database.Config) string {
 	c := driver.NewConfig()
 	c.User = config.User
@@ -164,6 +305,11 @@ func mysqlBuildDSN(config database.Config) string {
 	c.DBName = config.DbName
 	c.AllowCleartextPasswords = config.MySQLEnableCleartextPlugin
 	c.TLSConfig = config.SslMode
+	if usesCustomTLS(config) {
+		c.TLSConfig = customTLSConfig
+		// Keep falling back to an unencrypted connection like tls=preferred, e.g. with only a client certificate
+		c.AllowFallbackToPlaintext = config.SslMode == "preferred"
+	}
 	if config.Socket == "" {
 		c.Net = "tcp"
 		c.Addr = fmt.Sprintf("%s:%d", config.Host, config.Port)
@@ -171,23 +317,87 @@ func mysqlBuildDSN(config database.Config) string {
 		c.Net = "unix"
 		c.Addr = config.Socket
 	}
+	if config.SSHTunnel != nil {
+		c.Net = sshNetworkPrefix + c.Net
+	}
 	return c.FormatDSN()
 }
 
-func registerTLSConfig(pemPath string) error {
-	rootCertPool := x509.NewCertPool()
-	pem, err := os.ReadFile(pemPath)
-	if err != nil {
-		return err
+// The prefix of the networks registered to the driver to dial through ssh_tunnel of --config
+const sshNetworkPrefix = "ssh+"
+
+// The name of the TLS config registered to the driver for the CA, the client certificate, or SslMode "verify-ca"
+const customTLSConfig = "custom"
+
+func usesCustomTLS(config database.Config) bool {
+	if config.SslMode == "false" {
+		return false // the certificates are ignored like the mysql command does
+	}
+	return config.SslMode == customTLSConfig || config.SslMode == "verify-ca" || config.SslCa != "" || config.SslCert != "" || config.SslKey != ""
+}
+
+// Register the TLS config which trusts the CAs of SslCa, or the system ones when it's not given, and presents the client
+// certificate of SslCert and SslKey. SslMode "verify-ca" verifies the server certificate without its host name, and
+// "skip-verify" and "preferred" don't verify it, while the others verify both of them.
+func registerTLSConfig(config database.Config) error {
+	tlsConfig := &tls.Config{}
+	if config.SslCa != "" {
+		if config.SslMode == "skip-verify" || config.SslMode == "preferred" {
+			return fmt.Errorf("--ssl-ca can't be used with PREFERRED or SKIP_VERIFY of --ssl-mode, which don't verify the server certificate")
+		}
+		rootCertPool := x509.NewCertPool()
+		pem, err := os.ReadFile(config.SslCa)
+		if err != nil {
+			return err
+		}
+		if ok := rootCertPool.AppendCertsFromPEM(pem); !ok {
+			return fmt.Errorf("failed to append PEM")
+		}
+		tlsConfig.RootCAs = rootCertPool
+	}
+
+	if config.SslCert != "" || config.SslKey != "" {
+		if config.SslCert == "" || config.SslKey == "" {
+			return fmt.Errorf("both --ssl-cert and --ssl-key are needed for the client certificate")
+		}
+		certificate, err := tls.LoadX509KeyPair(config.SslCert, config.SslKey)
+		if err != nil {
+			return err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
 	}
 
-	if ok := rootCertPool.AppendCertsFromPEM(pem); !ok {
-		return fmt.Errorf("failed to append PEM")
+	switch config.SslMode {
+	case "skip-verify", "preferred":
+		tlsConfig.InsecureSkipVerify = true
+	case "verify-ca":
+		// Skip the default verification, which checks the host name as well, and verify only the chain
+		tlsConfig.InsecureSkipVerify = true
+		tlsConfig.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+			return verifyCertificateChain(rawCerts, tlsConfig.RootCAs)
+		}
 	}
 
-	driver.RegisterTLSConfig("custom", &tls.Config{
-		RootCAs: rootCertPool,
-	})
+	return driver.RegisterTLSConfig(customTLSConfig, tlsConfig)
+}
 
-	return nil
+func verifyCertificateChain(rawCerts [][]byte, roots *x509.CertPool) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("the server presented no certificate")
+	}
+	intermediates := x509.NewCertPool()
+	var leaf *x509.Certificate
+	for i, rawCert := range rawCerts {
+		cert, err := x509.ParseCertificate(rawCert)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			leaf = cert
+		} else {
+			intermediates.AddCert(cert)
+		}
+	}
+	_, err := leaf.Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates})
+	return err
 }
@@ -0,0 +1,43 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMysqlBuildDSN(t *testing.T) {
+	config := database.Config{DbName: "test", User: "root", Host: "127.0.0.1", Port: 3306, SslMode: "preferred"}
+	assert.Equal(t, "root@tcp(127.0.0.1:3306)/test?tls=preferred", mysqlBuildDSN(config))
+
+	config = database.Config{DbName: "test", User: "root", Host: "127.0.0.1", Port: 3306, SslMode: "skip-verify"}
+	assert.Equal(t, "root@tcp(127.0.0.1:3306)/test?tls=skip-verify", mysqlBuildDSN(config))
+
+	config = database.Config{DbName: "test", User: "root", Host: "127.0.0.1", Port: 3306, SslMode: "verify-ca", SslCa: "ca.pem"}
+	assert.Equal(t, "root@tcp(127.0.0.1:3306)/test?tls=custom", mysqlBuildDSN(config))
+
+	config = database.Config{DbName: "test", User: "root", Host: "127.0.0.1", Port: 3306, SslMode: "true", SslCert: "client-cert.pem", SslKey: "client-key.pem"}
+	assert.Equal(t, "root@tcp(127.0.0.1:3306)/test?tls=custom", mysqlBuildDSN(config))
+
+	config = database.Config{DbName: "test", User: "root", Host: "127.0.0.1", Port: 3306, SslMode: "preferred", SslCert: "client-cert.pem", SslKey: "client-key.pem"}
+	assert.Equal(t, "root@tcp(127.0.0.1:3306)/test?allowFallbackToPlaintext=true&tls=custom", mysqlBuildDSN(config))
+
+	config = database.Config{DbName: "test", User: "root", Host: "127.0.0.1", Port: 3306, SslMode: "false", SslCa: "ca.pem"}
+	assert.Equal(t, "root@tcp(127.0.0.1:3306)/test?tls=false", mysqlBuildDSN(config))
+}
+
+func TestRegisterTLSConfig(t *testing.T) {
+	// The CA would be ignored since the certificate isn't verified
+	for _, sslMode := range []string{"preferred", "skip-verify"} {
+		err := registerTLSConfig(database.Config{SslMode: sslMode, SslCa: "ca.pem"})
+		assert.ErrorContains(t, err, "--ssl-ca can't be used with PREFERRED or SKIP_VERIFY")
+	}
+}
+
+func TestQuoteDefiner(t *testing.T) {
+	assert.Equal(t, "`root`@`%`", quoteDefiner("root@%"))
+	assert.Equal(t, "`us@er`@`localhost`", quoteDefiner("us@er@localhost"))
+	assert.Equal(t, "`back``tick`@`%`", quoteDefiner("back`tick@%"))
+	assert.Equal(t, "`role`", quoteDefiner("role"))
+}
@@ -0,0 +1,36 @@
+package database
+
+import (
+	"fmt"
+	"os"
+)
+
+// Verbosity is the level of the messages written to stderr. DDLs and plans are always written to stdout, and the other
+// messages are written to stderr, so that stdout can be parsed by machines regardless of the verbosity.
+type Verbosity int
+
+const (
+	VerbosityQuiet   Verbosity = -1 // only errors
+	VerbosityNormal  Verbosity = 0  // and informational messages, e.g. "-- Nothing is modified --"
+	VerbosityVerbose Verbosity = 1  // and what each phase did
+)
+
+var verbosity = VerbosityNormal
+
+func SetVerbosity(v Verbosity) {
+	verbosity = v
+}
+
+// Write an informational message to stderr unless the verbosity is VerbosityQuiet.
+func Infof(format string, args ...interface{}) {
+	if verbosity >= VerbosityNormal {
+		fmt.Fprintf(os.Stderr, format, args...)
+	}
+}
+
+// Write a detailed message to stderr only when the verbosity is VerbosityVerbose.
+func Verbosef(format string, args ...interface{}) {
+	if verbosity >= VerbosityVerbose {
+		fmt.Fprintf(os.Stderr, format, args...)
+	}
+}
@@ -37,7 +37,7 @@ func (p GenericParser) Parse(sql string) ([]DDLStatement, error) {
 	var result []DDLStatement
 	for _, ddl := range ddls {
 		ddl = trimMarginComments(ddl)
-		stmt, err := parser.ParseDDL(ddl, p.mode)
+		stmt, err := parser.ParseDDL(trimIfNotExists(ddl), p.mode)
 		if err != nil {
 			return result, err
 		}
@@ -66,7 +66,7 @@ func (p GenericParser) splitDDLs(str string) ([]string, error) {
 			if ddl == "" {
 				break
 			}
-			_, err = parser.ParseDDL(ddl, p.mode)
+			_, err = parser.ParseDDL(trimIfNotExists(ddl), p.mode)
 			if err == nil || i == len(ddls) {
 				break
 			}
@@ -89,6 +89,15 @@ func (p GenericParser) splitDDLs(str string) ([]string, error) {
 	return result, nil
 }
 
+// IF NOT EXISTS of a CREATE statement, after the comments before it
+var ifNotExistsPattern = regexp.MustCompile(`(?i)^((?:\s+|--[^\n]*\n|/\*(?s:.*?)\*/)*CREATE\s+(?:\w+\s+)*?(?:TABLE|INDEX|VIEW|SCHEMA|EXTENSION|SEQUENCE|TRIGGER|EVENT)(?:\s+CONCURRENTLY)?)\s+IF\s+NOT\s+EXISTS\b`)
+
+// Remove IF NOT EXISTS of a CREATE statement, which the desired SQL generated by e.g. an ORM often has, to parse it as a
+// plain CREATE. The statement is still run as written, where the clause is harmless.
+func trimIfNotExists(ddl string) string {
+	return ifNotExistsPattern.ReplaceAllString(ddl, "$1")
+}
+
 // trimMarginComments pulls out any leading or trailing comments from a raw sql query.
 // This function also trims leading (if there's a comment) and trailing whitespace.
 func trimMarginComments(sql string) string {
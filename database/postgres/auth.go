@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/lib/pq"
+	"github.com/sqldef/sqldef/database"
+)
+
+// A connector which generates the short-lived token of auth of --config as the password of each connection,
+// so that a connection opened after the previous token expired can still log in.
+type tokenConnector struct {
+	config database.Config
+	tunnel *database.SSHDialer // nil unless ssh_tunnel of --config is given
+}
+
+func (c tokenConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	token, err := authToken(ctx, c.config)
+	if err != nil {
+		return nil, err
+	}
+	config := c.config
+	config.Password = token
+	connector, err := pq.NewConnector(postgresBuildDSN(config))
+	if err != nil {
+		return nil, err
+	}
+	if c.tunnel != nil {
+		connector.Dialer(c.tunnel)
+	}
+	return connector.Connect(ctx)
+}
+
+func (c tokenConnector) Driver() driver.Driver {
+	return &pq.Driver{}
+}
+
+func authToken(ctx context.Context, config database.Config) (string, error) {
+	switch config.Auth {
+	case database.AuthRDSIAM:
+		return rdsAuthToken(ctx, config)
+	case database.AuthCloudSQLIAM:
+		return cloudSQLAuthToken(ctx)
+	default:
+		return "", fmt.Errorf("unknown auth '%s'", config.Auth)
+	}
+}
+
+// Generate the token of RDS IAM database authentication, which is a URL presigned by Signature Version 4 and valid
+// for 15 minutes, as BuildAuthToken of aws-sdk-go-v2/feature/rds/auth does. The credentials and the region are
+// resolved by the default chain of the AWS SDK, e.g. the environment variables, the shared config files, or the role of
+// the instance.
+func rdsAuthToken(ctx context.Context, config database.Config) (string, error) {
+	awsConfig, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return "", fmt.Errorf("auth '%s' failed to load the AWS config: %w", database.AuthRDSIAM, err)
+	}
+	if awsConfig.Region == "" {
+		return "", fmt.Errorf("auth '%s' needs a region, e.g. AWS_REGION", database.AuthRDSIAM)
+	}
+	credentials, err := awsConfig.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("auth '%s' failed to get the AWS credentials: %w", database.AuthRDSIAM, err)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://%s:%d/", config.Host, config.Port), nil)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{}
+	query.Set("Action", "connect")
+	query.Set("DBUser", config.User)
+	query.Set("X-Amz-Expires", "900")
+	req.URL.RawQuery = query.Encode()
+
+	emptyPayloadHash := sha256.Sum256(nil)
+	signedURL, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, req, hex.EncodeToString(emptyPayloadHash[:]), "rds-db", awsConfig.Region, time.Now().UTC())
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimPrefix(signedURL, "https://"), nil
+}
+
+// Get the OAuth 2.0 access token of the IAM principal for Cloud SQL IAM database authentication, from
+// $GOOGLE_OAUTH_ACCESS_TOKEN, or otherwise from the metadata server of the service account running sqldef.
+func cloudSQLAuthToken(ctx context.Context) (string, error) {
+	if token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); token != "" {
+		return token, nil
+	}
+
+	host := os.Getenv("GCE_METADATA_HOST")
+	if host == "" {
+		host = "metadata.google.internal"
+	}
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://"+host+"/computeMetadata/v1/instance/service-accounts/default/token", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("auth '%s' failed to get a token from the metadata server (set GOOGLE_OAUTH_ACCESS_TOKEN outside Google Cloud): %w", database.AuthCloudSQLIAM, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("auth '%s' failed to get a token from the metadata server: %s", database.AuthCloudSQLIAM, resp.Status)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import (
+	"context"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRDSAuthToken(t *testing.T) {
+	// Don't read the shared config files of the host.
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "credentials"))
+	t.Setenv("AWS_PROFILE", "")
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY")
+	t.Setenv("AWS_SESSION_TOKEN", "session-token")
+	t.Setenv("AWS_REGION", "us-east-1")
+
+	config := database.Config{User: "sqldef", Host: "db.example.us-east-1.rds.amazonaws.com", Port: 5432}
+	token, err := rdsAuthToken(context.Background(), config)
+	assert.NoError(t, err)
+	host, rawQuery, ok := strings.Cut(token, "/?")
+	assert.True(t, ok)
+	assert.Equal(t, "db.example.us-east-1.rds.amazonaws.com:5432", host)
+	query, err := url.ParseQuery(rawQuery)
+	assert.NoError(t, err)
+	assert.Equal(t, "connect", query.Get("Action"))
+	assert.Equal(t, "sqldef", query.Get("DBUser"))
+	assert.Equal(t, "900", query.Get("X-Amz-Expires"))
+	assert.Equal(t, "session-token", query.Get("X-Amz-Security-Token"))
+	assert.Regexp(t, `^AKIDEXAMPLE/\d{8}/us-east-1/rds-db/aws4_request$`, query.Get("X-Amz-Credential"))
+	assert.Regexp(t, `^[0-9a-f]{64}$`, query.Get("X-Amz-Signature"))
+
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "")
+	_, err = rdsAuthToken(context.Background(), config)
+	assert.EqualError(t, err, "auth 'rds-iam' needs a region, e.g. AWS_REGION")
+}
+
+func TestAuthRequiresSSL(t *testing.T) {
+	t.Setenv("PGSSLMODE", "disable")
+	_, err := NewDatabase(database.Config{Auth: database.AuthRDSIAM})
+	assert.EqualError(t, err, "auth 'rds-iam' can't be used with PGSSLMODE=disable since it requires SSL")
+}
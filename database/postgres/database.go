@@ -2,14 +2,16 @@ package postgres
 
 import (
 	"database/sql"
+	"errors"
 	"fmt"
+	"math"
 	"net/url"
 	"os"
 	"regexp"
 	"sort"
 	"strings"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/sqldef/sqldef/database"
 	schemaLib "github.com/sqldef/sqldef/schema"
 )
@@ -20,23 +22,53 @@ type PostgresDatabase struct {
 	config        database.Config
 	db            *sql.DB
 	defaultSchema *string
+	tunnel        *database.SSHDialer
 }
 
 func NewDatabase(config database.Config) (database.Database, error) {
-	db, err := sql.Open("postgres", postgresBuildDSN(config))
-	if err != nil {
-		return nil, err
+	var tunnel *database.SSHDialer
+	if config.SSHTunnel != nil {
+		tunnel = database.NewSSHDialer(*config.SSHTunnel)
+	}
+
+	var db *sql.DB
+	if config.Auth != "" {
+		// The token would be sent in plaintext, and the servers reject it without SSL anyway.
+		if os.Getenv("PGSSLMODE") == "disable" {
+			return nil, fmt.Errorf("auth '%s' can't be used with PGSSLMODE=disable since it requires SSL", config.Auth)
+		}
+		db = sql.OpenDB(tokenConnector{config: config, tunnel: tunnel})
+	} else if tunnel != nil {
+		connector, err := pq.NewConnector(postgresBuildDSN(config))
+		if err != nil {
+			return nil, err
+		}
+		connector.Dialer(tunnel)
+		db = sql.OpenDB(connector)
+	} else {
+		var err error
+		db, err = sql.Open("postgres", postgresBuildDSN(config))
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &PostgresDatabase{
 		db:     db,
 		config: config,
+		tunnel: tunnel,
 	}, nil
 }
 
 func (d *PostgresDatabase) DumpDDLs() (string, error) {
 	var ddls []string
 
+	roleDDLs, err := d.roles()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, roleDDLs...)
+
 	schemaDDLs, err := d.schemas()
 	if err != nil {
 		return "", err
@@ -60,12 +92,13 @@ func (d *PostgresDatabase) DumpDDLs() (string, error) {
 		return "", err
 	}
 
-	tableDDLs, err := database.ConcurrentMapFuncWithError(
-		tableNames,
-		d.config.DumpConcurrency,
-		func(tableName string) (string, error) {
-			return d.dumpTableDDL(tableName)
-		})
+	inherits, err := d.getInherits(tableNames)
+	if err != nil {
+		return "", err
+	}
+	tableNames = sortTablesByInheritance(tableNames, inherits)
+
+	tableDDLs, err := d.dumpTableDDLs(tableNames, inherits)
 	if err != nil {
 		return "", err
 	}
@@ -83,6 +116,24 @@ func (d *PostgresDatabase) DumpDDLs() (string, error) {
 	}
 	ddls = append(ddls, matViewDDLs...)
 
+	publicationDDLs, err := d.publications()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, publicationDDLs...)
+
+	triggerDDLs, err := d.triggers()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, triggerDDLs...)
+
+	commentDDLs, err := d.objectComments()
+	if err != nil {
+		return "", err
+	}
+	ddls = append(ddls, commentDDLs...)
+
 	return strings.Join(ddls, "\n\n"), nil
 }
 
@@ -93,7 +144,7 @@ func (d *PostgresDatabase) tableNames() ([]string, error) {
 		where n.nspname not in ('information_schema', 'pg_catalog')
 		and c.relkind in ('r', 'p')
 		and c.relpersistence in ('p', 'u')
-		and not exists (select * from pg_catalog.pg_depend d where c.oid = d.objid and d.deptype = 'e')
+		and ` + notExtensionMember("pg_class", "c.oid") + `
 		order by relname asc;
 	`)
 	if err != nil {
@@ -120,17 +171,23 @@ var (
 	spaces          = regexp.MustCompile(`[ ]+`)
 )
 
+// Returns a condition to exclude the objects created by extensions, e.g. the tables and the schema of pg_cron or the views of
+// pg_buffercache. Such objects are recorded in pg_depend with deptype 'e', whatever kind of object they are.
+func notExtensionMember(catalog string, oid string) string {
+	return fmt.Sprintf("not exists (select * from pg_catalog.pg_depend d where d.classid = 'pg_catalog.%s'::regclass and d.objid = %s and d.deptype = 'e')", catalog, oid)
+}
+
 func (d *PostgresDatabase) views() ([]string, error) {
 	if d.config.SkipView {
 		return []string{}, nil
 	}
 
 	rows, err := d.db.Query(`
-		select n.nspname as table_schema, c.relname as table_name, pg_get_viewdef(c.oid) as definition
+		select n.nspname as table_schema, c.relname as table_name, pg_get_viewdef(c.oid) as definition, pg_get_userbyid(c.relowner) as owner
 		from pg_catalog.pg_class c inner join pg_catalog.pg_namespace n on c.relnamespace = n.oid
 		where n.nspname not in ('information_schema', 'pg_catalog')
 		and c.relkind = 'v'
-		and not exists (select * from pg_catalog.pg_depend d where c.oid = d.objid and d.deptype = 'e')
+		and ` + notExtensionMember("pg_class", "c.oid") + `
 	`)
 	if err != nil {
 		return nil, err
@@ -139,8 +196,8 @@ func (d *PostgresDatabase) views() ([]string, error) {
 
 	var ddls []string
 	for rows.Next() {
-		var schema, name, definition string
-		if err := rows.Scan(&schema, &name, &definition); err != nil {
+		var schema, name, definition, owner string
+		if err := rows.Scan(&schema, &name, &definition, &owner); err != nil {
 			return nil, err
 		}
 		definition = strings.TrimSpace(definition)
@@ -152,6 +209,9 @@ func (d *PostgresDatabase) views() ([]string, error) {
 				"CREATE VIEW %s AS %s;", schema+"."+name, definition,
 			),
 		)
+		if containsString(d.config.ManagedRoles, owner) {
+			ddls = append(ddls, fmt.Sprintf("ALTER VIEW %s OWNER TO %s;", schema+"."+name, escapeSQLName(owner)))
+		}
 	}
 	return ddls, nil
 }
@@ -165,14 +225,14 @@ func (d *PostgresDatabase) materializedViews() ([]string, error) {
 		select n.nspname as schemaname, c.relname as matviewname, pg_get_viewdef(c.oid) as definition
 		from pg_catalog.pg_class c inner join pg_catalog.pg_namespace n on c.relnamespace = n.oid
 		where c.relkind = 'm'
-		and not exists (select * from pg_catalog.pg_depend d where c.oid = d.objid and d.deptype = 'e')
+		and ` + notExtensionMember("pg_class", "c.oid") + `
 	`)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var ddls []string
+	var names, definitions []string
 	for rows.Next() {
 		var schema, name, definition string
 		if err := rows.Scan(&schema, &name, &definition); err != nil {
@@ -182,19 +242,173 @@ func (d *PostgresDatabase) materializedViews() ([]string, error) {
 		definition = strings.ReplaceAll(definition, "\n", "")
 		definition = suffixSemicolon.ReplaceAllString(definition, "")
 		definition = spaces.ReplaceAllString(definition, " ")
+		names = append(names, schema+"."+name)
+		definitions = append(definitions, definition)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	indexDefs, err := d.getIndexDefs(names)
+	if err != nil {
+		return nil, err
+	}
+
+	var ddls []string
+	for i, name := range names {
 		ddls = append(
 			ddls, fmt.Sprintf(
-				"CREATE MATERIALIZED VIEW %s AS %s;", schema+"."+name, definition,
+				"CREATE MATERIALIZED VIEW %s AS %s;", name, definitions[i],
 			),
 		)
+		for _, indexDef := range indexDefs[name] {
+			ddls = append(ddls, fmt.Sprintf("%s;", indexDef))
+		}
+	}
+	return ddls, nil
+}
+
+func (d *PostgresDatabase) publications() ([]string, error) {
+	rows, err := d.db.Query(`
+		select p.pubname, p.puballtables, pt.schemaname, pt.tablename
+		from pg_catalog.pg_publication p
+		left join pg_catalog.pg_publication_tables pt on p.pubname = pt.pubname and not p.puballtables
+		where ` + notExtensionMember("pg_publication", "p.oid") + `
+		order by p.pubname, pt.schemaname, pt.tablename
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var names []string
+	allTables := map[string]bool{}
+	tables := map[string][]string{}
+	for rows.Next() {
+		var name string
+		var puballtables bool
+		var schema, table sql.NullString
+		if err := rows.Scan(&name, &puballtables, &schema, &table); err != nil {
+			return nil, err
+		}
+		if _, ok := allTables[name]; !ok {
+			names = append(names, name)
+		}
+		allTables[name] = puballtables
+		if schema.Valid && table.Valid {
+			tables[name] = append(tables[name], escapeSQLName(schema.String)+"."+escapeSQLName(table.String))
+		}
+	}
+
+	var ddls []string
+	for _, name := range names {
+		if allTables[name] {
+			ddls = append(ddls, fmt.Sprintf("CREATE PUBLICATION %s FOR ALL TABLES;", escapeSQLName(name)))
+		} else if len(tables[name]) > 0 {
+			ddls = append(ddls, fmt.Sprintf("CREATE PUBLICATION %s FOR TABLE %s;", escapeSQLName(name), strings.Join(tables[name], ", ")))
+		} else {
+			ddls = append(ddls, fmt.Sprintf("CREATE PUBLICATION %s;", escapeSQLName(name)))
+		}
+	}
+	return ddls, nil
+}
+
+// Roles are dumped only for `managed_roles` because the generator ignores the others. Passwords are never dumped.
+func (d *PostgresDatabase) roles() ([]string, error) {
+	if len(d.config.ManagedRoles) == 0 {
+		return []string{}, nil
+	}
+
+	rows, err := d.db.Query(`
+		select r.rolname, r.rolcanlogin, r.rolsuper, r.rolcreatedb, r.rolcreaterole, r.rolinherit, r.rolreplication, r.rolbypassrls, r.rolconnlimit,
+		array(
+			select g.rolname from pg_catalog.pg_auth_members m
+			inner join pg_catalog.pg_roles g on m.roleid = g.oid
+			where m.member = r.oid
+			order by g.rolname
+		)
+		from pg_catalog.pg_roles r
+		where r.rolname = any($1)
+		order by r.rolname
+	`, pq.Array(d.config.ManagedRoles))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
 
-		indexDefs, err := d.getIndexDefs(schema + "." + name)
-		if err != nil {
-			return ddls, err
+	var ddls []string
+	for rows.Next() {
+		var name string
+		var login, superuser, createDB, createRole, inherit, replication, bypassRLS bool
+		var connectionLimit int
+		var inRoles []string
+		if err := rows.Scan(&name, &login, &superuser, &createDB, &createRole, &inherit, &replication, &bypassRLS, &connectionLimit, pq.Array(&inRoles)); err != nil {
+			return nil, err
 		}
-		for _, indexDef := range indexDefs {
-			ddls = append(ddls, fmt.Sprintf("%s;", indexDef))
+
+		// Only the attributes which differ from the defaults of CREATE ROLE are dumped.
+		var attributes []string
+		flags := []struct {
+			name  string
+			value bool
+		}{
+			{"LOGIN", login}, {"SUPERUSER", superuser}, {"CREATEDB", createDB}, {"CREATEROLE", createRole},
+			{"REPLICATION", replication}, {"BYPASSRLS", bypassRLS},
+		}
+		for _, flag := range flags {
+			if flag.value {
+				attributes = append(attributes, flag.name)
+			}
+		}
+		if !inherit {
+			attributes = append(attributes, "NOINHERIT")
+		}
+		if connectionLimit != -1 {
+			attributes = append(attributes, fmt.Sprintf("CONNECTION LIMIT %d", connectionLimit))
+		}
+		if len(inRoles) > 0 {
+			var escapedRoles []string
+			for _, inRole := range inRoles {
+				escapedRoles = append(escapedRoles, escapeSQLName(inRole))
+			}
+			attributes = append(attributes, "IN ROLE "+strings.Join(escapedRoles, ", "))
+		}
+
+		ddl := "CREATE ROLE " + escapeSQLName(name)
+		if len(attributes) > 0 {
+			ddl += " WITH " + strings.Join(attributes, " ")
+		}
+		ddls = append(ddls, ddl+";")
+	}
+	return ddls, rows.Err()
+}
+
+func (d *PostgresDatabase) triggers() ([]string, error) {
+	rows, err := d.db.Query(`
+		select n.nspname, pg_catalog.pg_get_triggerdef(t.oid)
+		from pg_catalog.pg_trigger t
+		inner join pg_catalog.pg_class c on t.tgrelid = c.oid
+		inner join pg_catalog.pg_namespace n on c.relnamespace = n.oid
+		where not t.tgisinternal
+		and n.nspname not in ('information_schema', 'pg_catalog')
+		and ` + notExtensionMember("pg_class", "c.oid") + `
+		order by n.nspname, c.relname, t.tgname
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	for rows.Next() {
+		var schema, definition string
+		if err := rows.Scan(&schema, &definition); err != nil {
+			return nil, err
 		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, schema) {
+			continue
+		}
+		ddls = append(ddls, definition+";")
 	}
 	return ddls, nil
 }
@@ -202,9 +416,11 @@ func (d *PostgresDatabase) materializedViews() ([]string, error) {
 func (d *PostgresDatabase) schemas() ([]string, error) {
 	rows, err := d.db.Query(`
 		SELECT schema_name
-		FROM information_schema.schemata
+		FROM information_schema.schemata s
+		INNER JOIN pg_catalog.pg_namespace n ON n.nspname = s.schema_name
 		WHERE schema_name NOT LIKE 'pg_%%'
-		AND schema_name not in ('information_schema', 'public');
+		AND schema_name not in ('information_schema', 'public')
+		AND ` + notExtensionMember("pg_namespace", "n.oid") + `;
 	`)
 	if err != nil {
 		return nil, err
@@ -261,7 +477,7 @@ func (d *PostgresDatabase) types() ([]string, error) {
 		from pg_enum e
 		join pg_type t on e.enumtypid = t.oid
 		inner join pg_catalog.pg_namespace n on t.typnamespace = n.oid
-		where not exists (select * from pg_depend d where d.objid = t.oid and d.deptype = 'e')
+		where ` + notExtensionMember("pg_type", "t.oid") + `
 		group by n.nspname, t.typname;
 	`)
 	if err != nil {
@@ -288,49 +504,254 @@ func (d *PostgresDatabase) types() ([]string, error) {
 			),
 		)
 	}
+
+	compositeTypeDDLs, err := d.compositeTypes()
+	if err != nil {
+		return nil, err
+	}
+	ddls = append(ddls, compositeTypeDDLs...)
+
+	domainDDLs, err := d.domains()
+	if err != nil {
+		return nil, err
+	}
+	return append(ddls, domainDDLs...), nil
+}
+
+// Dump a domain as CREATE DOMAIN and its CHECK constraints as ALTER DOMAIN, which keeps their names and NOT VALID.
+func (d *PostgresDatabase) domains() ([]string, error) {
+	rows, err := d.db.Query(`
+		select n.nspname as type_schema, t.typname, pg_catalog.format_type(t.typbasetype, t.typtypmod), t.typnotnull, t.typdefault,
+		       coalesce(con.conname, ''), coalesce(pg_catalog.pg_get_constraintdef(con.oid, true), '')
+		from pg_catalog.pg_type t
+		inner join pg_catalog.pg_namespace n on t.typnamespace = n.oid
+		left join pg_catalog.pg_constraint con on con.contypid = t.oid and con.contype = 'c'
+		where t.typtype = 'd'
+		and n.nspname not in ('information_schema', 'pg_catalog')
+		and ` + notExtensionMember("pg_type", "t.oid") + `
+		order by n.nspname, t.typname, con.conname
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ddls []string
+	var lastName string
+	for rows.Next() {
+		var typeSchema, typeName, baseType, constraintName, constraintDef string
+		var notNull bool
+		var defaultValue sql.NullString
+		if err := rows.Scan(&typeSchema, &typeName, &baseType, &notNull, &defaultValue, &constraintName, &constraintDef); err != nil {
+			return nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, typeSchema) {
+			continue
+		}
+		name := escapeSQLName(typeSchema) + "." + escapeSQLName(typeName)
+		if name != lastName {
+			ddl := fmt.Sprintf("CREATE DOMAIN %s AS %s", name, baseType)
+			if defaultValue.Valid {
+				ddl += " DEFAULT " + defaultValue.String
+			}
+			if notNull {
+				ddl += " NOT NULL"
+			}
+			ddls = append(ddls, ddl+";")
+			lastName = name
+		}
+		if constraintName != "" {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s ADD CONSTRAINT %s %s;", name, escapeSQLName(constraintName), constraintDef))
+		}
+	}
+	return ddls, rows.Err()
+}
+
+func (d *PostgresDatabase) compositeTypes() ([]string, error) {
+	rows, err := d.db.Query(`
+		select n.nspname as type_schema, t.typname, a.attname, pg_catalog.format_type(a.atttypid, a.atttypmod)
+		from pg_catalog.pg_type t
+		inner join pg_catalog.pg_namespace n on t.typnamespace = n.oid
+		inner join pg_catalog.pg_class c on t.typrelid = c.oid and c.relkind = 'c'
+		inner join pg_catalog.pg_attribute a on a.attrelid = c.oid and a.attnum > 0 and not a.attisdropped
+		where t.typtype = 'c'
+		and n.nspname not in ('information_schema', 'pg_catalog')
+		and ` + notExtensionMember("pg_type", "t.oid") + `
+		order by n.nspname, t.typname, a.attnum
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var typeNames []string
+	attributes := map[string][]string{}
+	for rows.Next() {
+		var typeSchema, typeName, attributeName, attributeType string
+		if err := rows.Scan(&typeSchema, &typeName, &attributeName, &attributeType); err != nil {
+			return nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, typeSchema) {
+			continue
+		}
+		name := escapeSQLName(typeSchema) + "." + escapeSQLName(typeName)
+		if _, ok := attributes[name]; !ok {
+			typeNames = append(typeNames, name)
+		}
+		attributes[name] = append(attributes[name], escapeSQLName(attributeName)+" "+attributeType)
+	}
+
+	var ddls []string
+	for _, name := range typeNames {
+		ddls = append(ddls, fmt.Sprintf("CREATE TYPE %s AS (%s);", name, strings.Join(attributes[name], ", ")))
+	}
 	return ddls, nil
 }
 
-func (d *PostgresDatabase) dumpTableDDL(table string) (string, error) {
-	cols, err := d.getColumns(table)
+// The number of tables whose metadata is fetched by one query of each kind. Batches are dumped concurrently
+// according to dump_concurrency.
+const dumpTableBatchSize = 500
+
+// Metadata of a table, fetched for a batch of tables at once.
+type tableMetadata struct {
+	columns              []column
+	pkeyCols             []string
+	pkeyOptions          string
+	indexDefs            []string
+	foreignDefs          []string
+	policyDefs           []string
+	comments             []string
+	checkConstraints     map[string]string
+	uniqueConstraints    map[string]string
+	exclusionConstraints map[string]string
+	clusterOn            string
+	owner                string
+	unlogged             bool
+}
+
+func (d *PostgresDatabase) dumpTableDDLs(tables []string, inherits map[string][]string) ([]string, error) {
+	var batches [][]string
+	for start := 0; start < len(tables); start += dumpTableBatchSize {
+		end := start + dumpTableBatchSize
+		if end > len(tables) {
+			end = len(tables)
+		}
+		batches = append(batches, tables[start:end])
+	}
+
+	batchDDLs, err := database.ConcurrentMapFuncWithError(
+		batches,
+		d.config.DumpConcurrency,
+		func(batch []string) ([]string, error) {
+			metadata, err := d.getTableMetadata(batch)
+			if err != nil {
+				return nil, err
+			}
+			var ddls []string
+			for _, table := range batch {
+				m := metadata[table]
+				ddls = append(ddls, buildDumpTableDDL(table, m.columns, m.pkeyCols, m.pkeyOptions, m.indexDefs, m.foreignDefs, m.policyDefs, m.comments, m.checkConstraints, m.uniqueConstraints, m.exclusionConstraints, m.clusterOn, m.owner, m.unlogged, inherits[table], d.GetDefaultSchema()))
+			}
+			return ddls, nil
+		})
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	var ddls []string
+	for _, batch := range batchDDLs {
+		ddls = append(ddls, batch...)
 	}
-	pkeyCols, err := d.getPrimaryKeyColumns(table)
+	return ddls, nil
+}
+
+// Fetch the metadata of the tables, keyed by the qualified table names. Each kind of metadata is fetched by a single
+// query for all the tables, instead of a query per table, which takes minutes for thousands of tables.
+func (d *PostgresDatabase) getTableMetadata(tables []string) (map[string]*tableMetadata, error) {
+	metadata := map[string]*tableMetadata{}
+	for _, table := range tables {
+		metadata[table] = &tableMetadata{}
+	}
+
+	columns, err := d.getColumns(tables)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	indexDefs, err := d.getIndexDefs(table)
+	pkeyCols, err := d.getPrimaryKeyColumns(tables)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	foreignDefs, err := d.getForeignDefs(table)
+	pkeyOptions, err := d.getPrimaryKeyOptions(tables)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	policyDefs, err := d.getPolicyDefs(table)
+	indexDefs, err := d.getIndexDefs(tables)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	checkConstraints, err := d.getTableCheckConstraints(table)
+	foreignDefs, err := d.getForeignDefs(tables)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	uniqueConstraints, err := d.getUniqueConstraints(table)
+	policyDefs, err := d.getPolicyDefs(tables)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	comments, err := d.getComments(table)
+	checkConstraints, err := d.getTableCheckConstraints(tables)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	uniqueConstraints, err := d.getUniqueConstraints(tables)
+	if err != nil {
+		return nil, err
+	}
+	exclusionConstraints, err := d.getExclusionConstraints(tables)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := d.getComments(tables)
+	if err != nil {
+		return nil, err
+	}
+	clusterOn, err := d.getClusterOn(tables)
+	if err != nil {
+		return nil, err
+	}
+	owners, err := d.getTableOwners(tables)
+	if err != nil {
+		return nil, err
 	}
-	return buildDumpTableDDL(table, cols, pkeyCols, indexDefs, foreignDefs, policyDefs, comments, checkConstraints, uniqueConstraints, d.GetDefaultSchema()), nil
+	unloggedTables, err := d.getUnloggedTables(tables)
+	if err != nil {
+		return nil, err
+	}
+
+	for table, m := range metadata {
+		m.columns = columns[table]
+		m.pkeyCols = pkeyCols[table]
+		m.pkeyOptions = pkeyOptions[table]
+		m.indexDefs = indexDefs[table]
+		m.foreignDefs = foreignDefs[table]
+		m.policyDefs = policyDefs[table]
+		m.checkConstraints = checkConstraints[table]
+		m.uniqueConstraints = uniqueConstraints[table]
+		m.exclusionConstraints = exclusionConstraints[table]
+		m.comments = comments[table]
+		m.clusterOn = clusterOn[table]
+		m.owner = owners[table]
+		m.unlogged = unloggedTables[table]
+	}
+	return metadata, nil
 }
 
-func buildDumpTableDDL(table string, columns []column, pkeyCols, indexDefs, foreignDefs, policyDefs, comments []string, checkConstraints, uniqueConstraints map[string]string, defaultSchema string) string {
+func buildDumpTableDDL(table string, columns []column, pkeyCols []string, pkeyOptions string, indexDefs, foreignDefs, policyDefs, comments []string, checkConstraints, uniqueConstraints, exclusionConstraints map[string]string, clusterOn string, owner string, unlogged bool, inherits []string, defaultSchema string) string {
 	var queryBuilder strings.Builder
 	schema, table := splitTableName(table, defaultSchema)
-	fmt.Fprintf(&queryBuilder, "CREATE TABLE %s.%s (", escapeSQLName(schema), escapeSQLName(table))
+	if unlogged {
+		fmt.Fprintf(&queryBuilder, "CREATE UNLOGGED TABLE %s.%s (", escapeSQLName(schema), escapeSQLName(table))
+	} else {
+		fmt.Fprintf(&queryBuilder, "CREATE TABLE %s.%s (", escapeSQLName(schema), escapeSQLName(table))
+	}
 	for i, col := range columns {
 		if i > 0 {
 			fmt.Fprint(&queryBuilder, ",")
@@ -345,6 +766,11 @@ func buildDumpTableDDL(table string, columns []column, pkeyCols, indexDefs, fore
 		}
 		if col.IdentityGeneration != "" {
 			fmt.Fprintf(&queryBuilder, " GENERATED %s AS IDENTITY", col.IdentityGeneration)
+			if col.IdentitySequence != nil {
+				if options := col.IdentitySequence.nonDefaultOptions(col.dataType); options != "" {
+					fmt.Fprintf(&queryBuilder, " (%s)", options)
+				}
+			}
 		}
 		if col.Check != nil {
 			fmt.Fprintf(&queryBuilder, " CONSTRAINT %s %s", col.Check.name, col.Check.definition)
@@ -353,12 +779,24 @@ func buildDumpTableDDL(table string, columns []column, pkeyCols, indexDefs, fore
 	if len(pkeyCols) > 0 {
 		fmt.Fprint(&queryBuilder, ",\n"+indent)
 		fmt.Fprintf(&queryBuilder, "PRIMARY KEY (\"%s\")", strings.Join(pkeyCols, "\", \""))
+		if pkeyOptions != "" {
+			fmt.Fprintf(&queryBuilder, " WITH (%s)", pkeyOptions)
+		}
 	}
 	for constraintName, constraintDef := range checkConstraints {
 		fmt.Fprint(&queryBuilder, ",\n"+indent)
 		fmt.Fprintf(&queryBuilder, "CONSTRAINT %s %s", constraintName, constraintDef)
 	}
-	fmt.Fprintf(&queryBuilder, "\n);\n")
+	fmt.Fprint(&queryBuilder, "\n)")
+	if len(inherits) > 0 {
+		parents := make([]string, len(inherits))
+		for i, parent := range inherits {
+			parentSchema, parentTable := splitTableName(parent, defaultSchema)
+			parents[i] = escapeSQLName(parentSchema) + "." + escapeSQLName(parentTable)
+		}
+		fmt.Fprintf(&queryBuilder, " INHERITS (%s)", strings.Join(parents, ", "))
+	}
+	fmt.Fprint(&queryBuilder, ";\n")
 	for _, v := range indexDefs {
 		fmt.Fprintf(&queryBuilder, "%s;\n", v)
 	}
@@ -371,9 +809,18 @@ func buildDumpTableDDL(table string, columns []column, pkeyCols, indexDefs, fore
 	for _, constraintDef := range uniqueConstraints {
 		fmt.Fprintf(&queryBuilder, "%s;\n", constraintDef)
 	}
+	for _, constraintDef := range exclusionConstraints {
+		fmt.Fprintf(&queryBuilder, "%s;\n", constraintDef)
+	}
+	if clusterOn != "" {
+		fmt.Fprintf(&queryBuilder, "ALTER TABLE %s.%s CLUSTER ON %s;\n", escapeSQLName(schema), escapeSQLName(table), escapeSQLName(clusterOn))
+	}
 	for _, v := range comments {
 		fmt.Fprintf(&queryBuilder, "%s\n", v)
 	}
+	if owner != "" {
+		fmt.Fprintf(&queryBuilder, "ALTER TABLE %s.%s OWNER TO %s;\n", escapeSQLName(schema), escapeSQLName(table), escapeSQLName(owner))
+	}
 	return strings.TrimSuffix(queryBuilder.String(), "\n")
 }
 
@@ -390,9 +837,58 @@ type column struct {
 	Default            string
 	IsAutoIncrement    bool
 	IdentityGeneration string
+	IdentitySequence   *identitySequence
 	Check              *columnConstraint
 }
 
+// The sequence of an identity column
+type identitySequence struct {
+	start     int64
+	increment int64
+	min       int64
+	max       int64
+	cache     int64
+	cycle     bool
+}
+
+// Return the options of the sequence which aren't the defaults of the column type, e.g. "START WITH 10 CACHE 5".
+func (s *identitySequence) nonDefaultOptions(dataType string) string {
+	var typeMin, typeMax int64
+	switch dataType {
+	case "smallint":
+		typeMin, typeMax = math.MinInt16, math.MaxInt16
+	case "bigint":
+		typeMin, typeMax = math.MinInt64, math.MaxInt64
+	default:
+		typeMin, typeMax = math.MinInt32, math.MaxInt32
+	}
+	defaultMin, defaultMax, defaultStart := int64(1), typeMax, s.min
+	if s.increment < 0 {
+		defaultMin, defaultMax, defaultStart = typeMin, -1, s.max
+	}
+
+	var options []string
+	if s.start != defaultStart {
+		options = append(options, fmt.Sprintf("START WITH %d", s.start))
+	}
+	if s.increment != 1 {
+		options = append(options, fmt.Sprintf("INCREMENT BY %d", s.increment))
+	}
+	if s.min != defaultMin {
+		options = append(options, fmt.Sprintf("MINVALUE %d", s.min))
+	}
+	if s.max != defaultMax {
+		options = append(options, fmt.Sprintf("MAXVALUE %d", s.max))
+	}
+	if s.cache != 1 {
+		options = append(options, fmt.Sprintf("CACHE %d", s.cache))
+	}
+	if s.cycle {
+		options = append(options, "CYCLE")
+	}
+	return strings.Join(options, " ")
+}
+
 func (c *column) GetDataType() string {
 	switch c.dataType {
 	case "smallint":
@@ -423,69 +919,85 @@ func (c *column) GetDataType() string {
 	}
 }
 
-func (d *PostgresDatabase) getColumns(table string) ([]column, error) {
+func (d *PostgresDatabase) getColumns(tables []string) (map[string][]column, error) {
 	const query = `WITH
 	  columns AS (
 	    SELECT
+	      n.nspname || '.' || c.relname AS table_name,
+	      f.attnum,
 	      s.column_name,
 	      s.column_default,
 	      s.is_nullable,
 	      CASE
 	      WHEN s.data_type IN ('ARRAY', 'USER-DEFINED') THEN format_type(f.atttypid, f.atttypmod)
 	      ELSE s.data_type
-	      END,
-	      format_type(f.atttypid, f.atttypmod),
-	      s.identity_generation
+	      END AS data_type,
+	      format_type(f.atttypid, f.atttypmod) AS formatted_data_type,
+	      s.identity_generation,
+	      seq.seqstart, seq.seqincrement, seq.seqmin, seq.seqmax, seq.seqcache, seq.seqcycle
 	    FROM pg_attribute f
 	    JOIN pg_class c ON c.oid = f.attrelid JOIN pg_type t ON t.oid = f.atttypid
 	    LEFT JOIN pg_attrdef d ON d.adrelid = c.oid AND d.adnum = f.attnum
 	    LEFT JOIN pg_namespace n ON n.oid = c.relnamespace
 	    LEFT JOIN information_schema.columns s ON s.column_name = f.attname AND s.table_name = c.relname AND s.table_schema = n.nspname
+	    LEFT JOIN (
+	      SELECT dep.refobjid, dep.refobjsubid, pg_sequence.*
+	      FROM   pg_depend dep
+	      JOIN   pg_sequence ON pg_sequence.seqrelid = dep.objid
+	      WHERE  dep.classid = 'pg_class'::regclass AND dep.deptype = 'i'
+	    ) seq ON seq.refobjid = c.oid AND seq.refobjsubid = f.attnum
 	    WHERE c.relkind = 'r'::char
-	    AND n.nspname = $1
-	    AND c.relname = $2
+	    AND (n.nspname, c.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
 	    AND f.attnum > 0
-	    ORDER BY f.attnum
+	    AND (f.attislocal OR c.relispartition)
 	  ),
 	  column_constraints AS (
-	    SELECT att.attname column_name, tmp.name, tmp.type , tmp.definition
+	    SELECT tmp.table_name, att.attname column_name, tmp.name, tmp.type , tmp.definition
 	    FROM (
 	      SELECT unnest(con.conkey) AS conkey,
 	             pg_get_constraintdef(con.oid, true) AS definition,
 	             cls.oid AS relid,
+	             nsp.nspname || '.' || cls.relname AS table_name,
 	             con.conname AS name,
 	             con.contype AS type
 	      FROM   pg_constraint con
 	      JOIN   pg_namespace nsp ON nsp.oid = con.connamespace
 	      JOIN   pg_class cls ON cls.oid = con.conrelid
-	      WHERE  nsp.nspname = $1
-	      AND    cls.relname = $2
+	      WHERE  (nsp.nspname, cls.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
 	      AND    array_length(con.conkey, 1) = 1
+	      AND    con.convalidated
 	    ) tmp
 	    JOIN pg_attribute att ON tmp.conkey = att.attnum AND tmp.relid = att.attrelid
 	  ),
 	  check_constraints AS (
-	    SELECT column_name, name, definition
+	    SELECT table_name, column_name, name, definition
 	    FROM   column_constraints
 	    WHERE  type = 'c'
 	  )
-	SELECT    columns.*, checks.name, checks.definition
+	SELECT    columns.table_name, columns.column_name, columns.column_default, columns.is_nullable,
+	          columns.data_type, columns.formatted_data_type, columns.identity_generation,
+	          columns.seqstart, columns.seqincrement, columns.seqmin, columns.seqmax, columns.seqcache, columns.seqcycle,
+	          checks.name, checks.definition
 	FROM      columns
-	LEFT JOIN check_constraints checks USING (column_name);`
+	LEFT JOIN check_constraints checks USING (table_name, column_name)
+	ORDER BY  columns.table_name, columns.attnum;`
 
-	schema, table := splitTableName(table, d.GetDefaultSchema())
-	rows, err := d.db.Query(query, schema, table)
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(query, pq.Array(schemas), pq.Array(names))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	cols := make([]column, 0)
+	cols := map[string][]column{}
 	for rows.Next() {
 		col := column{}
-		var colName, isNullable, dataType, formattedDataType string
+		var tableName, colName, isNullable, dataType, formattedDataType string
 		var colDefault, idGen, checkName, checkDefinition *string
-		err = rows.Scan(&colName, &colDefault, &isNullable, &dataType, &formattedDataType, &idGen, &checkName, &checkDefinition)
+		var seqStart, seqIncrement, seqMin, seqMax, seqCache *int64
+		var seqCycle *bool
+		err = rows.Scan(&tableName, &colName, &colDefault, &isNullable, &dataType, &formattedDataType, &idGen,
+			&seqStart, &seqIncrement, &seqMin, &seqMax, &seqCache, &seqCycle, &checkName, &checkDefinition)
 		if err != nil {
 			return nil, err
 		}
@@ -502,149 +1014,218 @@ func (d *PostgresDatabase) getColumns(table string) ([]column, error) {
 		if idGen != nil {
 			col.IdentityGeneration = *idGen
 		}
+		if seqStart != nil && seqIncrement != nil && seqMin != nil && seqMax != nil && seqCache != nil && seqCycle != nil {
+			col.IdentitySequence = &identitySequence{
+				start:     *seqStart,
+				increment: *seqIncrement,
+				min:       *seqMin,
+				max:       *seqMax,
+				cache:     *seqCache,
+				cycle:     *seqCycle,
+			}
+		}
 		if checkName != nil && checkDefinition != nil {
 			col.Check = &columnConstraint{
 				definition: *checkDefinition,
 				name:       *checkName,
 			}
 		}
-		cols = append(cols, col)
+		cols[tableName] = append(cols[tableName], col)
 	}
-	return cols, nil
+	return cols, rows.Err()
 }
 
-func (d *PostgresDatabase) getIndexDefs(table string) ([]string, error) {
-	// Exclude indexes that are implicitly created for primary keys or unique constraints.
+func (d *PostgresDatabase) getIndexDefs(tables []string) (map[string][]string, error) {
+	// Exclude indexes that are implicitly created for primary keys, unique constraints or exclusion constraints.
+	// Also exclude the indexes of partitions that are attached to an index of the partitioned table, which are created,
+	// dropped, and diffed with the index of the partitioned table.
 	const query = `WITH
 	  unique_and_pk_constraints AS (
-	    SELECT con.conname AS name
+	    SELECT nsp.nspname AS schema_name, con.conname AS name
 	    FROM   pg_constraint con
 	    JOIN   pg_namespace nsp ON nsp.oid = con.connamespace
 	    JOIN   pg_class cls ON cls.oid = con.conrelid
-	    WHERE  con.contype IN ('p', 'u')
-	    AND    nsp.nspname = $1
-	    AND    cls.relname = $2
+	    WHERE  con.contype IN ('p', 'u', 'x')
+	    AND    (nsp.nspname, cls.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+	  ),
+	  extension_indexes AS (
+	    SELECT n.nspname AS schema_name, c.relname AS name
+	    FROM   pg_class c
+	    JOIN   pg_namespace n ON n.oid = c.relnamespace
+	    JOIN   pg_depend d ON d.classid = 'pg_class'::regclass AND d.objid = c.oid AND d.deptype = 'e'
+	    WHERE  c.relkind IN ('i', 'I')
+	  ),
+	  partition_indexes AS (
+	    SELECT n.nspname AS schema_name, c.relname AS name
+	    FROM   pg_class c
+	    JOIN   pg_namespace n ON n.oid = c.relnamespace
+	    WHERE  c.relkind IN ('i', 'I')
+	    AND    c.relispartition
 	  )
-	SELECT indexName, indexdef
+	SELECT schemaname || '.' || tablename, indexdef
 	FROM   pg_indexes
-	WHERE  schemaname = $1
-	AND    tablename = $2
-	AND    indexName NOT IN (SELECT name FROM unique_and_pk_constraints)
+	WHERE  (schemaname, tablename) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+	AND    (schemaname, indexname) NOT IN (SELECT schema_name, name FROM unique_and_pk_constraints)
+	AND    (schemaname, indexname) NOT IN (SELECT schema_name, name FROM extension_indexes)
+	AND    (schemaname, indexname) NOT IN (SELECT schema_name, name FROM partition_indexes)
 	`
-	schema, table := splitTableName(table, d.GetDefaultSchema())
-	rows, err := d.db.Query(query, schema, table)
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(query, pq.Array(schemas), pq.Array(names))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	indexes := make([]string, 0)
+	indexes := map[string][]string{}
 	for rows.Next() {
-		var indexName, indexdef string
-		err = rows.Scan(&indexName, &indexdef)
+		var tableName, indexdef string
+		err = rows.Scan(&tableName, &indexdef)
 		if err != nil {
 			return nil, err
 		}
-		indexName = strings.Trim(indexName, `" `)
-
-		indexes = append(indexes, indexdef)
+		indexes[tableName] = append(indexes[tableName], indexdef)
 	}
-	return indexes, nil
+	return indexes, rows.Err()
 }
 
-func (d *PostgresDatabase) getTableCheckConstraints(tableName string) (map[string]string, error) {
-	const query = `SELECT con.conname, pg_get_constraintdef(con.oid, true)
+func (d *PostgresDatabase) getTableCheckConstraints(tables []string) (map[string]map[string]string, error) {
+	const query = `SELECT nsp.nspname || '.' || cls.relname, con.conname, pg_get_constraintdef(con.oid, true)
 	FROM   pg_constraint con
 	JOIN   pg_namespace nsp ON nsp.oid = con.connamespace
 	JOIN   pg_class cls ON cls.oid = con.conrelid
 	WHERE  con.contype = 'c'
-	AND    nsp.nspname = $1
-	AND    cls.relname = $2
-	AND    array_length(con.conkey, 1) > 1;`
+	AND    (nsp.nspname, cls.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+	AND    (con.conislocal OR cls.relispartition)
+	AND    (array_length(con.conkey, 1) > 1 OR NOT con.convalidated);`
 
-	result := map[string]string{}
-	schema, table := splitTableName(tableName, d.GetDefaultSchema())
-	rows, err := d.db.Query(query, schema, table)
+	result := map[string]map[string]string{}
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(query, pq.Array(schemas), pq.Array(names))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var constraintName, constraintDef string
-		err = rows.Scan(&constraintName, &constraintDef)
+		var tableName, constraintName, constraintDef string
+		err = rows.Scan(&tableName, &constraintName, &constraintDef)
 		if err != nil {
 			return nil, err
 		}
-		result[constraintName] = constraintDef
+		if result[tableName] == nil {
+			result[tableName] = map[string]string{}
+		}
+		result[tableName][constraintName] = constraintDef
 	}
 
-	return result, nil
+	return result, rows.Err()
+}
+
+func (d *PostgresDatabase) getUniqueConstraints(tables []string) (map[string]map[string]string, error) {
+	return d.getTableConstraints(tables, "u")
+}
+
+func (d *PostgresDatabase) getExclusionConstraints(tables []string) (map[string]map[string]string, error) {
+	return d.getTableConstraints(tables, "x")
 }
 
-func (d *PostgresDatabase) getUniqueConstraints(tableName string) (map[string]string, error) {
-	const query = `SELECT con.conname, pg_get_constraintdef(con.oid)
+// Return ALTER TABLE ... ADD CONSTRAINT of the constraints of the type, e.g. 'u' for unique constraints.
+func (d *PostgresDatabase) getTableConstraints(tables []string, constraintType string) (map[string]map[string]string, error) {
+	const query = `SELECT nsp.nspname, cls.relname, con.conname, pg_get_constraintdef(con.oid)
 	FROM   pg_constraint con
 	JOIN   pg_namespace nsp ON nsp.oid = con.connamespace
 	JOIN   pg_class cls ON cls.oid = con.conrelid
-	WHERE  con.contype = 'u'
-	AND    nsp.nspname = $1
-	AND    cls.relname = $2;`
+	WHERE  con.contype = $3
+	AND    (nsp.nspname, cls.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]));`
 
-	result := map[string]string{}
-	schema, table := splitTableName(tableName, d.GetDefaultSchema())
-	rows, err := d.db.Query(query, schema, table)
+	result := map[string]map[string]string{}
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(query, pq.Array(schemas), pq.Array(names), constraintType)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	for rows.Next() {
-		var constraintName, constraintDef string
-		err = rows.Scan(&constraintName, &constraintDef)
+		var schema, table, constraintName, constraintDef string
+		err = rows.Scan(&schema, &table, &constraintName, &constraintDef)
 		if err != nil {
 			return nil, err
 		}
 
-		result[constraintName] = fmt.Sprintf("ALTER TABLE %s.%s ADD CONSTRAINT %s %s",
+		tableName := schema + "." + table
+		if result[tableName] == nil {
+			result[tableName] = map[string]string{}
+		}
+		result[tableName][constraintName] = fmt.Sprintf("ALTER TABLE %s.%s ADD CONSTRAINT %s %s",
 			escapeSQLName(schema), escapeSQLName(table),
 			escapeSQLName(constraintName), constraintDef,
 		)
 	}
 
-	return result, nil
+	return result, rows.Err()
 }
 
-func (d *PostgresDatabase) getPrimaryKeyColumns(table string) ([]string, error) {
+func (d *PostgresDatabase) getPrimaryKeyColumns(tables []string) (map[string][]string, error) {
 	const query = `SELECT
-	tc.table_schema, tc.constraint_name, tc.table_name, kcu.column_name
+	tc.table_schema, tc.table_name, kcu.column_name
 FROM
 	information_schema.table_constraints AS tc
 	JOIN information_schema.key_column_usage AS kcu
 		USING (table_schema, table_name, constraint_name)
-WHERE constraint_type = 'PRIMARY KEY' AND tc.table_schema=$1 AND tc.table_name=$2 ORDER BY kcu.ordinal_position`
-	schema, table := splitTableName(table, d.GetDefaultSchema())
-	rows, err := d.db.Query(query, schema, table)
+WHERE constraint_type = 'PRIMARY KEY' AND (tc.table_schema, tc.table_name) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+ORDER BY tc.table_schema, tc.table_name, kcu.ordinal_position`
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(query, pq.Array(schemas), pq.Array(names))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	columnNames := make([]string, 0)
-	var tableSchema, constraintName, tableName string
+	columnNames := map[string][]string{}
 	for rows.Next() {
-		var columnName string
-		err = rows.Scan(&tableSchema, &constraintName, &tableName, &columnName)
+		var tableSchema, tableName, columnName string
+		err = rows.Scan(&tableSchema, &tableName, &columnName)
 		if err != nil {
 			return nil, err
 		}
-		columnNames = append(columnNames, columnName)
+		table := tableSchema + "." + tableName
+		columnNames[table] = append(columnNames[table], columnName)
+	}
+	return columnNames, rows.Err()
+}
+
+// Return the storage parameters of the index of each primary key, e.g. "fillfactor=70", which
+// information_schema doesn't have.
+func (d *PostgresDatabase) getPrimaryKeyOptions(tables []string) (map[string]string, error) {
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(`
+		SELECT n.nspname || '.' || t.relname, array_to_string(i.reloptions, ', ')
+		FROM pg_index x
+		JOIN pg_class i ON i.oid = x.indexrelid
+		JOIN pg_class t ON t.oid = x.indrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		WHERE x.indisprimary AND i.reloptions IS NOT NULL
+		AND (n.nspname, t.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+	`, pq.Array(schemas), pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	options := map[string]string{}
+	for rows.Next() {
+		var table, option string
+		if err := rows.Scan(&table, &option); err != nil {
+			return nil, err
+		}
+		options[table] = option
 	}
-	return columnNames, nil
+	return options, rows.Err()
 }
 
 // refs: https://gist.github.com/PickledDragon/dd41f4e72b428175354d
-func (d *PostgresDatabase) getForeignDefs(table string) ([]string, error) {
+func (d *PostgresDatabase) getForeignDefs(tables []string) (map[string][]string, error) {
 	const query = `SELECT
 		nc.nspname AS constraint_schema,
 		n1.nspname AS table_schema,
@@ -683,11 +1264,11 @@ func (d *PostgresDatabase) getForeignDefs(table string) ([]string, error) {
 	INNER JOIN pg_attribute AS a2
 		ON  a2.attrelid = c.confrelid
 		AND a2.attnum   = k.key2
-	WHERE c.contype = 'f' AND n1.nspname = $1 AND r1.relname = $2
+	WHERE c.contype = 'f' AND (n1.nspname, r1.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
 	ORDER BY constraint_schema, constraint_name, k.ordinality
 	`
-	schema, table := splitTableName(table, d.GetDefaultSchema())
-	rows, err := d.db.Query(query, schema, table)
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(query, pq.Array(schemas), pq.Array(names))
 	if err != nil {
 		return nil, err
 	}
@@ -724,6 +1305,9 @@ func (d *PostgresDatabase) getForeignDefs(table string) ([]string, error) {
 		c.foreignColumns = append(c.foreignColumns, foreignColumnName)
 		constraints[key] = c
 	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
 	var keys []identifier
 	for key := range constraints {
@@ -733,7 +1317,7 @@ func (d *PostgresDatabase) getForeignDefs(table string) ([]string, error) {
 		return keys[i].schema < keys[j].schema || keys[i].name < keys[j].name
 	})
 
-	defs := make([]string, 0)
+	defs := map[string][]string{}
 	for _, key := range keys {
 		c := constraints[key]
 		var escapedColumns []string
@@ -758,7 +1342,8 @@ func (d *PostgresDatabase) getForeignDefs(table string) ([]string, error) {
 			escapeSQLName(c.foreignTableSchema), escapeSQLName(c.foreignTableName), strings.Join(escapedForeignColumns, ", "), c.foreignUpdateRule, c.foreignDeleteRule,
 			constraintOptions,
 		)
-		defs = append(defs, def)
+		table := c.tableSchema + "." + c.tableName
+		defs[table] = append(defs[table], def)
 	}
 	return defs, nil
 }
@@ -768,22 +1353,22 @@ var (
 	policyRolesSuffixRegex = regexp.MustCompile(`}$`)
 )
 
-func (d *PostgresDatabase) getPolicyDefs(table string) ([]string, error) {
-	const query = "SELECT policyname, permissive, roles, cmd, qual, with_check FROM pg_policies WHERE schemaname = $1 AND tablename = $2;"
-	schema, table := splitTableName(table, d.GetDefaultSchema())
-	rows, err := d.db.Query(query, schema, table)
+func (d *PostgresDatabase) getPolicyDefs(tables []string) (map[string][]string, error) {
+	const query = "SELECT schemaname, tablename, policyname, permissive, roles, cmd, qual, with_check FROM pg_policies WHERE (schemaname, tablename) IN (SELECT * FROM unnest($1::text[], $2::text[]));"
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(query, pq.Array(schemas), pq.Array(names))
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	defs := make([]string, 0)
+	defs := map[string][]string{}
 	for rows.Next() {
 		var (
-			policyName, permissive, roles, cmd string
-			using, withCheck                   sql.NullString
+			schema, table, policyName, permissive, roles, cmd string
+			using, withCheck                                  sql.NullString
 		)
-		err = rows.Scan(&policyName, &permissive, &roles, &cmd, &using, &withCheck)
+		err = rows.Scan(&schema, &table, &policyName, &permissive, &roles, &cmd, &using, &withCheck)
 		if err != nil {
 			return nil, err
 		}
@@ -799,41 +1384,43 @@ func (d *PostgresDatabase) getPolicyDefs(table string) ([]string, error) {
 		if withCheck.Valid {
 			def += fmt.Sprintf(" WITH CHECK %s", withCheck.String)
 		}
-		defs = append(defs, def+";")
+		defs[schema+"."+table] = append(defs[schema+"."+table], def+";")
 	}
-	return defs, nil
+	return defs, rows.Err()
 }
 
-func (d *PostgresDatabase) getComments(table string) ([]string, error) {
-	schema, table := splitTableName(table, d.GetDefaultSchema())
-	var ddls []string
+func (d *PostgresDatabase) getComments(tables []string) (map[string][]string, error) {
+	ddls := map[string][]string{}
+	schemas, names := splitTableNames(tables)
 
 	// Table comments
 	tableRows, err := d.db.Query(`
-		SELECT obj_description(c.oid)
+		SELECT n.nspname, c.relname, obj_description(c.oid)
 		FROM pg_class c
 		JOIN pg_namespace n ON n.oid = c.relnamespace
 		WHERE c.relkind = 'r'
 		AND obj_description(c.oid) IS NOT NULL
-		AND n.nspname = $1
-		AND c.relname = $2
-	`, schema, table)
+		AND (n.nspname, c.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+	`, pq.Array(schemas), pq.Array(names))
 	if err != nil {
 		return nil, err
 	}
 	defer tableRows.Close()
 	for tableRows.Next() {
-		var comment string
-		if err := tableRows.Scan(&comment); err != nil {
+		var schema, table, comment string
+		if err := tableRows.Scan(&schema, &table, &comment); err != nil {
 			return nil, err
 		}
-		ddls = append(ddls, fmt.Sprintf("COMMENT ON TABLE \"%s\".\"%s\" IS %s;", schema, table, schemaLib.StringConstant(comment)))
+		ddls[schema+"."+table] = append(ddls[schema+"."+table], fmt.Sprintf("COMMENT ON TABLE \"%s\".\"%s\" IS %s;", schema, table, schemaLib.StringConstant(comment)))
+	}
+	if err := tableRows.Err(); err != nil {
+		return nil, err
 	}
 
 	// Column comments
 	columnRows, err := d.db.Query(`
 		select
-			c.column_name, pgd.description
+			c.table_schema, c.table_name, c.column_name, pgd.description
 		from pg_catalog.pg_statio_all_tables as st
 		inner join pg_catalog.pg_description pgd on (
 			pgd.objoid = st.relid
@@ -842,23 +1429,298 @@ func (d *PostgresDatabase) getComments(table string) ([]string, error) {
 			pgd.objsubid   = c.ordinal_position and
 			c.table_schema = st.schemaname and
 			c.table_name   = st.relname and
-			c.table_schema = $1 and
-			st.relname = $2
+			(c.table_schema, st.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
 		);
-	`, schema, table)
+	`, pq.Array(schemas), pq.Array(names))
 	if err != nil {
 		return nil, err
 	}
 	defer columnRows.Close()
 	for columnRows.Next() {
-		var columnName, comment string
-		if err := columnRows.Scan(&columnName, &comment); err != nil {
+		var schema, table, columnName, comment string
+		if err := columnRows.Scan(&schema, &table, &columnName, &comment); err != nil {
 			return nil, err
 		}
-		ddls = append(ddls, fmt.Sprintf("COMMENT ON COLUMN \"%s\".\"%s\".\"%s\" IS %s;", schema, table, columnName, schemaLib.StringConstant(comment)))
+		ddls[schema+"."+table] = append(ddls[schema+"."+table], fmt.Sprintf("COMMENT ON COLUMN \"%s\".\"%s\".\"%s\" IS %s;", schema, table, columnName, schemaLib.StringConstant(comment)))
+	}
+	if err := columnRows.Err(); err != nil {
+		return nil, err
 	}
 
-	return ddls, nil
+	// Index comments
+	indexRows, err := d.db.Query(`
+		SELECT n.nspname, t.relname, i.relname, obj_description(i.oid, 'pg_class')
+		FROM pg_index x
+		JOIN pg_class i ON i.oid = x.indexrelid
+		JOIN pg_class t ON t.oid = x.indrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		WHERE obj_description(i.oid, 'pg_class') IS NOT NULL
+		AND (n.nspname, t.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+		ORDER BY i.relname
+	`, pq.Array(schemas), pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer indexRows.Close()
+	for indexRows.Next() {
+		var schema, table, index, comment string
+		if err := indexRows.Scan(&schema, &table, &index, &comment); err != nil {
+			return nil, err
+		}
+		ddls[schema+"."+table] = append(ddls[schema+"."+table], fmt.Sprintf("COMMENT ON INDEX \"%s\".\"%s\" IS %s;", schema, index, schemaLib.StringConstant(comment)))
+	}
+	if err := indexRows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Constraint comments
+	constraintRows, err := d.db.Query(`
+		SELECT n.nspname, t.relname, con.conname, obj_description(con.oid, 'pg_constraint')
+		FROM pg_constraint con
+		JOIN pg_class t ON t.oid = con.conrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		WHERE obj_description(con.oid, 'pg_constraint') IS NOT NULL
+		AND (n.nspname, t.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+		ORDER BY con.conname
+	`, pq.Array(schemas), pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer constraintRows.Close()
+	for constraintRows.Next() {
+		var schema, table, constraint, comment string
+		if err := constraintRows.Scan(&schema, &table, &constraint, &comment); err != nil {
+			return nil, err
+		}
+		ddls[schema+"."+table] = append(ddls[schema+"."+table], fmt.Sprintf("COMMENT ON CONSTRAINT \"%s\" ON \"%s\".\"%s\" IS %s;", constraint, schema, table, schemaLib.StringConstant(comment)))
+	}
+
+	return ddls, constraintRows.Err()
+}
+
+// Comments on the objects which don't belong to a table, i.e. schemas, types, and functions.
+func (d *PostgresDatabase) objectComments() ([]string, error) {
+	var ddls []string
+
+	schemaRows, err := d.db.Query(`
+		SELECT n.nspname, obj_description(n.oid, 'pg_namespace')
+		FROM pg_namespace n
+		WHERE obj_description(n.oid, 'pg_namespace') IS NOT NULL
+		AND n.nspname NOT LIKE 'pg_%'
+		AND n.nspname NOT IN ('information_schema', 'public')
+		AND ` + notExtensionMember("pg_namespace", "n.oid") + `
+		ORDER BY n.nspname
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer schemaRows.Close()
+	for schemaRows.Next() {
+		var schema, comment string
+		if err := schemaRows.Scan(&schema, &comment); err != nil {
+			return nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, schema) {
+			continue
+		}
+		ddls = append(ddls, fmt.Sprintf("COMMENT ON SCHEMA %s IS %s;", escapeSQLName(schema), schemaLib.StringConstant(comment)))
+	}
+	if err := schemaRows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Only enum and composite types, which are managed by psqldef
+	typeRows, err := d.db.Query(`
+		SELECT n.nspname, t.typname, obj_description(t.oid, 'pg_type')
+		FROM pg_type t
+		JOIN pg_namespace n ON n.oid = t.typnamespace
+		LEFT JOIN pg_class c ON c.oid = t.typrelid
+		WHERE obj_description(t.oid, 'pg_type') IS NOT NULL
+		AND (t.typtype = 'e' OR (t.typtype = 'c' AND c.relkind = 'c'))
+		AND n.nspname NOT IN ('information_schema', 'pg_catalog')
+		AND ` + notExtensionMember("pg_type", "t.oid") + `
+		ORDER BY n.nspname, t.typname
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer typeRows.Close()
+	for typeRows.Next() {
+		var schema, typeName, comment string
+		if err := typeRows.Scan(&schema, &typeName, &comment); err != nil {
+			return nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, schema) {
+			continue
+		}
+		ddls = append(ddls, fmt.Sprintf("COMMENT ON TYPE %s.%s IS %s;", escapeSQLName(schema), escapeSQLName(typeName), schemaLib.StringConstant(comment)))
+	}
+	if err := typeRows.Err(); err != nil {
+		return nil, err
+	}
+
+	functionRows, err := d.db.Query(`
+		SELECT n.nspname, p.proname, pg_catalog.oidvectortypes(p.proargtypes), obj_description(p.oid, 'pg_proc')
+		FROM pg_proc p
+		JOIN pg_namespace n ON n.oid = p.pronamespace
+		WHERE obj_description(p.oid, 'pg_proc') IS NOT NULL
+		AND p.prokind = 'f'
+		AND n.nspname NOT IN ('information_schema', 'pg_catalog')
+		AND ` + notExtensionMember("pg_proc", "p.oid") + `
+		ORDER BY n.nspname, p.proname
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer functionRows.Close()
+	for functionRows.Next() {
+		var schema, function, args, comment string
+		if err := functionRows.Scan(&schema, &function, &args, &comment); err != nil {
+			return nil, err
+		}
+		if d.config.TargetSchema != nil && !containsString(d.config.TargetSchema, schema) {
+			continue
+		}
+		ddls = append(ddls, fmt.Sprintf("COMMENT ON FUNCTION %s.%s(%s) IS %s;", escapeSQLName(schema), escapeSQLName(function), args, schemaLib.StringConstant(comment)))
+	}
+
+	return ddls, functionRows.Err()
+}
+
+func (d *PostgresDatabase) getClusterOn(tables []string) (map[string]string, error) {
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(`
+		SELECT n.nspname || '.' || c.relname, ic.relname
+		FROM pg_index i
+		JOIN pg_class c ON c.oid = i.indrelid
+		JOIN pg_class ic ON ic.oid = i.indexrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE i.indisclustered
+		AND (n.nspname, c.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+	`, pq.Array(schemas), pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	indexNames := map[string]string{}
+	for rows.Next() {
+		var tableName, indexName string
+		if err := rows.Scan(&tableName, &indexName); err != nil {
+			return nil, err
+		}
+		indexNames[tableName] = indexName
+	}
+	return indexNames, rows.Err()
+}
+
+// Return the parents of the tables declared by INHERITS, in the declared order. The parents of partitions are omitted.
+func (d *PostgresDatabase) getInherits(tables []string) (map[string][]string, error) {
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(`
+		SELECT n.nspname || '.' || c.relname, pn.nspname || '.' || p.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		JOIN pg_class p ON p.oid = i.inhparent
+		JOIN pg_namespace pn ON pn.oid = p.relnamespace
+		WHERE NOT c.relispartition
+		AND (n.nspname, c.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+		ORDER BY 1, i.inhseqno
+	`, pq.Array(schemas), pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	inherits := map[string][]string{}
+	for rows.Next() {
+		var tableName, parentName string
+		if err := rows.Scan(&tableName, &parentName); err != nil {
+			return nil, err
+		}
+		inherits[tableName] = append(inherits[tableName], parentName)
+	}
+	return inherits, rows.Err()
+}
+
+// Move the parents of each table before it, so that the exported INHERITS can be run in order.
+func sortTablesByInheritance(tables []string, inherits map[string][]string) []string {
+	var result []string
+	added := map[string]bool{}
+	var addTable func(table string)
+	addTable = func(table string) {
+		if added[table] {
+			return
+		}
+		added[table] = true
+		for _, parent := range inherits[table] {
+			if containsString(tables, parent) {
+				addTable(parent)
+			}
+		}
+		result = append(result, table)
+	}
+	for _, table := range tables {
+		addTable(table)
+	}
+	return result
+}
+
+func (d *PostgresDatabase) getUnloggedTables(tables []string) (map[string]bool, error) {
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(`
+		SELECT n.nspname || '.' || c.relname
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE c.relpersistence = 'u'
+		AND (n.nspname, c.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+	`, pq.Array(schemas), pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	unloggedTables := map[string]bool{}
+	for rows.Next() {
+		var tableName string
+		if err := rows.Scan(&tableName); err != nil {
+			return nil, err
+		}
+		unloggedTables[tableName] = true
+	}
+	return unloggedTables, rows.Err()
+}
+
+// Owners are dumped only for `managed_roles` because the generator ignores the others.
+func (d *PostgresDatabase) getTableOwners(tables []string) (map[string]string, error) {
+	owners := map[string]string{}
+	if len(d.config.ManagedRoles) == 0 {
+		return owners, nil
+	}
+
+	schemas, names := splitTableNames(tables)
+	rows, err := d.db.Query(`
+		SELECT n.nspname || '.' || c.relname, pg_get_userbyid(c.relowner)
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE (n.nspname, c.relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))
+	`, pq.Array(schemas), pq.Array(names))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tableName, owner string
+		if err := rows.Scan(&tableName, &owner); err != nil {
+			return nil, err
+		}
+		if containsString(d.config.ManagedRoles, owner) {
+			owners[tableName] = owner
+		}
+	}
+	return owners, rows.Err()
 }
 
 func (d *PostgresDatabase) DB() *sql.DB {
@@ -866,13 +1728,17 @@ func (d *PostgresDatabase) DB() *sql.DB {
 }
 
 func (d *PostgresDatabase) Close() error {
-	return d.db.Close()
+	return errors.Join(d.db.Close(), d.tunnel.Close())
 }
 
 func (d *PostgresDatabase) GetDefaultSchema() string {
 	if d.defaultSchema != nil {
 		return *d.defaultSchema
 	}
+	if d.config.DefaultSchema != "" {
+		d.defaultSchema = &d.config.DefaultSchema
+		return d.config.DefaultSchema
+	}
 
 	var defaultSchema string
 	query := "SELECT current_schema();"
@@ -915,6 +1781,16 @@ func postgresBuildDSN(config database.Config) string {
 		options = append(options, fmt.Sprintf("sslkey=%s", sslkey))
 	}
 
+	// Resolve unqualified names in the desired SQL the same way as sqldef does. public is kept in search_path
+	// so that functions of extensions installed there, e.g. uuid_generate_v4(), can still be called unqualified.
+	if config.DefaultSchema != "" {
+		searchPath := escapeSQLName(config.DefaultSchema)
+		if config.DefaultSchema != "public" {
+			searchPath += ", public"
+		}
+		options = append(options, fmt.Sprintf("search_path=%s", url.QueryEscape(searchPath)))
+	}
+
 	// `QueryEscape` instead of `PathEscape` so that colon can be escaped.
 	return fmt.Sprintf("postgres://%s:%s@%s/%s?%s", url.QueryEscape(user), url.QueryEscape(password), host, database, strings.Join(options, "&"))
 }
@@ -933,6 +1809,18 @@ func splitTableName(table string, defaultSchema string) (string, string) {
 	return schema, table
 }
 
+// Split the qualified table names into the parallel arrays of the schemas and the names, which are matched by
+// `(nspname, relname) IN (SELECT * FROM unnest($1::text[], $2::text[]))`. Unlike matching the concatenation of them,
+// it can use the indexes of the catalogs.
+func splitTableNames(tables []string) ([]string, []string) {
+	schemas := make([]string, len(tables))
+	names := make([]string, len(tables))
+	for i, table := range tables {
+		schemas[i], names[i] = splitTableName(table, "")
+	}
+	return schemas, names
+}
+
 func containsString(strs []string, str string) bool {
 	for _, s := range strs {
 		if s == str {
@@ -7,6 +7,7 @@ import (
 	pgquery "github.com/pganalyze/pg_query_go/v5"
 	"github.com/sqldef/sqldef/database"
 	"github.com/sqldef/sqldef/parser"
+	schemaLib "github.com/sqldef/sqldef/schema"
 	go_pgquery "github.com/wasilibs/go-pgquery"
 )
 
@@ -83,6 +84,14 @@ func (p PostgresParser) parseStmt(node *pgquery.Node) (parser.Statement, error)
 		return p.parseAlterTableStmt(stmt.AlterTableStmt)
 	case *pgquery.Node_CreateSchemaStmt:
 		return p.parseCreateSchemaStmt(stmt.CreateSchemaStmt)
+	case *pgquery.Node_CreatePublicationStmt:
+		return p.parseCreatePublicationStmt(stmt.CreatePublicationStmt)
+	case *pgquery.Node_CreateRoleStmt:
+		return p.parseCreateRoleStmt(stmt.CreateRoleStmt)
+	case *pgquery.Node_GrantStmt:
+		return p.parseGrantStmt(stmt.GrantStmt)
+	case *pgquery.Node_DropStmt:
+		return p.parseDropStmt(stmt.DropStmt)
 	default:
 		return nil, fmt.Errorf("unknown node in parseStmt: %#v", stmt)
 	}
@@ -124,6 +133,10 @@ func (p PostgresParser) parseCreateStmt(stmt *pgquery.CreateStmt) (parser.Statem
 			}
 			switch node.Constraint.Contype {
 			case pgquery.ConstrType_CONSTR_PRIMARY:
+				options, err := p.parseIndexOptions(node.Constraint.Options)
+				if err != nil {
+					return nil, err
+				}
 				index := &parser.IndexDefinition{
 					Info: &parser.IndexInfo{
 						Type:      "primary key",
@@ -133,18 +146,24 @@ func (p PostgresParser) parseCreateStmt(stmt *pgquery.CreateStmt) (parser.Statem
 						Clustered: true,
 					},
 					Columns: indexCols,
-					Options: []*parser.IndexOption{},
+					Options: options,
 				}
 				indexes = append(indexes, index)
 			case pgquery.ConstrType_CONSTR_UNIQUE:
+				options, err := p.parseIndexOptions(node.Constraint.Options)
+				if err != nil {
+					return nil, err
+				}
 				index := &parser.IndexDefinition{
 					Info: &parser.IndexInfo{
 						Type:   "unique key",
 						Name:   parser.NewColIdent(node.Constraint.Conname),
 						Unique: true,
 					},
-					Columns: indexCols,
-					Options: []*parser.IndexOption{},
+					Columns:          indexCols,
+					Included:         p.parseIncludedColumns(node.Constraint.Including),
+					NullsNotDistinct: node.Constraint.NullsNotDistinct,
+					Options:          options,
 					ConstraintOptions: &parser.ConstraintOptions{
 						Deferrable:        node.Constraint.Deferrable,
 						InitiallyDeferred: node.Constraint.Initdeferred,
@@ -165,6 +184,7 @@ func (p PostgresParser) parseCreateStmt(stmt *pgquery.CreateStmt) (parser.Statem
 				check := &parser.CheckDefinition{
 					Where:          *parser.NewWhere(parser.WhereStr, expr),
 					ConstraintName: parser.NewColIdent(node.Constraint.Conname),
+					NotValid:       node.Constraint.SkipValidation,
 				}
 				checks = append(checks, check)
 			default:
@@ -175,6 +195,18 @@ func (p PostgresParser) parseCreateStmt(stmt *pgquery.CreateStmt) (parser.Statem
 		}
 	}
 
+	// PARTITION OF has its parent in InhRelations too, which isn't inheritance declared by INHERITS.
+	var inherits []parser.TableName
+	if stmt.Partbound == nil {
+		for _, relation := range stmt.InhRelations {
+			parent, err := p.parseTableName(relation.GetRangeVar())
+			if err != nil {
+				return nil, err
+			}
+			inherits = append(inherits, parent)
+		}
+	}
+
 	return &parser.DDL{
 		Action:  parser.CreateTable,
 		NewName: tableName,
@@ -184,6 +216,8 @@ func (p PostgresParser) parseCreateStmt(stmt *pgquery.CreateStmt) (parser.Statem
 			ForeignKeys: foreignKeys,
 			Checks:      checks,
 			Options:     map[string]string{},
+			Unlogged:    stmt.Relation.Relpersistence == "u",
+			Inherits:    inherits,
 		},
 	}, nil
 }
@@ -215,15 +249,32 @@ func (p PostgresParser) parseIndexStmt(stmt *pgquery.IndexStmt) (parser.Statemen
 		indexCols = append(indexCols, indexCol)
 	}
 
+	var included []parser.ColIdent
+	for _, indexParam := range stmt.IndexIncludingParams {
+		indexElem, ok := indexParam.Node.(*pgquery.Node_IndexElem)
+		if !ok || indexElem.IndexElem.Name == "" {
+			return nil, fmt.Errorf("unknown node in parseIndexStmt: %#v", indexParam)
+		}
+		included = append(included, parser.NewColIdent(indexElem.IndexElem.Name))
+	}
+
+	options, err := p.parseIndexOptions(stmt.Options)
+	if err != nil {
+		return nil, err
+	}
+
 	return &parser.DDL{
 		Action:  parser.CreateIndex,
 		Table:   table,
 		NewName: table,
 		IndexSpec: &parser.IndexSpec{
-			Name:   parser.NewColIdent(stmt.Idxname),
-			Type:   parser.NewColIdent(stmt.AccessMethod),
-			Unique: stmt.Unique,
-			Where:  where,
+			Name:             parser.NewColIdent(stmt.Idxname),
+			Type:             parser.NewColIdent(stmt.AccessMethod),
+			Unique:           stmt.Unique,
+			Included:         included,
+			NullsNotDistinct: stmt.NullsNotDistinct,
+			Where:            where,
+			Options:          options,
 		},
 		IndexCols: indexCols,
 	}, nil
@@ -690,6 +741,11 @@ func (p PostgresParser) parseExpr(stmt *pgquery.Node) (parser.Expr, error) {
 func (p PostgresParser) parseIndexColumn(stmt *pgquery.Node) (parser.IndexColumn, error) {
 	switch node := stmt.Node.(type) {
 	case *pgquery.Node_IndexElem:
+		var opClass string // without the schema, as pg_get_indexdef omits it in the search path
+		for _, name := range node.IndexElem.Opclass {
+			opClass = name.Node.(*pgquery.Node_String_).String_.Sval
+		}
+
 		if node.IndexElem.Expr != nil {
 			expr, err := p.parseExpr(node.IndexElem.Expr)
 			if err != nil {
@@ -697,7 +753,8 @@ func (p PostgresParser) parseIndexColumn(stmt *pgquery.Node) (parser.IndexColumn
 			}
 
 			return parser.IndexColumn{
-				Column: parser.NewColIdent(parser.String(expr)),
+				Column:        parser.NewColIdent(parser.String(expr)),
+				OperatorClass: opClass,
 			}, nil
 		} else {
 			var direction string
@@ -712,8 +769,9 @@ func (p PostgresParser) parseIndexColumn(stmt *pgquery.Node) (parser.IndexColumn
 				return parser.IndexColumn{}, fmt.Errorf("unexpected direction in parseIndexColumn: %d", node.IndexElem.Ordering)
 			}
 			return parser.IndexColumn{
-				Column:    parser.NewColIdent(node.IndexElem.Name),
-				Direction: direction,
+				Column:        parser.NewColIdent(node.IndexElem.Name),
+				Direction:     direction,
+				OperatorClass: opClass,
 			}, nil
 		}
 	default:
@@ -721,6 +779,45 @@ func (p PostgresParser) parseIndexColumn(stmt *pgquery.Node) (parser.IndexColumn
 	}
 }
 
+// Parse a storage parameter in WITH of CREATE INDEX, e.g. fastupdate = off
+// Parse the storage parameters in WITH (...) of an index or a constraint using an index.
+func (p PostgresParser) parseIndexOptions(nodes []*pgquery.Node) ([]*parser.IndexOption, error) {
+	options := []*parser.IndexOption{}
+	for _, node := range nodes {
+		option, err := p.parseIndexOption(node)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
+	}
+	return options, nil
+}
+
+func (p PostgresParser) parseIndexOption(stmt *pgquery.Node) (*parser.IndexOption, error) {
+	node, ok := stmt.Node.(*pgquery.Node_DefElem)
+	if !ok {
+		return nil, fmt.Errorf("unexpected node type in parseIndexOption: %#v", stmt)
+	}
+
+	var value *parser.SQLVal
+	switch arg := node.DefElem.Arg.GetNode().(type) {
+	case nil: // e.g. WITH (deduplicate_items), which is true
+		value = parser.NewStrVal([]byte("true"))
+	case *pgquery.Node_Integer:
+		value = parser.NewIntVal([]byte(fmt.Sprint(arg.Integer.Ival)))
+	case *pgquery.Node_Float:
+		value = parser.NewFloatVal([]byte(arg.Float.Fval))
+	case *pgquery.Node_String_:
+		value = parser.NewStrVal([]byte(arg.String_.Sval))
+	case *pgquery.Node_TypeName: // an unquoted word, e.g. off
+		names := arg.TypeName.Names
+		value = parser.NewStrVal([]byte(names[len(names)-1].Node.(*pgquery.Node_String_).String_.Sval))
+	default:
+		return nil, fmt.Errorf("unexpected value of %s in parseIndexOption: %#v", node.DefElem.Defname, arg)
+	}
+	return &parser.IndexOption{Name: node.DefElem.Defname, Value: value}, nil
+}
+
 func (p PostgresParser) parseArrayElement(node parser.Expr) (parser.ArrayElement, error) {
 	switch node := node.(type) {
 	case *parser.SQLVal:
@@ -737,14 +834,32 @@ func (p PostgresParser) parseArrayElement(node parser.Expr) (parser.ArrayElement
 func (p PostgresParser) parseCommentStmt(stmt *pgquery.CommentStmt) (parser.Statement, error) {
 	var object string
 	switch node := stmt.Object.Node.(type) {
-	case *pgquery.Node_List:
+	case *pgquery.Node_List: // e.g. TABLE, COLUMN, INDEX, and CONSTRAINT ... ON, whose table comes before the constraint
 		var err error
 		object, err = p.parseStringList(node.List)
 		if err != nil {
 			return nil, err
 		}
+	case *pgquery.Node_String_: // SCHEMA
+		object = node.String_.Sval
+	case *pgquery.Node_TypeName: // TYPE
+		object = p.parseObjectTypeName(node.TypeName)
+	case *pgquery.Node_ObjectWithArgs: // FUNCTION, e.g. "public.f(int4, text)"
+		name, err := p.parseStringList(&pgquery.List{Items: node.ObjectWithArgs.Objname})
+		if err != nil {
+			return nil, err
+		}
+		var args []string
+		for _, arg := range node.ObjectWithArgs.Objargs {
+			typeName, ok := arg.Node.(*pgquery.Node_TypeName)
+			if !ok {
+				return nil, fmt.Errorf("unknown argument in parseCommentStmt: %#v", arg)
+			}
+			args = append(args, p.parseObjectTypeName(typeName.TypeName))
+		}
+		object = name + "(" + strings.Join(args, ", ") + ")"
 	default:
-		return nil, fmt.Errorf("unknown node in parseColumnStmt: %#v", node)
+		return nil, fmt.Errorf("unknown node in parseCommentStmt: %#v", node)
 	}
 
 	return &parser.DDL{
@@ -767,6 +882,176 @@ func (p PostgresParser) parseTableName(relation *pgquery.RangeVar) (parser.Table
 	}, nil
 }
 
+func (p PostgresParser) parseDropStmt(stmt *pgquery.DropStmt) (parser.Statement, error) {
+	var action parser.DDLAction
+	switch stmt.RemoveType {
+	case pgquery.ObjectType_OBJECT_TABLE:
+		action = parser.DropTable
+	case pgquery.ObjectType_OBJECT_VIEW, pgquery.ObjectType_OBJECT_MATVIEW:
+		action = parser.DropView
+	case pgquery.ObjectType_OBJECT_INDEX:
+		action = parser.DropIndex
+	default:
+		return nil, fmt.Errorf("unhandled object type in parseDropStmt: %#v", stmt)
+	}
+
+	var names parser.TableNames
+	for _, node := range stmt.Objects {
+		list := node.GetList()
+		if list == nil || len(list.Items) == 0 || len(list.Items) > 2 {
+			return nil, fmt.Errorf("unhandled object in parseDropStmt: %#v", node)
+		}
+		var name parser.TableName
+		for i, item := range list.Items {
+			ident := parser.NewTableIdent(item.GetString_().GetSval())
+			if i == len(list.Items)-1 {
+				name.Name = ident
+			} else {
+				name.Schema = ident
+			}
+		}
+		names = append(names, name)
+	}
+
+	return &parser.DDL{
+		Action:   action,
+		IfExists: stmt.MissingOk,
+		Drop:     &parser.Drop{Names: names},
+	}, nil
+}
+
+func (p PostgresParser) parseCreatePublicationStmt(stmt *pgquery.CreatePublicationStmt) (parser.Statement, error) {
+	if len(stmt.Options) > 0 {
+		return nil, fmt.Errorf("unhandled options in parseCreatePublicationStmt: %#v", stmt.Options)
+	}
+
+	var tables parser.TableNames
+	for _, node := range stmt.Pubobjects {
+		spec := node.Node.(*pgquery.Node_PublicationObjSpec).PublicationObjSpec
+		if spec.Pubobjtype != pgquery.PublicationObjSpecType_PUBLICATIONOBJ_TABLE || spec.Pubtable.WhereClause != nil || len(spec.Pubtable.Columns) > 0 {
+			return nil, fmt.Errorf("unhandled publication object in parseCreatePublicationStmt: %#v", spec)
+		}
+		tableName, err := p.parseTableName(spec.Pubtable.Relation)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, tableName)
+	}
+
+	return &parser.DDL{
+		Action: parser.CreatePublication,
+		Publication: &parser.Publication{
+			Name:      parser.NewColIdent(stmt.Pubname),
+			Tables:    tables,
+			AllTables: stmt.ForAllTables,
+		},
+	}, nil
+}
+
+func (p PostgresParser) parseCreateRoleStmt(stmt *pgquery.CreateRoleStmt) (parser.Statement, error) {
+	role := &parser.Role{
+		Name:            stmt.Role,
+		Login:           stmt.StmtType == pgquery.RoleStmtType_ROLESTMT_USER, // CREATE USER implies LOGIN
+		Inherit:         true,
+		ConnectionLimit: -1,
+	}
+	for _, option := range stmt.Options {
+		defElem, ok := option.Node.(*pgquery.Node_DefElem)
+		if !ok {
+			return nil, fmt.Errorf("unexpected node type in parseCreateRoleStmt: %#v", option)
+		}
+		switch defElem.DefElem.Defname {
+		case "canlogin":
+			role.Login = defElem.DefElem.Arg.GetBoolean().GetBoolval()
+		case "superuser":
+			role.Superuser = defElem.DefElem.Arg.GetBoolean().GetBoolval()
+		case "createdb":
+			role.CreateDB = defElem.DefElem.Arg.GetBoolean().GetBoolval()
+		case "createrole":
+			role.CreateRole = defElem.DefElem.Arg.GetBoolean().GetBoolval()
+		case "inherit":
+			role.Inherit = defElem.DefElem.Arg.GetBoolean().GetBoolval()
+		case "isreplication":
+			role.Replication = defElem.DefElem.Arg.GetBoolean().GetBoolval()
+		case "bypassrls":
+			role.BypassRLS = defElem.DefElem.Arg.GetBoolean().GetBoolval()
+		case "connectionlimit":
+			role.ConnectionLimit = int(defElem.DefElem.Arg.GetInteger().GetIval())
+		case "addroleto":
+			for _, node := range defElem.DefElem.Arg.GetList().GetItems() {
+				role.InRoles = append(role.InRoles, node.GetRoleSpec().GetRolename())
+			}
+		case "password", "validUntil":
+			// Passwords are never managed, so that they can be set out of the schema file.
+		default:
+			return nil, fmt.Errorf("unhandled option in parseCreateRoleStmt: %#v", defElem.DefElem)
+		}
+	}
+
+	return &parser.DDL{
+		Action: parser.CreateRole,
+		Role:   role,
+	}, nil
+}
+
+// The privileges of tables which GRANT ALL gives
+var tablePrivileges = []string{"SELECT", "INSERT", "UPDATE", "DELETE", "TRUNCATE", "REFERENCES", "TRIGGER"}
+
+// GRANT is parsed to report the privileges with --report-privileges, but psqldef doesn't generate GRANT or REVOKE.
+func (p PostgresParser) parseGrantStmt(stmt *pgquery.GrantStmt) (parser.Statement, error) {
+	if !stmt.IsGrant || stmt.Targtype != pgquery.GrantTargetType_ACL_TARGET_OBJECT || stmt.Objtype != pgquery.ObjectType_OBJECT_TABLE {
+		return nil, fmt.Errorf("unhandled GRANT in parseGrantStmt: %#v", stmt)
+	}
+
+	var privileges []string
+	for _, node := range stmt.Privileges {
+		privilege := node.GetAccessPriv()
+		if privilege == nil || len(privilege.Cols) > 0 {
+			return nil, fmt.Errorf("unhandled privilege in parseGrantStmt: %#v", node)
+		}
+		privileges = append(privileges, strings.ToUpper(privilege.PrivName))
+	}
+	if len(privileges) == 0 { // ALL PRIVILEGES
+		privileges = append(privileges, tablePrivileges...)
+	}
+
+	var tables parser.TableNames
+	for _, node := range stmt.Objects {
+		relation := node.GetRangeVar()
+		if relation == nil {
+			return nil, fmt.Errorf("unhandled object in parseGrantStmt: %#v", node)
+		}
+		tableName, err := p.parseTableName(relation)
+		if err != nil {
+			return nil, err
+		}
+		tables = append(tables, tableName)
+	}
+
+	var grantees []string
+	for _, node := range stmt.Grantees {
+		roleSpec := node.GetRoleSpec()
+		switch roleSpec.GetRoletype() {
+		case pgquery.RoleSpecType_ROLESPEC_CSTRING:
+			grantees = append(grantees, roleSpec.Rolename)
+		case pgquery.RoleSpecType_ROLESPEC_PUBLIC:
+			grantees = append(grantees, "PUBLIC")
+		default:
+			return nil, fmt.Errorf("unhandled grantee in parseGrantStmt: %#v", node)
+		}
+	}
+
+	return &parser.DDL{
+		Action: parser.GrantOn,
+		Grant: &parser.Grant{
+			Privileges:  privileges,
+			Tables:      tables,
+			Grantees:    grantees,
+			GrantOption: stmt.GrantOption,
+		},
+	}, nil
+}
+
 func (p PostgresParser) parseExtensionStmt(stmt *pgquery.CreateExtensionStmt) (parser.Statement, error) {
 	return &parser.DDL{
 		Action: parser.CreateExtension,
@@ -786,7 +1071,22 @@ func (p PostgresParser) parseAlterTableStmt(stmt *pgquery.AlterTableStmt) (parse
 		return nil, fmt.Errorf("multiple actions are not supported in parseAlterTableStmt")
 	}
 
-	switch node := stmt.Cmds[0].Node.(*pgquery.Node_AlterTableCmd).AlterTableCmd.Def.Node.(type) {
+	cmd := stmt.Cmds[0].Node.(*pgquery.Node_AlterTableCmd).AlterTableCmd
+	switch cmd.Subtype {
+	case pgquery.AlterTableType_AT_ChangeOwner:
+		return p.parseChangeOwner(stmt.Objtype, cmd.Newowner, tableName)
+	case pgquery.AlterTableType_AT_ClusterOn:
+		return &parser.DDL{
+			Action:  parser.ClusterOn,
+			Table:   tableName,
+			NewName: tableName,
+			IndexSpec: &parser.IndexSpec{
+				Name: parser.NewColIdent(cmd.Name),
+			},
+		}, nil
+	}
+
+	switch node := cmd.Def.Node.(type) {
 	case *pgquery.Node_Constraint:
 		return p.parseConstraint(node.Constraint, tableName)
 	default:
@@ -794,6 +1094,40 @@ func (p PostgresParser) parseAlterTableStmt(stmt *pgquery.AlterTableStmt) (parse
 	}
 }
 
+func (p PostgresParser) parseChangeOwner(objtype pgquery.ObjectType, newowner *pgquery.RoleSpec, tableName parser.TableName) (parser.Statement, error) {
+	var objectType string
+	switch objtype {
+	case pgquery.ObjectType_OBJECT_TABLE:
+		objectType = "TABLE"
+	case pgquery.ObjectType_OBJECT_VIEW:
+		objectType = "VIEW"
+	default:
+		return nil, fmt.Errorf("unhandled object type in parseChangeOwner: %s", objtype)
+	}
+	if newowner.Roletype != pgquery.RoleSpecType_ROLESPEC_CSTRING {
+		return nil, fmt.Errorf("unhandled role type in parseChangeOwner: %s", newowner.Roletype)
+	}
+
+	return &parser.DDL{
+		Action:  parser.AlterOwner,
+		Table:   tableName,
+		NewName: tableName,
+		Owner: &parser.Owner{
+			ObjectType: objectType,
+			Role:       newowner.Rolename,
+		},
+	}, nil
+}
+
+// Return the columns of INCLUDE of a unique constraint.
+func (p PostgresParser) parseIncludedColumns(including []*pgquery.Node) []parser.ColIdent {
+	var included []parser.ColIdent
+	for _, column := range including {
+		included = append(included, parser.NewColIdent(column.Node.(*pgquery.Node_String_).String_.Sval))
+	}
+	return included
+}
+
 func (p PostgresParser) parseConstraint(constraint *pgquery.Constraint, tableName parser.TableName) (parser.Statement, error) {
 	switch constraint.Contype {
 	case pgquery.ConstrType_CONSTR_UNIQUE:
@@ -804,14 +1138,21 @@ func (p PostgresParser) parseConstraint(constraint *pgquery.Constraint, tableNam
 				Direction: "asc",
 			}
 		}
+		options, err := p.parseIndexOptions(constraint.Options)
+		if err != nil {
+			return nil, err
+		}
 		return &parser.DDL{
 			Action:  parser.AddIndex,
 			Table:   tableName,
 			NewName: tableName,
 			IndexSpec: &parser.IndexSpec{
-				Name:       parser.NewColIdent(constraint.Conname),
-				Constraint: true,
-				Unique:     true,
+				Name:             parser.NewColIdent(constraint.Conname),
+				Constraint:       true,
+				Unique:           true,
+				Included:         p.parseIncludedColumns(constraint.Including),
+				NullsNotDistinct: constraint.NullsNotDistinct,
+				Options:          options,
 				ConstraintOptions: &parser.ConstraintOptions{
 					Deferrable:        constraint.Deferrable,
 					InitiallyDeferred: constraint.Initdeferred,
@@ -830,6 +1171,17 @@ func (p PostgresParser) parseConstraint(constraint *pgquery.Constraint, tableNam
 			NewName:    tableName,
 			ForeignKey: fk,
 		}, nil
+	case pgquery.ConstrType_CONSTR_EXCLUSION:
+		exclusion, err := p.parseExclusion(constraint)
+		if err != nil {
+			return nil, err
+		}
+		return &parser.DDL{
+			Action:    parser.AddExclusion,
+			Table:     tableName,
+			NewName:   tableName,
+			Exclusion: exclusion,
+		}, nil
 	default:
 		return nil, fmt.Errorf("unhandled constraint type in parseAlterTableStmt: %d", constraint.Contype)
 	}
@@ -865,6 +1217,57 @@ func (p PostgresParser) parseForeignKey(constraint *pgquery.Constraint) (*parser
 	}, nil
 }
 
+func (p PostgresParser) parseExclusion(constraint *pgquery.Constraint) (*parser.ExclusionDefinition, error) {
+	var exclusions []parser.ExclusionPair
+	for _, exclusion := range constraint.Exclusions {
+		// Each element is a two-item list of (IndexElem, operator name list)
+		items := exclusion.Node.(*pgquery.Node_List).List.Items
+		if len(items) != 2 {
+			return nil, fmt.Errorf("unexpected exclusion element in parseExclusion: %#v", exclusion)
+		}
+		elem, ok := items[0].Node.(*pgquery.Node_IndexElem)
+		if !ok || elem.IndexElem.Name == "" {
+			return nil, fmt.Errorf("unhandled exclusion element in parseExclusion: %#v", items[0])
+		}
+		var opClass string
+		for _, node := range elem.IndexElem.Opclass {
+			opClass = node.Node.(*pgquery.Node_String_).String_.Sval
+		}
+		var operator string
+		for _, node := range items[1].Node.(*pgquery.Node_List).List.Items {
+			operator = node.Node.(*pgquery.Node_String_).String_.Sval
+		}
+		exclusions = append(exclusions, parser.ExclusionPair{
+			Column:   parser.NewColIdent(elem.IndexElem.Name),
+			OpClass:  opClass,
+			Operator: operator,
+		})
+	}
+
+	var where *parser.Where
+	if constraint.WhereClause != nil {
+		whereExpr, err := p.parseExpr(constraint.WhereClause)
+		if err != nil {
+			return nil, err
+		}
+		where = &parser.Where{
+			Type: "where",
+			Expr: whereExpr,
+		}
+	}
+
+	return &parser.ExclusionDefinition{
+		ConstraintName: parser.NewColIdent(constraint.Conname),
+		IndexType:      constraint.AccessMethod,
+		Exclusions:     exclusions,
+		Where:          where,
+		ConstraintOptions: &parser.ConstraintOptions{
+			Deferrable:        constraint.Deferrable,
+			InitiallyDeferred: constraint.Initdeferred,
+		},
+	}, nil
+}
+
 func (p PostgresParser) parseFkAction(action string) parser.ColIdent {
 	// https://github.com/pganalyze/pg_query_go/blob/v2.2.0/parser/include/nodes/parsenodes.h#L2145-L2149C23
 	switch action {
@@ -917,8 +1320,9 @@ func (p PostgresParser) parseColumnDef(columnDef *pgquery.ColumnDef, tableName p
 			}
 			columnType.Check = check
 			if constraint.Conname == "" {
-				name, truncated := p.absentConstraintName(tableName.Name.String(), columnDef.Colname, "check")
-				if truncated {
+				// A long name is truncated by the server, so it's named here to be compared with the current one.
+				name := schemaLib.PostgresConstraintName(tableName.Name.String(), []string{columnDef.Colname}, "check")
+				if name != fmt.Sprintf("%s_%s_check", tableName.Name.String(), columnDef.Colname) {
 					check.ConstraintName = parser.NewColIdent(name)
 				}
 			}
@@ -931,11 +1335,8 @@ func (p PostgresParser) parseColumnDef(columnDef *pgquery.ColumnDef, tableName p
 			if err != nil {
 				return nil, nil, err
 			}
+			// An unnamed foreign key is named as the server does by schema.ParseDDLs
 			foreignKey.IndexColumns = []parser.ColIdent{parser.NewColIdent(columnDef.Colname)}
-			if constraint.Conname == "" {
-				name, _ := p.absentConstraintName(tableName.Name.String(), columnDef.Colname, "fkey")
-				foreignKey.ConstraintName = parser.NewColIdent(name)
-			}
 		case pgquery.ConstrType_CONSTR_ATTR_DEFERRABLE:
 			foreignKey.ConstraintOptions.Deferrable = true
 		case pgquery.ConstrType_CONSTR_ATTR_NOT_DEFERRABLE:
@@ -965,26 +1366,6 @@ func (p PostgresParser) parseColumnDef(columnDef *pgquery.ColumnDef, tableName p
 	}, foreignKey, nil
 }
 
-func (p PostgresParser) absentConstraintName(tableName, columnName, suffix string) (string, bool) {
-	if name := fmt.Sprintf("%s_%s_%s", tableName, columnName, suffix); len(name) <= 63 {
-		return name, false
-	}
-
-	var tableThreshold, columnThreshold = 33 - len(suffix), 28
-	var maxSum = tableThreshold + columnThreshold
-
-	if len(tableName) <= tableThreshold {
-		columnName = columnName[:maxSum-len(tableName)]
-	} else if len(columnName) <= columnThreshold {
-		tableName = tableName[:maxSum-len(columnName)]
-	} else {
-		tableName = tableName[:tableThreshold]
-		columnName = columnName[:columnThreshold]
-	}
-
-	return fmt.Sprintf("%s_%s_%s", tableName, columnName, suffix), true
-}
-
 func (p PostgresParser) parseDefaultValue(rawExpr *pgquery.Node) (*parser.DefaultDefinition, error) {
 	node, err := p.parseExpr(rawExpr)
 	if err != nil {
@@ -1167,6 +1548,22 @@ func (p PostgresParser) parseTypmods(typmods []*pgquery.Node) ([]*parser.SQLVal,
 	return values, nil
 }
 
+// Name of a type in COMMENT ON, e.g. "int4" for integer and "public.status[]". The parser of PostgreSQL resolves
+// the aliases of built-in types, e.g. integer into pg_catalog.int4, so that the same type gets the same name.
+func (p PostgresParser) parseObjectTypeName(typeName *pgquery.TypeName) string {
+	var names []string
+	for _, name := range typeName.Names {
+		if n, ok := name.Node.(*pgquery.Node_String_); ok && !(len(names) == 0 && n.String_.Sval == "pg_catalog") {
+			names = append(names, n.String_.Sval)
+		}
+	}
+	name := strings.Join(names, ".")
+	for range typeName.ArrayBounds {
+		name += "[]"
+	}
+	return name
+}
+
 func (p PostgresParser) parseStringList(list *pgquery.List) (string, error) {
 	var objects []string
 	for _, node := range list.Items {
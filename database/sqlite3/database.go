@@ -63,8 +63,10 @@ func (d *Sqlite3Database) DumpDDLs() (string, error) {
 }
 
 func (d *Sqlite3Database) tableNames() ([]string, error) {
+	// The shadow tables of virtual tables, e.g. posts_fts_data of FTS5, are managed by the virtual tables.
 	rows, err := d.db.Query(
-		`select tbl_name from sqlite_master where type = 'table' and tbl_name not like 'sqlite_%'`,
+		`select tbl_name from sqlite_master where type = 'table' and tbl_name not like 'sqlite_%'
+		and tbl_name not in (select name from pragma_table_list where type = 'shadow')`,
 	)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,219 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// SSHTunnel is ssh_tunnel of --config to connect to a database behind a bastion host, like ssh -L does:
+//
+//	ssh_tunnel:
+//	  host: bastion.example.com:22
+//	  user: ubuntu
+//	  key: ~/.ssh/id_ed25519
+//	  destination: db.internal:5432
+//
+// The connections to the database are dialed from the SSH server, so the host of the database and destination are
+// resolved there. It authenticates with the key, or with $SSH_AUTH_SOCK when the key isn't given, and verifies the host
+// key of the SSH server with known_hosts, which is ~/.ssh/known_hosts by default.
+type SSHTunnel struct {
+	Host        string `yaml:"host"`
+	User        string `yaml:"user"`
+	Key         string `yaml:"key"`
+	KnownHosts  string `yaml:"known_hosts"`
+	Destination string `yaml:"destination"` // address to connect to instead of the host and port of the database
+}
+
+const sshConnectTimeout = 30 * time.Second
+
+// SSHDialer dials the connections of a database driver through the SSH server of an SSHTunnel. It connects to the SSH
+// server on the first dial, and the later connections share the SSH connection until it's closed.
+type SSHDialer struct {
+	tunnel SSHTunnel
+	mutex  sync.Mutex
+	client *ssh.Client
+}
+
+func NewSSHDialer(tunnel SSHTunnel) *SSHDialer {
+	return &SSHDialer{tunnel: tunnel}
+}
+
+func (d *SSHDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	client, err := d.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if d.tunnel.Destination != "" {
+		address = d.tunnel.Destination
+	}
+	conn, err := client.DialContext(ctx, network, address)
+	if err != nil && isClosedConnection(err) {
+		// The SSH connection was closed, e.g. by the server or the network while idle, so it's connected again.
+		d.disconnect(client)
+		if client, err = d.connect(ctx); err != nil {
+			return nil, err
+		}
+		conn, err = client.DialContext(ctx, network, address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s through the SSH server %s: %w", address, d.address(), err)
+	}
+	return conn, nil
+}
+
+func isClosedConnection(err error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, net.ErrClosed)
+}
+
+func (d *SSHDialer) Dial(network, address string) (net.Conn, error) {
+	return d.DialContext(context.Background(), network, address)
+}
+
+func (d *SSHDialer) DialTimeout(network, address string, timeout time.Duration) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return d.DialContext(ctx, network, address)
+}
+
+// Close the SSH connection. It's a no-op for a nil dialer, i.e. a database without ssh_tunnel.
+func (d *SSHDialer) Close() error {
+	if d == nil {
+		return nil
+	}
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.client == nil {
+		return nil
+	}
+	err := d.client.Close()
+	d.client = nil
+	return err
+}
+
+// Forget the closed SSH connection, unless another dial has already connected again.
+func (d *SSHDialer) disconnect(client *ssh.Client) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.client == client {
+		d.client.Close()
+		d.client = nil
+	}
+}
+
+func (d *SSHDialer) connect(ctx context.Context) (*ssh.Client, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.client != nil {
+		return d.client, nil
+	}
+
+	config, agentConn, err := d.clientConfig()
+	if err != nil {
+		return nil, err
+	}
+	if agentConn != nil {
+		// ssh-agent is only used to sign in the handshake.
+		defer agentConn.Close()
+	}
+	ctx, cancel := context.WithTimeout(ctx, sshConnectTimeout)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", d.address())
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the SSH server %s: %w", d.address(), err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	clientConn, channels, requests, err := ssh.NewClientConn(conn, d.address(), config)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to log in to the SSH server %s: %w", d.address(), err)
+	}
+	conn.SetDeadline(time.Time{})
+	d.client = ssh.NewClient(clientConn, channels, requests)
+	return d.client, nil
+}
+
+// The address of the SSH server, which is port 22 of the host unless the host has its port
+func (d *SSHDialer) address() string {
+	if _, _, err := net.SplitHostPort(d.tunnel.Host); err == nil {
+		return d.tunnel.Host
+	}
+	return net.JoinHostPort(d.tunnel.Host, "22")
+}
+
+// Return the config to log in to the SSH server, and the connection to ssh-agent used by it if any, which the caller
+// closes after logging in.
+func (d *SSHDialer) clientConfig() (*ssh.ClientConfig, net.Conn, error) {
+	user := d.tunnel.User
+	if user == "" {
+		user = os.Getenv("USER")
+	}
+
+	var auth ssh.AuthMethod
+	var agentConn net.Conn
+	if d.tunnel.Key != "" {
+		pem, err := os.ReadFile(expandHome(d.tunnel.Key))
+		if err != nil {
+			return nil, nil, err
+		}
+		signer, err := ssh.ParsePrivateKey(pem)
+		var passphraseMissing *ssh.PassphraseMissingError
+		if errors.As(err, &passphraseMissing) {
+			return nil, nil, fmt.Errorf("the key of ssh_tunnel '%s' is encrypted, so add it to ssh-agent and omit the key instead", d.tunnel.Key)
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse the key of ssh_tunnel '%s': %w", d.tunnel.Key, err)
+		}
+		auth = ssh.PublicKeys(signer)
+	} else if socket := os.Getenv("SSH_AUTH_SOCK"); socket != "" {
+		var err error
+		if agentConn, err = net.Dial("unix", socket); err != nil {
+			return nil, nil, fmt.Errorf("failed to connect to ssh-agent: %w", err)
+		}
+		auth = ssh.PublicKeysCallback(agent.NewClient(agentConn).Signers)
+	} else {
+		return nil, nil, fmt.Errorf("ssh_tunnel needs its key or $SSH_AUTH_SOCK to log in to the SSH server")
+	}
+
+	knownHosts := d.tunnel.KnownHosts
+	if knownHosts == "" {
+		knownHosts = "~/.ssh/known_hosts"
+	}
+	hostKeyCallback, err := knownhosts.New(expandHome(knownHosts))
+	if err != nil {
+		if agentConn != nil {
+			agentConn.Close()
+		}
+		return nil, nil, fmt.Errorf("failed to read the known hosts of ssh_tunnel: %w", err)
+	}
+
+	return &ssh.ClientConfig{
+		User:            user,
+		Auth:            []ssh.AuthMethod{auth},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         sshConnectTimeout,
+	}, agentConn, nil
+}
+
+func expandHome(path string) string {
+	if !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[2:])
+}
@@ -0,0 +1,183 @@
+package database
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+func TestSSHDialer(t *testing.T) {
+	// The "database", which echoes what it receives
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer echo.Close()
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	dir := t.TempDir()
+	_, clientKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(clientKey, "")
+	assert.NoError(t, err)
+	keyFile := filepath.Join(dir, "id_ed25519")
+	assert.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(block), 0600))
+	clientSigner, err := ssh.NewSignerFromKey(clientKey)
+	assert.NoError(t, err)
+
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	assert.NoError(t, err)
+	server, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer server.Close()
+	knownHostsFile := filepath.Join(dir, "known_hosts")
+	knownHostsLine := knownhosts.Line([]string{server.Addr().String()}, hostSigner.PublicKey())
+	assert.NoError(t, os.WriteFile(knownHostsFile, []byte(knownHostsLine+"\n"), 0600))
+	go serveSSHTunnel(server, hostSigner, clientSigner.PublicKey())
+
+	dialer := NewSSHDialer(SSHTunnel{
+		Host:        server.Addr().String(),
+		User:        "sqldef",
+		Key:         keyFile,
+		KnownHosts:  knownHostsFile,
+		Destination: echo.Addr().String(),
+	})
+	defer dialer.Close()
+	for i := 0; i < 2; i++ {
+		assertEchoThroughTunnel(t, dialer)
+	}
+
+	// A closed SSH connection is connected again
+	dialer.client.Close()
+	assertEchoThroughTunnel(t, dialer)
+
+	// Without the key, ssh-agent signs in the handshake, and the connection to it is closed after that
+	keyring := agent.NewKeyring()
+	assert.NoError(t, keyring.Add(agent.AddedKey{PrivateKey: clientKey}))
+	agentSocket, err := net.Listen("unix", filepath.Join(dir, "agent.sock"))
+	assert.NoError(t, err)
+	defer agentSocket.Close()
+	agentClosed := make(chan struct{})
+	go func() {
+		conn, err := agentSocket.Accept()
+		if err != nil {
+			return
+		}
+		agent.ServeAgent(keyring, conn)
+		close(agentClosed)
+	}()
+	t.Setenv("SSH_AUTH_SOCK", agentSocket.Addr().String())
+	agentDialer := NewSSHDialer(SSHTunnel{
+		Host:        server.Addr().String(),
+		User:        "sqldef",
+		KnownHosts:  knownHostsFile,
+		Destination: echo.Addr().String(),
+	})
+	defer agentDialer.Close()
+	assertEchoThroughTunnel(t, agentDialer)
+	select {
+	case <-agentClosed:
+	case <-time.After(5 * time.Second):
+		t.Error("the connection to ssh-agent is left open after the handshake")
+	}
+
+	// An SSH server whose host key isn't known is rejected
+	assert.NoError(t, os.WriteFile(knownHostsFile, nil, 0600))
+	dialer = NewSSHDialer(SSHTunnel{Host: server.Addr().String(), User: "sqldef", Key: keyFile, KnownHosts: knownHostsFile})
+	_, err = dialer.Dial("tcp", echo.Addr().String())
+	assert.ErrorContains(t, err, "key is unknown")
+}
+
+func assertEchoThroughTunnel(t *testing.T, dialer *SSHDialer) {
+	conn, err := dialer.Dial("tcp", "db.internal:3306")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte("SELECT 1"))
+	assert.NoError(t, err)
+	buf := make([]byte, 8)
+	_, err = io.ReadFull(conn, buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELECT 1", string(buf))
+}
+
+// Serve the SSH connections which only forward direct-tcpip channels like ssh -L, for the client key
+func serveSSHTunnel(listener net.Listener, hostSigner ssh.Signer, clientKey ssh.PublicKey) {
+	config := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if string(key.Marshal()) != string(clientKey.Marshal()) {
+				return nil, io.EOF
+			}
+			return nil, nil
+		},
+	}
+	config.AddHostKey(hostSigner)
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			_, channels, requests, err := ssh.NewServerConn(conn, config)
+			if err != nil {
+				return
+			}
+			go ssh.DiscardRequests(requests)
+			for newChannel := range channels {
+				var payload struct {
+					Host       string
+					Port       uint32
+					OriginHost string
+					OriginPort uint32
+				}
+				if newChannel.ChannelType() != "direct-tcpip" || ssh.Unmarshal(newChannel.ExtraData(), &payload) != nil {
+					newChannel.Reject(ssh.UnknownChannelType, "unsupported")
+					continue
+				}
+				target, err := net.Dial("tcp", net.JoinHostPort(payload.Host, fmt.Sprint(payload.Port)))
+				if err != nil {
+					newChannel.Reject(ssh.ConnectionFailed, err.Error())
+					continue
+				}
+				channel, channelRequests, err := newChannel.Accept()
+				if err != nil {
+					target.Close()
+					continue
+				}
+				go ssh.DiscardRequests(channelRequests)
+				go func() {
+					defer channel.Close()
+					io.Copy(channel, target)
+				}()
+				go func() {
+					defer target.Close()
+					io.Copy(target, channel)
+				}()
+			}
+		}()
+	}
+}
@@ -0,0 +1,96 @@
+package database
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Timeouts of a DDL category, specified by timeouts of --config. Values are passed to PostgreSQL as is, e.g. "5m".
+type DDLTimeout struct {
+	StatementTimeout string `yaml:"statement_timeout"`
+	LockTimeout      string `yaml:"lock_timeout"`
+}
+
+type ddlCategory struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// Categories of DDLs that can be listed in timeouts of --config. The first matching category wins,
+// and "default" applies to DDLs whose category isn't configured.
+var ddlCategories = []ddlCategory{
+	{name: "index_build", pattern: regexp.MustCompile(`(?is)^CREATE (UNIQUE )?INDEX `)},
+	{name: "create_table", pattern: regexp.MustCompile(`(?is)^CREATE TABLE `)},
+	{name: "alter_table", pattern: regexp.MustCompile(`(?is)^ALTER TABLE `)},
+	{name: "drop", pattern: regexp.MustCompile(`(?is)^DROP `)},
+}
+
+const defaultDDLCategory = "default"
+
+func isValidDDLCategory(name string) bool {
+	if name == defaultDDLCategory {
+		return true
+	}
+	for _, category := range ddlCategories {
+		if category.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func ddlCategoryNames() []string {
+	var names []string
+	for _, category := range ddlCategories {
+		names = append(names, category.name)
+	}
+	return append(names, defaultDDLCategory)
+}
+
+func ddlTimeout(ddl string, timeouts map[string]DDLTimeout) DDLTimeout {
+	for _, category := range ddlCategories {
+		if category.pattern.MatchString(strings.TrimSpace(ddl)) {
+			if timeout, ok := timeouts[category.name]; ok {
+				return timeout
+			}
+			break
+		}
+	}
+	return timeouts[defaultDDLCategory]
+}
+
+// InsertTimeouts inserts SET LOCAL statement_timeout / lock_timeout before each DDL that runs in the transaction of
+// RunDDLs, so that fast DDLs fail fast while long index builds are permitted. A timeout that isn't configured for
+// the DDL's category is reset to the server default since SET LOCAL lasts until the end of the transaction.
+func InsertTimeouts(ddls []string, timeouts map[string]DDLTimeout, enableDropTable bool) []string {
+	if len(timeouts) == 0 {
+		return ddls
+	}
+
+	var result []string
+	var current DDLTimeout
+	for _, ddl := range ddls {
+		if !TransactionSupported(ddl) || (!enableDropTable && strings.Contains(ddl, "DROP TABLE")) {
+			result = append(result, ddl)
+			continue
+		}
+		timeout := ddlTimeout(ddl, timeouts)
+		if timeout.StatementTimeout != current.StatementTimeout {
+			result = append(result, setLocal("statement_timeout", timeout.StatementTimeout))
+		}
+		if timeout.LockTimeout != current.LockTimeout {
+			result = append(result, setLocal("lock_timeout", timeout.LockTimeout))
+		}
+		current = timeout
+		result = append(result, ddl)
+	}
+	return result
+}
+
+func setLocal(name string, value string) string {
+	if value == "" {
+		return fmt.Sprintf("SET LOCAL %s TO DEFAULT", name)
+	}
+	return fmt.Sprintf("SET LOCAL %s = '%s'", name, strings.ReplaceAll(value, "'", "''"))
+}
@@ -0,0 +1,49 @@
+package sqldef
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/sqldef/sqldef/database"
+)
+
+// Return the statements to disable and enable the DDL triggers of a SQL Server database for disable_ddl_triggers of
+// --config, which are run before and after the DDLs so that the triggers don't reject or rewrite them. Only the enabled
+// triggers are listed, so that the triggers disabled on purpose stay disabled after the apply. Both are empty when no
+// DDL trigger is enabled.
+func ddlTriggerStatements(db database.Database) (string, string, error) {
+	rows, err := db.DB().Query("SELECT name FROM sys.triggers WHERE parent_class = 0 AND is_disabled = 0 ORDER BY name")
+	if err != nil {
+		return "", "", err
+	}
+	defer rows.Close()
+
+	var triggers []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return "", "", err
+		}
+		triggers = append(triggers, "["+strings.ReplaceAll(name, "]", "]]")+"]")
+	}
+	if err := rows.Err(); err != nil {
+		return "", "", err
+	}
+	if len(triggers) == 0 {
+		return "", "", nil
+	}
+	list := strings.Join(triggers, ", ")
+	return fmt.Sprintf("DISABLE TRIGGER %s ON DATABASE", list), fmt.Sprintf("ENABLE TRIGGER %s ON DATABASE", list), nil
+}
+
+// Enable the DDL triggers again after a failed apply, in case a batch committed before the failure disabled them.
+// Enabling the triggers which the rollback has enabled again does nothing.
+func restoreDDLTriggers(db database.Database, enableDDLTriggers string) {
+	if len(enableDDLTriggers) == 0 {
+		return
+	}
+	if _, err := db.DB().Exec(enableDDLTriggers); err != nil {
+		fmt.Fprintf(os.Stderr, "-- Failed to enable the DDL triggers again: %s --\n", err)
+	}
+}
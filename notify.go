@@ -0,0 +1,91 @@
+package sqldef
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sqldef/sqldef/database"
+)
+
+// Classes of DDLs reported as destructive changes in notifications
+var destructiveDDLClasses = []string{
+	"drop_table", "drop_column", "drop_index", "drop_constraint", "drop_view", "drop_trigger", "drop_policy",
+}
+
+var notifyClient = &http.Client{Timeout: 10 * time.Second}
+
+// Notification is the JSON summary of a run posted to notify_webhook of --config.
+type Notification struct {
+	Database        string   `json:"database"`
+	DryRun          bool     `json:"dry_run"`
+	DDLs            int      `json:"ddls"`
+	Destructive     []string `json:"destructive"`
+	DurationSeconds float64  `json:"duration_seconds"`
+	Success         bool     `json:"success"`
+	Error           string   `json:"error,omitempty"` // why the run failed, unless it succeeded
+	Text            string   `json:"text"`            // shown by Slack incoming webhooks
+}
+
+// Summarize a run planning the DDLs. A non-empty failure tells why the run failed.
+func newNotification(databaseName string, ddls []string, enableDropTable bool, dryRun bool, duration time.Duration, failure string) Notification {
+	count := 0
+	for _, ddl := range ddls {
+		if enableDropTable || !strings.Contains(ddl, "DROP TABLE") {
+			count++
+		}
+	}
+	destructive := []string{}
+	for _, ddl := range database.FindForbiddenDDLs(ddls, destructiveDDLClasses, enableDropTable) {
+		destructive = append(destructive, ddl.DDL)
+	}
+
+	action := "Applied"
+	if dryRun {
+		action = "Planned"
+	}
+	if len(failure) > 0 {
+		action = "Failed to apply"
+		if dryRun {
+			action = "Failed to plan"
+		}
+	}
+	text := fmt.Sprintf("sqldef: %s %d DDLs to %s in %s", action, count, databaseName, duration.Round(time.Millisecond))
+	if len(destructive) > 0 {
+		text += fmt.Sprintf(" (%d destructive)", len(destructive))
+	}
+	if len(failure) > 0 {
+		text += ": " + failure
+	}
+
+	return Notification{
+		Database:        databaseName,
+		DryRun:          dryRun,
+		DDLs:            count,
+		Destructive:     destructive,
+		DurationSeconds: duration.Seconds(),
+		Success:         len(failure) == 0,
+		Error:           failure,
+		Text:            text,
+	}
+}
+
+// Post the notification to the webhook. A failure is returned but shouldn't fail the run, which has already finished.
+func postNotification(url string, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+	resp, err := notifyClient.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("the webhook responded %s", resp.Status)
+	}
+	return nil
+}
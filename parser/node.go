@@ -410,6 +410,7 @@ type DDL struct {
 	IndexCols     []IndexColumn
 	IndexExpr     Expr
 	ForeignKey    *ForeignKeyDefinition
+	Exclusion     *ExclusionDefinition
 	Policy        *Policy
 	View          *View
 	Trigger       *Trigger
@@ -417,6 +418,14 @@ type DDL struct {
 	Comment       *Comment
 	Extension     *Extension
 	Schema        *Schema
+	Owner         *Owner
+	Publication   *Publication
+	Event         *Event
+	Role          *Role
+	Grant         *Grant
+	Drop          *Drop
+	Like          *TableName      // for MySQL, CREATE TABLE ... LIKE other
+	Select        SelectStatement // for MySQL, CREATE TABLE ... SELECT
 }
 
 type DDLAction int
@@ -435,6 +444,17 @@ const (
 	CreateType
 	CreateView
 	CreateSchema
+	AlterOwner
+	ClusterOn
+	AddExclusion
+	CreatePublication
+	CreateEvent
+	CreateRole
+	GrantOn
+	DropTable
+	DropView
+	DropIndex
+	AddDomainConstraint
 )
 
 // View types
@@ -448,7 +468,9 @@ const (
 func (node *DDL) Format(buf *nodeBuffer) {
 	switch node.Action {
 	case CreateTable:
-		if node.TableSpec == nil {
+		if node.Like != nil {
+			buf.Printf("create table %v like %v", node.NewName, *node.Like)
+		} else if node.TableSpec == nil {
 			buf.Printf("create table %v", node.NewName)
 		} else {
 			buf.Printf("create table %v %v", node.NewName, node.TableSpec)
@@ -515,6 +537,9 @@ type TableSpec struct {
 	ForeignKeys []*ForeignKeyDefinition
 	Checks      []*CheckDefinition
 	Options     map[string]string
+	Unlogged    bool        // for Postgres, CREATE UNLOGGED TABLE
+	Inherits    []TableName // for Postgres, INHERITS (parent, ...)
+	Virtual     bool        // for SQLite3, CREATE VIRTUAL TABLE
 }
 
 // Format formats the node.
@@ -533,9 +558,23 @@ func (ts *TableSpec) Format(buf *nodeBuffer) {
 
 	options := ""
 	for key, value := range ts.Options {
-		options += " " + key + "=" + value
+		if value == "" { // SQLite3 table options, e.g. STRICT
+			options += " " + key
+		} else {
+			options += " " + key + "=" + value
+		}
 	}
 	buf.Printf("\n)%s", strings.Replace(options, ", ", ",\n  ", -1))
+	if len(ts.Inherits) > 0 {
+		buf.Printf(" INHERITS (")
+		for i, parent := range ts.Inherits {
+			if i > 0 {
+				buf.Printf(", ")
+			}
+			buf.Printf("%v", parent)
+		}
+		buf.Printf(")")
+	}
 }
 
 // addColumn appends the given column to the list in the spec
@@ -639,8 +678,14 @@ type ColumnType struct {
 	// MySQL: GENERATED ALWAYS AS (expr)
 	Generated *GeneratedColumn
 
+	// MySQL: INVISIBLE
+	Invisible bool
+
 	// PostgreSQL: GENERATED AS IDENTITY
 	Identity *IdentityOpt
+
+	// SQL Server: PRIMARY KEY NONCLUSTERED
+	NonClustered BoolVal
 }
 
 type DefaultDefinition struct {
@@ -662,6 +707,8 @@ type CheckDefinition struct {
 	ConstraintName    ColIdent
 	NotForReplication bool
 	NoInherit         BoolVal
+	NotValid          bool // for Postgres, not validated for the existing rows
+	NotEnforced       bool // for MySQL, NOT ENFORCED
 }
 
 // Format returns a canonical string representation of the type and all relevant options
@@ -714,6 +761,9 @@ func (ct *ColumnType) Format(buf *nodeBuffer) {
 	if ct.Comment != nil {
 		buf.Printf(" %s %s", keywordStrings[COMMENT_KEYWORD], String(ct.Comment))
 	}
+	if ct.Invisible {
+		buf.Printf(" invisible")
+	}
 	if ct.Check != nil {
 		buf.Printf(" %s %s", keywordStrings[CHECK], String(&ct.Check.Where))
 	}
@@ -738,6 +788,8 @@ func (ct *ColumnType) Format(buf *nodeBuffer) {
 type IndexDefinition struct {
 	Info              *IndexInfo
 	Columns           []IndexColumn
+	Included          []ColIdent // for Postgres
+	NullsNotDistinct  bool       // for Postgres
 	Options           []*IndexOption
 	Partition         *IndexPartition
 	ConstraintOptions *ConstraintOptions
@@ -748,7 +800,10 @@ func (idx *IndexDefinition) Format(buf *nodeBuffer) {
 	buf.Printf("%v (", idx.Info)
 	for i, col := range idx.Columns {
 		if i != 0 {
-			buf.Printf(", %v", col.Column)
+			buf.Printf(", ")
+		}
+		if col.Expression != nil {
+			buf.Printf("(%v)", col.Expression)
 		} else {
 			buf.Printf("%v", col.Column)
 		}
@@ -770,13 +825,14 @@ func (idx *IndexDefinition) Format(buf *nodeBuffer) {
 
 // IndexInfo describes the name and type of an index in a CREATE TABLE statement
 type IndexInfo struct {
-	Type      string
-	Name      ColIdent
-	Primary   bool
-	Spatial   bool
-	Unique    bool
-	Fulltext  bool
-	Clustered BoolVal
+	Type        string
+	Name        ColIdent
+	Primary     bool
+	Spatial     bool
+	Unique      bool
+	Fulltext    bool
+	Clustered   BoolVal
+	ColumnStore bool // for MSSQL
 }
 
 // Format formats the node.
@@ -796,6 +852,7 @@ type IndexColumnsOrExpression struct {
 // IndexColumn describes a column in an index definition with optional length
 type IndexColumn struct {
 	Column        ColIdent
+	Expression    Expr // for a functional key part of MySQL, instead of Column
 	Length        *SQLVal
 	Direction     string
 	OperatorClass string
@@ -841,6 +898,7 @@ type IndexSpec struct {
 	Clustered         bool // for MSSQL
 	ColumnStore       bool // for MSSQL
 	Included          []ColIdent
+	NullsNotDistinct  bool // for Postgres
 	Where             *Where
 	Options           []*IndexOption
 	Partition         *IndexPartition // for MSSQL
@@ -864,6 +922,20 @@ type ForeignKeyDefinition struct {
 	ConstraintOptions *ConstraintOptions
 }
 
+type ExclusionDefinition struct {
+	ConstraintName    ColIdent
+	IndexType         string
+	Exclusions        []ExclusionPair
+	Where             *Where
+	ConstraintOptions *ConstraintOptions
+}
+
+type ExclusionPair struct {
+	Column   ColIdent
+	OpClass  string
+	Operator string
+}
+
 type Policy struct {
 	Name       ColIdent
 	Permissive Permissive
@@ -881,6 +953,49 @@ type Schema struct {
 	Name string
 }
 
+type Publication struct {
+	Name      ColIdent
+	Tables    TableNames
+	AllTables bool
+}
+
+// Role is a PostgreSQL role. The attributes which are not specified have the defaults of CREATE ROLE, and
+// PASSWORD and VALID UNTIL are not kept because they are never managed.
+type Role struct {
+	Name            string
+	Login           bool
+	Superuser       bool
+	CreateDB        bool
+	CreateRole      bool
+	Inherit         bool
+	Replication     bool
+	BypassRLS       bool
+	ConnectionLimit int
+	InRoles         []string // IN ROLE, i.e. the roles which the role is a member of
+}
+
+// Grant is a PostgreSQL GRANT of privileges on tables. Privileges are upper-cased, and ALL is expanded to them.
+type Grant struct {
+	Privileges  []string
+	Tables      TableNames
+	Grantees    []string // role names, or PUBLIC
+	GrantOption bool     // WITH GRANT OPTION
+}
+
+// Drop is the objects of DROP TABLE, VIEW, or INDEX. For DROP INDEX ... ON of MySQL and SQL Server, DDL.Table is the
+// table of the index.
+type Drop struct {
+	Names TableNames
+}
+
+// Event is a MySQL event. Clauses keeps the tokens between ON SCHEDULE and DO, e.g. EVERY 1 DAY STARTS '...' ENABLE.
+type Event struct {
+	Name    ColIdent
+	Definer string // `user`@`host`
+	Clauses []string
+	Body    Statement
+}
+
 type Permissive string
 
 // Show represents a show statement.
@@ -1031,6 +1146,7 @@ func (node Comments) Format(buf *nodeBuffer) {
 type View struct {
 	Type         string
 	SecurityType string
+	Definer      string // `user`@`host` of MySQL
 	Name         TableName
 	Definition   SelectStatement
 }
@@ -1040,12 +1156,35 @@ type Trigger struct {
 	TableName TableName
 	Time      string
 	Event     []string
+	Definer   string // `user`@`host` of MySQL
+	Level     string // ROW, STATEMENT, or empty if omitted
+	When      Expr
+	Function  Expr // EXECUTE FUNCTION of PostgreSQL, used instead of Body
 	Body      []Statement
 }
 
 type Type struct {
-	Name TableName // workaround: using TableName to handle schema
-	Type ColumnType
+	Name       TableName // workaround: using TableName to handle schema
+	Type       ColumnType
+	Attributes []*ColumnDefinition // for composite types
+	TableSpec  *TableSpec          // for table types of SQL Server
+	Domain     bool                // for domains of Postgres, whose Type is the base type with DEFAULT and NOT NULL
+	Checks     []*CheckDefinition  // for domains of Postgres
+}
+
+// Build the Type of CREATE DOMAIN, whose CHECK is moved to Checks since a domain may have more than one.
+func newDomain(name TableName, baseType ColumnType) *Type {
+	var checks []*CheckDefinition
+	if baseType.Check != nil {
+		checks = append(checks, baseType.Check)
+		baseType.Check = nil
+	}
+	return &Type{
+		Name:   name,
+		Type:   baseType,
+		Domain: true,
+		Checks: checks,
+	}
 }
 
 type Comment struct {
@@ -1054,6 +1193,22 @@ type Comment struct {
 	Comment    string
 }
 
+// joinNameParts joins the non-empty parts of a qualified name with dots, e.g. "dbo.users.id".
+func joinNameParts(parts ...string) string {
+	var names []string
+	for _, part := range parts {
+		if part != "" {
+			names = append(names, part)
+		}
+	}
+	return strings.Join(names, ".")
+}
+
+type Owner struct {
+	ObjectType string
+	Role       string
+}
+
 // SelectExprs represents SELECT expressions.
 type SelectExprs []SelectExpr
 
@@ -1,12 +1,11 @@
 // Code generated by goyacc -o parser/parser.go parser/parser.y. DO NOT EDIT.
 
-//line parser/parser.y:17
-
+//line parser/parser.y:18
 package parser
 
 import __yyfmt__ "fmt"
 
-//line parser/parser.y:19
+//line parser/parser.y:18
 
 import (
 	"fmt"
@@ -108,6 +107,8 @@ type yySymType struct {
 	indexColumns             []IndexColumn
 	indexColumnsOrExpression IndexColumnsOrExpression
 	foreignKeyDefinition     *ForeignKeyDefinition
+	exclusionPair            ExclusionPair
+	exclusionPairs           []ExclusionPair
 	partDefs                 []*PartitionDefinition
 	partDef                  *PartitionDefinition
 	partSpec                 *PartitionSpec
@@ -455,32 +456,34 @@ const EXPANSION = 57672
 const UNUSED = 57673
 const VIRTUAL = 57674
 const STORED = 57675
-const GENERATED = 57676
-const ALWAYS = 57677
-const IDENTITY = 57678
-const SEQUENCE = 57679
-const INCREMENT = 57680
-const MINVALUE = 57681
-const CACHE = 57682
-const CYCLE = 57683
-const OWNED = 57684
-const NONE = 57685
-const CLUSTERED = 57686
-const NONCLUSTERED = 57687
-const REPLICATION = 57688
-const COLUMNSTORE = 57689
-const INCLUDE = 57690
-const HOLDLOCK = 57691
-const NOLOCK = 57692
-const NOWAIT = 57693
-const PAGLOCK = 57694
-const ROWLOCK = 57695
-const TABLELOCK = 57696
-const DEFINER = 57697
-const INVOKER = 57698
-const TYPECAST = 57699
-const CHECK = 57700
-const OVER = 57701
+const PERSISTENT = 57676
+const GENERATED = 57677
+const ALWAYS = 57678
+const IDENTITY = 57679
+const SEQUENCE = 57680
+const INCREMENT = 57681
+const MINVALUE = 57682
+const CACHE = 57683
+const CYCLE = 57684
+const OWNED = 57685
+const NONE = 57686
+const CLUSTERED = 57687
+const NONCLUSTERED = 57688
+const REPLICATION = 57689
+const COLUMNSTORE = 57690
+const INCLUDE = 57691
+const NULLS = 57692
+const HOLDLOCK = 57693
+const NOLOCK = 57694
+const NOWAIT = 57695
+const PAGLOCK = 57696
+const ROWLOCK = 57697
+const TABLELOCK = 57698
+const DEFINER = 57699
+const INVOKER = 57700
+const TYPECAST = 57701
+const CHECK = 57702
+const OVER = 57703
 
 var yyToknames = [...]string{
 	"$end",
@@ -833,6 +836,7 @@ var yyToknames = [...]string{
 	"UNUSED",
 	"VIRTUAL",
 	"STORED",
+	"PERSISTENT",
 	"GENERATED",
 	"ALWAYS",
 	"IDENTITY",
@@ -848,6 +852,7 @@ var yyToknames = [...]string{
 	"REPLICATION",
 	"COLUMNSTORE",
 	"INCLUDE",
+	"NULLS",
 	"HOLDLOCK",
 	"NOLOCK",
 	"NOWAIT",
@@ -875,955 +880,1300 @@ var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 6,
-	130, 396,
-	-2, 145,
-	-1, 397,
-	59, 366,
-	-2, 363,
-	-1, 425,
-	119, 785,
-	-2, 236,
-	-1, 445,
-	119, 784,
-	-2, 780,
-	-1, 540,
-	119, 785,
-	-2, 236,
-	-1, 562,
-	266, 794,
-	-2, 693,
-	-1, 610,
-	266, 794,
-	-2, 436,
-	-1, 642,
-	5, 35,
-	-2, 13,
-	-1, 648,
-	5, 35,
-	-2, 15,
-	-1, 783,
-	266, 794,
-	-2, 436,
-	-1, 933,
-	119, 787,
-	-2, 783,
-	-1, 943,
-	266, 794,
-	-2, 305,
-	-1, 1020,
-	266, 794,
-	-2, 436,
-	-1, 1079,
-	58, 97,
-	-2, 194,
-	-1, 1082,
-	58, 97,
-	-2, 194,
-	-1, 1133,
-	5, 36,
-	-2, 562,
-	-1, 1209,
-	5, 35,
-	-2, 14,
-	-1, 1262,
-	58, 97,
-	-2, 165,
-	-1, 1394,
-	86, 782,
-	-2, 770,
-	-1, 1483,
-	55, 49,
-	57, 49,
-	-2, 51,
-	-1, 1649,
-	5, 35,
-	-2, 741,
-	-1, 1674,
-	5, 35,
-	-2, 58,
-	-1, 1745,
-	5, 36,
-	-2, 742,
-	-1, 1775,
-	5, 35,
-	-2, 744,
-	-1, 1796,
-	5, 36,
-	-2, 745,
+	-1, 8,
+	130, 480,
+	-2, 203,
+	-1, 16,
+	57, 208,
+	58, 208,
+	-2, 1064,
+	-1, 17,
+	5, 72,
+	-2, 11,
+	-1, 62,
+	5, 72,
+	-2, 12,
+	-1, 221,
+	119, 894,
+	-2, 808,
+	-1, 224,
+	119, 893,
+	-2, 888,
+	-1, 472,
+	119, 895,
+	-2, 310,
+	-1, 498,
+	266, 904,
+	-2, 795,
+	-1, 598,
+	59, 441,
+	-2, 438,
+	-1, 626,
+	119, 895,
+	-2, 310,
+	-1, 729,
+	266, 904,
+	-2, 523,
+	-1, 773,
+	266, 904,
+	-2, 523,
+	-1, 844,
+	119, 894,
+	-2, 890,
+	-1, 845,
+	119, 897,
+	-2, 892,
+	-1, 895,
+	58, 272,
+	-2, 279,
+	-1, 996,
+	266, 904,
+	-2, 379,
+	-1, 1058,
+	119, 894,
+	-2, 379,
+	-1, 1062,
+	5, 72,
+	-2, 20,
+	-1, 1064,
+	5, 72,
+	-2, 22,
+	-1, 1188,
+	266, 904,
+	-2, 523,
+	-1, 1190,
+	5, 73,
+	-2, 661,
+	-1, 1481,
+	58, 134,
+	-2, 256,
+	-1, 1484,
+	58, 134,
+	-2, 256,
+	-1, 1593,
+	5, 72,
+	-2, 21,
+	-1, 1623,
+	86, 891,
+	-2, 878,
+	-1, 1640,
+	58, 134,
+	-2, 225,
+	-1, 1743,
+	55, 86,
+	57, 86,
+	-2, 88,
+	-1, 1915,
+	266, 904,
+	-2, 523,
+	-1, 1916,
+	266, 904,
+	-2, 523,
+	-1, 1922,
+	5, 72,
+	-2, 846,
+	-1, 1931,
+	5, 72,
+	-2, 95,
+	-1, 2039,
+	5, 73,
+	-2, 847,
+	-1, 2060,
+	5, 72,
+	-2, 849,
+	-1, 2077,
+	5, 73,
+	-2, 850,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 8380
+const yyLast = 11550
 
 var yyAct = [...]int16{
-	542, 523, 1578, 1754, 1703, 1596, 1366, 1667, 655, 745,
-	1506, 1704, 30, 1700, 1640, 1579, 746, 39, 40, 552,
-	1193, 1659, 1062, 1672, 995, 1519, 57, 833, 1518, 459,
-	1493, 63, 63, 63, 1388, 125, 128, 1504, 1090, 1508,
-	1391, 1375, 1571, 1048, 1374, 1225, 1051, 1032, 1385, 860,
-	1371, 1222, 1203, 1198, 1129, 1278, 30, 848, 26, 386,
-	872, 942, 56, 516, 1123, 1028, 636, 887, 976, 979,
-	677, 204, 534, 806, 837, 222, 601, 637, 897, 550,
-	1013, 188, 1182, 521, 389, 153, 932, 236, 522, 1367,
-	398, 502, 58, 810, 123, 124, 773, 237, 43, 424,
-	47, 392, 64, 59, 422, 133, 430, 148, 171, 1301,
-	703, 702, 712, 713, 705, 706, 707, 708, 709, 710,
-	711, 704, 190, 526, 448, 930, 1568, 43, 228, 9,
-	1183, 186, 1475, 43, 704, 602, 33, 129, 714, 131,
-	1029, 63, 396, 509, 49, 32, 683, 142, 384, 206,
-	207, 208, 209, 510, 232, 233, 588, 1261, 399, 400,
-	393, 1124, 50, 51, 44, 1798, 45, 244, 1735, 585,
-	33, 1095, 31, 410, 1328, 1329, 1794, 645, 792, 1075,
-	1065, 1064, 1000, 1001, 1692, 1668, 1455, 441, 382, 1094,
-	1787, 1066, 150, 992, 420, 1361, 224, 471, 472, 1448,
-	1126, 247, 1067, 1734, 1317, 1086, 43, 764, 1115, 43,
-	1441, 43, 43, 397, 43, 1755, 1756, 1757, 1758, 1759,
-	1760, 246, 43, 1691, 52, 1725, 43, 478, 245, 1678,
-	168, 1520, 1677, 1521, 189, 1679, 463, 464, 465, 466,
-	1606, 167, 1726, 1727, 491, 193, 1425, 160, 823, 159,
-	822, 163, 164, 166, 1607, 1608, 1786, 161, 168, 434,
-	450, 192, 43, 740, 205, 414, 444, 452, 1311, 698,
-	454, 701, 457, 458, 989, 432, 197, 715, 716, 717,
-	718, 719, 720, 721, 830, 699, 700, 697, 722, 723,
-	724, 725, 703, 702, 712, 713, 705, 706, 707, 708,
-	709, 710, 711, 704, 1299, 43, 1073, 629, 628, 43,
-	445, 44, 45, 45, 195, 194, 1072, 200, 220, 1145,
-	202, 217, 703, 702, 712, 713, 705, 706, 707, 708,
-	709, 710, 711, 704, 1143, 470, 1730, 212, 213, 214,
-	215, 216, 1619, 467, 1407, 1213, 130, 490, 36, 1622,
-	857, 707, 708, 709, 710, 711, 704, 1623, 553, 1068,
-	1069, 1071, 438, 1635, 1538, 1070, 1685, 1684, 703, 702,
-	712, 713, 705, 706, 707, 708, 709, 710, 711, 704,
-	135, 384, 511, 714, 399, 400, 1454, 126, 1456, 503,
-	489, 242, 33, 1514, 878, 1620, 714, 645, 504, 1075,
-	1065, 1064, 1212, 1535, 1047, 888, 135, 1572, 587, 694,
-	1330, 1066, 651, 652, 435, 134, 437, 436, 37, 1251,
-	1772, 499, 1067, 1272, 441, 645, 680, 1075, 1065, 1064,
-	685, 1300, 684, 855, 165, 413, 412, 690, 407, 1066,
-	221, 394, 453, 405, 399, 400, 508, 33, 205, 33,
-	1067, 714, 703, 702, 712, 713, 705, 706, 707, 708,
-	709, 710, 711, 704, 495, 1557, 501, 705, 706, 707,
-	708, 709, 710, 711, 704, 793, 1618, 590, 1095, 1447,
-	639, 834, 615, 1537, 617, 512, 162, 620, 621, 500,
-	656, 1089, 642, 660, 648, 664, 434, 584, 675, 384,
-	1076, 149, 1509, 444, 1615, 643, 603, 643, 1334, 586,
-	1087, 1088, 432, 1690, 167, 503, 662, 1544, 591, 589,
-	1336, 401, 1323, 600, 694, 616, 1073, 1729, 598, 675,
-	419, 168, 48, 663, 127, 657, 1072, 504, 44, 668,
-	1511, 475, 494, 136, 137, 146, 166, 27, 1616, 473,
-	496, 167, 638, 841, 1073, 469, 138, 1331, 483, 444,
-	43, 678, 679, 681, 1072, 714, 38, 43, 168, 136,
-	137, 665, 1252, 1253, 1254, 166, 643, 682, 658, 1068,
-	1069, 1071, 138, 666, 647, 1070, 654, 33, 443, 442,
-	1597, 1599, 689, 1671, 656, 714, 395, 659, 403, 404,
-	1670, 686, 790, 63, 1669, 35, 34, 1068, 1069, 1071,
-	1636, 53, 46, 1070, 384, 497, 378, 741, 714, 6,
-	7, 730, 731, 1459, 1791, 1748, 1638, 809, 1523, 801,
-	1340, 1165, 1131, 1017, 639, 827, 1507, 744, 743, 613,
-	141, 714, 656, 904, 728, 623, 818, 461, 460, 694,
-	832, 1351, 693, 1680, 817, 1657, 839, 902, 903, 901,
-	692, 691, 854, 41, 1522, 1014, 856, 692, 691, 643,
-	1681, 32, 1598, 503, 1106, 788, 786, 693, 1105, 778,
-	779, 605, 607, 1104, 693, 587, 873, 874, 432, 503,
-	813, 813, 813, 1103, 1084, 1102, 33, 819, 1082, 821,
-	826, 33, 624, 1016, 796, 691, 638, 1332, 1333, 1335,
-	1337, 1338, 1101, 444, 1645, 43, 1353, 898, 692, 691,
-	1076, 693, 1176, 1081, 1100, 714, 1098, 43, 1319, 1682,
-	1049, 927, 927, 980, 391, 693, 714, 692, 691, 929,
-	692, 691, 1080, 144, 384, 384, 880, 851, 1076, 673,
-	676, 980, 643, 1162, 693, 1352, 139, 693, 938, 877,
-	982, 981, 875, 1208, 871, 1556, 885, 879, 1616, 876,
-	391, 643, 409, 592, 692, 691, 882, 766, 767, 768,
-	769, 770, 771, 772, 931, 934, 881, 1137, 996, 1136,
-	1279, 693, 604, 391, 402, 198, 1616, 44, 390, 45,
-	610, 611, 612, 933, 808, 814, 816, 920, 692, 691,
-	1280, 922, 1015, 1449, 925, 928, 1015, 923, 779, 692,
-	691, 899, 391, 246, 408, 693, 1406, 1380, 1555, 813,
-	813, 1453, 639, 813, 813, 813, 693, 1153, 1452, 983,
-	804, 646, 996, 646, 402, 451, 42, 973, 974, 1021,
-	1050, 1022, 692, 691, 1079, 900, 803, 991, 1036, 1321,
-	1450, 451, 813, 813, 813, 813, 1046, 1451, 201, 693,
-	687, 203, 456, 1281, 791, 143, 455, 33, 727, 729,
-	1277, 145, 451, 1006, 503, 673, 1279, 813, 1004, 1398,
-	692, 691, 692, 691, 825, 892, 894, 895, 1030, 824,
-	645, 610, 893, 1438, 638, 597, 1280, 693, 1092, 693,
-	476, 444, 748, 749, 750, 751, 752, 753, 754, 755,
-	756, 898, 759, 474, 761, 762, 763, 765, 765, 765,
-	765, 765, 765, 765, 765, 1509, 782, 783, 784, 785,
-	1052, 447, 694, 939, 940, 1116, 1117, 1118, 815, 975,
-	402, 1527, 1370, 44, 227, 45, 445, 230, 45, 234,
-	235, 1111, 241, 402, 44, 1481, 45, 44, 742, 1511,
-	376, 44, 1553, 1511, 379, 1307, 990, 1308, 993, 994,
-	1119, 33, 32, 1526, 1130, 703, 702, 712, 713, 705,
-	706, 707, 708, 709, 710, 711, 704, 44, 610, 45,
-	44, 1008, 45, 741, 33, 646, 1016, 33, 742, 31,
-	416, 1078, 645, 1099, 820, 1015, 468, 415, 384, 849,
-	694, 1781, 1780, 1142, 1096, 899, 924, 639, 503, 849,
-	1779, 694, 834, 1146, 33, 543, 926, 541, 545, 546,
-	547, 548, 802, 1172, 1768, 544, 549, 1206, 1161, 1724,
-	694, 1343, 1197, 477, 622, 1209, 813, 481, 931, 583,
-	643, 582, 402, 1205, 1166, 33, 1174, 1221, 643, 1247,
-	1248, 1249, 1436, 694, 1697, 694, 1260, 933, 1747, 694,
-	1262, 1079, 1079, 1262, 1079, 1079, 503, 503, 646, 813,
-	1216, 1190, 1273, 1191, 1189, 1184, 1276, 246, 513, 638,
-	813, 1187, 1188, 1181, 1186, 1192, 444, 748, 406, 1207,
-	996, 503, 1172, 1693, 672, 1626, 703, 702, 712, 713,
-	705, 706, 707, 708, 709, 710, 711, 704, 1490, 694,
-	672, 1540, 384, 1575, 1289, 1486, 1255, 1258, 1217, 1218,
-	1219, 1215, 1223, 1701, 1275, 123, 1656, 997, 672, 1539,
-	849, 1466, 645, 1294, 672, 1421, 1196, 43, 1282, 1283,
-	1284, 1285, 1286, 1268, 1269, 1179, 384, 1172, 1420, 1489,
-	1159, 1178, 1303, 1324, 1487, 1566, 1020, 1647, 1287, 1288,
-	1292, 1194, 1648, 935, 937, 1295, 1025, 1318, 1290, 1139,
-	1140, 1194, 1141, 645, 1037, 1490, 656, 1144, 1304, 985,
-	986, 987, 402, 988, 1347, 861, 1322, 1302, 1656, 1147,
-	1148, 1342, 1310, 1149, 1150, 1312, 1151, 1152, 1488, 863,
-	1486, 1009, 63, 1774, 384, 933, 1490, 998, 1417, 1416,
-	672, 1411, 672, 1410, 672, 1344, 1656, 1114, 1263, 1264,
-	1265, 1266, 1267, 402, 1007, 246, 1010, 1011, 1383, 672,
-	1291, 1399, 1018, 1356, 1019, 1009, 694, 1373, 714, 1172,
-	1171, 1157, 1348, 1262, 1368, 1355, 672, 1113, 849, 1031,
-	1024, 503, 503, 936, 694, 1155, 1369, 1044, 849, 999,
-	1397, 1495, 1498, 1499, 1500, 1496, 1077, 1497, 1501, 488,
-	1020, 1660, 1661, 862, 834, 672, 886, 1023, 43, 43,
-	672, 671, 1364, 632, 631, 626, 627, 1156, 640, 626,
-	625, 55, 54, 1005, 1211, 653, 1172, 1408, 850, 829,
-	805, 1154, 1112, 798, 795, 864, 865, 866, 867, 868,
-	869, 870, 1423, 619, 618, 614, 1009, 487, 1743, 936,
-	488, 384, 645, 1378, 1490, 1404, 1605, 1515, 1412, 1413,
-	515, 1381, 488, 1354, 1009, 1138, 1426, 861, 849, 1701,
-	672, 794, 1127, 630, 1463, 402, 594, 1460, 1083, 1467,
-	1719, 863, 1418, 1419, 634, 633, 1133, 1134, 1135, 1717,
-	29, 1303, 1513, 1688, 1465, 1660, 1661, 384, 1468, 714,
-	1554, 194, 402, 1444, 1525, 1414, 646, 1445, 1446, 1271,
-	1270, 1195, 643, 43, 646, 223, 1110, 1472, 1109, 1085,
-	1473, 1027, 1531, 1158, 1533, 503, 1542, 1026, 1003, 1164,
-	883, 1484, 1479, 853, 151, 831, 787, 688, 1167, 1168,
-	641, 1169, 1170, 1512, 609, 1516, 608, 606, 813, 593,
-	514, 479, 218, 421, 1529, 862, 1180, 417, 388, 43,
-	43, 211, 1534, 1532, 210, 1476, 1478, 225, 226, 43,
-	1510, 1546, 518, 828, 1293, 199, 695, 1543, 11, 492,
-	1091, 1663, 1175, 635, 480, 840, 229, 864, 865, 866,
-	867, 868, 869, 870, 132, 1592, 1666, 1499, 1500, 1590,
-	982, 1580, 1541, 859, 1591, 1588, 1052, 1359, 1665, 1587,
-	1589, 1561, 747, 1378, 645, 1586, 1075, 1065, 1064, 1041,
-	1042, 758, 1769, 1733, 63, 938, 384, 1564, 1066, 1469,
-	760, 387, 1528, 1576, 384, 462, 1574, 1741, 643, 1067,
-	1199, 1614, 1593, 1582, 1583, 1581, 1585, 1558, 1584, 596,
-	1383, 789, 1339, 1200, 1345, 1530, 1604, 377, 1349, 1601,
-	1603, 1139, 1474, 243, 1613, 996, 873, 874, 1503, 811,
-	1045, 43, 1038, 1039, 1612, 43, 43, 1570, 595, 983,
-	43, 43, 43, 43, 43, 486, 484, 482, 1379, 1637,
-	140, 1649, 1594, 977, 1629, 43, 1643, 1602, 843, 1510,
-	844, 845, 846, 1409, 643, 1652, 984, 1654, 847, 1655,
-	650, 1673, 1642, 842, 1644, 507, 1664, 1033, 1740, 1378,
-	1559, 1325, 1674, 1653, 1378, 1378, 1378, 1378, 1378, 1457,
-	1034, 1478, 1215, 1478, 43, 643, 1683, 1341, 834, 1378,
-	1739, 1675, 1415, 1073, 1699, 1194, 884, 1403, 1402, 384,
-	889, 890, 1422, 1072, 1357, 1093, 43, 1401, 982, 1580,
-	1702, 1709, 1673, 1400, 1108, 43, 1788, 982, 1580, 1350,
-	1707, 1327, 1326, 1686, 1687, 506, 505, 1696, 1705, 238,
-	239, 240, 1714, 643, 1712, 1710, 1439, 1107, 411, 1694,
-	1711, 836, 838, 1713, 1485, 661, 1068, 1069, 1071, 996,
-	1378, 852, 1070, 1462, 8, 1464, 1, 747, 1224, 1378,
-	941, 972, 13, 12, 1639, 231, 1570, 1128, 739, 538,
-	1621, 1536, 1737, 1732, 524, 1753, 1742, 1382, 656, 1220,
-	1363, 656, 656, 656, 1250, 1765, 1752, 983, 446, 1761,
-	1762, 1763, 173, 1177, 1764, 418, 983, 1766, 1505, 14,
-	1360, 1002, 1427, 1210, 1428, 649, 485, 1429, 1777, 1778,
-	1430, 1431, 1433, 1435, 1437, 1773, 1775, 1771, 1274, 1750,
-	858, 1751, 674, 1705, 157, 147, 667, 380, 28, 643,
-	1785, 10, 1545, 1478, 1097, 158, 156, 1458, 155, 1789,
-	154, 152, 449, 191, 196, 1792, 1790, 219, 62, 982,
-	1580, 1795, 1797, 1793, 1705, 60, 61, 65, 1386, 643,
-	183, 1306, 1502, 1510, 1524, 493, 186, 187, 1012, 1495,
-	1498, 1499, 1500, 1496, 1562, 1497, 1501, 726, 1563, 1676,
-	1393, 1570, 1708, 1202, 1738, 1698, 1160, 1076, 757, 978,
-	525, 174, 891, 645, 537, 1075, 1065, 1064, 536, 535,
-	1646, 696, 1377, 1480, 1379, 1494, 181, 1066, 169, 1379,
-	1379, 1379, 1379, 1379, 1492, 170, 1478, 1491, 1067, 1662,
-	1658, 1376, 1565, 1440, 1505, 1634, 1600, 1434, 983, 1040,
-	1358, 1063, 835, 1043, 5, 1731, 1074, 1061, 1552, 645,
-	4, 1075, 1065, 1064, 3, 1132, 1060, 1059, 1058, 1056,
-	1624, 1625, 1057, 1066, 1054, 1055, 1053, 1035, 1560, 644,
-	2, 0, 0, 0, 1067, 1259, 694, 0, 0, 0,
-	0, 0, 0, 177, 0, 172, 182, 0, 0, 0,
-	0, 0, 0, 179, 178, 1379, 0, 0, 0, 1163,
-	1650, 1651, 0, 0, 1379, 0, 0, 0, 0, 0,
-	0, 0, 1595, 0, 0, 0, 1173, 0, 0, 703,
-	702, 712, 713, 705, 706, 707, 708, 709, 710, 711,
-	704, 646, 1073, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1072, 0, 0, 0, 0, 0, 0, 0,
-	1627, 0, 1201, 1204, 0, 1630, 1631, 1632, 1633, 0,
-	732, 733, 734, 735, 736, 737, 738, 0, 1214, 0,
-	0, 0, 0, 0, 0, 0, 0, 1706, 1073, 646,
-	0, 0, 0, 0, 0, 1068, 1069, 1071, 1072, 0,
-	0, 1070, 1257, 0, 0, 0, 0, 0, 1720, 1721,
-	1722, 0, 702, 712, 713, 705, 706, 707, 708, 709,
-	710, 711, 704, 0, 1432, 694, 1372, 0, 0, 175,
-	0, 0, 0, 0, 25, 176, 0, 0, 0, 0,
-	0, 1068, 1069, 1071, 0, 0, 0, 1070, 0, 0,
-	0, 0, 1689, 0, 0, 0, 0, 1695, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1309, 703, 702,
-	712, 713, 705, 706, 707, 708, 709, 710, 711, 704,
-	0, 0, 1706, 0, 0, 1776, 0, 20, 0, 15,
-	1723, 1320, 712, 713, 705, 706, 707, 708, 709, 710,
-	711, 704, 16, 0, 23, 0, 0, 0, 184, 0,
-	185, 0, 0, 1706, 1736, 646, 0, 0, 0, 0,
-	17, 18, 0, 1346, 1744, 1745, 1746, 0, 1749, 0,
-	0, 1443, 180, 0, 0, 0, 1076, 0, 1296, 896,
-	1362, 0, 905, 906, 907, 908, 909, 910, 911, 912,
-	913, 914, 915, 916, 917, 918, 919, 0, 0, 0,
-	0, 1125, 703, 702, 712, 713, 705, 706, 707, 708,
-	709, 710, 711, 704, 0, 0, 0, 1482, 1483, 1782,
-	1783, 1784, 1076, 0, 1617, 703, 702, 712, 713, 705,
-	706, 707, 708, 709, 710, 711, 704, 0, 0, 0,
-	0, 0, 714, 0, 0, 0, 0, 0, 1796, 0,
-	0, 0, 0, 0, 0, 645, 0, 1075, 1065, 1064,
-	0, 0, 520, 0, 0, 0, 0, 519, 0, 1066,
-	1477, 0, 0, 0, 563, 0, 564, 0, 0, 0,
-	1067, 0, 0, 0, 554, 555, 0, 0, 0, 0,
-	1442, 0, 0, 0, 402, 0, 0, 445, 543, 540,
-	541, 545, 546, 547, 548, 0, 0, 0, 544, 549,
-	439, 440, 0, 0, 0, 0, 517, 532, 0, 562,
-	0, 1470, 1471, 1204, 714, 0, 0, 0, 0, 1573,
-	0, 0, 0, 0, 1577, 0, 0, 0, 645, 0,
-	1075, 1065, 1064, 529, 530, 0, 0, 19, 0, 579,
-	0, 531, 1066, 0, 943, 528, 533, 0, 0, 21,
-	22, 0, 24, 1067, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 577, 0, 0, 0, 0, 0, 0,
-	0, 714, 0, 0, 1073, 1120, 1121, 1122, 0, 945,
-	0, 0, 1628, 0, 1072, 0, 0, 797, 426, 427,
-	428, 0, 0, 714, 0, 0, 431, 429, 439, 440,
-	0, 539, 0, 0, 0, 0, 0, 1767, 0, 0,
-	0, 0, 0, 0, 0, 0, 732, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1068, 1069, 1071,
-	0, 0, 0, 1070, 1567, 0, 0, 954, 960, 958,
-	0, 0, 955, 1405, 0, 953, 0, 0, 962, 0,
-	0, 961, 947, 957, 959, 956, 951, 1073, 946, 0,
-	964, 963, 965, 944, 967, 714, 0, 1072, 971, 968,
-	970, 969, 565, 966, 0, 0, 0, 0, 774, 0,
-	0, 1611, 948, 949, 0, 0, 0, 0, 714, 0,
-	0, 0, 0, 581, 0, 566, 567, 0, 0, 0,
-	0, 0, 950, 952, 0, 0, 0, 0, 0, 0,
-	1068, 1069, 1071, 776, 0, 0, 1070, 0, 1641, 0,
-	0, 0, 0, 0, 0, 0, 551, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1256, 0, 0, 568, 578,
-	574, 575, 572, 573, 571, 570, 569, 580, 556, 557,
-	558, 559, 561, 0, 0, 443, 442, 560, 1076, 0,
-	0, 106, 107, 108, 109, 110, 111, 112, 113, 114,
-	115, 0, 116, 117, 0, 118, 119, 120, 122, 121,
-	0, 921, 777, 433, 438, 0, 0, 1297, 1298, 0,
-	66, 775, 576, 0, 0, 0, 781, 780, 0, 0,
-	0, 0, 0, 0, 0, 0, 1715, 0, 599, 1716,
-	0, 445, 1718, 425, 426, 427, 428, 1313, 1314, 1315,
-	1316, 0, 431, 429, 439, 440, 0, 0, 0, 1728,
-	0, 0, 0, 0, 0, 0, 435, 0, 437, 436,
-	0, 1076, 0, 0, 0, 0, 1641, 0, 0, 0,
-	0, 0, 0, 0, 0, 747, 0, 0, 0, 362,
-	351, 0, 310, 364, 280, 298, 372, 300, 301, 337,
-	259, 320, 0, 295, 277, 0, 283, 252, 290, 253,
-	281, 312, 0, 278, 0, 353, 323, 0, 1770, 747,
-	370, 0, 328, 67, 0, 0, 0, 0, 315, 355,
-	318, 346, 309, 338, 267, 327, 365, 296, 333, 366,
-	0, 0, 0, 33, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 332, 360, 292, 375, 0,
-	336, 251, 330, 0, 257, 260, 371, 358, 287, 288,
-	0, 0, 0, 0, 0, 0, 0, 314, 319, 343,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 1424,
-	0, 0, 0, 284, 0, 326, 0, 0, 0, 264,
-	258, 0, 311, 0, 0, 0, 266, 0, 285, 344,
-	0, 248, 349, 356, 308, 0, 774, 359, 305, 304,
-	0, 0, 0, 0, 0, 0, 297, 0, 341, 373,
-	363, 316, 354, 282, 291, 0, 289, 0, 0, 0,
-	325, 339, 0, 0, 0, 0, 0, 361, 0, 433,
-	438, 776, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 249, 286, 347,
-	350, 271, 335, 261, 293, 342, 294, 317, 276, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1387, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 435, 0, 437, 436, 0, 0, 0, 106,
-	107, 108, 109, 110, 111, 112, 113, 114, 115, 443,
-	442, 0, 0, 1395, 0, 1547, 0, 1548, 0, 1549,
-	777, 1550, 1551, 0, 0, 0, 0, 0, 66, 775,
-	0, 0, 0, 0, 781, 780, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 254, 0, 0, 0,
-	0, 0, 255, 275, 357, 0, 0, 0, 0, 1396,
-	1394, 1390, 1389, 0, 0, 0, 0, 334, 0, 0,
-	0, 0, 1392, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 274, 268, 269, 321, 322,
-	367, 368, 369, 345, 265, 0, 272, 273, 0, 352,
-	0, 0, 0, 324, 0, 0, 0, 374, 0, 0,
-	0, 0, 0, 0, 0, 299, 250, 303, 0, 0,
-	0, 67, 0, 0, 0, 262, 263, 0, 0, 307,
-	302, 329, 331, 340, 348, 0, 279, 313, 362, 351,
-	0, 310, 364, 280, 298, 372, 300, 301, 337, 259,
-	320, 0, 295, 277, 0, 283, 252, 290, 253, 281,
-	312, 0, 278, 0, 353, 323, 0, 0, 0, 370,
-	0, 328, 0, 0, 0, 0, 0, 315, 355, 318,
-	346, 309, 338, 267, 327, 365, 296, 333, 366, 0,
-	0, 0, 33, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 332, 360, 292, 375, 0, 336,
-	251, 330, 0, 257, 260, 371, 358, 287, 288, 0,
-	645, 0, 1075, 1065, 1064, 0, 314, 319, 343, 306,
-	0, 0, 0, 0, 1066, 0, 0, 0, 0, 0,
-	0, 0, 284, 0, 326, 1067, 0, 0, 264, 258,
-	0, 311, 0, 0, 0, 266, 0, 285, 344, 0,
-	248, 349, 356, 308, 0, 0, 359, 305, 304, 0,
-	0, 0, 0, 0, 0, 297, 0, 341, 373, 363,
-	316, 354, 282, 291, 0, 289, 0, 0, 0, 325,
-	339, 0, 0, 0, 0, 0, 361, 0, 0, 1569,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 249, 286, 347, 350,
-	271, 335, 261, 293, 342, 294, 317, 276, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1517,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1073,
-	0, 0, 0, 645, 0, 1075, 1065, 1064, 0, 1072,
-	0, 0, 0, 0, 0, 0, 0, 1066, 0, 0,
-	0, 0, 1395, 0, 0, 0, 0, 0, 1067, 1226,
-	1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235, 1236,
-	1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245, 1246,
-	0, 0, 1068, 1069, 1071, 254, 0, 0, 1070, 0,
-	0, 255, 275, 357, 0, 0, 0, 0, 1396, 1394,
-	0, 0, 0, 0, 0, 0, 334, 0, 0, 0,
-	0, 1392, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 274, 268, 269, 321, 322, 367,
-	368, 369, 345, 265, 0, 272, 273, 0, 352, 0,
-	0, 0, 324, 0, 0, 0, 374, 0, 0, 0,
-	0, 0, 1073, 0, 299, 250, 303, 0, 0, 0,
-	0, 0, 1072, 0, 262, 263, 0, 0, 307, 302,
-	329, 331, 340, 348, 0, 279, 313, 362, 351, 0,
-	310, 364, 280, 298, 372, 300, 301, 337, 259, 320,
-	0, 295, 277, 0, 283, 252, 290, 253, 281, 312,
-	0, 278, 0, 353, 323, 1068, 1069, 1071, 370, 0,
-	328, 1070, 0, 1076, 0, 0, 315, 355, 318, 346,
-	309, 338, 267, 327, 365, 296, 333, 366, 0, 0,
-	0, 33, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 360, 292, 375, 0, 336, 251,
-	330, 0, 257, 260, 371, 358, 287, 288, 0, 0,
-	0, 0, 0, 0, 0, 314, 319, 343, 306, 0,
-	0, 0, 0, 0, 1305, 0, 0, 0, 0, 0,
-	0, 284, 0, 326, 0, 0, 0, 264, 258, 0,
-	311, 0, 0, 0, 266, 0, 285, 344, 0, 248,
-	349, 356, 308, 0, 0, 359, 305, 304, 0, 945,
-	0, 0, 0, 0, 297, 0, 341, 373, 363, 316,
-	354, 282, 291, 0, 289, 0, 0, 0, 325, 339,
-	0, 0, 0, 0, 0, 361, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1076, 0, 0, 0,
-	0, 0, 0, 0, 256, 249, 286, 347, 350, 271,
-	335, 261, 293, 342, 294, 317, 276, 954, 960, 958,
-	0, 0, 955, 0, 0, 953, 0, 0, 962, 0,
-	0, 961, 947, 957, 959, 956, 951, 0, 946, 0,
-	964, 963, 965, 944, 967, 0, 0, 0, 971, 968,
-	970, 969, 0, 966, 0, 0, 0, 0, 0, 0,
-	0, 1395, 948, 949, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 950, 952, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 254, 0, 0, 0, 0, 0,
-	255, 275, 357, 0, 0, 0, 0, 1396, 1394, 0,
-	0, 0, 0, 0, 0, 334, 0, 0, 0, 0,
-	1392, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 270, 274, 268, 269, 321, 322, 367, 368,
-	369, 345, 265, 0, 272, 273, 0, 352, 0, 0,
-	0, 324, 0, 0, 0, 374, 0, 0, 0, 0,
-	0, 0, 0, 299, 250, 303, 0, 0, 0, 0,
-	0, 0, 0, 262, 263, 0, 0, 307, 302, 329,
-	331, 340, 348, 0, 279, 313, 362, 351, 0, 310,
-	364, 280, 298, 372, 300, 301, 337, 259, 320, 0,
-	295, 277, 0, 283, 252, 290, 253, 281, 312, 0,
-	278, 0, 353, 323, 0, 89, 0, 370, 32, 328,
-	0, 0, 0, 0, 0, 315, 355, 318, 346, 309,
-	338, 267, 327, 365, 296, 333, 366, 0, 0, 0,
-	445, 1084, 45, 33, 0, 1082, 0, 0, 0, 0,
-	0, 0, 332, 360, 292, 375, 0, 336, 251, 330,
-	0, 257, 260, 371, 358, 287, 288, 0, 0, 0,
-	1081, 0, 0, 0, 314, 319, 343, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1185, 1080,
-	284, 0, 326, 0, 0, 0, 264, 258, 0, 311,
-	74, 0, 0, 266, 0, 285, 344, 0, 248, 349,
-	356, 308, 0, 0, 359, 305, 304, 0, 0, 0,
-	0, 0, 0, 297, 0, 341, 373, 363, 316, 354,
-	282, 291, 0, 289, 0, 90, 0, 325, 339, 0,
-	0, 0, 0, 0, 361, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 249, 286, 347, 350, 271, 335,
-	261, 293, 342, 294, 317, 276, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 106, 107, 108, 109, 110, 111, 112,
-	113, 114, 115, 0, 116, 117, 0, 118, 119, 120,
-	122, 121, 91, 92, 93, 97, 95, 94, 96, 68,
-	70, 0, 66, 69, 75, 71, 72, 73, 87, 76,
-	77, 78, 79, 80, 81, 82, 83, 84, 85, 86,
-	88, 98, 99, 100, 101, 102, 103, 104, 105, 0,
-	0, 0, 0, 254, 0, 0, 0, 0, 0, 255,
-	275, 357, 0, 0, 0, 0, 0, 385, 0, 0,
-	0, 0, 0, 0, 334, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 274, 268, 269, 321, 322, 367, 368, 369,
-	345, 265, 0, 272, 273, 0, 352, 0, 0, 0,
-	324, 0, 0, 0, 374, 67, 0, 0, 0, 0,
-	0, 0, 299, 250, 303, 0, 0, 0, 0, 0,
-	0, 0, 262, 263, 0, 0, 307, 302, 329, 331,
-	340, 348, 0, 279, 313, 362, 351, 0, 310, 364,
-	280, 298, 372, 300, 301, 337, 259, 320, 0, 295,
-	277, 0, 283, 252, 290, 253, 281, 312, 0, 278,
-	0, 353, 323, 0, 89, 0, 370, 0, 328, 0,
-	0, 0, 0, 0, 315, 355, 318, 346, 309, 338,
-	267, 327, 365, 296, 333, 366, 0, 0, 0, 33,
-	0, 669, 33, 670, 0, 0, 0, 0, 0, 0,
-	0, 332, 360, 292, 375, 0, 336, 251, 330, 0,
-	257, 260, 371, 358, 287, 288, 0, 0, 0, 0,
-	0, 0, 0, 314, 319, 343, 306, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 284,
-	0, 326, 0, 0, 0, 264, 258, 0, 311, 74,
-	0, 0, 266, 0, 285, 344, 0, 248, 349, 356,
-	308, 0, 0, 359, 305, 304, 0, 0, 0, 0,
-	0, 0, 297, 0, 341, 373, 363, 316, 354, 282,
-	291, 0, 289, 0, 90, 0, 325, 339, 0, 0,
-	0, 0, 0, 361, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 249, 286, 347, 350, 271, 335, 261,
-	293, 342, 294, 317, 276, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 106, 107, 108, 109, 110, 111, 112, 113,
-	114, 115, 0, 116, 117, 0, 118, 119, 120, 122,
-	121, 91, 92, 93, 97, 95, 94, 96, 68, 70,
-	0, 66, 69, 75, 71, 72, 73, 87, 76, 77,
-	78, 79, 80, 81, 82, 83, 84, 85, 86, 88,
-	98, 99, 100, 101, 102, 103, 104, 105, 0, 0,
-	0, 0, 254, 645, 0, 1075, 1065, 1064, 255, 275,
-	357, 0, 0, 0, 0, 0, 385, 1066, 0, 0,
-	0, 0, 0, 334, 0, 0, 0, 0, 1067, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 274, 268, 269, 321, 322, 367, 368, 369, 345,
-	265, 0, 272, 273, 0, 352, 0, 0, 0, 324,
-	0, 0, 0, 374, 67, 0, 0, 0, 0, 0,
-	0, 299, 250, 303, 0, 0, 0, 0, 0, 0,
-	0, 262, 263, 0, 0, 307, 302, 329, 331, 340,
-	348, 0, 279, 313, 362, 351, 0, 310, 364, 280,
-	298, 372, 300, 301, 337, 259, 320, 0, 295, 277,
-	0, 283, 252, 290, 253, 281, 312, 0, 278, 0,
-	353, 323, 1073, 0, 0, 370, 0, 328, 0, 0,
-	0, 0, 1072, 315, 355, 318, 346, 309, 338, 267,
-	327, 365, 296, 333, 366, 0, 381, 0, 33, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 383, 0,
-	332, 360, 292, 375, 0, 336, 251, 330, 0, 257,
-	260, 371, 358, 287, 288, 1068, 1069, 1071, 0, 0,
-	0, 1070, 314, 319, 343, 306, 0, 0, 0, 0,
-	0, 1365, 0, 0, 0, 0, 0, 0, 284, 0,
-	326, 0, 0, 0, 264, 258, 0, 311, 0, 0,
-	0, 266, 0, 285, 344, 0, 248, 349, 356, 308,
-	0, 0, 359, 305, 304, 0, 0, 0, 0, 0,
-	0, 297, 0, 341, 373, 363, 316, 354, 282, 291,
-	0, 289, 0, 0, 0, 325, 339, 0, 0, 0,
-	0, 0, 361, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 249, 286, 347, 350, 271, 335, 261, 293,
-	342, 294, 317, 276, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1076, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 254, 0, 0, 0, 0, 0, 255, 275, 357,
-	0, 0, 0, 0, 0, 385, 0, 0, 0, 0,
-	0, 0, 334, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	274, 268, 269, 321, 322, 367, 368, 369, 345, 265,
-	0, 272, 273, 0, 352, 0, 0, 0, 324, 0,
-	0, 0, 374, 0, 0, 0, 0, 0, 0, 0,
-	299, 250, 303, 0, 0, 0, 0, 0, 0, 0,
-	262, 263, 0, 0, 307, 302, 329, 331, 340, 348,
-	0, 279, 313, 362, 351, 0, 310, 364, 280, 298,
-	372, 300, 301, 337, 259, 320, 0, 295, 277, 0,
-	283, 252, 290, 253, 281, 312, 0, 278, 0, 353,
-	323, 0, 0, 0, 370, 0, 328, 0, 0, 0,
-	0, 0, 315, 355, 318, 346, 309, 338, 267, 327,
-	365, 296, 333, 366, 0, 0, 0, 33, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 332,
-	360, 292, 375, 0, 336, 251, 330, 0, 257, 260,
-	371, 358, 287, 288, 0, 0, 0, 0, 0, 0,
-	0, 314, 319, 343, 306, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1461, 0, 284, 0, 326,
-	0, 0, 0, 264, 258, 0, 311, 0, 0, 0,
-	266, 0, 285, 344, 0, 248, 349, 356, 308, 0,
-	0, 359, 305, 304, 0, 0, 0, 0, 0, 0,
-	297, 0, 341, 373, 363, 316, 354, 282, 291, 0,
-	289, 0, 0, 0, 325, 339, 0, 0, 0, 0,
-	0, 361, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 249, 286, 347, 350, 271, 335, 261, 293, 342,
-	294, 317, 276, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	254, 0, 0, 0, 0, 0, 255, 275, 357, 0,
-	0, 0, 0, 0, 385, 0, 0, 0, 0, 0,
-	0, 334, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 274,
-	268, 269, 321, 322, 367, 368, 369, 345, 265, 0,
-	272, 273, 0, 352, 0, 0, 0, 324, 0, 0,
-	0, 374, 0, 0, 0, 0, 0, 0, 0, 299,
-	250, 303, 0, 0, 0, 0, 0, 0, 0, 262,
-	263, 0, 0, 307, 302, 329, 331, 340, 348, 0,
-	279, 313, 362, 351, 0, 310, 364, 280, 298, 372,
-	300, 301, 337, 259, 320, 0, 295, 277, 0, 283,
-	252, 290, 253, 281, 312, 0, 278, 0, 353, 323,
-	0, 0, 0, 370, 0, 328, 0, 0, 0, 0,
-	0, 315, 355, 318, 346, 309, 338, 267, 327, 365,
-	296, 333, 366, 0, 0, 0, 445, 0, 45, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 332, 360,
-	292, 375, 0, 336, 251, 330, 0, 257, 260, 371,
-	358, 287, 288, 0, 0, 0, 0, 0, 0, 0,
-	314, 319, 343, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 326, 0,
-	0, 0, 264, 258, 0, 311, 0, 0, 0, 266,
-	0, 285, 344, 0, 248, 349, 356, 308, 0, 0,
-	359, 305, 304, 0, 0, 0, 0, 0, 0, 297,
-	0, 341, 373, 363, 316, 354, 282, 291, 0, 289,
-	0, 0, 0, 325, 339, 0, 0, 0, 0, 0,
-	361, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	249, 286, 347, 350, 271, 335, 261, 293, 342, 294,
-	317, 276, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 254,
-	0, 0, 0, 0, 0, 255, 275, 357, 0, 0,
-	0, 0, 0, 385, 0, 0, 0, 0, 0, 0,
-	334, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 274, 268,
-	269, 321, 322, 367, 368, 369, 345, 265, 0, 272,
-	273, 0, 352, 0, 0, 0, 324, 0, 0, 0,
-	374, 0, 0, 0, 0, 0, 0, 0, 299, 250,
-	303, 0, 0, 0, 0, 0, 0, 0, 262, 263,
-	0, 0, 307, 302, 329, 331, 340, 348, 0, 279,
-	313, 362, 351, 0, 310, 364, 280, 298, 372, 300,
-	301, 337, 259, 320, 0, 295, 277, 0, 283, 252,
-	290, 253, 281, 312, 0, 278, 0, 353, 323, 0,
-	0, 0, 370, 0, 328, 0, 0, 0, 0, 0,
-	315, 355, 318, 346, 309, 338, 267, 327, 365, 296,
-	333, 366, 0, 0, 0, 33, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 332, 360, 292,
-	375, 0, 336, 251, 330, 0, 257, 260, 371, 358,
-	287, 288, 498, 0, 0, 0, 0, 0, 0, 314,
-	319, 343, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 284, 0, 326, 0, 0,
-	0, 264, 258, 0, 311, 0, 0, 0, 266, 0,
-	285, 344, 0, 248, 349, 356, 308, 0, 0, 359,
-	305, 304, 0, 0, 0, 0, 0, 0, 297, 0,
-	341, 373, 363, 316, 354, 282, 291, 0, 289, 0,
-	0, 0, 325, 339, 0, 0, 0, 0, 0, 361,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 249,
-	286, 347, 350, 271, 335, 261, 293, 342, 294, 317,
-	276, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 254, 0,
-	0, 0, 0, 0, 255, 275, 357, 0, 0, 0,
-	0, 0, 385, 0, 0, 0, 0, 0, 0, 334,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 274, 268, 269,
-	321, 322, 367, 368, 369, 345, 265, 0, 272, 273,
-	0, 352, 0, 0, 0, 324, 0, 0, 0, 374,
-	0, 0, 0, 0, 0, 0, 0, 299, 250, 303,
-	0, 0, 0, 0, 0, 0, 0, 262, 263, 0,
-	0, 307, 302, 329, 331, 340, 348, 0, 279, 313,
-	362, 351, 0, 310, 364, 280, 298, 372, 300, 301,
-	337, 259, 320, 0, 295, 277, 0, 283, 252, 290,
-	253, 281, 312, 0, 278, 0, 353, 323, 0, 0,
-	0, 370, 0, 328, 0, 0, 0, 0, 0, 315,
-	355, 318, 346, 309, 338, 267, 327, 365, 296, 333,
-	366, 0, 0, 0, 33, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 332, 360, 292, 375,
-	0, 336, 251, 330, 0, 257, 260, 371, 358, 287,
-	288, 0, 0, 0, 0, 0, 0, 0, 314, 319,
-	343, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 284, 0, 326, 0, 0, 0,
-	264, 258, 0, 311, 0, 0, 0, 266, 0, 285,
-	344, 0, 248, 349, 356, 308, 0, 0, 359, 305,
-	304, 0, 0, 0, 0, 0, 0, 297, 0, 341,
-	373, 363, 316, 354, 282, 291, 0, 289, 0, 0,
-	0, 325, 339, 0, 0, 0, 0, 0, 361, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 249, 286,
-	347, 350, 271, 335, 261, 293, 342, 294, 317, 276,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 254, 0, 0,
-	0, 0, 0, 255, 275, 357, 0, 0, 0, 0,
-	0, 385, 0, 0, 0, 0, 0, 0, 334, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 274, 268, 269, 321,
-	322, 367, 368, 369, 345, 265, 0, 272, 273, 0,
-	352, 0, 0, 0, 324, 0, 0, 0, 374, 0,
-	0, 0, 0, 0, 0, 0, 299, 250, 303, 0,
-	0, 0, 0, 0, 0, 0, 262, 263, 0, 0,
-	307, 302, 329, 331, 340, 348, 0, 279, 313, 362,
-	351, 0, 310, 364, 280, 298, 372, 300, 301, 337,
-	259, 320, 0, 295, 277, 0, 283, 252, 290, 253,
-	281, 312, 0, 278, 0, 353, 323, 0, 0, 0,
-	370, 0, 328, 0, 0, 0, 0, 0, 315, 355,
-	318, 346, 309, 338, 267, 327, 365, 296, 333, 366,
-	0, 0, 0, 44, 0, 45, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 332, 360, 292, 375, 0,
-	336, 251, 330, 0, 257, 260, 371, 358, 287, 288,
-	0, 0, 0, 0, 0, 0, 0, 314, 319, 343,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 326, 0, 0, 0, 264,
-	258, 0, 311, 0, 0, 0, 266, 0, 285, 344,
-	0, 248, 349, 356, 308, 0, 0, 359, 305, 304,
-	0, 0, 0, 0, 0, 0, 297, 0, 341, 373,
-	363, 316, 354, 282, 291, 0, 289, 0, 0, 0,
-	325, 339, 0, 0, 0, 0, 0, 361, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 249, 286, 347,
-	350, 271, 335, 261, 293, 342, 294, 317, 276, 520,
-	0, 0, 0, 0, 519, 0, 0, 0, 0, 0,
-	0, 563, 0, 564, 0, 0, 0, 0, 0, 0,
-	0, 554, 555, 0, 0, 0, 0, 0, 0, 1609,
-	0, 402, 0, 0, 445, 543, 540, 541, 545, 546,
-	547, 548, 0, 0, 0, 544, 549, 439, 440, 1610,
-	0, 0, 0, 517, 532, 0, 562, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 254, 0, 0, 0,
-	529, 530, 255, 275, 357, 0, 579, 0, 531, 0,
-	0, 527, 528, 533, 0, 0, 0, 334, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	577, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 274, 268, 269, 321, 322,
-	367, 368, 369, 345, 265, 0, 272, 273, 0, 352,
-	0, 0, 0, 324, 0, 0, 0, 374, 539, 0,
-	0, 0, 0, 0, 0, 299, 250, 303, 0, 0,
-	0, 0, 0, 0, 0, 262, 263, 0, 0, 307,
-	302, 329, 331, 340, 348, 520, 279, 313, 423, 0,
-	519, 445, 0, 425, 426, 427, 428, 563, 0, 564,
-	0, 0, 431, 429, 439, 440, 0, 554, 555, 0,
-	0, 0, 0, 0, 0, 0, 0, 402, 0, 694,
-	445, 543, 540, 541, 545, 546, 547, 548, 0, 565,
-	0, 544, 549, 439, 440, 0, 0, 0, 0, 517,
-	532, 0, 562, 0, 0, 0, 0, 0, 0, 0,
-	581, 0, 566, 567, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 529, 530, 0, 0,
-	0, 0, 579, 0, 531, 0, 0, 527, 528, 533,
-	0, 0, 0, 551, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 577, 0, 0, 0,
-	0, 0, 0, 0, 0, 568, 578, 574, 575, 572,
-	573, 571, 570, 569, 580, 556, 557, 558, 559, 561,
-	0, 0, 443, 442, 560, 0, 807, 0, 520, 0,
-	0, 0, 0, 519, 539, 0, 0, 0, 0, 0,
-	563, 0, 564, 0, 0, 0, 0, 0, 0, 0,
-	554, 555, 0, 0, 0, 0, 0, 0, 0, 576,
-	402, 0, 0, 445, 543, 540, 541, 545, 546, 547,
-	548, 0, 0, 0, 544, 549, 439, 440, 0, 0,
-	0, 0, 517, 532, 0, 562, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 433,
-	438, 0, 0, 0, 0, 565, 0, 0, 0, 529,
-	530, 812, 0, 0, 0, 579, 0, 531, 0, 0,
-	527, 528, 533, 0, 0, 0, 581, 0, 566, 567,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 577,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 435, 0, 437, 436, 0, 0, 0, 551,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 443,
-	442, 0, 0, 0, 0, 0, 0, 539, 0, 0,
-	0, 568, 578, 574, 575, 572, 573, 571, 570, 569,
-	580, 556, 557, 558, 559, 561, 0, 0, 443, 442,
-	560, 0, 0, 0, 520, 0, 0, 0, 0, 519,
-	0, 0, 0, 0, 0, 0, 563, 0, 564, 0,
-	0, 0, 0, 0, 0, 0, 554, 555, 0, 0,
-	0, 0, 0, 0, 0, 576, 402, 0, 0, 445,
-	543, 540, 541, 545, 546, 547, 548, 0, 565, 0,
-	544, 549, 439, 440, 0, 0, 0, 0, 517, 532,
-	0, 562, 0, 0, 0, 0, 0, 0, 0, 581,
-	0, 566, 567, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 529, 530, 812, 0, 0,
-	0, 579, 0, 531, 0, 0, 527, 528, 533, 0,
-	0, 0, 551, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 577, 0, 0, 0, 0,
-	0, 0, 0, 0, 568, 578, 574, 575, 572, 573,
-	571, 570, 569, 580, 556, 557, 558, 559, 561, 0,
-	0, 443, 442, 560, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 539, 645, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 520, 0, 0, 0, 0, 519, 576, 0,
-	0, 0, 0, 0, 563, 0, 564, 0, 0, 0,
-	0, 0, 0, 0, 554, 555, 0, 0, 0, 0,
-	0, 0, 0, 0, 402, 0, 0, 445, 543, 540,
-	541, 545, 546, 547, 548, 0, 0, 0, 544, 549,
-	439, 440, 0, 0, 565, 0, 517, 532, 0, 562,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 581, 0, 566, 567, 0,
-	0, 0, 0, 529, 530, 0, 0, 0, 0, 579,
-	0, 531, 0, 0, 527, 528, 533, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 551, 0,
-	0, 0, 0, 577, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	568, 578, 574, 575, 572, 573, 571, 570, 569, 580,
-	556, 557, 558, 559, 561, 0, 0, 443, 442, 560,
-	0, 539, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 520,
-	0, 0, 0, 0, 519, 0, 0, 0, 0, 0,
-	0, 563, 0, 564, 576, 0, 0, 0, 0, 0,
-	0, 554, 555, 0, 0, 0, 0, 0, 0, 0,
-	0, 402, 0, 0, 445, 543, 540, 541, 545, 546,
-	547, 548, 0, 0, 0, 544, 549, 439, 440, 0,
-	0, 0, 565, 517, 532, 0, 562, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 581, 0, 566, 567, 0, 0, 0,
-	529, 530, 0, 0, 0, 0, 579, 0, 531, 0,
-	0, 527, 528, 533, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 551, 0, 0, 0,
-	577, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 568, 578,
-	574, 575, 572, 573, 571, 570, 569, 580, 556, 557,
-	558, 559, 561, 0, 0, 443, 442, 560, 539, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 520, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 563, 0,
-	564, 0, 576, 0, 0, 0, 0, 0, 554, 555,
-	0, 0, 0, 0, 0, 0, 0, 0, 402, 0,
-	0, 445, 543, 540, 541, 545, 546, 547, 548, 0,
-	0, 0, 544, 549, 439, 440, 0, 0, 0, 565,
-	0, 532, 0, 562, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	581, 0, 566, 567, 0, 0, 0, 529, 530, 0,
-	0, 0, 0, 579, 0, 531, 0, 0, 527, 528,
-	533, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 551, 0, 0, 0, 577, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 568, 578, 574, 575, 572,
-	573, 571, 570, 569, 580, 556, 557, 558, 559, 561,
-	0, 0, 443, 442, 560, 539, 0, 0, 563, 0,
-	564, 0, 0, 0, 0, 0, 0, 0, 554, 555,
-	0, 0, 0, 0, 0, 0, 0, 0, 402, 0,
-	0, 445, 543, 540, 541, 545, 546, 547, 548, 576,
-	0, 0, 544, 549, 439, 440, 0, 0, 0, 0,
-	0, 532, 0, 562, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 565, 529, 530, 0,
-	0, 0, 0, 579, 0, 531, 0, 0, 527, 528,
-	533, 0, 0, 0, 0, 0, 0, 581, 0, 566,
-	567, 0, 0, 0, 0, 0, 0, 577, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	551, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	89, 0, 0, 0, 0, 539, 0, 0, 0, 0,
-	0, 0, 568, 578, 574, 575, 572, 573, 571, 570,
-	569, 580, 556, 557, 558, 559, 561, 0, 33, 443,
-	442, 560, 0, 0, 0, 563, 0, 564, 0, 0,
-	0, 0, 0, 0, 0, 554, 555, 0, 0, 0,
-	0, 0, 0, 0, 0, 830, 0, 0, 445, 543,
-	540, 541, 545, 546, 547, 548, 576, 0, 0, 544,
-	549, 439, 440, 0, 0, 0, 565, 0, 532, 0,
-	562, 0, 0, 0, 0, 74, 0, 800, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 581, 0, 566,
-	567, 0, 0, 0, 529, 530, 0, 0, 0, 0,
-	579, 0, 531, 0, 0, 527, 528, 533, 0, 0,
-	90, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	551, 0, 0, 0, 577, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 568, 578, 574, 575, 572, 573, 571, 570,
-	569, 580, 556, 557, 558, 559, 561, 0, 0, 443,
-	442, 560, 539, 0, 0, 0, 0, 0, 106, 107,
-	108, 109, 110, 111, 112, 113, 114, 115, 0, 116,
-	117, 0, 118, 119, 120, 122, 121, 91, 92, 93,
-	97, 95, 94, 96, 68, 70, 576, 66, 69, 75,
-	71, 72, 73, 87, 76, 77, 78, 79, 80, 81,
-	82, 83, 84, 85, 86, 88, 98, 99, 100, 101,
-	102, 103, 104, 105, 0, 0, 0, 0, 799, 0,
-	0, 0, 0, 565, 0, 0, 0, 0, 0, 89,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 581, 0, 566, 567, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 33, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 551, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	67, 0, 0, 0, 0, 0, 0, 0, 0, 568,
-	578, 574, 575, 572, 573, 571, 570, 569, 580, 556,
-	557, 558, 559, 561, 74, 0, 443, 442, 560, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 90,
-	0, 0, 0, 576, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1384, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 106, 107, 108,
-	109, 110, 111, 112, 113, 114, 115, 0, 116, 117,
-	0, 118, 119, 120, 122, 121, 91, 92, 93, 97,
-	95, 94, 96, 68, 70, 0, 66, 69, 75, 71,
-	72, 73, 87, 76, 77, 78, 79, 80, 81, 82,
-	83, 84, 85, 86, 88, 98, 99, 100, 101, 102,
-	103, 104, 105, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 67,
+	474, 1855, 1983, 733, 2047, 455, 1989, 1826, 1962, 2012,
+	2005, 17, 1984, 174, 486, 1331, 1980, 57, 1879, 1732,
+	1712, 1892, 62, 1566, 1831, 1856, 70, 64, 1755, 77,
+	78, 80, 1756, 1391, 1617, 1240, 656, 1849, 1564, 1428,
+	1243, 975, 1818, 1055, 1512, 734, 590, 1072, 105, 1113,
+	581, 1432, 1329, 1445, 1097, 1568, 1614, 225, 111, 111,
+	111, 111, 1267, 444, 1488, 1553, 1442, 1394, 1336, 1263,
+	104, 1495, 188, 38, 192, 1174, 578, 1395, 780, 1639,
+	1583, 804, 995, 448, 1362, 1183, 1168, 979, 805, 1279,
+	855, 599, 1054, 586, 593, 219, 466, 19, 68, 541,
+	218, 423, 843, 727, 57, 1031, 407, 938, 19, 441,
+	1404, 454, 19, 623, 371, 1485, 55, 197, 542, 522,
+	84, 526, 453, 112, 625, 389, 631, 1733, 106, 171,
+	172, 173, 56, 366, 107, 763, 569, 436, 667, 664,
+	645, 971, 754, 688, 698, 699, 691, 692, 693, 694,
+	695, 696, 697, 690, 1813, 228, 178, 230, 409, 689,
+	688, 698, 699, 691, 692, 693, 694, 695, 696, 697,
+	690, 698, 699, 691, 692, 693, 694, 695, 696, 697,
+	690, 1358, 458, 525, 376, 1604, 867, 13, 1363, 405,
+	1842, 532, 533, 563, 234, 193, 868, 195, 1093, 728,
+	700, 537, 538, 232, 210, 524, 111, 690, 61, 1491,
+	111, 1405, 21, 220, 1292, 1282, 1281, 1111, 222, 369,
+	21, 86, 1292, 1282, 1281, 72, 1283, 425, 426, 427,
+	428, 49, 61, 51, 1283, 862, 1119, 1284, 224, 484,
+	51, 879, 1991, 621, 1407, 1284, 1803, 691, 692, 693,
+	694, 695, 696, 697, 690, 48, 2075, 402, 555, 1959,
+	1489, 1490, 2070, 405, 406, 1491, 1897, 61, 1412, 19,
+	1569, 48, 408, 601, 602, 368, 550, 1827, 443, 48,
+	48, 693, 694, 695, 696, 697, 690, 574, 392, 1411,
+	1569, 2065, 859, 400, 48, 224, 2053, 51, 59, 1874,
+	234, 883, 884, 399, 440, 387, 49, 1134, 1571, 551,
+	87, 88, 388, 1963, 1964, 1965, 1966, 1967, 1968, 597,
+	49, 1486, 51, 61, 48, 1484, 49, 59, 1571, 49,
+	48, 51, 21, 48, 223, 48, 61, 48, 1042, 48,
+	233, 1290, 2016, 1741, 1796, 1337, 1338, 1339, 1729, 1290,
+	1483, 1289, 61, 1171, 58, 668, 669, 601, 602, 1289,
+	1991, 59, 1123, 1607, 1958, 573, 583, 575, 859, 1482,
+	395, 61, 390, 401, 1386, 49, 49, 51, 1571, 594,
+	397, 396, 63, 858, 1896, 61, 61, 54, 58, 1515,
+	598, 611, 1545, 61, 1285, 1286, 1288, 1757, 89, 1758,
+	1287, 1814, 1285, 1286, 1288, 1862, 641, 385, 1287, 2000,
+	2001, 49, 21, 51, 1292, 1282, 1281, 700, 640, 1935,
+	1999, 823, 1934, 1526, 1567, 1936, 1283, 869, 1157, 48,
+	1863, 1864, 564, 48, 700, 48, 48, 1284, 48, 1156,
+	647, 30, 411, 1199, 700, 615, 233, 1802, 726, 1804,
+	48, 860, 602, 1380, 48, 413, 424, 1039, 37, 858,
+	416, 1205, 660, 661, 662, 663, 684, 1356, 687, 48,
+	637, 700, 639, 638, 701, 702, 703, 704, 705, 706,
+	707, 1074, 685, 686, 683, 708, 709, 710, 711, 689,
+	688, 698, 699, 691, 692, 693, 694, 695, 696, 697,
+	690, 923, 635, 922, 439, 1357, 393, 1203, 21, 2004,
+	1917, 33, 394, 27, 1600, 1636, 649, 633, 700, 651,
+	2048, 654, 655, 53, 53, 700, 28, 194, 35, 189,
+	53, 788, 199, 1889, 61, 1293, 74, 881, 783, 52,
+	52, 1290, 2007, 1293, 29, 31, 52, 2067, 2066, 803,
+	700, 1289, 2049, 801, 214, 824, 547, 57, 63, 53,
+	1751, 49, 1848, 51, 835, 596, 837, 604, 605, 583,
+	666, 670, 1318, 489, 488, 52, 672, 19, 1412, 583,
+	620, 1597, 1427, 1330, 367, 1872, 403, 53, 404, 856,
+	1597, 1067, 1068, 1872, 1285, 1286, 1288, 1734, 53, 63,
+	1287, 1850, 49, 52, 51, 1135, 75, 2057, 878, 1653,
+	822, 398, 53, 570, 52, 53, 1121, 1471, 53, 842,
+	1945, 53, 601, 602, 1795, 897, 606, 600, 52, 851,
+	199, 52, 854, 424, 52, 714, 768, 52, 1120, 1086,
+	614, 48, 613, 1116, 53, 642, 65, 769, 756, 757,
+	758, 759, 760, 761, 762, 59, 1087, 1107, 607, 1599,
+	52, 2006, 845, 53, 802, 198, 1300, 53, 53, 1107,
+	595, 866, 56, 825, 1895, 830, 190, 53, 53, 52,
+	61, 861, 58, 52, 52, 53, 909, 870, 911, 187,
+	1882, 914, 915, 52, 52, 200, 201, 384, 939, 1735,
+	1091, 52, 877, 53, 386, 1668, 1493, 1918, 202, 1674,
+	384, 847, 2003, 852, 385, 61, 1596, 968, 968, 52,
+	829, 895, 572, 571, 386, 970, 85, 385, 831, 111,
+	898, 32, 583, 583, 899, 1293, 633, 880, 891, 882,
+	988, 1713, 1715, 96, 24, 34, 93, 36, 41, 893,
+	219, 384, 910, 1299, 94, 1033, 1256, 379, 833, 378,
+	565, 382, 383, 386, 700, 794, 61, 380, 385, 48,
+	1472, 1473, 1474, 39, 48, 978, 98, 974, 1114, 1115,
+	1117, 76, 983, 984, 1830, 2034, 2074, 1829, 1684, 1828,
+	1057, 81, 48, 200, 201, 553, 191, 1062, 73, 1064,
+	71, 90, 1073, 83, 561, 19, 202, 1678, 233, 675,
+	48, 43, 46, 45, 44, 1041, 48, 834, 215, 1680,
+	417, 10, 111, 1714, 964, 845, 19, 57, 1084, 961,
+	1088, 1026, 1027, 1089, 1090, 769, 963, 832, 2042, 966,
+	969, 1244, 79, 716, 717, 207, 40, 1049, 41, 205,
+	1953, 583, 1760, 53, 583, 1246, 1675, 1529, 1187, 1095,
+	907, 732, 731, 568, 642, 100, 940, 917, 1029, 52,
+	658, 657, 560, 856, 8, 9, 11, 678, 567, 1937,
+	204, 945, 1213, 19, 676, 19, 1056, 1073, 1142, 1143,
+	1144, 1145, 1080, 1079, 53, 943, 944, 942, 566, 1048,
+	678, 1098, 1076, 552, 1930, 1759, 95, 1096, 583, 21,
+	52, 1423, 1938, 187, 1118, 1063, 1126, 680, 1070, 1071,
+	1521, 1077, 781, 782, 918, 1422, 18, 1421, 1130, 1245,
+	1102, 677, 676, 1420, 1132, 677, 676, 1419, 677, 676,
+	1418, 420, 56, 1083, 422, 206, 1417, 1152, 678, 1092,
+	1415, 97, 678, 99, 1112, 678, 679, 939, 1151, 16,
+	1122, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1738, 982,
+	1388, 233, 53, 677, 676, 677, 676, 982, 982, 982,
+	982, 1185, 1939, 982, 982, 982, 1184, 1429, 52, 223,
+	678, 1185, 678, 1032, 1032, 1222, 592, 15, 381, 1496,
+	1324, 1313, 873, 212, 633, 229, 1138, 1676, 1677, 1679,
+	1681, 1682, 982, 982, 982, 982, 982, 982, 982, 677,
+	676, 1153, 61, 1155, 1186, 982, 1196, 1650, 1195, 642,
+	48, 48, 677, 676, 528, 1513, 678, 2015, 48, 677,
+	676, 21, 208, 1292, 1282, 1281, 2013, 677, 676, 678,
+	1164, 2014, 1057, 1236, 1514, 1283, 678, 203, 677, 676,
+	1317, 1320, 1073, 1888, 678, 1635, 1284, 1316, 977, 1133,
+	1161, 1162, 1163, 677, 676, 678, 989, 991, 992, 993,
+	1390, 1176, 591, 1651, 1028, 592, 1298, 677, 676, 48,
+	678, 592, 1301, 931, 933, 934, 583, 63, 1265, 1584,
+	932, 1887, 1627, 583, 678, 856, 592, 1584, 941, 1254,
+	1202, 1040, 610, 1043, 1044, 1045, 1046, 1047, 1149, 1585,
+	1206, 878, 1801, 648, 1050, 940, 856, 1585, 1434, 1242,
+	1332, 799, 799, 1800, 798, 1148, 1221, 799, 63, 1309,
+	648, 221, 1797, 730, 1235, 653, 1304, 1314, 1056, 652,
+	800, 800, 609, 851, 1799, 1586, 800, 50, 60, 1582,
+	1315, 1764, 648, 1342, 608, 1319, 1159, 1158, 890, 1326,
+	1290, 840, 841, 50, 838, 839, 65, 1266, 673, 671,
+	1289, 50, 50, 644, 1311, 1185, 1693, 1312, 583, 1798,
+	1348, 61, 1349, 1763, 1175, 1310, 50, 1376, 1416, 1377,
+	1139, 627, 628, 629, 730, 61, 1268, 1186, 1154, 632,
+	630, 482, 483, 1322, 665, 1321, 982, 616, 1956, 187,
+	1746, 61, 1667, 1285, 1286, 1288, 50, 1517, 2069, 1287,
+	1367, 1655, 50, 1906, 187, 50, 1413, 50, 983, 50,
+	1057, 50, 50, 898, 1294, 60, 965, 1387, 2041, 187,
+	1264, 187, 1352, 2025, 2024, 1264, 2023, 1332, 1517, 2018,
+	1104, 1947, 1944, 1943, 1747, 1430, 570, 1361, 187, 982,
+	1364, 916, 1426, 1393, 1225, 876, 1368, 1369, 1370, 1359,
+	21, 845, 1409, 875, 1441, 871, 1467, 1468, 1469, 589,
+	1366, 642, 549, 48, 1104, 1885, 1381, 216, 1481, 1719,
+	1244, 1981, 1379, 48, 1929, 1920, 1392, 1436, 583, 583,
+	1921, 1104, 1877, 1748, 1246, 1178, 61, 475, 967, 473,
+	477, 478, 479, 480, 856, 1104, 1876, 476, 481, 856,
+	63, 50, 1406, 1104, 1875, 50, 1056, 50, 50, 1550,
+	50, 1264, 1837, 1555, 1558, 1559, 1560, 1556, 50, 1557,
+	1561, 1550, 50, 1819, 1820, 1638, 50, 1104, 1784, 1098,
+	1517, 1783, 1577, 1498, 1293, 1104, 1772, 1506, 1219, 1726,
+	1725, 50, 1511, 1475, 1478, 1524, 1479, 1480, 1523, 1437,
+	1438, 1439, 1401, 1443, 1497, 1104, 1720, 1519, 1245, 1853,
+	186, 570, 1500, 1433, 1550, 187, 1392, 1435, 1104, 1666,
+	1104, 1661, 1351, 1057, 856, 1350, 634, 640, 1343, 1578,
+	1517, 1516, 1238, 1581, 1873, 1104, 1509, 1398, 65, 982,
+	1247, 1248, 1249, 1250, 1251, 1252, 1253, 233, 982, 1593,
+	1264, 1424, 1179, 187, 1527, 1264, 1335, 1150, 1510, 1104,
+	1327, 1929, 111, 1141, 583, 1307, 1306, 1574, 570, 187,
+	1605, 1234, 1580, 103, 1295, 986, 187, 1140, 1592, 637,
+	1179, 639, 638, 1104, 1103, 103, 1082, 926, 925, 1575,
+	1217, 1628, 920, 921, 48, 920, 919, 1215, 1609, 642,
+	103, 102, 1640, 1481, 1481, 1640, 1481, 1481, 856, 19,
+	1612, 21, 1137, 1652, 1620, 1573, 1608, 1179, 583, 1056,
+	1587, 1588, 1589, 1590, 1591, 1332, 856, 584, 1595, 913,
+	1517, 1606, 1572, 912, 908, 19, 1216, 1929, 1669, 364,
+	1659, 2059, 2037, 1214, 21, 1626, 1861, 986, 1752, 1610,
+	583, 1646, 1555, 1558, 1559, 1560, 1556, 1550, 1557, 1561,
+	1264, 63, 63, 50, 1104, 1664, 1665, 50, 851, 1660,
+	1179, 1197, 1657, 1658, 1499, 171, 1685, 1136, 570, 1501,
+	1602, 1663, 1641, 1642, 1643, 1644, 1645, 924, 1079, 988,
+	1052, 1051, 1492, 779, 63, 2017, 1901, 1073, 1899, 1886,
+	1304, 1778, 1398, 1819, 1820, 750, 1777, 1662, 1410, 1649,
+	1673, 1648, 1647, 1576, 487, 413, 1505, 1504, 1487, 1403,
+	1402, 1341, 22, 1633, 1328, 1323, 1297, 1239, 442, 1721,
+	1199, 1750, 1129, 22, 1125, 1061, 583, 22, 1697, 906,
+	905, 1700, 1398, 1762, 1722, 1717, 903, 1709, 1727, 1698,
+	1699, 886, 1701, 872, 853, 826, 789, 1718, 437, 48,
+	1570, 1640, 642, 622, 618, 588, 529, 530, 856, 856,
+	856, 430, 429, 418, 1768, 19, 1770, 583, 827, 1731,
+	1408, 179, 1981, 856, 1822, 1744, 1620, 1520, 1081, 1739,
+	1053, 50, 1753, 1736, 48, 791, 50, 1749, 48, 48,
+	790, 1766, 576, 534, 196, 42, 786, 1769, 1723, 1825,
+	1706, 1773, 1774, 1775, 50, 1707, 1704, 1824, 60, 1703,
+	1771, 1705, 1702, 2022, 1781, 1782, 1786, 1098, 1787, 1957,
+	844, 1780, 50, 1708, 990, 1559, 1560, 1832, 50, 1160,
+	584, 1793, 1794, 1792, 1268, 1839, 1779, 183, 184, 927,
+	857, 587, 445, 1398, 1398, 1398, 1398, 1398, 1765, 659,
+	889, 1767, 1816, 1823, 2035, 446, 781, 782, 1398, 219,
+	559, 111, 1833, 583, 1857, 554, 1835, 1836, 548, 227,
+	1563, 583, 1425, 1433, 888, 1268, 50, 1840, 1870, 821,
+	797, 795, 1785, 793, 22, 209, 1847, 1790, 1880, 856,
+	1776, 1852, 1507, 1860, 1854, 180, 181, 1859, 1838, 1127,
+	48, 48, 48, 48, 48, 527, 1858, 1737, 1869, 1612,
+	1262, 1066, 1710, 1620, 1258, 48, 1259, 1260, 1261, 1570,
+	1034, 1868, 865, 175, 1632, 1806, 1805, 1696, 176, 1257,
+	1883, 1884, 1881, 65, 1695, 1548, 1392, 543, 544, 545,
+	1834, 1672, 1671, 69, 1631, 1630, 1629, 864, 863, 1503,
+	2071, 1502, 674, 612, 67, 1745, 48, 48, 1085, 1296,
+	12, 1, 1444, 1922, 25, 23, 1891, 536, 1173, 725,
+	1844, 470, 1931, 456, 1961, 1611, 1440, 1470, 643, 1907,
+	391, 896, 1910, 844, 584, 894, 1522, 619, 26, 1728,
+	1594, 1946, 1065, 796, 1579, 1241, 1925, 1332, 1927, 1928,
+	447, 523, 1926, 1106, 1932, 375, 1844, 1602, 1844, 1908,
+	1073, 1101, 1952, 1073, 1073, 1073, 365, 1973, 48, 1788,
+	1789, 14, 1941, 1942, 1414, 1878, 784, 377, 374, 1955,
+	373, 219, 1982, 372, 1990, 1832, 1857, 1987, 1398, 1972,
+	219, 1058, 50, 50, 370, 1857, 1985, 1979, 646, 19,
+	50, 410, 1975, 1075, 415, 1880, 1976, 1960, 19, 1993,
+	1969, 1970, 1971, 1994, 583, 1997, 1996, 1977, 1978, 438,
+	1995, 110, 108, 2011, 109, 113, 1615, 1375, 60, 1562,
+	1761, 1914, 1841, 828, 48, 48, 603, 2010, 2021, 1182,
+	1598, 48, 712, 1933, 1622, 48, 1940, 1988, 223, 521,
+	1694, 50, 584, 2029, 1547, 584, 1951, 1220, 747, 2036,
+	1914, 1030, 457, 930, 2019, 469, 468, 467, 2044, 1919,
+	2045, 2030, 682, 19, 857, 1397, 1740, 1554, 1332, 1552,
+	1551, 2050, 1821, 1817, 2046, 1396, 2051, 1233, 1075, 1544,
+	1812, 182, 785, 2052, 2054, 1280, 1398, 2055, 66, 185,
+	7, 1291, 2063, 2064, 2060, 1890, 713, 715, 2062, 584,
+	2058, 1278, 1985, 6, 5, 2068, 4, 3, 1277, 1276,
+	1275, 1273, 2072, 1274, 1271, 2073, 1272, 1270, 177, 729,
+	20, 2, 219, 2076, 1985, 2078, 0, 1857, 0, 0,
+	0, 1909, 736, 737, 738, 739, 740, 741, 742, 743,
+	744, 745, 746, 48, 749, 0, 751, 752, 753, 755,
+	755, 755, 755, 755, 755, 755, 755, 1844, 772, 773,
+	774, 775, 776, 777, 778, 0, 0, 0, 0, 0,
+	0, 0, 48, 0, 985, 987, 450, 0, 1949, 1950,
+	19, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1035, 1036, 1037, 1570, 1038, 0, 0, 0,
+	0, 19, 1974, 0, 0, 982, 982, 0, 0, 836,
+	223, 0, 1914, 0, 681, 0, 0, 1542, 0, 223,
+	0, 0, 849, 0, 1844, 0, 50, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2008, 2009, 0, 0,
+	0, 0, 0, 1058, 0, 50, 0, 0, 0, 0,
+	0, 0, 735, 1075, 0, 50, 187, 0, 0, 0,
+	885, 0, 0, 748, 0, 0, 0, 0, 0, 0,
+	0, 2031, 0, 0, 1540, 187, 0, 0, 0, 900,
+	0, 901, 0, 0, 0, 0, 729, 584, 0, 0,
+	0, 0, 0, 0, 584, 0, 857, 0, 0, 689,
+	688, 698, 699, 691, 692, 693, 694, 695, 696, 697,
+	690, 0, 0, 0, 0, 1128, 0, 857, 689, 688,
+	698, 699, 691, 692, 693, 694, 695, 696, 697, 690,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1146, 1147, 0, 21, 0, 1292, 1282, 1281, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1283, 0,
+	22, 223, 0, 0, 0, 1353, 0, 0, 874, 1284,
+	0, 0, 1400, 0, 0, 0, 0, 0, 0, 844,
+	0, 736, 0, 887, 0, 0, 0, 0, 47, 689,
+	688, 698, 699, 691, 692, 693, 694, 695, 696, 697,
+	690, 0, 0, 0, 82, 0, 1172, 0, 0, 0,
+	0, 0, 91, 92, 0, 0, 1177, 0, 1180, 1181,
+	0, 0, 0, 1871, 0, 0, 50, 101, 0, 1190,
+	1191, 1058, 1192, 1193, 1194, 0, 0, 0, 22, 0,
+	22, 928, 929, 0, 935, 936, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1431, 211, 0, 0,
+	0, 0, 0, 213, 1538, 0, 217, 0, 226, 1218,
+	226, 0, 231, 1290, 1224, 0, 0, 0, 0, 0,
+	0, 1226, 1227, 1289, 1228, 1229, 1230, 1231, 1232, 60,
+	0, 0, 0, 0, 980, 0, 0, 0, 0, 584,
+	584, 735, 0, 187, 0, 0, 994, 1025, 0, 0,
+	0, 0, 0, 0, 1124, 857, 0, 0, 0, 0,
+	857, 0, 0, 0, 0, 0, 1285, 1286, 1288, 0,
+	0, 0, 1287, 0, 0, 1303, 0, 1305, 0, 0,
+	412, 0, 729, 0, 523, 0, 689, 688, 698, 699,
+	691, 692, 693, 694, 695, 696, 697, 690, 0, 1536,
+	187, 0, 531, 0, 0, 0, 535, 0, 539, 540,
+	0, 546, 1334, 0, 700, 0, 0, 0, 0, 0,
+	0, 0, 0, 558, 0, 0, 0, 562, 0, 0,
+	0, 50, 50, 700, 1058, 857, 0, 0, 0, 0,
+	0, 1094, 226, 689, 688, 698, 699, 691, 692, 693,
+	694, 695, 696, 697, 690, 0, 0, 0, 0, 1360,
+	0, 0, 0, 0, 1110, 0, 50, 0, 1188, 0,
+	50, 50, 0, 0, 0, 584, 718, 719, 720, 721,
+	722, 723, 724, 776, 778, 0, 0, 0, 0, 777,
+	1131, 0, 0, 0, 0, 0, 0, 414, 0, 0,
+	419, 0, 0, 421, 700, 0, 0, 1293, 0, 0,
+	0, 0, 0, 60, 60, 60, 60, 60, 60, 857,
+	431, 432, 433, 434, 435, 0, 0, 0, 0, 584,
+	0, 0, 0, 0, 0, 0, 0, 857, 0, 0,
+	0, 0, 729, 0, 21, 0, 1292, 1282, 1281, 0,
+	0, 0, 0, 0, 0, 0, 0, 1872, 1283, 0,
+	0, 584, 0, 0, 0, 0, 0, 0, 0, 1284,
+	0, 0, 0, 187, 0, 0, 0, 0, 1302, 0,
+	0, 0, 50, 50, 50, 50, 50, 0, 0, 0,
+	0, 1189, 0, 0, 50, 0, 0, 50, 0, 0,
+	0, 50, 0, 0, 0, 0, 1508, 0, 1075, 0,
+	0, 0, 0, 0, 617, 1333, 689, 688, 698, 699,
+	691, 692, 693, 694, 695, 696, 697, 690, 0, 0,
+	0, 0, 0, 0, 0, 0, 1188, 1223, 50, 50,
+	0, 0, 0, 0, 0, 0, 0, 584, 1530, 0,
+	0, 1531, 0, 0, 0, 0, 1532, 0, 0, 1533,
+	0, 700, 1534, 1535, 1537, 1539, 1541, 0, 0, 0,
+	0, 0, 60, 1290, 0, 0, 0, 0, 0, 857,
+	857, 857, 0, 1289, 0, 0, 0, 0, 584, 0,
+	0, 0, 0, 0, 857, 0, 0, 0, 0, 937,
+	50, 0, 946, 947, 948, 949, 950, 951, 952, 953,
+	954, 955, 956, 957, 958, 959, 960, 0, 700, 1399,
+	0, 0, 0, 0, 0, 0, 1285, 1286, 1288, 1325,
+	0, 0, 1287, 0, 0, 0, 0, 0, 0, 1340,
+	0, 0, 787, 0, 0, 0, 0, 792, 0, 689,
+	688, 698, 699, 691, 692, 693, 694, 695, 696, 697,
+	690, 0, 0, 0, 0, 226, 50, 50, 0, 0,
+	0, 0, 0, 50, 0, 1654, 0, 50, 0, 0,
+	0, 0, 0, 846, 584, 0, 0, 1170, 0, 848,
+	0, 0, 584, 0, 0, 0, 650, 0, 0, 1670,
+	1169, 0, 1378, 0, 764, 0, 0, 0, 0, 1683,
+	857, 689, 688, 698, 699, 691, 692, 693, 694, 695,
+	696, 697, 690, 0, 0, 1692, 0, 1389, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 50, 0, 766,
+	0, 0, 0, 0, 0, 1711, 0, 0, 1528, 689,
+	688, 698, 699, 691, 692, 693, 694, 695, 696, 697,
+	690, 0, 0, 0, 0, 0, 0, 1293, 0, 0,
+	0, 1543, 892, 0, 0, 224, 0, 626, 627, 628,
+	629, 0, 0, 0, 1565, 50, 632, 630, 482, 483,
+	0, 700, 0, 0, 0, 1477, 0, 154, 155, 156,
+	157, 158, 159, 160, 161, 162, 163, 1494, 0, 0,
+	22, 0, 0, 0, 50, 0, 0, 1843, 767, 0,
+	0, 0, 0, 0, 1399, 0, 114, 765, 0, 0,
+	0, 1075, 771, 770, 1075, 1075, 1075, 50, 1518, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 21,
+	0, 1292, 1282, 1281, 0, 1992, 0, 0, 0, 0,
+	1165, 1166, 1167, 1283, 0, 0, 1807, 0, 1808, 1809,
+	1810, 1811, 0, 0, 1284, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 584, 0, 21, 0, 1292,
+	1282, 1281, 0, 0, 0, 1546, 21, 1549, 1292, 1282,
+	1281, 1283, 0, 1059, 1060, 0, 0, 0, 0, 0,
+	1283, 1069, 1284, 718, 0, 0, 0, 0, 902, 904,
+	0, 1284, 0, 0, 700, 0, 0, 0, 2056, 115,
+	0, 0, 0, 0, 1601, 1399, 1399, 1399, 1399, 1399,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1565, 0, 1716, 0, 0, 0, 0, 0, 0, 0,
+	636, 0, 226, 1724, 0, 0, 1913, 0, 0, 0,
+	811, 0, 819, 1992, 820, 1637, 0, 807, 1290, 808,
+	809, 0, 0, 634, 640, 813, 700, 0, 1289, 0,
+	0, 0, 624, 1894, 812, 224, 0, 626, 627, 628,
+	629, 0, 0, 0, 0, 0, 632, 630, 482, 483,
+	0, 0, 1905, 0, 0, 0, 1290, 0, 0, 0,
+	1911, 817, 818, 0, 700, 1290, 1289, 0, 0, 0,
+	0, 1285, 1286, 1288, 810, 1289, 637, 1287, 639, 638,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 489, 488, 0, 0, 0, 0, 1344,
+	1345, 1346, 1347, 0, 0, 0, 0, 53, 0, 1285,
+	1286, 1288, 1954, 0, 0, 1287, 0, 0, 1285, 1286,
+	1288, 0, 0, 52, 1287, 0, 1354, 1355, 0, 0,
+	0, 0, 0, 0, 1269, 0, 0, 0, 0, 0,
+	1730, 1446, 1447, 1448, 1449, 1450, 1451, 1452, 1453, 1454,
+	1455, 1456, 1457, 1458, 1459, 1460, 1461, 1462, 1463, 1464,
+	1465, 1466, 0, 0, 0, 0, 0, 0, 0, 0,
+	1399, 1382, 1383, 1384, 1385, 0, 2020, 1105, 1108, 1109,
+	0, 0, 0, 0, 0, 0, 0, 816, 0, 0,
+	2026, 2027, 2028, 0, 0, 0, 0, 0, 2032, 2033,
+	0, 0, 0, 0, 0, 0, 1237, 2038, 2039, 2040,
+	137, 0, 1293, 0, 2043, 0, 1255, 0, 0, 0,
+	636, 0, 0, 815, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 61, 0,
+	1815, 735, 0, 634, 640, 0, 1476, 0, 0, 0,
+	1293, 0, 0, 1902, 1903, 1904, 0, 0, 0, 1293,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1915, 1916, 0, 0, 1923, 1924, 814, 0, 1399, 0,
+	0, 0, 0, 22, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2077, 122, 637, 973, 639, 638,
+	0, 0, 0, 0, 1867, 0, 0, 0, 0, 0,
+	1525, 0, 0, 489, 488, 0, 0, 1198, 1200, 0,
+	1201, 0, 0, 0, 0, 1204, 0, 53, 0, 0,
+	138, 0, 0, 0, 0, 0, 0, 1207, 1208, 0,
+	0, 1209, 1210, 52, 1211, 1212, 1986, 0, 22, 0,
+	0, 1893, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1898, 0, 0, 1900, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1105, 1108,
+	0, 0, 0, 0, 0, 0, 0, 1912, 154, 155,
+	156, 157, 158, 159, 160, 161, 162, 163, 0, 164,
+	165, 0, 166, 167, 168, 170, 169, 139, 140, 141,
+	145, 143, 142, 144, 116, 118, 0, 114, 117, 123,
+	119, 120, 121, 135, 124, 125, 126, 127, 128, 129,
+	130, 131, 132, 133, 134, 136, 146, 147, 148, 149,
+	150, 151, 152, 153, 0, 0, 0, 0, 972, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1986, 0, 0, 2061, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1998, 0, 0, 0, 0,
+	0, 0, 2002, 0, 1986, 0, 22, 0, 0, 0,
+	0, 0, 0, 1687, 0, 1688, 0, 1689, 0, 1690,
+	1691, 1893, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	115, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 735, 0, 0, 0, 350, 339, 0, 297, 352,
+	267, 285, 360, 287, 288, 324, 246, 307, 0, 282,
+	264, 0, 270, 239, 277, 240, 268, 299, 0, 265,
+	0, 341, 310, 0, 335, 0, 358, 0, 315, 0,
+	0, 0, 0, 0, 302, 343, 305, 333, 296, 325,
+	254, 314, 353, 283, 320, 354, 0, 1603, 0, 61,
+	0, 226, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 319, 348, 279, 363, 0, 323, 238, 317, 0,
+	244, 247, 359, 346, 274, 275, 0, 21, 0, 1292,
+	1282, 1281, 0, 301, 306, 330, 293, 0, 0, 0,
+	0, 1283, 0, 0, 0, 0, 0, 0, 0, 271,
+	0, 313, 1284, 0, 0, 251, 245, 0, 298, 0,
+	0, 0, 253, 0, 272, 331, 0, 235, 337, 344,
+	295, 0, 0, 347, 292, 291, 0, 0, 0, 0,
+	0, 0, 284, 0, 328, 361, 351, 303, 342, 269,
+	278, 0, 276, 0, 0, 0, 312, 326, 0, 0,
+	0, 0, 1198, 349, 1201, 1204, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 243, 236, 273, 334, 338, 258, 322, 248,
+	280, 329, 281, 304, 263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1616, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1290, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1289, 0, 0, 1742,
+	1743, 0, 0, 0, 0, 0, 0, 0, 0, 1624,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1285,
+	1286, 1288, 241, 0, 0, 1287, 0, 0, 242, 262,
+	345, 0, 0, 0, 0, 1625, 1623, 1619, 1618, 0,
+	0, 1791, 0, 321, 0, 0, 0, 0, 1621, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	257, 261, 255, 256, 308, 309, 355, 356, 357, 332,
+	252, 0, 259, 260, 0, 340, 0, 0, 0, 311,
+	0, 0, 0, 362, 0, 0, 0, 0, 0, 0,
+	0, 53, 286, 237, 290, 0, 0, 1845, 1846, 0,
+	0, 0, 249, 250, 1851, 0, 294, 52, 289, 316,
+	318, 327, 336, 0, 266, 300, 0, 0, 350, 339,
+	0, 297, 352, 267, 285, 360, 287, 288, 324, 246,
+	307, 0, 282, 264, 0, 270, 239, 277, 240, 268,
+	299, 0, 265, 0, 341, 310, 0, 335, 0, 358,
+	1293, 315, 0, 0, 0, 0, 0, 302, 343, 305,
+	333, 296, 325, 254, 314, 353, 283, 320, 354, 0,
+	0, 0, 61, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 348, 279, 363, 0, 323,
+	238, 317, 0, 244, 247, 359, 346, 274, 275, 0,
+	0, 0, 0, 0, 0, 0, 301, 306, 330, 293,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 271, 0, 313, 0, 0, 0, 251, 245,
+	0, 298, 764, 0, 0, 253, 0, 272, 331, 0,
+	235, 337, 344, 295, 0, 1948, 347, 292, 291, 0,
+	0, 0, 0, 0, 0, 284, 0, 328, 361, 351,
+	303, 342, 269, 278, 0, 276, 0, 766, 0, 312,
+	326, 0, 0, 0, 0, 0, 349, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 236, 273, 334, 338,
+	258, 322, 248, 280, 329, 281, 304, 263, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1754,
+	0, 0, 0, 0, 0, 154, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 0, 164, 165, 0, 166,
+	167, 168, 170, 169, 0, 962, 767, 0, 0, 0,
+	0, 0, 1624, 0, 114, 765, 0, 0, 0, 0,
+	771, 770, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 242, 262, 345, 0, 0, 0, 0, 1625, 1623,
+	0, 0, 0, 0, 0, 0, 321, 0, 0, 0,
+	0, 1621, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 261, 255, 256, 308, 309, 355,
+	356, 357, 332, 252, 0, 259, 260, 0, 340, 0,
+	0, 0, 311, 0, 0, 0, 362, 115, 0, 0,
+	0, 0, 0, 0, 53, 286, 237, 290, 0, 0,
+	0, 0, 0, 0, 0, 249, 250, 0, 0, 294,
+	52, 289, 316, 318, 327, 336, 0, 266, 300, 350,
+	339, 0, 297, 352, 267, 285, 360, 287, 288, 324,
+	246, 307, 0, 282, 264, 0, 270, 239, 277, 240,
+	268, 299, 0, 265, 0, 341, 310, 0, 335, 0,
+	358, 0, 315, 0, 0, 0, 0, 0, 302, 343,
+	305, 333, 296, 325, 254, 314, 353, 283, 320, 354,
+	0, 0, 0, 61, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 319, 348, 279, 363, 0,
+	323, 238, 317, 0, 244, 247, 359, 346, 274, 275,
+	0, 0, 0, 0, 0, 0, 0, 301, 306, 330,
+	293, 0, 0, 0, 0, 0, 1371, 0, 0, 0,
+	0, 0, 0, 271, 0, 313, 0, 0, 0, 251,
+	245, 0, 298, 0, 0, 0, 253, 0, 272, 331,
+	0, 235, 337, 344, 295, 0, 0, 347, 292, 291,
+	0, 1372, 0, 0, 0, 0, 284, 0, 328, 361,
+	351, 303, 342, 269, 278, 0, 276, 0, 0, 0,
+	312, 326, 0, 0, 0, 0, 0, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 243, 236, 273, 334,
+	338, 258, 322, 248, 280, 329, 281, 304, 263, 1007,
+	1013, 1011, 0, 0, 1008, 0, 0, 1006, 0, 0,
+	1015, 0, 0, 1014, 1000, 1010, 1012, 1009, 1374, 0,
+	1373, 0, 1017, 1016, 1018, 997, 1020, 0, 0, 0,
+	1024, 1021, 1023, 1022, 0, 1019, 0, 0, 0, 0,
+	0, 0, 0, 1624, 1001, 1002, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1003, 1005, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
+	0, 0, 242, 262, 345, 0, 0, 0, 0, 1625,
+	1623, 0, 0, 0, 0, 0, 0, 321, 0, 0,
+	0, 0, 1621, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 257, 261, 255, 256, 308, 309,
+	355, 356, 357, 332, 252, 0, 259, 260, 0, 340,
+	0, 0, 0, 311, 0, 0, 0, 362, 0, 0,
+	0, 0, 0, 0, 0, 53, 286, 237, 290, 0,
+	0, 0, 0, 0, 0, 0, 249, 250, 0, 0,
+	294, 52, 289, 316, 318, 327, 336, 0, 266, 300,
+	350, 339, 0, 297, 352, 267, 285, 360, 287, 288,
+	324, 246, 307, 0, 282, 264, 0, 270, 239, 277,
+	240, 268, 299, 0, 265, 0, 341, 310, 0, 335,
+	0, 358, 0, 315, 0, 0, 0, 0, 0, 302,
+	343, 305, 333, 296, 325, 254, 314, 353, 283, 320,
+	354, 0, 0, 0, 61, 0, 1099, 0, 1100, 0,
+	0, 0, 0, 0, 0, 0, 319, 348, 279, 363,
+	0, 323, 238, 317, 0, 244, 247, 359, 346, 274,
+	275, 0, 21, 0, 1292, 1282, 1281, 0, 301, 306,
+	330, 293, 0, 0, 0, 0, 1283, 0, 0, 0,
+	0, 0, 0, 0, 271, 0, 313, 1284, 0, 0,
+	251, 245, 0, 298, 0, 0, 0, 253, 0, 272,
+	331, 0, 235, 337, 344, 295, 0, 0, 347, 292,
+	291, 0, 0, 0, 0, 0, 0, 284, 0, 328,
+	361, 351, 303, 342, 269, 278, 0, 276, 0, 0,
+	0, 312, 326, 0, 0, 0, 0, 0, 349, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 236, 273,
+	334, 338, 258, 322, 248, 280, 329, 281, 304, 263,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1290, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1289, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 811, 0, 819, 0, 820, 806, 0, 807,
+	0, 808, 809, 0, 0, 0, 0, 813, 0, 0,
+	0, 0, 0, 0, 0, 0, 812, 0, 0, 0,
+	0, 0, 0, 0, 1285, 1286, 1288, 241, 0, 0,
+	1287, 0, 0, 242, 262, 345, 0, 0, 0, 0,
+	1634, 585, 0, 817, 818, 0, 0, 0, 321, 0,
+	0, 0, 0, 0, 0, 0, 810, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 257, 261, 255, 256, 308,
+	309, 355, 356, 357, 332, 252, 0, 259, 260, 0,
+	340, 0, 0, 0, 311, 0, 0, 0, 362, 0,
+	0, 0, 0, 0, 0, 0, 53, 286, 237, 290,
+	0, 0, 0, 0, 0, 0, 0, 249, 250, 0,
+	0, 294, 52, 289, 316, 318, 327, 336, 0, 266,
+	300, 350, 339, 0, 297, 352, 267, 285, 360, 287,
+	288, 324, 246, 307, 0, 282, 264, 0, 270, 239,
+	277, 240, 268, 299, 0, 265, 0, 341, 310, 0,
+	335, 137, 358, 0, 315, 1293, 0, 0, 0, 816,
+	302, 343, 305, 333, 296, 325, 254, 314, 353, 283,
+	320, 354, 0, 0, 0, 224, 0, 51, 0, 61,
+	0, 0, 0, 0, 0, 0, 0, 319, 348, 279,
+	363, 0, 323, 238, 317, 815, 244, 247, 359, 346,
+	274, 275, 0, 0, 0, 0, 0, 0, 0, 301,
+	306, 330, 293, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1365, 0, 271, 0, 313, 0, 0,
+	0, 251, 245, 0, 298, 0, 122, 0, 253, 0,
+	272, 331, 0, 235, 337, 344, 295, 0, 814, 347,
+	292, 291, 0, 0, 0, 0, 0, 0, 284, 0,
+	328, 361, 351, 303, 342, 269, 278, 0, 276, 0,
+	0, 138, 312, 326, 0, 0, 0, 0, 0, 349,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 243, 236,
+	273, 334, 338, 258, 322, 248, 280, 329, 281, 304,
+	263, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 154,
+	155, 156, 157, 158, 159, 160, 161, 162, 163, 0,
+	164, 165, 0, 166, 167, 168, 170, 169, 139, 140,
+	141, 145, 143, 142, 144, 116, 118, 0, 114, 117,
+	123, 119, 120, 121, 135, 124, 125, 126, 127, 128,
+	129, 130, 131, 132, 133, 134, 136, 146, 147, 148,
+	149, 150, 151, 152, 153, 0, 0, 0, 241, 0,
+	0, 0, 0, 0, 242, 262, 345, 0, 0, 0,
+	0, 0, 585, 0, 0, 0, 0, 0, 0, 321,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 257, 261, 255, 256,
+	308, 309, 355, 356, 357, 332, 252, 0, 259, 260,
+	0, 340, 0, 0, 0, 311, 0, 0, 0, 362,
+	0, 115, 0, 0, 0, 0, 0, 53, 286, 237,
+	290, 0, 0, 0, 0, 0, 0, 0, 249, 250,
+	0, 0, 294, 52, 289, 316, 318, 327, 336, 0,
+	266, 300, 350, 339, 0, 297, 352, 267, 285, 360,
+	287, 288, 324, 246, 307, 0, 282, 264, 0, 270,
+	239, 277, 240, 268, 299, 0, 265, 0, 341, 310,
+	0, 335, 0, 358, 0, 315, 0, 0, 0, 0,
+	0, 302, 343, 305, 333, 296, 325, 254, 314, 353,
+	283, 320, 354, 0, 579, 0, 577, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 582, 0, 319, 348,
+	279, 363, 0, 323, 238, 317, 0, 244, 247, 359,
+	346, 274, 275, 0, 0, 0, 0, 0, 0, 0,
+	301, 306, 330, 293, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 271, 0, 313, 0,
+	0, 0, 251, 245, 0, 298, 0, 0, 0, 253,
+	0, 272, 331, 0, 235, 337, 344, 295, 0, 0,
+	347, 292, 291, 0, 0, 0, 0, 0, 0, 284,
+	0, 328, 361, 351, 303, 342, 269, 278, 0, 276,
+	0, 0, 0, 312, 326, 0, 0, 0, 0, 0,
+	349, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 243,
+	236, 273, 334, 338, 258, 322, 248, 280, 329, 281,
+	304, 263, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 811, 0, 819, 0, 820, 1078, 0, 807, 0,
+	808, 809, 0, 0, 0, 0, 813, 0, 0, 0,
+	0, 0, 0, 0, 0, 812, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 241,
+	0, 0, 817, 818, 0, 242, 262, 345, 0, 0,
+	0, 0, 0, 585, 0, 810, 0, 0, 0, 0,
+	321, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 257, 261, 255,
+	256, 308, 309, 355, 356, 357, 332, 252, 0, 259,
+	260, 0, 340, 0, 0, 0, 311, 0, 0, 0,
+	580, 0, 0, 0, 0, 0, 0, 0, 53, 286,
+	237, 290, 0, 0, 0, 0, 0, 0, 0, 249,
+	250, 0, 0, 294, 52, 289, 316, 318, 327, 336,
+	0, 266, 300, 350, 339, 0, 297, 352, 267, 285,
+	360, 287, 288, 324, 246, 307, 0, 282, 264, 0,
+	270, 239, 277, 240, 268, 299, 0, 265, 816, 341,
+	310, 0, 335, 0, 358, 0, 315, 0, 0, 0,
+	0, 0, 302, 343, 305, 333, 296, 325, 254, 314,
+	353, 283, 320, 354, 0, 0, 0, 61, 0, 0,
+	0, 0, 0, 0, 815, 0, 0, 0, 0, 319,
+	348, 279, 363, 0, 323, 238, 317, 0, 244, 247,
+	359, 346, 274, 275, 0, 0, 0, 0, 0, 0,
+	0, 301, 306, 330, 293, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1686, 0, 271, 0, 313,
+	0, 0, 0, 251, 245, 0, 298, 814, 0, 0,
+	253, 0, 272, 331, 0, 235, 337, 344, 295, 0,
+	0, 347, 292, 291, 0, 0, 0, 0, 0, 0,
+	284, 0, 328, 361, 351, 303, 342, 269, 278, 0,
+	276, 0, 0, 0, 312, 326, 0, 0, 0, 0,
+	0, 349, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	243, 236, 273, 334, 338, 258, 322, 248, 280, 329,
+	281, 304, 263, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	241, 0, 0, 0, 0, 0, 242, 262, 345, 0,
+	0, 0, 0, 0, 585, 0, 0, 0, 0, 0,
+	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 257, 261,
+	255, 256, 308, 309, 355, 356, 357, 332, 252, 0,
+	259, 260, 0, 340, 0, 0, 0, 311, 0, 0,
+	0, 362, 0, 0, 0, 0, 0, 0, 0, 53,
+	286, 237, 290, 0, 0, 0, 0, 0, 0, 0,
+	249, 250, 0, 0, 294, 52, 289, 316, 318, 327,
+	336, 0, 266, 300, 350, 339, 0, 297, 352, 267,
+	285, 360, 287, 288, 324, 246, 307, 0, 282, 264,
+	0, 270, 239, 277, 240, 268, 299, 0, 265, 0,
+	341, 310, 0, 335, 0, 358, 0, 315, 0, 0,
+	0, 0, 0, 302, 343, 305, 333, 296, 325, 254,
+	314, 353, 283, 320, 354, 0, 0, 0, 61, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	319, 348, 279, 363, 0, 323, 238, 317, 0, 244,
+	247, 359, 346, 274, 275, 1656, 0, 0, 0, 0,
+	0, 0, 301, 306, 330, 293, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 271, 0,
+	313, 0, 0, 0, 251, 245, 0, 298, 0, 0,
+	0, 253, 0, 272, 331, 0, 235, 337, 344, 295,
+	0, 0, 347, 292, 291, 0, 0, 0, 0, 0,
+	0, 284, 0, 328, 361, 351, 303, 342, 269, 278,
+	0, 276, 0, 0, 0, 312, 326, 0, 0, 0,
+	0, 0, 349, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 243, 236, 273, 334, 338, 258, 322, 248, 280,
+	329, 281, 304, 263, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 241, 0, 0, 0, 0, 0, 242, 262, 345,
+	0, 0, 0, 0, 0, 585, 0, 0, 0, 0,
+	0, 0, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 257,
+	261, 255, 256, 308, 309, 355, 356, 357, 332, 252,
+	0, 259, 260, 0, 340, 0, 0, 0, 311, 0,
+	0, 0, 362, 0, 0, 0, 0, 0, 0, 0,
+	53, 286, 237, 290, 0, 0, 0, 0, 0, 0,
+	0, 249, 250, 0, 0, 294, 52, 289, 316, 318,
+	327, 336, 0, 266, 300, 350, 339, 0, 297, 352,
+	267, 285, 360, 287, 288, 324, 246, 307, 0, 282,
+	264, 0, 270, 239, 277, 240, 268, 299, 0, 265,
+	0, 341, 310, 0, 335, 0, 358, 0, 315, 0,
+	0, 0, 0, 0, 302, 343, 305, 333, 296, 325,
+	254, 314, 353, 283, 320, 354, 0, 0, 0, 61,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 582,
+	0, 319, 348, 279, 363, 0, 323, 238, 317, 0,
+	244, 247, 359, 346, 274, 275, 0, 0, 0, 0,
+	0, 0, 0, 301, 306, 330, 293, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 271,
+	0, 313, 0, 0, 0, 251, 245, 0, 298, 0,
+	0, 0, 253, 0, 272, 331, 0, 235, 337, 344,
+	295, 0, 0, 347, 292, 291, 0, 0, 0, 0,
+	0, 0, 284, 0, 328, 361, 351, 303, 342, 269,
+	278, 0, 276, 0, 0, 0, 312, 326, 0, 0,
+	0, 0, 0, 349, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 243, 236, 273, 334, 338, 258, 322, 248,
+	280, 329, 281, 304, 263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 241, 0, 0, 0, 0, 0, 242, 262,
+	345, 0, 0, 0, 0, 0, 585, 0, 0, 0,
+	0, 0, 0, 321, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	257, 261, 255, 256, 308, 309, 355, 356, 357, 332,
+	252, 0, 259, 260, 0, 340, 0, 0, 0, 311,
+	0, 0, 0, 362, 0, 0, 0, 0, 0, 0,
+	0, 53, 286, 237, 290, 0, 0, 0, 0, 0,
+	0, 0, 249, 250, 0, 0, 294, 52, 289, 316,
+	318, 327, 336, 0, 266, 300, 350, 339, 0, 297,
+	352, 267, 285, 360, 287, 288, 324, 246, 307, 0,
+	282, 264, 0, 270, 239, 277, 240, 268, 299, 0,
+	265, 0, 341, 310, 0, 335, 0, 358, 0, 315,
+	0, 0, 0, 0, 0, 302, 343, 305, 333, 296,
+	325, 254, 314, 353, 283, 320, 354, 0, 0, 0,
+	61, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 319, 348, 279, 363, 0, 323, 238, 317,
+	0, 244, 247, 359, 346, 274, 275, 1308, 0, 0,
+	0, 0, 0, 0, 301, 306, 330, 293, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	271, 0, 313, 0, 0, 0, 251, 245, 0, 298,
+	0, 0, 0, 253, 0, 272, 331, 0, 235, 337,
+	344, 295, 0, 0, 347, 292, 291, 0, 0, 0,
+	0, 0, 0, 284, 0, 328, 361, 351, 303, 342,
+	269, 278, 0, 276, 0, 0, 0, 312, 326, 0,
+	0, 0, 0, 0, 349, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 243, 236, 273, 334, 338, 258, 322,
+	248, 280, 329, 281, 304, 263, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 241, 0, 0, 0, 0, 0, 242,
+	262, 345, 0, 0, 0, 0, 0, 585, 0, 0,
+	0, 0, 0, 0, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 257, 261, 255, 256, 308, 309, 355, 356, 357,
+	332, 252, 0, 259, 260, 0, 340, 0, 0, 0,
+	311, 0, 0, 0, 362, 0, 0, 0, 0, 0,
+	0, 0, 53, 286, 237, 290, 0, 0, 0, 0,
+	0, 0, 0, 249, 250, 0, 0, 294, 52, 289,
+	316, 318, 327, 336, 0, 266, 300, 350, 339, 0,
+	297, 352, 267, 285, 360, 287, 288, 324, 246, 307,
+	0, 282, 264, 0, 270, 239, 277, 240, 268, 299,
+	0, 265, 0, 341, 310, 0, 335, 0, 358, 0,
+	315, 0, 0, 0, 0, 0, 302, 343, 305, 333,
+	296, 325, 254, 314, 353, 283, 320, 354, 0, 0,
+	0, 224, 0, 51, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 319, 348, 279, 363, 0, 323, 238,
+	317, 0, 244, 247, 359, 346, 274, 275, 0, 0,
+	0, 0, 0, 0, 0, 301, 306, 330, 293, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 271, 0, 313, 0, 0, 0, 251, 245, 0,
+	298, 0, 0, 0, 253, 0, 272, 331, 0, 235,
+	337, 344, 295, 0, 0, 347, 292, 291, 0, 0,
+	0, 0, 0, 0, 284, 0, 328, 361, 351, 303,
+	342, 269, 278, 0, 276, 0, 0, 0, 312, 326,
+	0, 0, 0, 0, 0, 349, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 243, 236, 273, 334, 338, 258,
+	322, 248, 280, 329, 281, 304, 263, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 241, 0, 0, 0, 0, 0,
+	242, 262, 345, 0, 0, 0, 0, 0, 585, 0,
+	0, 0, 0, 0, 0, 321, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 257, 261, 255, 256, 308, 309, 355, 356,
+	357, 332, 252, 0, 259, 260, 0, 340, 0, 0,
+	0, 311, 0, 0, 0, 362, 0, 0, 0, 0,
+	0, 0, 0, 53, 286, 237, 290, 0, 0, 0,
+	0, 0, 0, 0, 249, 250, 0, 0, 294, 52,
+	289, 316, 318, 327, 336, 0, 266, 300, 350, 339,
+	0, 297, 352, 267, 285, 360, 287, 288, 324, 246,
+	307, 0, 282, 264, 0, 270, 239, 277, 240, 268,
+	299, 0, 265, 0, 341, 310, 0, 335, 0, 358,
+	0, 315, 0, 0, 0, 0, 0, 302, 343, 305,
+	333, 296, 325, 254, 314, 353, 283, 320, 354, 0,
+	0, 0, 61, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 319, 348, 279, 363, 0, 323,
+	238, 317, 0, 244, 247, 359, 346, 274, 275, 850,
+	0, 0, 0, 0, 0, 0, 301, 306, 330, 293,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 271, 0, 313, 0, 0, 0, 251, 245,
+	0, 298, 0, 0, 0, 253, 0, 272, 331, 0,
+	235, 337, 344, 295, 0, 0, 347, 292, 291, 0,
+	0, 0, 0, 0, 0, 284, 0, 328, 361, 351,
+	303, 342, 269, 278, 0, 276, 0, 0, 0, 312,
+	326, 0, 0, 0, 0, 0, 349, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 243, 236, 273, 334, 338,
+	258, 322, 248, 280, 329, 281, 304, 263, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 241, 0, 0, 0, 0,
+	0, 242, 262, 345, 0, 0, 0, 0, 0, 585,
+	0, 0, 0, 0, 0, 0, 321, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 257, 261, 255, 256, 308, 309, 355,
+	356, 357, 332, 252, 0, 259, 260, 0, 340, 0,
+	0, 0, 311, 0, 0, 0, 362, 0, 0, 0,
+	0, 0, 0, 0, 53, 286, 237, 290, 0, 0,
+	0, 0, 0, 0, 0, 249, 250, 0, 0, 294,
+	52, 289, 316, 318, 327, 336, 0, 266, 300, 350,
+	339, 0, 297, 352, 267, 285, 360, 287, 288, 324,
+	246, 307, 0, 282, 264, 0, 270, 239, 277, 240,
+	268, 299, 0, 265, 0, 341, 310, 0, 335, 0,
+	358, 0, 315, 0, 0, 0, 0, 0, 302, 343,
+	305, 333, 296, 325, 254, 314, 353, 283, 320, 354,
+	0, 0, 0, 61, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 319, 348, 279, 363, 0,
+	323, 238, 317, 0, 244, 247, 359, 346, 274, 275,
+	0, 0, 0, 0, 0, 0, 0, 301, 306, 330,
+	293, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 271, 0, 313, 0, 0, 0, 251,
+	245, 0, 298, 0, 0, 0, 253, 0, 272, 331,
+	0, 235, 337, 344, 295, 0, 0, 347, 292, 291,
+	0, 0, 0, 0, 0, 0, 284, 0, 328, 361,
+	351, 303, 342, 269, 278, 0, 276, 0, 0, 0,
+	312, 326, 0, 0, 0, 0, 0, 349, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 243, 236, 273, 334,
+	338, 258, 322, 248, 280, 329, 281, 304, 263, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 241, 0, 0, 0,
+	0, 0, 242, 262, 345, 0, 0, 0, 0, 0,
+	585, 0, 0, 0, 0, 0, 0, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 257, 261, 255, 256, 308, 309,
+	355, 356, 357, 332, 252, 0, 259, 260, 0, 340,
+	0, 0, 0, 311, 0, 0, 0, 362, 0, 0,
+	0, 0, 0, 0, 0, 53, 286, 237, 290, 0,
+	0, 0, 0, 0, 0, 0, 249, 250, 0, 0,
+	294, 52, 289, 316, 318, 327, 336, 0, 266, 300,
+	350, 339, 0, 297, 352, 267, 285, 360, 287, 288,
+	324, 246, 307, 0, 282, 264, 0, 270, 239, 277,
+	240, 268, 299, 0, 265, 0, 341, 310, 0, 335,
+	0, 358, 0, 315, 0, 0, 0, 0, 0, 302,
+	343, 305, 333, 296, 325, 254, 314, 353, 283, 320,
+	354, 0, 0, 0, 49, 0, 51, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 319, 348, 279, 363,
+	0, 323, 238, 317, 0, 244, 247, 359, 346, 274,
+	275, 0, 0, 0, 0, 0, 0, 0, 301, 306,
+	330, 293, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 271, 0, 313, 0, 0, 0,
+	251, 245, 0, 298, 0, 0, 0, 253, 0, 272,
+	331, 0, 235, 337, 344, 295, 0, 0, 347, 292,
+	291, 0, 0, 0, 0, 0, 0, 284, 0, 328,
+	361, 351, 303, 342, 269, 278, 0, 276, 0, 0,
+	0, 312, 326, 0, 0, 0, 0, 0, 349, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 243, 236, 273,
+	334, 338, 258, 322, 248, 280, 329, 281, 304, 263,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 452, 0, 0, 0, 0, 451, 0, 0,
+	0, 0, 220, 0, 499, 0, 500, 222, 0, 0,
+	0, 0, 0, 0, 490, 491, 0, 0, 0, 0,
+	0, 0, 0, 0, 63, 0, 0, 224, 475, 472,
+	473, 477, 478, 479, 480, 0, 0, 0, 476, 481,
+	482, 483, 0, 0, 0, 0, 449, 464, 0, 498,
+	0, 0, 0, 0, 0, 0, 0, 241, 0, 0,
+	0, 0, 0, 242, 262, 345, 0, 0, 0, 0,
+	0, 0, 0, 461, 462, 0, 0, 0, 321, 515,
+	0, 463, 0, 0, 996, 460, 465, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 513, 0, 257, 261, 255, 256, 308,
+	309, 355, 356, 357, 332, 252, 0, 259, 260, 998,
+	340, 0, 0, 0, 311, 0, 0, 0, 362, 0,
+	0, 0, 0, 0, 0, 0, 53, 286, 237, 290,
+	0, 471, 0, 0, 0, 0, 0, 249, 250, 0,
+	0, 294, 52, 289, 316, 318, 327, 336, 0, 266,
+	300, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1007, 1013, 1011,
+	0, 0, 1008, 0, 0, 1006, 0, 0, 1015, 0,
+	0, 1014, 1000, 1010, 1012, 1009, 1004, 0, 999, 0,
+	1017, 1016, 1018, 997, 1020, 0, 0, 0, 1024, 1021,
+	1023, 1022, 501, 1019, 0, 0, 0, 0, 0, 0,
+	0, 0, 1001, 1002, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 520, 0, 502, 503, 0, 0, 0,
+	0, 0, 1003, 1005, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 485, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 504, 514,
+	510, 511, 508, 509, 507, 506, 505, 516, 492, 493,
+	494, 495, 497, 0, 0, 489, 488, 496, 0, 0,
+	452, 0, 0, 0, 0, 451, 0, 0, 0, 53,
+	220, 0, 499, 0, 500, 222, 0, 0, 0, 0,
+	0, 0, 490, 491, 0, 52, 0, 0, 0, 0,
+	1865, 0, 63, 0, 512, 224, 475, 472, 473, 477,
+	478, 479, 480, 0, 0, 0, 476, 481, 482, 483,
+	1866, 0, 0, 0, 449, 464, 0, 498, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 461, 462, 0, 0, 0, 0, 515, 0, 463,
+	0, 0, 459, 460, 465, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 513, 0, 0, 0, 0, 976, 0, 452, 0,
+	0, 0, 0, 451, 0, 0, 0, 517, 220, 0,
+	499, 0, 500, 222, 0, 0, 0, 0, 0, 0,
+	490, 491, 0, 0, 0, 0, 0, 0, 0, 471,
+	63, 0, 0, 224, 475, 472, 473, 477, 478, 479,
+	480, 0, 0, 0, 476, 481, 482, 483, 0, 0,
+	0, 0, 449, 464, 0, 498, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 461,
+	462, 981, 0, 0, 518, 515, 519, 463, 0, 0,
+	459, 460, 465, 0, 0, 0, 0, 0, 0, 0,
+	501, 0, 0, 0, 0, 0, 0, 0, 0, 513,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 520, 0, 502, 503, 517, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 471, 0, 0,
+	0, 0, 0, 0, 485, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 504, 514, 510, 511,
+	508, 509, 507, 506, 505, 516, 492, 493, 494, 495,
+	497, 0, 0, 489, 488, 496, 0, 0, 0, 0,
+	0, 0, 518, 0, 519, 0, 0, 53, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 501, 0,
+	0, 0, 0, 52, 0, 0, 0, 0, 0, 0,
+	0, 0, 512, 0, 0, 0, 0, 0, 0, 520,
+	0, 502, 503, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 485, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 504, 514, 510, 511, 508, 509,
+	507, 506, 505, 516, 492, 493, 494, 495, 497, 0,
+	0, 489, 488, 496, 0, 0, 0, 452, 0, 0,
+	0, 0, 451, 0, 0, 53, 0, 220, 0, 499,
+	0, 500, 222, 0, 0, 0, 0, 0, 0, 490,
+	491, 52, 0, 0, 0, 0, 0, 0, 0, 63,
+	512, 187, 224, 475, 472, 473, 477, 478, 479, 480,
+	0, 0, 0, 476, 481, 482, 483, 0, 0, 0,
+	0, 449, 464, 0, 498, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 461, 462,
+	0, 0, 0, 0, 515, 0, 463, 0, 0, 459,
+	460, 465, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 513, 0,
+	0, 0, 0, 0, 0, 452, 0, 0, 0, 0,
+	451, 0, 0, 0, 517, 220, 0, 499, 0, 500,
+	222, 0, 0, 0, 0, 0, 0, 490, 491, 0,
+	0, 0, 0, 0, 0, 0, 471, 63, 0, 0,
+	224, 475, 472, 473, 477, 478, 479, 480, 0, 0,
+	0, 476, 481, 482, 483, 0, 0, 0, 0, 449,
+	464, 0, 498, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 461, 462, 981, 0,
+	0, 518, 515, 519, 463, 0, 0, 459, 460, 465,
+	0, 0, 0, 0, 0, 0, 0, 501, 0, 0,
+	0, 0, 0, 0, 0, 0, 513, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 520, 0,
+	502, 503, 517, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 471, 0, 0, 0, 0, 0,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 504, 514, 510, 511, 508, 509, 507,
+	506, 505, 516, 492, 493, 494, 495, 497, 0, 0,
+	489, 488, 496, 0, 0, 0, 0, 0, 0, 518,
+	0, 519, 0, 0, 53, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 501, 0, 0, 0, 0,
+	52, 0, 0, 0, 0, 0, 0, 0, 0, 512,
+	0, 0, 0, 0, 0, 0, 520, 0, 502, 503,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 504, 514, 510, 511, 508, 509, 507, 506, 505,
+	516, 492, 493, 494, 495, 497, 0, 0, 489, 488,
+	496, 21, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 53, 0, 0, 0, 0, 0, 0, 452,
+	0, 0, 0, 0, 451, 0, 0, 0, 52, 220,
+	0, 499, 0, 500, 222, 0, 0, 512, 0, 0,
+	0, 490, 491, 0, 0, 0, 0, 0, 0, 0,
+	0, 63, 0, 0, 224, 475, 472, 473, 477, 478,
+	479, 480, 0, 0, 0, 476, 481, 482, 483, 0,
+	0, 0, 0, 449, 464, 0, 498, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	461, 462, 0, 0, 0, 0, 515, 0, 463, 0,
+	0, 459, 460, 465, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	513, 0, 0, 0, 0, 0, 0, 452, 0, 0,
+	0, 0, 451, 0, 0, 0, 517, 220, 0, 499,
+	0, 500, 222, 0, 0, 0, 0, 0, 0, 490,
+	491, 0, 0, 0, 0, 0, 0, 0, 471, 63,
+	0, 0, 224, 475, 472, 473, 477, 478, 479, 480,
+	0, 0, 0, 476, 481, 482, 483, 0, 0, 0,
+	0, 449, 464, 0, 498, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 461, 462,
+	0, 0, 0, 518, 515, 519, 463, 0, 0, 459,
+	460, 465, 0, 0, 0, 0, 0, 0, 0, 501,
+	0, 0, 0, 0, 0, 0, 0, 0, 513, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	520, 0, 502, 503, 517, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 471, 0, 0, 0,
+	0, 0, 0, 485, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 504, 514, 510, 511, 508,
+	509, 507, 506, 505, 516, 492, 493, 494, 495, 497,
+	0, 0, 489, 488, 496, 0, 0, 0, 0, 0,
+	0, 518, 0, 519, 0, 0, 53, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 501, 0, 0,
+	0, 0, 52, 0, 0, 0, 0, 0, 0, 0,
+	0, 512, 0, 0, 0, 0, 0, 0, 520, 0,
+	502, 503, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 485, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 504, 514, 510, 511, 508, 509, 507,
+	506, 505, 516, 492, 493, 494, 495, 497, 0, 0,
+	489, 488, 496, 0, 0, 452, 0, 0, 0, 0,
+	0, 0, 0, 0, 53, 220, 0, 499, 0, 500,
+	222, 0, 0, 0, 0, 0, 0, 490, 491, 0,
+	52, 0, 0, 0, 0, 0, 0, 63, 0, 512,
+	224, 475, 472, 473, 477, 478, 479, 480, 0, 0,
+	0, 476, 481, 482, 483, 0, 0, 0, 0, 0,
+	464, 0, 498, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 461, 462, 0, 0,
+	0, 0, 515, 0, 463, 0, 0, 459, 460, 465,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 513, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 517, 220, 0, 499, 0, 500, 222, 0,
+	0, 0, 0, 0, 0, 490, 491, 0, 0, 0,
+	0, 0, 0, 0, 471, 63, 0, 0, 224, 475,
+	472, 473, 477, 478, 479, 480, 0, 0, 0, 476,
+	481, 482, 483, 0, 0, 0, 0, 0, 464, 0,
+	498, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 461, 462, 0, 0, 0, 518,
+	515, 519, 463, 0, 0, 459, 460, 465, 0, 0,
+	0, 0, 0, 0, 0, 501, 0, 0, 0, 0,
+	0, 0, 0, 0, 513, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 520, 0, 502, 503,
+	517, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 471, 0, 0, 0, 0, 0, 0, 485,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 504, 514, 510, 511, 508, 509, 507, 506, 505,
+	516, 492, 493, 494, 495, 497, 0, 0, 489, 488,
+	496, 0, 0, 0, 0, 0, 0, 518, 0, 519,
+	0, 0, 53, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 501, 0, 0, 0, 0, 52, 0,
+	0, 0, 0, 0, 0, 0, 0, 512, 0, 0,
+	0, 0, 0, 0, 520, 0, 502, 503, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 485, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 504,
+	514, 510, 511, 508, 509, 507, 506, 505, 516, 492,
+	493, 494, 495, 497, 0, 0, 489, 488, 496, 0,
+	0, 0, 220, 0, 499, 0, 500, 222, 0, 0,
+	53, 0, 0, 0, 490, 491, 0, 0, 0, 0,
+	0, 0, 0, 0, 1199, 0, 52, 224, 475, 472,
+	473, 477, 478, 479, 480, 512, 0, 0, 476, 481,
+	482, 483, 0, 0, 0, 0, 0, 464, 0, 498,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 461, 462, 0, 0, 0, 0, 515,
+	0, 463, 0, 0, 459, 460, 465, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 513, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 517,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 471, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 137, 0, 0,
+	59, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1486, 0, 61, 518, 1484, 519, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 501, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1483, 137, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 520, 0, 502, 503, 0, 0, 0,
+	0, 1482, 0, 0, 0, 0, 0, 0, 556, 0,
+	0, 61, 122, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 485, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 504, 514,
+	510, 511, 508, 509, 507, 506, 505, 516, 492, 493,
+	494, 495, 497, 0, 0, 489, 488, 496, 122, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 53,
+	0, 557, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 52, 0, 0, 0, 0,
+	0, 0, 0, 138, 512, 154, 155, 156, 157, 158,
+	159, 160, 161, 162, 163, 0, 164, 165, 0, 166,
+	167, 168, 170, 169, 139, 140, 141, 145, 143, 142,
+	144, 116, 118, 0, 114, 117, 123, 119, 120, 121,
+	135, 124, 125, 126, 127, 128, 129, 130, 131, 132,
+	133, 134, 136, 146, 147, 148, 149, 150, 151, 152,
+	153, 154, 155, 156, 157, 158, 159, 160, 161, 162,
+	163, 0, 164, 165, 0, 166, 167, 168, 170, 169,
+	139, 140, 141, 145, 143, 142, 144, 116, 118, 137,
+	114, 117, 123, 119, 120, 121, 135, 124, 125, 126,
+	127, 128, 129, 130, 131, 132, 133, 134, 136, 146,
+	147, 148, 149, 150, 151, 152, 153, 61, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 115, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 53, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 52, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 122, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 115, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1613, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 154, 155, 156,
+	157, 158, 159, 160, 161, 162, 163, 0, 164, 165,
+	0, 166, 167, 168, 170, 169, 139, 140, 141, 145,
+	143, 142, 144, 116, 118, 0, 114, 117, 123, 119,
+	120, 121, 135, 124, 125, 126, 127, 128, 129, 130,
+	131, 132, 133, 134, 136, 146, 147, 148, 149, 150,
+	151, 152, 153, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 115,
 }
 
 var yyPact = [...]int16{
-	497, -1000, -248, -1000, -1000, 1412, 1978, 413, -1000, -1000,
-	-1000, 948, 476, 475, 216, 434, 818, 528, 905, 483,
-	397, -1000, -220, -199, -1000, -105, 482, -1000, 1254, -1000,
-	4133, 4133, 4133, -1000, 333, 818, 397, 143, 397, 1430,
-	361, 678, 1557, 521, -1000, -1000, 397, 905, 665, -1000,
-	-1000, -1000, -1000, 252, 157, 111, 1777, -144, -14, -1000,
-	-1000, -1000, -1000, -1000, 1335, -1000, -1000, -1000, 1335, 39,
-	1409, 1335, 1409, -1000, 1335, 1409, 25, 25, 25, 25,
-	25, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1398, 1395,
-	-1000, 1335, 1335, 1335, 1335, 1335, -1000, -1000, -1000, -1000,
+	752, -1000, -192, -1000, -1000, -1000, -1000, 903, 382, 714,
+	1631, 682, -1000, -1000, -1000, 270, 293, -1000, 1518, 1808,
+	1839, -1000, 1518, 670, -140, 668, 404, 649, 1162, 707,
+	656, 270, 674, 591, -144, -52, -1000, 69, 672, 270,
+	270, -1000, 617, 608, 608, 644, 608, -1000, 746, -1000,
+	-1000, -1000, -1000, -1000, 270, 1423, -1000, 5190, 5190, 5190,
+	5190, -1000, -1000, -1000, 1796, 1802, 1518, 1764, 1685, -1000,
+	1210, 475, 666, 1162, 591, 324, 591, 1630, 611, 979,
+	826, 964, 1752, 591, 270, 925, -1000, -1000, -1000, -1000,
+	261, 691, 1238, 270, 179, 270, 1735, 270, 608, 270,
+	8525, 1461, 240, 621, 234, -108, 167, -1000, -1000, -1000,
+	-1000, -1000, 1539, -1000, -1000, -1000, 1539, 223, 1597, 1539,
+	1597, -1000, 1539, 1597, 217, 217, 217, 217, 217, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1596, 1595, -1000, 1539,
+	1539, 1539, 1539, 1539, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1582, 282, 1582, 1552, 1552, -1000,
+	-1000, 234, 234, 234, 1713, 10033, 10033, 1808, -1000, 1518,
+	-1000, -1000, 1773, -1000, -1000, 966, -1000, -1000, 1592, 270,
+	1162, 1162, 1629, 270, -173, 270, 270, 1819, 270, -1000,
+	-1000, -1000, 360, 1734, 1233, 5190, 8525, 774, 1731, 11002,
+	270, -1000, 1726, 745, 270, 55, 627, 805, 785, -1000,
+	-1000, -1000, -1000, 744, -1000, 556, -1000, -1000, 556, 270,
+	556, 1628, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1386, 96, 1386, 1349,
-	1349, -1000, -1000, 1777, 1777, 1403, 905, 818, 1422, 905,
-	-218, 905, 905, 1661, 905, -1000, -1000, -1000, 195, 1529,
-	4133, 6344, 905, -1000, 1523, 489, 905, 4499, -1000, 1487,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1392, 744, 818,
-	294, 83, 1309, 421, 384, 1049, 291, -1000, -1000, -1000,
-	753, -1000, 818, -1000, 1669, -1000, -1000, 289, -1000, 288,
-	656, 956, 905, 1391, 178, 1387, 6662, 878, -1000, -255,
-	-1000, -16, -1000, -1000, 819, 25, 1335, -1000, 25, 813,
-	25, 25, -1000, -1000, 532, 1494, 532, 532, 532, 532,
-	955, 955, -146, -146, -1000, -1000, -1000, -1000, 860, 1386,
-	-1000, -1000, -1000, 847, -1000, 905, 818, 1385, 1420, 905,
-	1554, 426, -1000, -1000, 1553, 1552, 1283, -1000, -1000, 194,
-	-1000, 387, -1000, 818, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -1835,356 +2185,408 @@ var yyPact = [...]int16{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 5557, -1000, 1697, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1589, 1230, 1028, 1162, 523,
+	260, 1486, 580, 567, -1000, -1000, 511, -1000, 1093, -1000,
+	1162, -1000, 1834, -1000, -1000, 495, -1000, 493, 918, 1156,
+	-1000, 270, 1588, 227, 1587, 3146, 1120, -1000, -241, -1000,
+	164, -1000, -1000, 1099, 217, 1539, -1000, 217, 1086, 217,
+	217, -1000, -1000, 755, 1708, 755, 755, 755, 755, 1153,
+	1153, 12, 12, -1000, -1000, -1000, -1000, 1116, 1582, -1000,
+	-1000, -1000, 1115, -1000, -1000, 1833, 713, 899, -1000, 10033,
+	388, 1486, 1486, -1000, -1000, 723, -1000, -1000, -1000, 10469,
+	10469, 10469, 10469, 10469, 10469, 10469, -1000, -1000, -1000, -1000,
+	182, -1000, -177, -1000, 1082, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 743, 742, -1000, 9915, 1486, 1486,
+	1486, 1486, 1486, 1486, 1486, 1486, 1486, 1486, 10033, 1486,
+	1546, 1486, 1486, 1486, 1486, 1486, 1486, 1486, 1486, 1486,
+	1486, 1486, 2798, 1486, 1486, 1486, 1486, 1486, 1486, 1486,
+	-1000, 1516, -1000, 897, 1796, 1210, 1641, -1000, -1000, 270,
+	1162, 1580, 1626, 1621, 270, 1750, 633, -1000, -1000, 1748,
+	1747, 1080, -1000, -1000, 357, -1000, 513, -1000, 1162, 4998,
+	234, 1746, 270, 141, 1162, -1000, 327, 1579, 1603, -1000,
+	582, 704, 690, 1162, 1486, 1162, 1113, 1110, 7412, -1000,
+	270, -1000, -1000, -1000, 556, -1000, 270, 1486, 7783, 240,
+	1578, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 312, 89,
+	-1000, 1828, 1793, 529, 50, -89, 1226, -1000, -1000, 1577,
+	924, -1000, -1000, 10033, 1224, 1216, -1000, 1162, -1000, -1000,
+	-123, 160, 150, -61, -1000, 1486, -1000, 1575, 10033, 1741,
+	-1000, 1711, 1105, -1000, 2926, -1000, -177, -1000, -1000, -1000,
+	-177, -1000, -1000, -1000, 1486, -1000, 1486, 1570, 1564, -1000,
+	1563, 1486, 741, -1000, -1000, -1000, -1000, -1000, 1456, 755,
+	217, 755, 1455, 1451, 755, 755, -1000, -1000, 1212, 808,
+	-1000, -1000, -1000, -1000, 1418, -1000, 1415, -1000, 275, 273,
+	-1000, 1510, -1000, 1410, -1000, 1689, 10033, 10033, 1022, 10033,
+	10033, 784, 10469, 1041, 801, 10469, 10469, 10469, 10469, 10469,
+	10469, 10469, 10469, 10469, 10469, 10469, 10469, 10469, 10469, 10469,
+	4076, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1187, -1000, 1518, 1257, 1257, -176, -176,
+	-176, -176, -176, -176, 149, -1000, -239, -1000, 3349, 9144,
+	-1000, 7412, 8154, 1210, 1398, 956, 9915, 9581, 9581, 9581,
+	9581, 8708, 10033, 9581, 9581, 9581, 1773, 912, 956, 179,
+	1791, 1210, 1210, 1210, -1000, 1210, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 220, -1000, -1000, -1000, -1000,
+	-1000, -1000, 9581, 9581, 9581, 9581, 9581, 9581, 9581, 10033,
+	-1000, -1000, -1000, 1713, -1000, 9581, -1000, 1515, 1616, 236,
+	270, 270, 1559, 1518, 591, 1518, 1782, 421, 270, 1819,
+	1819, 334, 1819, 513, 5727, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 1414, -1000, 404, 488,
-	5606, 157, -1000, -1000, -1000, -1000, -1000, -1000, 390, -1000,
-	1656, 1586, 304, 7, -203, 1039, -1000, -1000, 1384, -1000,
-	-1000, 7345, -1000, 1002, 1000, -1000, 23, 818, -1000, -207,
-	102, 94, -1000, -1000, 1309, -1000, 1383, 7345, 1545, -1000,
-	1510, 842, -1000, 2552, -1000, -239, -1000, -1000, -1000, -239,
-	-1000, -1000, -1000, 1309, -1000, 1381, 1380, -1000, 1378, -1000,
-	-1000, 1309, 1309, 1309, 520, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1277, 532, 25, 532, 1276, 1275, 532, 532, -1000,
-	-1000, 995, 586, -1000, -1000, -1000, -1000, 1252, -1000, 1248,
-	-1000, 80, 79, -1000, 1306, -1000, 1246, 1319, 1419, 251,
-	905, 1374, 1336, 397, 1336, 1581, 242, 905, 1661, 388,
-	1661, 387, 818, 386, 818, -1000, -1000, 433, 4130, -1000,
-	-1000, 1243, -1000, 259, 1335, 395, 395, -217, 285, 283,
-	-203, 1309, 1371, -1000, 390, 591, -1000, 7345, 191, 1309,
-	1309, -1000, -1000, 501, -1000, -1000, -1000, 7652, 7652, 7652,
-	7652, 7652, 7652, 7652, -1000, -1000, -1000, -1000, -3, -1000,
-	-239, -1000, 907, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	519, 518, -1000, 7178, 1309, 1309, 1309, 1309, 1309, 1309,
-	1309, 1309, 7345, 1309, 1481, 1309, 1309, 1309, 1309, 1309,
-	1309, 1309, 1309, 1309, 1309, 1309, 2670, 1309, 1309, 1309,
-	1309, -1000, -1000, -1000, -1000, -203, 1370, -1000, -1000, -1000,
-	656, -1000, 7345, 388, 816, 122, -1000, 1304, 1266, 2316,
-	1265, -1000, 7789, -1000, 973, -1000, 798, -1000, 782, 1262,
-	6834, 7010, 7010, 5975, -1000, -1000, 532, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 25, 953, 25, -27, -29,
-	836, -1000, 831, 251, 818, 905, 1261, 1303, -1000, 228,
-	1369, 388, -1000, 1613, 1676, -1000, 1336, 905, -1000, 420,
-	1582, -1000, -1000, 1579, -1000, 1301, -1000, -1000, 1295, 1661,
-	1367, 818, -1000, -1000, 287, 818, -1000, -1000, -1000, -1000,
-	-1000, 1150, 390, 1531, -1000, -1000, -1000, 715, -1000, -1000,
-	688, 225, 692, -1000, 818, -203, 1364, 7345, 390, 1238,
-	237, 7345, 7345, 824, -1000, 559, 7652, 788, 563, 7652,
-	7652, 7652, 7652, 7652, 7652, 7652, 7652, 7652, 7652, 7652,
-	7652, 7652, 7652, 7652, 2352, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 967, -1000, 1336,
-	975, 975, -236, -236, -236, -236, -236, -236, 77, -1000,
-	-253, -1000, -1000, 5237, 5975, 973, 1216, 584, 7178, 7010,
-	7010, 2218, 7345, 7010, 7010, 7010, 1561, 651, 584, 897,
-	1577, 973, 973, 973, -1000, 973, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 37, -1000, -1000, -1000, -1000,
-	-1000, -1000, 7010, 7010, 7010, 7010, -1000, 818, 1309, 591,
-	1221, -167, 7345, 1362, 825, -1000, 1255, -239, -1000, -1000,
-	-1000, -144, -1000, -1000, -1000, -1000, 973, 7010, 1198, 1216,
-	-1000, 642, -1000, 514, 1198, 642, 1198, 1309, -1000, 532,
-	-1000, 532, -1000, -1000, 1239, 1212, 1128, 1361, 1355, -225,
-	819, 251, 1211, 1590, 1604, 1336, 1541, 1467, -1000, 973,
-	1537, 818, -1000, -1000, -1000, -1000, -1000, 220, 648, 818,
-	3237, 1232, -1000, 637, 1353, 149, 355, 1416, 1302, 133,
-	-1000, 965, 640, 952, 638, 626, 609, 607, 597, 592,
-	588, -1000, -1000, -1000, -1000, -1000, 1668, -1000, -1000, -1000,
-	1644, 1352, 1350, 390, 591, 1209, 1150, -1000, -128, 559,
-	628, -1000, -1000, 874, -1000, -1000, 221, -1000, -1000, -1000,
-	-1000, 788, 7652, 7652, 7652, 9, 221, 2104, 2009, 1930,
-	-236, 244, 244, 22, 22, 22, 22, 22, 362, 362,
-	-1000, -143, -1000, 1335, 973, -1000, -239, 947, -1000, -1000,
-	923, 1309, 513, -1000, -1000, -1000, 7345, -1000, 973, 1198,
-	1198, 732, 1298, 7819, 1335, -1000, 1335, 1349, -1000, -1000,
-	119, 1335, 104, -1000, -1000, -1000, -1000, 1349, -1000, -1000,
-	-1000, -1000, -1000, 1335, 1335, -1000, -1000, 1335, 1335, -1000,
-	1335, 1335, 814, 1264, 1250, 1198, 7010, -1000, 669, -1000,
-	7345, 973, -1000, 512, 905, -1000, -1000, -1000, -1000, -1000,
-	1198, 973, 1297, 1198, 1198, 1202, -1000, 7345, 237, 1418,
-	-1000, -1000, 664, -1000, 1113, 1107, -1000, -1000, 1198, 7010,
-	-246, -1000, -1000, -1000, 945, -1000, -1000, 3761, -246, -246,
-	7010, -1000, -1000, -1000, -1000, -225, 251, 390, 1623, 1345,
-	1098, 1623, 1511, 7345, 7345, 1613, -1000, 1336, -1000, -1000,
-	1561, -1000, -1000, 695, -1000, 1336, 1259, 217, 141, 7345,
-	-1000, 3237, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1613, -1000, -1000, -1000, 818, 2970, 818, 818,
-	818, 381, 7512, 7345, -1000, -1000, -1000, 905, 1018, 3764,
-	637, 637, 3764, 637, 637, 390, 390, 1344, 1343, 276,
-	-1000, 818, -1000, -174, 1302, 818, -1000, 817, -1000, -1000,
-	736, 810, 736, 736, 736, 736, 736, 395, 395, 818,
-	390, 1192, 237, 1150, 1416, -1000, -1000, -1000, -1000, -1000,
-	9, 221, 2081, -1000, 7652, 7652, 76, -1000, 52, -1000,
-	-239, 5975, 584, -1000, -1000, -1000, 3378, 916, 7345, -1000,
-	209, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 3378, 7652, 7652, 7652, 7652, -136, 1279,
-	643, -1000, 7345, 776, -1000, 5237, -1000, -1000, -1000, -1000,
-	-1000, 383, 818, 591, -1000, 1652, -175, 352, -1000, -1000,
-	-1000, -1000, -1000, 1309, -1000, -1000, 511, -1000, -1000, 973,
-	1623, 993, 1177, 1150, 7345, 388, -225, 1150, -1000, 1650,
-	555, 698, 1296, -1000, 661, 1590, 973, 1452, -1000, -1000,
-	-148, 7345, 4397, 3237, 584, -1000, 1590, 413, 941, 738,
-	1294, 8038, -1000, 2654, 832, -1000, -1000, -1000, -1000, -1000,
+	-1000, 5190, 1501, -1000, 1614, 1408, 327, 1162, 509, 1162,
+	-1000, -1000, 1162, 1162, 562, -179, 10033, -1000, -1000, -1000,
+	-1000, -1000, -1000, 740, -1000, -1000, -1000, -1000, -1000, 270,
+	4815, -1000, -1000, 6670, 1406, -1000, 399, 1539, 1539, 10033,
+	-148, -1000, -89, 612, 612, -128, 491, 469, -5, 1486,
+	1558, -1000, 312, 1767, 855, -1000, -1000, 1556, -1000, -1000,
+	-1000, 918, -1000, -1000, -1000, 10033, 334, 1011, 251, -1000,
+	1500, 1434, 1139, 1399, 1385, -1000, 783, 1486, -1000, -1000,
+	1210, 1210, -1000, 1077, -1000, 1060, 1379, 8154, -1000, -1000,
+	755, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 217,
+	1147, 217, 162, 151, 1104, -1000, 1103, 1678, 784, 807,
+	-1000, -1000, 999, -1000, -1000, 956, 956, 2858, -1000, -1000,
+	-1000, -1000, 1041, 10469, 10469, 10469, 2758, 2858, 2820, 68,
+	41, -176, 174, 174, 95, 95, 95, 95, 95, 142,
+	142, -1000, 10, -1000, 1539, 1210, -1000, -177, 1143, -1000,
+	-1000, 1133, -1000, -1000, -108, 1210, 9581, 1375, 1398, -1000,
+	963, -1000, 739, 1486, -1000, -1000, 10033, -1000, 1210, 1375,
+	963, 1375, 1375, 1375, 971, 1494, 10778, 1539, 1486, 1554,
+	1552, -1000, -1000, 292, 1554, 246, -1000, -1000, -1000, -1000,
+	1552, -1000, -1000, -1000, -1000, -1000, 1539, 1539, -1000, -1000,
+	1539, 1539, -1000, 1539, 1539, 859, 1466, 1459, 1375, 9581,
+	911, -1000, 10033, 1210, 270, -1000, -1000, -1000, -1000, -1000,
+	1375, 1210, 1493, 1375, 1375, 1375, 1375, 1375, -1000, -1000,
+	1440, 236, 1162, 270, 1354, 1487, -1000, 387, 1539, 1551,
+	786, 334, -1000, 270, -1000, 623, 1798, -1000, -1000, 1781,
+	-1000, -1000, 1483, -1000, -1000, -1000, 1075, 1819, 3100, -1000,
+	234, 1185, -1000, 1396, 1550, 1162, -1000, -1000, 607, -1000,
+	-1000, 1162, -1000, 1486, 855, 8154, 1391, -1000, -1000, -1000,
+	-1000, 1388, 7041, 786, 312, 1721, -1000, -1000, 1721, -1000,
+	943, 786, -1000, 1013, -1000, -1000, 989, 403, 1007, -1000,
+	1162, -89, 1549, 922, 10033, 312, 1382, 1548, 415, 1162,
+	1486, 855, 1378, -4, 10033, 1545, 1100, -1000, 1350, -177,
+	-1000, -1000, 10469, 10469, 10469, 10469, -1000, -1000, -1000, -1000,
+	-1000, 1486, -1000, 755, -1000, 755, -1000, -1000, 1347, 1344,
+	-1000, -1000, -1000, -1000, -1000, 2758, 2858, 2238, -1000, 10469,
+	10469, 239, -1000, 124, -1000, -177, -1000, -1000, 1375, 9581,
+	-190, -1000, -1000, -1000, 1146, -1000, -1000, 5186, 9581, 956,
+	-1000, -190, -190, -1000, -1000, 4430, 1138, 10033, -1000, 1099,
+	394, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 4430, 10469, 10469, 10469, 10469, 34, 1403,
+	885, -1000, 10033, 997, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1814, 543, 1324, 1544, 1543, -155, 236,
+	1606, 1245, 233, -1000, 1177, 864, 1137, 860, 854, 851,
+	847, 841, 839, 825, 1373, 1739, 1162, -1000, -1000, -1000,
+	-1000, -1000, 398, 905, 173, 3781, 1074, -1000, -1000, 3781,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1808,
+	-1000, -1000, -1000, 1162, 3012, 1162, 1162, 1162, 579, 10351,
+	10033, -1000, -1000, -1000, 4998, -1000, -1000, 264, 1542, -102,
+	1517, 570, 10033, 921, -1000, -1000, -1000, 6670, 4815, 1606,
+	-1000, -1000, -1000, 1721, 1606, -1000, 1832, -1000, -1000, -1000,
+	1829, 1541, 1540, 312, 1760, 855, 1358, -155, 312, 976,
+	53, 1353, -1000, 10033, 415, 1613, -1000, -1000, -1000, -1000,
+	862, -1000, 1320, 1317, 2858, 2858, 2858, 2858, -1000, -1000,
+	-1000, -1000, -1000, 10469, 2858, 2858, 146, -1000, 1133, -1000,
+	-1000, -1000, -1000, 1486, -1000, -1000, 738, 1210, -1000, -1000,
+	1210, 1539, -1000, 1539, 1539, 1210, -1000, -1000, 855, -1000,
+	-1000, 1210, 2452, 2395, 2167, 2148, 1486, 57, -1000, 956,
+	10033, 1812, 10033, 1480, 1488, -1000, -1000, -1000, 1737, 502,
+	267, -155, 236, 312, -158, 1537, 1304, -1000, 1162, -1000,
+	-77, 1245, 1162, -1000, 1096, -1000, -1000, 1053, 1092, 1053,
+	1053, 1053, 1053, 1053, 1814, 1518, 1453, 531, 455, 10033,
+	-1000, -1000, 3781, -1000, 270, -194, 1796, 614, 352, 543,
+	1472, 11208, -1000, 3700, 1045, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	818, 1642, 1636, 1627, 1626, 2229, 191, 743, 140, 1574,
-	-1000, -1000, 3764, -1000, -1000, -1000, -1000, -1000, 1175, 1173,
-	390, 390, 1339, 1309, 1171, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 656, 656, 1110,
-	1097, 1150, -1000, 1416, -1000, -1000, 7652, 221, 221, -31,
-	-1000, 923, -1000, -1000, 973, 1335, 973, -1000, -1000, 591,
-	-1000, -1000, 973, 1987, 1848, 1015, 884, 1309, -125, -1000,
-	584, 7345, -1000, 905, -1000, 237, 395, 395, -1000, -1000,
-	-1000, 136, 797, 804, 775, 768, 30, -1000, 1603, 466,
-	4868, -1000, 1150, 1623, 1150, 1416, 584, 1093, 1623, 1416,
-	-1000, 1479, 7345, 7345, 7345, -1000, 1511, -1000, 7010, -1000,
-	-1000, -243, 584, -1000, -1000, 3237, 1873, -1000, 1511, 938,
-	905, 1163, -1000, 1138, 1765, -1000, -1000, -1000, 1535, 894,
-	479, 818, 206, -1000, -1000, 1290, 3023, -58, -1000, -1000,
-	-1000, 578, 509, 922, -1000, 1491, -1000, -1000, 2970, 1518,
-	-1000, -1000, -1000, -1000, -1000, 3237, 3237, 3237, 648, 219,
-	-1000, 286, 1091, 1073, 390, 818, -1000, 1302, -1000, -1000,
-	378, 1150, 1416, -1000, 221, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 7652, -1000, 7652, -1000, 7652, -1000, 7652, 7652,
-	973, 911, 584, 1334, -1000, -1000, -1000, 765, -1000, 702,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 105, -1000, 1594,
-	973, -1000, 1416, 1150, -1000, -1000, -1000, 1150, -1000, 1476,
-	584, 584, -1000, -1000, 1164, 7345, -251, 3104, -1000, -1000,
-	250, 905, -1000, 250, 1078, 738, 905, -1000, -1000, 897,
-	738, 738, 738, 738, 738, -1000, 1461, 1455, -1000, 1451,
-	1445, 1441, 905, -1000, 1071, 894, 538, 1309, -1000, 908,
-	-1000, -1000, -1000, 4133, 1568, 3392, 1290, -58, 1289, -1000,
-	-50, -38, 6515, 5975, 532, -1000, -1000, -1000, -1000, -1000,
-	818, 419, 1827, 391, 138, 210, 151, -1000, 160, 1150,
-	1150, 1057, 973, -1000, 905, 1416, -1000, 351, 351, 351,
-	351, 267, -1000, -1000, 818, -1000, -1000, -1000, 507, 7345,
-	-1000, -1000, -1000, 1416, -1000, 1623, 738, 584, 629, -1000,
-	-1000, 1146, 1309, -1000, 1623, 738, 1169, -1000, 1179, -1000,
-	569, 1765, 1331, 1417, 1237, -1000, -1000, -1000, -1000, 1454,
-	-1000, 1442, -1000, -1000, -1000, -1000, -158, 474, 470, 463,
-	818, -1000, 1336, -1000, 1289, -58, -62, -1000, -1000, -1000,
-	-1000, 584, 567, -1000, -1000, -1000, 3237, 585, 645, 3237,
-	-1000, -1000, 167, -1000, 1416, 1416, -1000, -1000, 1327, -1000,
-	-1000, -1000, -1000, -1000, 973, 172, -162, 1055, 5975, 1017,
-	-1000, 584, -1000, 1621, 1287, -1000, 1305, 897, 1309, -1000,
-	1006, 818, 1613, 1169, -1000, 1613, 897, 7345, -1000, -1000,
-	7345, 1323, -1000, 7345, -1000, -1000, -1000, -1000, 1314, 1309,
-	1309, 1309, 992, -1000, -1000, -1000, -1000, -66, -53, -1000,
-	7345, 392, 132, 1498, -1000, -1000, -1000, -1000, 818, -1000,
-	1472, -139, -179, -1000, -1000, -1000, 973, 7345, 1616, 1592,
-	-1000, 1499, 1089, 1281, -1000, -1000, 6691, 973, 1021, 506,
-	992, 1590, -1000, 1590, -1000, 584, 584, 388, 584, -151,
-	388, 388, 388, 912, 818, -1000, -1000, -1000, 584, -1000,
-	3237, 2312, 986, -1000, 1471, -1000, -1000, -1000, -1000, 7345,
-	7345, 273, -1000, 1309, -1000, -1000, 1187, 818, 818, -1000,
-	-1000, -1000, 972, 964, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 962, 962, 962, 538, -1000, 171, -1000, -1000, -153,
-	584, 1282, 1647, -1000, 1309, -1000, 1336, 505, -1000, -1000,
-	-1000, -151, -1000, -1000, -1000, -158, -1000, -170, 897, 1281,
-	973, 818, -1000, -1000, -182, 1151, -1000, -1000, -1000,
+	1162, 1825, 1824, 1823, 1803, 4896, 388, 982, 311, 3126,
+	1297, 10946, 264, 264, 10946, 264, 264, 312, 1536, 1535,
+	1533, 1005, 1162, 462, 855, -1000, 1172, 6299, -1000, -1000,
+	-1000, -1000, 612, 612, 1162, 312, 1343, 1531, 415, 786,
+	786, 1341, -1000, -1000, 1163, -1000, 566, 1162, 855, -1000,
+	1822, -4, 651, -1000, -1000, 2858, -1000, -1000, 631, 5928,
+	-1000, -1000, -1000, -1000, -1000, -1000, 10469, -1000, 10469, -1000,
+	10469, -1000, 10469, 10469, 1210, 1125, 956, 1810, 1801, 956,
+	543, 543, 543, 543, 543, -1000, 1658, 1655, -1000, 1652,
+	1646, 1669, 270, -1000, 1337, 502, 689, 1486, -1000, 317,
+	-1000, -1000, -158, 1241, 1328, 1814, 334, -155, 1486, 1312,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 786, -1000, 5, 10033, 3100, -1000, -1000, 3781,
+	538, 956, -1000, 1778, 883, 1713, 316, 270, 1209, 1282,
+	1162, 373, -1000, -1000, 1471, 4073, 108, -1000, -1000, -1000,
+	819, 733, 1132, -1000, 1707, -1000, -1000, 3012, 1714, -1000,
+	-1000, -1000, -1000, -1000, 3781, 3781, 3781, 3100, -1000, -1000,
+	10946, -1000, -1000, -1000, -1000, -1000, 1308, 312, 312, 312,
+	-1000, 1758, 1530, 1525, 921, -1000, 4815, 918, 918, 1303,
+	1300, -155, 312, 976, 1606, 1606, -155, -1000, 270, -1000,
+	415, 612, 612, -1000, -1000, 281, 1126, 1091, 1070, 1059,
+	90, -1000, 1800, -1000, 1799, 1210, -1000, 2625, 2625, 2625,
+	2625, 58, -1000, -1000, -1000, 10033, 10033, 1488, 1529, 1610,
+	1299, -1000, -1000, -1000, -1000, 1653, -1000, 1645, -1000, -1000,
+	-1000, -1000, -66, 659, 657, 654, 1162, -1000, 1814, -155,
+	786, 786, 1284, -158, 1162, -1000, 1245, 1606, -1000, -187,
+	956, -1000, 2648, -1000, 270, 270, 905, 378, -1000, -1000,
+	444, 270, -1000, 444, 1334, 543, -1000, -1000, 179, -1000,
+	5190, 1777, 4444, 1471, 108, 1469, -1000, 115, 138, 9026,
+	8154, 755, -1000, -1000, -1000, -1000, -1000, 1162, 2288, 1035,
+	214, -1000, -1000, 1276, 1268, 1254, -1000, 1162, 312, -1000,
+	-1000, -1000, -1000, 551, 786, 786, 1237, -1000, -1000, -1000,
+	-1000, 1523, -1000, -1000, -1000, 1038, -1000, 1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 172, 10033, -1000, -1000, -1000,
+	-1000, -1000, 1210, 333, -80, 956, 1470, -1000, -1000, 10033,
+	1522, -1000, 10033, -1000, -1000, -1000, -1000, 1520, 1486, 1486,
+	1486, 1176, -1000, 786, -158, -1000, 1606, -1000, 1814, 1210,
+	-1000, -1000, 10033, 3091, -1000, 1486, 1486, 306, 522, 1274,
+	1486, -1000, 1814, 543, 1294, 1384, -1000, 818, 1518, -1000,
+	1469, 108, 128, -1000, -1000, -1000, -1000, 956, 793, -1000,
+	-1000, -1000, 3781, 827, 898, -155, 786, 786, 1205, -1000,
+	277, 1203, 270, 1606, 1606, -155, 1162, -1000, -1000, -1000,
+	731, 1161, -1000, 956, -1000, 1668, 22, -88, 956, 334,
+	956, -55, 334, 334, 334, 247, 1162, 1606, 1814, -1000,
+	786, -1000, 956, -1000, -1000, 9581, 9581, 3781, -1000, 1608,
+	179, 1486, -1000, 326, 1162, 1808, 1294, -1000, 1808, 179,
+	10033, -1000, -1000, -1000, 129, 114, -1000, 10033, 577, 305,
+	464, 1606, 1606, 1814, 1162, 960, -1, -1000, 1519, -1000,
+	-1000, -1000, 1201, 8154, -1000, 1210, 10033, -1000, 1662, -1000,
+	1198, 1196, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1193,
+	1193, 1193, 689, -1000, -1000, 786, 1606, 1210, 1210, 406,
+	-1000, 1716, 1247, 1465, -1000, -1000, 9463, 1210, 1191, -1000,
+	719, -1000, -1000, 1176, 1796, -1000, 1796, -1000, 956, -1000,
+	-1000, -1000, 956, -1000, 3781, 322, -1000, 355, -1000, -1000,
+	464, -1000, -1000, -1000, -1000, -1000, 960, 1162, -1000, -1000,
+	-1000, -1000, -47, -1000, -1000, -55, -1000, -1000, -1000, -66,
+	-1000, -1000, -1000, -1000, 3053, 460, -1000, 1486, -1000, -1000,
+	1485, 208, 1162, -1000, -1000, -1000, 206, -1000, 348, -1000,
+	322, -1000, 1170, -84, -1000, -1000, -1000, 1831, -1000, 1486,
+	-1000, 1518, -1000, 667, -1000, -1000, -1000, -1000, -1000, -1000,
+	-91, 179, 1465, 1210, 1162, -1000, 1460, -1000, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 1900, 9, 22, 1899, 1897, 1896, 1895, 1894, 1892,
-	1889, 1888, 1887, 1886, 1884, 1880, 1877, 1876, 1874, 74,
-	1873, 1872, 1871, 68, 1870, 1869, 1865, 1863, 64, 193,
-	73, 93, 948, 1862, 37, 44, 41, 1861, 21, 1860,
-	1859, 50, 1857, 30, 1854, 1845, 827, 1843, 1842, 5,
-	20, 63, 88, 1841, 1840, 83, 1462, 1839, 1838, 72,
-	1834, 1832, 78, 16, 4, 19, 11, 1830, 123, 1,
-	1829, 69, 1828, 1826, 1825, 1824, 27, 1823, 52, 60,
-	47, 53, 1822, 8, 65, 42, 23, 13, 2, 48,
-	28, 1820, 15, 34, 25, 1819, 58, 1817, 100, 43,
-	57, 59, 0, 40, 80, 1808, 1805, 1804, 79, 86,
-	39, 10, 1802, 1801, 1798, 61, 96, 26, 103, 102,
-	1797, 92, 1796, 1795, 1788, 1787, 1784, 245, 795, 106,
-	71, 29, 1783, 1782, 81, 335, 321, 75, 343, 1368,
-	62, 1781, 1780, 1778, 1776, 85, 1775, 70, 101, 24,
-	434, 1774, 1771, 1768, 1767, 1766, 1765, 1764, 91, 1762,
-	66, 77, 38, 350, 49, 1760, 1758, 1746, 1745, 76,
-	1743, 1740, 1739, 67, 1735, 1733, 90, 84, 108, 104,
-	99, 1732, 1728, 55, 105, 97, 1724, 87, 46, 6,
-	89, 1720, 51, 1719, 1717, 1715, 7, 3, 1714, 1711,
-	1710, 1709, 1708, 1707, 54, 1705, 82, 1704, 14, 1703,
-	1702, 45, 1698, 1696, 1694, 1691, 1685, 358, 437, 1684,
-	157, 107, 1682, 207,
+	0, 2071, 3, 89, 2070, 2068, 2067, 2066, 2064, 2063,
+	2061, 2060, 2059, 2058, 2057, 2056, 2054, 2053, 2051, 2041,
+	2040, 98, 2039, 2038, 2035, 121, 2032, 2031, 2030, 2029,
+	86, 338, 41, 87, 1714, 2027, 38, 67, 77, 2025,
+	42, 2023, 2022, 57, 2020, 65, 2019, 2017, 2322, 2016,
+	2015, 20, 33, 83, 111, 2012, 2009, 122, 2126, 2007,
+	2006, 96, 2005, 2003, 107, 45, 2, 14, 12, 2002,
+	182, 5, 2001, 105, 1998, 1997, 1994, 1990, 27, 1989,
+	119, 78, 13, 63, 1987, 47, 110, 37, 24, 16,
+	1, 56, 28, 1984, 25, 34, 32, 1983, 73, 1982,
+	120, 136, 906, 69, 39, 1980, 93, 1141, 0, 50,
+	85, 1979, 6, 1973, 1970, 239, 102, 55, 23, 1969,
+	1967, 1966, 82, 135, 48, 134, 123, 1965, 128, 1964,
+	1962, 1961, 1959, 1944, 2490, 820, 126, 101, 36, 1941,
+	1938, 106, 138, 137, 109, 139, 115, 70, 1934, 1923,
+	1920, 1918, 114, 1917, 18, 1915, 9, 49, 94, 15,
+	184, 1914, 1911, 116, 76, 54, 133, 1906, 1901, 1895,
+	90, 1893, 92, 43, 244, 35, 40, 1885, 1884, 1883,
+	1882, 103, 1880, 1879, 1878, 52, 44, 71, 1877, 68,
+	1876, 91, 46, 125, 113, 124, 1875, 1871, 1870, 1868,
+	80, 117, 118, 1867, 99, 81, 88, 51, 19, 127,
+	62, 66, 1866, 1865, 1864, 7, 8, 1863, 10, 4,
+	64, 1861, 1859, 1858, 75, 1857, 84, 1856, 21, 1855,
+	1854, 53, 1852, 1851, 1850, 1849, 1848, 1594, 1390, 1845,
+	79, 1833, 142,
 }
 
 var yyR1 = [...]uint8{
-	0, 213, 214, 214, 1, 1, 14, 14, 14, 14,
+	0, 233, 234, 234, 1, 1, 1, 1, 14, 14,
+	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
 	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
-	14, 14, 14, 15, 15, 15, 15, 15, 15, 15,
-	216, 216, 2, 2, 3, 4, 4, 5, 5, 6,
-	6, 22, 22, 7, 8, 8, 8, 219, 219, 41,
-	41, 85, 85, 9, 9, 9, 9, 10, 10, 193,
-	193, 192, 194, 194, 11, 11, 11, 11, 11, 186,
-	186, 186, 186, 186, 12, 12, 189, 189, 189, 13,
-	13, 13, 90, 90, 94, 94, 94, 95, 95, 95,
-	95, 205, 205, 114, 114, 215, 215, 220, 220, 220,
-	220, 220, 220, 220, 184, 184, 184, 184, 185, 185,
-	185, 185, 187, 187, 188, 188, 190, 190, 190, 190,
-	190, 190, 190, 190, 190, 190, 191, 191, 100, 100,
-	167, 167, 167, 168, 168, 168, 168, 168, 168, 170,
-	170, 171, 171, 106, 106, 172, 172, 18, 152, 153,
-	153, 153, 153, 153, 153, 153, 153, 139, 139, 139,
-	117, 117, 117, 117, 117, 117, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 140, 140, 140, 140, 140,
-	140, 140, 140, 140, 140, 178, 178, 178, 178, 178,
-	179, 179, 179, 179, 179, 179, 179, 179, 179, 180,
-	181, 182, 174, 174, 175, 175, 175, 175, 175, 175,
-	175, 175, 175, 175, 175, 175, 175, 175, 129, 129,
-	129, 129, 129, 129, 173, 173, 169, 169, 169, 169,
-	121, 121, 119, 119, 119, 119, 119, 119, 119, 119,
-	119, 119, 120, 120, 120, 120, 120, 120, 120, 125,
-	125, 122, 122, 122, 122, 122, 122, 122, 122, 118,
-	118, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 123, 123, 123, 123, 123, 123, 123,
-	123, 123, 123, 126, 126, 124, 124, 124, 124, 124,
-	124, 124, 124, 138, 138, 127, 127, 136, 136, 137,
-	137, 137, 128, 128, 128, 135, 135, 135, 132, 132,
-	133, 133, 134, 134, 134, 130, 130, 130, 131, 131,
-	131, 141, 163, 163, 163, 165, 165, 166, 166, 164,
-	164, 164, 164, 164, 164, 164, 164, 164, 164, 164,
-	151, 151, 183, 183, 162, 162, 162, 157, 157, 157,
-	157, 157, 157, 157, 157, 157, 150, 150, 160, 160,
-	161, 161, 158, 158, 158, 159, 145, 145, 145, 145,
-	145, 146, 146, 147, 147, 147, 147, 142, 142, 143,
-	143, 144, 144, 176, 176, 176, 209, 209, 209, 209,
-	209, 209, 210, 210, 177, 177, 148, 148, 149, 149,
-	156, 156, 156, 156, 221, 221, 154, 154, 154, 155,
-	155, 155, 222, 19, 20, 20, 21, 21, 21, 25,
-	25, 25, 23, 23, 24, 24, 30, 30, 29, 29,
-	31, 31, 31, 31, 105, 105, 105, 104, 104, 206,
-	206, 206, 206, 206, 33, 33, 34, 34, 35, 35,
-	36, 36, 36, 196, 196, 195, 195, 197, 197, 197,
-	197, 197, 197, 48, 48, 83, 83, 83, 86, 86,
-	37, 37, 37, 37, 38, 38, 39, 39, 40, 40,
-	112, 112, 111, 111, 111, 110, 110, 42, 42, 42,
-	44, 43, 43, 43, 43, 45, 45, 47, 47, 46,
-	46, 49, 49, 49, 49, 50, 50, 84, 84, 32,
-	32, 32, 32, 32, 32, 32, 97, 97, 52, 52,
-	51, 51, 51, 51, 51, 51, 51, 51, 51, 51,
-	61, 61, 61, 61, 61, 61, 53, 53, 53, 53,
-	53, 53, 53, 53, 53, 53, 53, 28, 28, 62,
-	62, 62, 68, 63, 63, 56, 56, 56, 56, 56,
-	56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
-	56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
-	56, 56, 56, 56, 56, 56, 56, 56, 56, 56,
-	56, 56, 59, 59, 59, 59, 59, 59, 59, 57,
-	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
-	57, 57, 57, 57, 57, 57, 57, 57, 57, 57,
-	57, 58, 58, 58, 58, 58, 58, 58, 58, 58,
-	223, 223, 60, 60, 60, 60, 26, 26, 26, 26,
-	26, 113, 113, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 115, 115, 116, 116, 116, 116, 116,
-	116, 116, 116, 72, 72, 27, 27, 70, 70, 71,
-	99, 99, 73, 73, 69, 69, 69, 198, 55, 55,
-	55, 55, 55, 55, 55, 55, 55, 55, 74, 74,
-	75, 75, 207, 207, 208, 76, 76, 77, 77, 78,
-	79, 79, 79, 80, 80, 80, 80, 81, 81, 81,
-	54, 54, 54, 54, 54, 54, 82, 82, 82, 82,
-	87, 87, 64, 64, 66, 66, 65, 67, 88, 88,
-	92, 89, 89, 93, 93, 93, 93, 93, 16, 17,
-	91, 91, 91, 107, 107, 107, 98, 98, 96, 96,
-	102, 103, 103, 103, 108, 108, 109, 109, 199, 199,
-	199, 200, 200, 200, 201, 201, 202, 203, 203, 204,
-	212, 212, 211, 211, 211, 211, 211, 211, 211, 211,
-	211, 211, 211, 211, 211, 211, 211, 211, 211, 211,
-	211, 211, 211, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 101, 101, 101, 101, 101, 101, 101, 101, 101,
-	101, 217, 218,
+	14, 14, 14, 14, 14, 14, 14, 14, 14, 14,
+	16, 16, 16, 16, 17, 17, 17, 17, 17, 102,
+	102, 101, 101, 101, 15, 15, 15, 15, 15, 15,
+	15, 15, 15, 15, 15, 15, 15, 236, 236, 2,
+	2, 3, 4, 4, 5, 5, 6, 6, 24, 24,
+	7, 8, 8, 8, 239, 239, 43, 43, 87, 87,
+	9, 9, 9, 9, 10, 10, 212, 212, 211, 213,
+	213, 11, 11, 11, 11, 11, 203, 203, 203, 203,
+	203, 12, 12, 208, 208, 208, 13, 13, 13, 92,
+	92, 96, 96, 96, 97, 97, 97, 97, 225, 225,
+	121, 121, 235, 235, 240, 240, 240, 240, 240, 240,
+	240, 201, 201, 201, 201, 202, 202, 202, 202, 204,
+	204, 204, 207, 207, 209, 209, 209, 209, 209, 209,
+	209, 209, 209, 209, 205, 205, 206, 206, 206, 206,
+	206, 206, 206, 206, 206, 206, 206, 206, 206, 206,
+	206, 210, 210, 103, 103, 103, 105, 105, 179, 179,
+	179, 180, 180, 180, 180, 180, 180, 182, 182, 183,
+	183, 113, 113, 184, 184, 20, 162, 162, 163, 163,
+	163, 163, 163, 163, 163, 163, 146, 146, 146, 146,
+	124, 124, 124, 124, 124, 124, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 147, 147, 147,
+	147, 147, 147, 147, 147, 147, 147, 193, 193, 193,
+	193, 193, 193, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 195, 195, 196, 196, 196, 196, 197, 197,
+	198, 199, 189, 189, 189, 189, 188, 188, 190, 190,
+	190, 190, 190, 190, 190, 190, 190, 190, 190, 190,
+	190, 190, 136, 136, 136, 136, 136, 136, 185, 185,
+	181, 181, 181, 181, 128, 128, 126, 126, 126, 126,
+	126, 126, 126, 126, 126, 126, 127, 127, 127, 127,
+	127, 127, 127, 132, 132, 129, 129, 129, 129, 129,
+	129, 129, 129, 125, 125, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 130, 130, 130,
+	130, 130, 130, 130, 130, 130, 130, 133, 133, 131,
+	131, 131, 131, 131, 131, 131, 131, 145, 145, 134,
+	134, 143, 143, 144, 144, 144, 135, 135, 135, 142,
+	142, 142, 139, 139, 140, 140, 141, 141, 141, 137,
+	137, 137, 138, 138, 138, 148, 148, 175, 175, 175,
+	177, 177, 178, 178, 176, 176, 176, 176, 176, 176,
+	176, 176, 176, 176, 176, 161, 161, 200, 200, 174,
+	174, 174, 169, 169, 169, 169, 169, 169, 169, 169,
+	169, 160, 160, 172, 172, 173, 173, 170, 170, 170,
+	170, 170, 171, 152, 152, 152, 152, 152, 153, 153,
+	157, 157, 157, 157, 149, 149, 150, 150, 150, 150,
+	151, 151, 187, 187, 186, 186, 186, 191, 191, 191,
+	229, 229, 229, 229, 229, 229, 230, 230, 192, 192,
+	158, 158, 159, 159, 167, 167, 167, 167, 167, 168,
+	168, 166, 166, 164, 164, 164, 165, 165, 165, 241,
+	21, 22, 22, 23, 23, 23, 27, 27, 27, 25,
+	25, 26, 26, 32, 32, 31, 31, 33, 33, 33,
+	33, 111, 111, 111, 110, 110, 226, 226, 226, 226,
+	226, 35, 35, 36, 36, 37, 37, 38, 38, 38,
+	215, 215, 214, 214, 216, 216, 216, 216, 216, 216,
+	50, 50, 85, 85, 85, 85, 85, 88, 88, 39,
+	39, 39, 39, 40, 40, 41, 41, 42, 42, 119,
+	119, 118, 118, 118, 117, 117, 44, 44, 44, 46,
+	45, 45, 45, 45, 47, 47, 49, 49, 48, 48,
+	51, 51, 51, 51, 155, 155, 154, 154, 156, 156,
+	156, 52, 52, 86, 86, 220, 220, 220, 34, 34,
+	34, 34, 34, 34, 34, 99, 99, 54, 54, 53,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 63,
+	63, 63, 63, 63, 63, 55, 55, 55, 55, 55,
+	55, 55, 55, 55, 55, 55, 30, 30, 64, 64,
+	64, 70, 65, 65, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 58, 58, 58, 58, 58, 58, 58, 58, 58,
+	58, 61, 61, 61, 61, 61, 61, 61, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	59, 59, 59, 59, 59, 59, 59, 59, 59, 59,
+	60, 60, 60, 60, 60, 60, 60, 60, 60, 242,
+	242, 62, 62, 62, 62, 62, 62, 62, 28, 28,
+	28, 28, 28, 120, 120, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
+	122, 122, 122, 122, 122, 122, 122, 123, 123, 123,
+	123, 123, 123, 123, 123, 74, 74, 29, 29, 72,
+	72, 73, 104, 104, 75, 75, 71, 71, 71, 71,
+	71, 71, 217, 57, 57, 57, 57, 57, 57, 57,
+	57, 57, 57, 76, 76, 77, 77, 227, 227, 228,
+	78, 78, 79, 79, 80, 81, 81, 81, 82, 82,
+	82, 82, 83, 83, 83, 56, 56, 56, 56, 56,
+	56, 84, 84, 84, 84, 112, 112, 112, 89, 89,
+	66, 66, 68, 68, 67, 69, 90, 90, 94, 91,
+	91, 95, 95, 95, 95, 95, 18, 19, 93, 93,
+	93, 114, 114, 114, 100, 100, 98, 98, 108, 109,
+	109, 109, 109, 115, 115, 115, 116, 116, 218, 218,
+	218, 219, 219, 219, 221, 221, 222, 223, 223, 224,
+	232, 232, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 231, 231, 231, 231, 231, 231, 231,
+	231, 231, 231, 107, 107, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
+	106, 106, 106, 106, 237, 238,
 }
 
 var yyR2 = [...]int8{
-	0, 2, 0, 1, 1, 1, 2, 13, 12, 14,
-	12, 13, 12, 7, 10, 7, 11, 11, 9, 13,
-	16, 5, 8, 11, 13, 13, 14, 14, 6, 7,
-	1, 1, 4, 6, 10, 1, 3, 1, 3, 7,
-	8, 1, 1, 8, 8, 7, 6, 1, 1, 1,
-	3, 0, 4, 3, 4, 5, 4, 2, 6, 1,
-	3, 2, 0, 1, 2, 2, 2, 3, 5, 0,
-	2, 2, 2, 2, 3, 5, 1, 2, 3, 7,
-	5, 9, 1, 3, 3, 2, 2, 2, 2, 2,
-	1, 1, 1, 1, 1, 0, 3, 0, 2, 2,
-	2, 2, 2, 2, 1, 1, 1, 2, 1, 1,
-	1, 3, 1, 3, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 4, 1, 4, 0, 3,
-	0, 2, 2, 0, 2, 2, 2, 2, 2, 0,
-	2, 0, 3, 0, 1, 0, 2, 4, 4, 0,
-	1, 3, 3, 3, 3, 3, 3, 2, 2, 2,
-	3, 1, 1, 1, 1, 1, 2, 2, 3, 2,
-	4, 2, 4, 2, 2, 3, 2, 3, 2, 7,
-	9, 3, 3, 6, 9, 9, 6, 6, 8, 8,
-	5, 8, 7, 4, 0, 2, 4, 6, 2, 4,
+	0, 2, 0, 1, 1, 1, 1, 1, 2, 3,
+	5, 2, 3, 14, 13, 15, 12, 15, 9, 12,
+	7, 10, 7, 11, 11, 10, 9, 13, 16, 8,
+	11, 5, 7, 5, 7, 8, 3, 6, 6, 8,
+	6, 6, 6, 6, 5, 5, 6, 5, 6, 0,
+	2, 0, 1, 1, 11, 13, 15, 14, 14, 6,
+	7, 16, 7, 7, 11, 9, 6, 1, 1, 4,
+	6, 10, 1, 3, 1, 3, 7, 8, 1, 1,
+	8, 8, 7, 6, 1, 1, 1, 3, 0, 4,
+	3, 4, 5, 4, 2, 6, 1, 3, 2, 0,
+	1, 2, 2, 2, 3, 5, 0, 2, 2, 2,
+	2, 3, 5, 1, 2, 3, 7, 5, 9, 1,
+	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
+	1, 1, 0, 3, 0, 2, 2, 2, 2, 2,
 	2, 1, 1, 1, 2, 1, 1, 1, 3, 1,
-	2, 1, 1, 2, 0, 4, 3, 4, 3, 3,
-	3, 3, 3, 3, 3, 2, 4, 6, 2, 3,
-	2, 3, 1, 3, 0, 2, 0, 2, 2, 3,
-	2, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 3, 2, 2, 2, 1, 1, 0,
-	1, 1, 3, 3, 2, 2, 2, 1, 1, 1,
-	1, 4, 5, 4, 4, 4, 1, 2, 2, 3,
-	3, 3, 3, 3, 1, 1, 1, 1, 1, 1,
-	1, 6, 6, 0, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 3, 0, 3, 0, 5, 0,
-	3, 5, 0, 3, 3, 0, 3, 3, 0, 1,
-	0, 1, 0, 2, 1, 0, 3, 3, 0, 1,
-	2, 6, 0, 1, 4, 1, 2, 1, 3, 2,
-	3, 2, 3, 3, 3, 3, 3, 3, 3, 3,
-	0, 1, 1, 1, 0, 2, 5, 2, 3, 3,
-	2, 3, 2, 2, 3, 4, 1, 1, 1, 1,
-	1, 3, 3, 2, 2, 1, 2, 5, 5, 8,
-	8, 13, 11, 1, 1, 2, 2, 10, 8, 9,
-	7, 7, 5, 0, 1, 1, 0, 1, 1, 1,
-	2, 2, 1, 2, 0, 3, 0, 1, 1, 3,
-	0, 4, 1, 3, 2, 1, 1, 2, 1, 1,
-	1, 1, 0, 2, 0, 2, 1, 2, 2, 0,
-	1, 1, 0, 1, 0, 1, 0, 1, 1, 3,
-	1, 2, 3, 5, 0, 1, 2, 1, 1, 0,
-	3, 6, 4, 7, 0, 2, 1, 3, 1, 1,
-	1, 3, 3, 0, 4, 1, 3, 1, 1, 1,
-	1, 1, 1, 4, 8, 1, 1, 3, 1, 3,
-	4, 4, 4, 3, 2, 4, 0, 1, 0, 2,
-	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
-	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
-	3, 0, 5, 5, 5, 0, 2, 0, 4, 1,
-	3, 3, 2, 3, 1, 2, 0, 3, 1, 1,
-	3, 3, 4, 4, 5, 3, 4, 5, 6, 2,
-	1, 2, 1, 2, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 0, 2, 1,
-	1, 1, 3, 1, 3, 1, 1, 1, 1, 1,
-	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 6, 2, 2, 2,
-	2, 2, 2, 2, 3, 3, 1, 1, 1, 1,
-	2, 1, 4, 5, 5, 5, 5, 6, 4, 4,
-	4, 6, 6, 6, 6, 6, 8, 6, 8, 6,
-	8, 6, 8, 9, 7, 5, 4, 4, 3, 3,
-	3, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	0, 2, 4, 4, 4, 4, 0, 3, 4, 7,
-	3, 1, 1, 2, 3, 3, 1, 2, 2, 1,
-	1, 1, 2, 2, 1, 2, 1, 1, 1, 1,
-	2, 1, 1, 1, 1, 1, 2, 2, 1, 1,
-	2, 2, 1, 2, 2, 1, 2, 1, 1, 1,
-	1, 1, 1, 0, 1, 0, 2, 1, 2, 4,
-	0, 2, 0, 2, 1, 3, 5, 3, 2, 1,
-	2, 1, 1, 1, 1, 1, 1, 1, 0, 3,
-	0, 2, 1, 3, 1, 0, 3, 1, 3, 2,
-	0, 1, 1, 0, 2, 4, 4, 0, 2, 4,
-	2, 1, 3, 5, 4, 6, 1, 3, 3, 5,
-	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
-	3, 1, 3, 3, 3, 3, 5, 3, 1, 3,
-	1, 2, 1, 1, 1, 1, 0, 3, 0, 1,
+	3, 3, 1, 2, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 4, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 4, 0, 3, 3, 6, 6, 0, 2,
+	2, 0, 2, 2, 2, 2, 2, 0, 2, 0,
+	3, 0, 1, 0, 2, 4, 4, 8, 0, 1,
+	3, 3, 3, 3, 3, 3, 2, 2, 2, 2,
+	3, 1, 1, 1, 1, 1, 2, 2, 3, 2,
+	4, 2, 4, 2, 2, 3, 4, 4, 2, 3,
+	2, 7, 9, 3, 2, 3, 3, 6, 9, 9,
+	6, 8, 5, 8, 7, 4, 0, 2, 4, 6,
+	2, 4, 4, 2, 1, 1, 1, 2, 1, 1,
+	1, 3, 1, 3, 3, 3, 3, 3, 1, 1,
+	2, 1, 0, 1, 1, 1, 1, 2, 0, 4,
+	3, 4, 3, 3, 3, 3, 3, 3, 3, 2,
+	4, 6, 2, 3, 2, 3, 1, 3, 0, 2,
+	0, 2, 2, 3, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 2, 3, 2, 2,
+	2, 1, 1, 0, 1, 1, 3, 3, 2, 2,
+	2, 1, 1, 1, 1, 4, 5, 4, 4, 4,
+	1, 2, 2, 3, 3, 3, 3, 3, 1, 1,
+	1, 1, 1, 1, 1, 6, 6, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
+	3, 0, 5, 0, 3, 5, 0, 3, 3, 0,
+	3, 3, 0, 1, 0, 1, 0, 2, 1, 0,
+	3, 3, 0, 1, 2, 6, 6, 0, 1, 4,
+	1, 2, 1, 3, 2, 3, 2, 3, 3, 3,
+	3, 3, 3, 3, 3, 0, 1, 1, 1, 0,
+	2, 5, 2, 3, 3, 2, 3, 2, 2, 3,
+	4, 1, 1, 1, 1, 1, 3, 3, 3, 2,
+	2, 4, 1, 2, 5, 5, 8, 8, 13, 11,
+	1, 1, 2, 2, 10, 8, 10, 10, 8, 8,
+	8, 6, 0, 2, 0, 1, 2, 0, 1, 1,
+	0, 1, 1, 1, 2, 2, 1, 2, 0, 3,
+	0, 1, 1, 3, 0, 4, 1, 3, 5, 3,
+	5, 2, 1, 1, 2, 1, 1, 1, 1, 0,
+	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
+	1, 0, 1, 0, 1, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 1, 0, 3, 6, 4,
+	7, 0, 2, 1, 3, 1, 1, 1, 3, 3,
+	0, 4, 1, 3, 1, 1, 1, 1, 1, 1,
+	4, 8, 1, 1, 1, 3, 3, 1, 3, 4,
+	4, 4, 3, 2, 4, 0, 1, 0, 2, 0,
+	1, 0, 1, 2, 1, 1, 1, 2, 2, 1,
+	2, 3, 2, 3, 2, 2, 2, 1, 1, 3,
+	0, 5, 5, 5, 1, 3, 3, 4, 1, 1,
+	1, 0, 2, 0, 4, 0, 2, 3, 1, 3,
+	3, 2, 3, 1, 2, 0, 3, 1, 1, 3,
+	3, 4, 4, 5, 3, 4, 5, 6, 2, 1,
+	2, 1, 2, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 2, 1, 1,
+	1, 3, 1, 3, 1, 1, 1, 1, 1, 1,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 6, 2, 2, 2, 2,
+	2, 2, 2, 3, 3, 1, 1, 1, 1, 2,
+	1, 4, 5, 5, 5, 5, 6, 4, 4, 4,
+	6, 6, 6, 6, 6, 8, 6, 8, 6, 8,
+	6, 8, 9, 7, 5, 4, 4, 3, 3, 3,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 0,
+	2, 4, 4, 4, 4, 4, 4, 4, 0, 3,
+	4, 7, 3, 1, 1, 2, 3, 3, 1, 2,
+	2, 1, 1, 1, 2, 2, 1, 2, 1, 1,
+	1, 1, 2, 1, 1, 1, 1, 1, 2, 2,
+	1, 1, 2, 2, 1, 2, 2, 1, 2, 1,
+	1, 1, 1, 1, 1, 0, 1, 0, 2, 1,
+	2, 4, 0, 2, 0, 2, 1, 1, 1, 1,
+	3, 5, 3, 2, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 0, 3, 0, 2, 1, 3, 1,
+	0, 3, 1, 3, 2, 0, 1, 1, 0, 2,
+	4, 4, 0, 2, 4, 2, 1, 3, 5, 4,
+	6, 1, 3, 3, 5, 1, 1, 1, 0, 5,
+	1, 3, 1, 2, 3, 1, 1, 3, 3, 1,
+	3, 3, 3, 3, 5, 3, 1, 3, 1, 2,
+	1, 1, 1, 1, 0, 3, 0, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 0, 1,
 	2, 0, 2, 2, 0, 1, 4, 1, 3, 2,
 	1, 3, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2202,373 +2604,429 @@ var yyR2 = [...]int8{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1,
+	1, 1, 1, 1, 1, 1,
 }
 
 var yyChk = [...]int16{
-	-1000, -213, -1, -14, -15, -18, 122, 123, -214, 377,
-	-152, 56, -209, -210, -172, 131, 144, 162, 163, 349,
-	129, 361, 362, 146, 364, 76, -96, 134, -153, -139,
-	-102, 61, 34, 59, 130, 130, 132, 202, 132, -102,
-	-102, 135, -46, -108, 59, 61, 129, -98, 135, 364,
-	361, 362, 329, 129, 58, 57, -140, -117, -121, -118,
-	-123, -122, -124, -102, -119, -120, 238, 341, 235, 239,
-	236, 241, 242, 243, 116, 240, 245, 246, 247, 248,
-	249, 250, 251, 252, 253, 254, 255, 244, 256, 31,
-	151, 228, 229, 230, 233, 232, 234, 231, 257, 258,
-	259, 260, 261, 262, 263, 264, 209, 210, 211, 212,
-	213, 214, 215, 216, 217, 218, 220, 221, 223, 224,
-	225, 227, 226, -140, -140, -102, 54, 201, -102, -98,
-	203, -98, 54, -184, 54, 19, 182, 183, 195, 78,
-	23, 119, -98, -46, 78, -46, 293, -156, -221, 344,
-	35, -139, -141, -145, -142, -143, -144, -157, -146, 138,
-	136, 146, 375, 140, 141, -150, 142, 130, 147, 71,
-	78, -178, 138, -181, 54, 272, 278, 136, 147, 146,
-	375, 69, 139, 23, 351, 353, 29, 30, -134, 378,
-	266, -132, 275, -127, 56, -127, -126, 237, -128, 56,
-	-127, -128, -127, -128, -130, 239, -130, -130, -130, -130,
-	56, 56, -127, -127, -127, -127, -127, -136, 56, -125,
-	222, -136, -137, 56, -137, 54, 55, -46, -102, 54,
-	-46, -205, 372, 373, -46, -46, -187, -185, 8, 9,
-	10, -46, 196, 24, -117, -109, -108, -101, 127, 183,
-	352, 77, 23, 25, 272, 278, 182, 80, 116, 16,
-	81, 189, 361, 362, 115, 330, 122, 50, 322, 323,
-	320, 187, 332, 333, 321, 279, 194, 20, 29, 372,
-	10, 26, 149, 22, 109, 124, 184, 84, 85, 152,
-	24, 150, 73, 190, 192, 19, 53, 142, 11, 351,
-	13, 14, 366, 353, 135, 134, 96, 365, 130, 48,
-	8, 118, 27, 373, 93, 44, 147, 193, 46, 94,
-	17, 324, 325, 32, 339, 156, 111, 51, 38, 367,
-	78, 368, 71, 54, 293, 188, 76, 15, 49, 157,
-	369, 144, 191, 95, 125, 329, 47, 185, 370, 128,
-	186, 6, 335, 31, 148, 45, 129, 280, 83, 133,
-	72, 163, 5, 146, 9, 52, 55, 326, 327, 328,
-	36, 82, 12, 145, 343, 74, -46, 24, 127, -46,
-	-154, 57, -103, 69, -102, 286, -101, 34, 56, -177,
-	54, 78, -148, -102, 147, -150, 59, 130, -176, 361,
-	362, -217, 56, -150, -150, 59, 59, 147, 71, 19,
-	-102, 9, 147, 147, -177, 61, -46, 56, -174, 352,
-	16, 56, -179, 56, -180, 61, 62, 63, 64, 71,
-	-129, 70, -52, 267, -59, 320, 323, 322, 268, 72,
-	73, -102, 338, 337, -108, 59, -182, 63, 379, -133,
-	276, 63, -130, -127, -130, 63, 59, -130, -130, -131,
-	116, 115, 31, -131, -131, -131, -131, -138, 61, -138,
-	-135, 343, 344, -135, 63, -136, 63, -46, -102, 56,
-	54, -46, 23, 132, 23, -167, 23, 54, 57, 196,
-	-184, -102, 55, -106, 138, -145, 146, 127, 86, -103,
-	-221, -161, -158, -102, 147, 10, 9, 19, 142, 136,
-	146, 375, -176, 59, 56, -32, -51, 78, -56, 29,
-	24, -55, -52, -69, -198, -67, -68, 116, 117, 105,
-	106, 113, 79, 118, -59, -57, -58, -60, -201, 173,
-	61, 62, -102, 60, 70, 63, 64, 65, 66, 71,
-	-108, 298, -65, -217, 46, 47, 330, 331, 332, 333,
-	339, 334, 81, 36, 38, 244, 267, 268, 320, 328,
-	327, 326, 324, 325, 322, 323, 374, 135, 321, 111,
-	329, 265, 59, 59, -176, 146, -148, -102, 363, -178,
-	375, -129, -217, 56, -32, 23, 29, 63, -179, 56,
-	-180, -169, 374, -169, -217, -127, 56, -127, 56, 56,
-	-217, -217, -217, 119, 58, -131, -130, -131, 58, 58,
-	-131, -131, 59, 59, 116, 58, 57, 58, 228, 228,
-	57, 58, 57, 56, 55, 54, -160, -161, -59, -102,
-	-46, 56, -2, -3, -4, 6, -217, -98, -2, -168,
-	19, 170, 171, -46, -185, -83, -102, 147, -187, -184,
-	-102, -216, 130, 147, -102, 138, -145, -155, -103, 61,
-	63, 58, 57, -127, -159, 270, -127, -147, 166, 167,
-	31, 168, -147, 363, 147, 147, -176, -217, 56, -161,
-	-218, 77, 76, 93, 58, -32, -53, 96, 78, 94,
-	95, 80, 102, 101, 112, 105, 106, 107, 108, 109,
-	110, 111, 103, 104, 374, 86, 87, 88, 89, 90,
-	91, 92, 97, 98, 99, 100, -97, -217, -68, -217,
-	120, 121, -56, -56, -56, -56, -56, -56, -56, -202,
-	266, -169, 61, 119, 119, -2, -63, -32, -217, -217,
-	-217, -217, -217, -217, -217, -217, -217, -72, -32, -217,
-	39, -217, -217, -217, -223, -217, -223, -223, -223, -223,
-	-223, -223, -223, -116, 116, 239, 151, 230, -119, -118,
-	245, 244, -217, -217, -217, -217, -176, 56, -177, -32,
-	-83, 58, 56, 353, 57, 58, -179, 61, 58, 269,
-	118, -117, -218, 58, 58, 58, -30, 22, -29, -63,
-	-31, -32, 107, -108, -29, -32, -29, -103, -131, -130,
-	61, -130, 277, 277, 63, 63, -160, -102, -46, 58,
-	56, 56, -83, -76, 15, -21, 5, -19, -222, -2,
-	-46, 133, 21, 6, 8, 9, 10, 19, -100, 57,
-	23, -187, -215, 56, -102, 146, -102, -163, -165, 343,
-	-164, 55, 143, 69, 175, 176, 177, 178, 179, 180,
-	181, -158, -79, 25, 26, -177, 54, 71, 169, -177,
-	54, -148, -176, 56, -32, -161, 58, -173, 168, -32,
-	-32, -61, 71, 78, 72, 73, -56, -62, -65, -68,
-	67, 96, 94, 95, 80, -56, -56, -56, -56, -56,
-	-56, -56, -56, -56, -56, -56, -56, -56, -56, -56,
-	-121, 229, -116, -119, 59, -55, 61, -102, -55, -102,
-	378, -103, -109, -101, -103, -218, 57, -218, -2, -29,
-	-29, -32, -115, 116, 235, 151, 230, 224, 254, 255,
-	274, 228, 275, 217, 209, 214, 227, 225, 211, 226,
-	210, 223, 220, 233, 232, 234, 245, 236, 241, 243,
-	242, 240, -32, -31, -31, -29, -23, 22, -70, -71,
-	82, -69, -102, -108, 19, -218, -218, -218, -218, 237,
-	-29, -30, -29, -29, -29, -149, -102, -217, -218, 58,
-	349, 350, -32, 56, 63, 58, -134, -218, -29, 57,
-	-218, -218, -105, -104, 23, -102, 61, 119, -218, -218,
-	-217, -131, -131, 58, 58, 58, 56, 56, -84, 365,
-	-160, 58, -80, 17, 16, -5, -3, -217, 21, 22,
-	-25, 42, 43, -20, -218, 23, -149, 184, -99, 82,
-	-102, -188, -190, -6, -8, -7, -10, -9, -11, -12,
-	-13, -16, -3, -22, 10, 9, 20, 31, 188, 189,
-	194, 190, 145, 135, -17, 8, 329, 54, -220, -102,
-	105, 86, 61, -139, 57, 56, 56, 361, 362, 136,
-	-162, 54, -164, 343, 56, 345, 59, -151, 86, 61,
-	86, 86, 86, 86, 86, 86, 86, 9, 10, 56,
-	56, -161, -218, 58, -163, 336, 71, 72, 73, -62,
-	-56, -56, -56, -28, 152, 77, 343, -218, -203, -204,
-	61, 119, -32, -218, -218, -218, 57, 55, 57, -127,
-	-127, -127, -137, 215, -127, 215, -137, -127, -127, -127,
-	-127, -127, -127, 23, 57, 11, 57, 11, -218, -29,
-	-73, -71, 84, -32, -218, 119, -108, -218, -218, -218,
-	-218, 58, 57, -32, -173, 54, 58, -175, 58, 58,
-	-218, -31, -206, 376, -104, 107, -109, -206, -206, -30,
-	-84, -160, -161, -50, 12, 56, 58, -50, -81, 19,
-	32, -32, -77, -78, -32, -76, -2, -23, 68, -2,
-	-170, 55, 185, 204, -32, -190, -76, -19, -19, -19,
-	-193, -102, -192, -19, -212, -211, 299, 300, 301, 302,
+	-1000, -233, -1, -14, -15, -16, -17, -20, 122, 123,
+	69, 124, -234, 379, -162, 94, 56, -2, 23, -3,
+	-4, 6, -237, -229, 362, -230, -184, 131, 144, 162,
+	59, 163, 349, 129, 363, 146, 365, 76, -98, 59,
+	132, 134, 54, 129, 132, 131, 130, -48, -115, 59,
+	-107, 61, 367, 351, 94, -163, -146, -108, 61, 34,
+	-107, 59, -2, 56, -78, 15, -23, 5, -21, -241,
+	-2, 130, 365, 130, 132, 202, 132, -108, -108, 135,
+	-108, 135, -48, 129, -100, 135, 365, 362, 363, 329,
+	129, -48, -48, 129, 137, -102, 135, -102, 132, -102,
+	119, -48, 58, 57, -147, -124, -128, -125, -130, -129,
+	-131, -108, -126, -127, 238, 341, 235, 239, 236, 241,
+	242, 243, 116, 240, 245, 246, 247, 248, 249, 250,
+	251, 252, 253, 254, 255, 244, 256, 31, 151, 228,
+	229, 230, 233, 232, 234, 231, 257, 258, 259, 260,
+	261, 262, 263, 264, 209, 210, 211, 212, 213, 214,
+	215, 216, 217, 218, 220, 221, 223, 224, 225, 227,
+	226, -147, -147, -147, -82, 17, 16, -5, -3, -237,
+	21, 22, -27, 42, 43, -22, -238, 58, -108, 54,
+	201, 130, -108, -100, 203, -100, 54, -201, 54, 19,
+	182, 183, 195, 78, 54, 23, 119, 19, 78, 23,
+	-100, -48, 78, -48, 293, 127, 59, -48, -71, -108,
+	34, -107, 39, -115, 59, -43, -48, 24, -43, -102,
+	-43, -48, -116, -115, -106, 127, 183, 353, 77, 23,
+	25, 272, 278, 182, 80, 116, 16, 81, 189, 362,
+	363, 115, 330, 122, 50, 322, 323, 320, 187, 332,
+	333, 321, 279, 194, 20, 29, 374, 10, 26, 149,
+	22, 109, 124, 184, 84, 85, 152, 24, 150, 73,
+	190, 192, 19, 53, 142, 11, 352, 13, 14, 368,
+	354, 135, 134, 96, 366, 130, 48, 8, 118, 27,
+	375, 93, 44, 147, 193, 46, 94, 17, 324, 325,
+	32, 339, 156, 111, 51, 38, 369, 78, 370, 71,
+	54, 293, 188, 76, 15, 49, 157, 371, 144, 191,
+	95, 125, 329, 47, 185, 34, 372, 128, 186, 6,
+	335, 31, 148, 45, 129, 280, 83, 133, 72, 163,
+	5, 146, 9, 52, 55, 326, 327, 328, 36, 82,
+	12, 145, 343, 74, 58, -167, -166, 344, 35, -146,
+	-148, -152, -149, -150, -151, -169, -160, -153, 138, 136,
+	146, 377, 140, 141, 130, 147, 142, 71, 78, -193,
+	138, -198, 54, 272, 278, 136, 147, 146, 377, 69,
+	59, 139, 23, 352, 354, 29, 30, -141, 380, 266,
+	-139, 275, -134, 56, -134, -133, 237, -135, 56, -134,
+	-135, -134, -135, -137, 239, -137, -137, -137, -137, 56,
+	56, -134, -134, -134, -134, -134, -143, 56, -132, 222,
+	-143, -144, 56, -144, -83, 19, 32, -34, -53, 78,
+	-58, 29, 24, -57, -54, -71, -217, -69, -70, 116,
+	117, 105, 106, 113, 79, 118, -61, -59, -60, -62,
+	-221, 173, 61, 62, -108, 60, 70, 63, 64, 65,
+	66, 71, 72, 73, -115, 298, -67, -237, 338, 337,
+	46, 47, 330, 331, 332, 333, 339, 334, 81, 36,
+	38, 244, 267, 268, 320, 328, 327, 326, 324, 325,
+	322, 323, 376, 135, 321, 111, 329, 151, 228, 230,
+	265, -79, -80, -34, -78, -2, -25, 22, 68, 54,
+	55, -48, -108, -108, 54, -48, -225, 374, 375, -48,
+	-48, -204, -202, 8, 9, 10, -48, 196, 24, 59,
+	-147, -116, 129, 21, 24, -124, 56, 129, -48, 24,
+	127, 59, -48, 138, 377, 133, 93, 93, 119, -101,
+	57, 167, 166, -101, -43, -101, 54, 59, -164, 57,
+	343, -109, 69, -108, -107, 286, -106, 34, 56, 59,
+	-192, 54, 78, -158, -108, 147, -160, 59, 130, -191,
+	367, 362, 363, -237, -160, -160, 59, 147, 71, 59,
+	19, -108, 9, 147, 147, -192, 61, -48, 56, -188,
+	353, 16, 56, -194, 56, -195, 61, 62, 63, 64,
+	71, -136, 70, -54, 267, -61, 244, 320, 323, 322,
+	268, -108, -115, -199, 63, 381, -140, 276, 63, -137,
+	-134, -137, 63, 59, -137, -137, -138, 116, 115, 31,
+	-138, -138, -138, -138, -145, 61, -145, -142, 343, 344,
+	-142, 63, -143, 63, 9, 96, 77, 76, 93, 57,
+	18, -34, -55, 96, 78, 94, 95, 80, 102, 101,
+	112, 105, 106, 107, 108, 109, 110, 111, 103, 104,
+	376, 86, 87, 88, 89, 90, 91, 92, 97, 98,
+	99, 100, -99, -237, -70, -237, 120, 121, -58, -58,
+	-58, -58, -58, -58, -58, -222, 266, -181, 376, -237,
+	61, 119, 119, -2, -65, -34, -237, -237, -237, -237,
+	-237, -237, -237, -237, -237, -237, -237, -74, -34, -237,
+	39, -237, -237, -237, -242, -237, -242, -242, -242, -242,
+	-242, -242, -242, -123, 116, 239, 151, 230, -126, -125,
+	245, 244, -237, -237, -237, -237, -237, -237, -237, 57,
+	-81, 25, 26, -82, -238, -26, 45, -48, -108, 56,
+	54, 54, -48, 23, 132, 23, -179, 23, 54, 57,
+	76, 196, -201, -108, -205, -206, 59, 61, 63, 64,
+	118, 54, 78, 69, 320, 267, 231, 105, 106, 56,
+	58, 23, -43, 280, -108, -163, 56, 55, -113, 138,
+	-152, 146, 133, 54, 127, -108, -237, -108, 61, 62,
+	61, 62, -109, -116, -107, -106, -48, -101, -48, -237,
+	86, -109, -166, 56, -173, -170, -108, -107, 147, 56,
+	362, -191, 146, 10, 9, 19, 142, 136, 146, 377,
+	-191, 59, 56, 78, -34, 59, 59, -158, -108, 364,
+	-193, 377, -136, 362, 363, -237, 56, -34, 23, 29,
+	63, -194, 56, -195, -196, -61, -197, -108, -181, -181,
+	-237, -237, -134, 56, -134, 56, 56, 119, 58, -138,
+	-137, -138, 58, 58, -138, -138, 59, 59, 116, 58,
+	57, 58, 228, 228, 57, 58, 57, 40, -34, -34,
+	-63, 71, 78, 72, 73, -34, -34, -58, -64, -67,
+	-70, 67, 96, 94, 95, 80, -58, -58, -58, -58,
+	-58, -58, -58, -58, -58, -58, -58, -58, -58, -58,
+	-58, -128, 229, -123, -126, 59, -57, 61, -108, -57,
+	-108, 380, 269, 118, -124, -32, 22, -31, -65, -33,
+	-34, 107, -115, -109, -109, -238, 57, -238, -2, -31,
+	-34, -31, -31, -31, -34, -122, 116, 235, 151, 230,
+	224, 254, 255, 274, 228, 275, 217, 209, 214, 227,
+	225, 211, 226, 210, 223, 220, 233, 232, 234, 245,
+	236, 241, 243, 242, 240, -34, -33, -33, -31, -25,
+	-72, -73, 82, -71, 19, -238, -238, -238, -238, 237,
+	-31, -32, -31, -31, -31, -31, -31, -31, -80, -83,
+	-31, 56, 55, 54, -172, -173, -61, -108, -107, -48,
+	-48, 56, -2, -100, -2, -180, 19, 170, 171, -48,
+	-202, -202, -85, -108, 147, -107, -204, -201, 59, -206,
+	-147, 54, 58, -163, -108, -236, 130, 147, -108, -108,
+	-108, 138, -152, 377, -34, 119, -43, -165, -109, 61,
+	63, -168, -164, 58, 57, -134, -171, 270, -134, -134,
+	-34, 365, -191, -157, 166, 167, 31, 168, -157, 364,
+	147, 147, -191, 367, -237, 56, -173, 22, -238, 56,
+	-192, -34, -85, 58, 56, 354, 57, 58, -194, 61,
+	58, 58, 105, 106, 107, 108, -238, -238, 58, 58,
+	58, -109, -138, -137, 61, -137, 277, 277, 63, 63,
+	41, 71, 72, 73, -64, -58, -58, -58, -30, 152,
+	77, 343, -238, -223, -224, 61, -141, -238, -31, 57,
+	-238, -238, -111, -110, 23, -108, 61, 119, -237, -34,
+	-238, -238, -238, -238, -238, 57, 55, 57, -134, 56,
+	-134, -134, -144, 215, -134, 215, -144, -134, -134, -134,
+	-134, -134, -134, 23, 57, 11, 57, 11, -238, -31,
+	-75, -73, 84, -34, -238, -115, -238, -238, -238, -238,
+	-238, -238, -238, -35, 11, -172, -108, -48, 58, 56,
+	-175, -177, 343, -176, 55, 143, 69, 175, 176, 177,
+	178, 179, 180, 181, -85, -48, 133, 21, 6, 8,
+	9, 10, 19, -103, 57, 23, -204, -210, -209, 204,
+	-6, -8, -7, -10, -9, -11, -12, -13, -18, -3,
+	-24, 10, 9, 20, 31, 188, 189, 194, 190, 145,
+	135, -19, 8, 329, 59, 58, -235, 56, -108, 146,
+	59, -108, -237, -238, -109, -238, 58, 57, 86, -175,
+	-170, -81, -81, 58, -175, -192, 54, 71, 169, -192,
+	54, -158, -191, 56, 78, -34, -173, 58, 56, -185,
+	168, -159, -108, -237, -238, 58, -189, 349, 350, 351,
+	-34, 56, 63, 58, -58, -58, -58, -58, -138, -138,
+	58, 58, -30, 77, -58, -58, 228, 381, 57, -181,
+	-238, -33, -226, 378, -110, 107, -116, -32, -226, -226,
+	-122, 116, 151, 230, 228, -120, 59, 61, -34, -137,
+	59, -122, -58, -58, -58, -58, 340, -78, 85, -34,
+	83, -52, 12, -36, -37, -38, -39, -50, -70, -237,
+	-48, 58, 56, 56, -86, 366, -172, -174, 54, -176,
+	343, 56, 345, 59, -161, 86, 61, 86, 86, 86,
+	86, 86, 86, 86, 58, 23, -159, 184, -104, 82,
+	-108, -107, -207, -209, 54, -209, -78, -21, -21, -21,
+	-212, -108, -211, -21, -232, -231, 299, 300, 301, 302,
 	303, 304, 305, 306, 307, 308, 309, 310, 311, 312,
-	313, 314, 315, 316, 317, 318, 319, -102, -102, -102,
-	-186, 38, 191, 192, 193, -51, -56, -32, -51, -46,
-	58, -220, -102, -220, -220, -220, -220, -220, -161, -161,
-	56, 56, 147, -102, -166, -164, -102, 63, -183, 54,
-	74, 63, -183, -183, -183, -183, -183, -147, -147, -149,
-	-161, 58, -173, -163, -162, -28, 77, -56, -56, 228,
-	379, 57, -169, -103, -115, 116, -113, 59, 61, -32,
-	-130, 59, -115, -56, -56, -56, -56, 340, -76, 85,
-	-32, 83, -103, 139, -102, -218, 10, 9, 349, 350,
-	58, 205, 355, 356, 156, 357, 168, 358, 359, -217,
-	119, -218, -50, 58, 58, -163, -32, -83, -84, -163,
-	9, 96, 57, 18, 57, -79, -80, -218, -24, 45,
-	-171, 343, -32, -191, -190, 204, -189, -190, -80, -96,
-	11, -41, -46, -34, -35, -36, -37, -48, -68, -217,
-	-46, 57, -194, -117, 186, -89, -114, 206, -93, 288,
-	287, -103, 298, -91, 286, 239, 285, -183, 57, -102,
-	11, 11, 11, 11, -190, 204, 83, 204, -100, 19,
-	58, 58, -161, -161, 56, -217, 58, 57, -177, -177,
-	58, 58, -163, -162, -56, 277, -204, -218, -218, -218,
-	-218, -218, 57, -218, 19, -218, 57, -218, 19, -217,
-	-27, 335, -32, -46, -173, -147, -147, 343, 63, 16,
-	63, 63, 63, 63, 356, 156, 358, 16, -218, 157,
-	-76, 107, -163, -50, -163, -162, 58, -50, -162, 40,
-	-32, -32, -78, -81, -29, 375, -190, 377, -190, -81,
-	-47, 27, -46, -46, -41, -219, 57, 11, 55, 31,
-	57, -42, -44, -43, -45, 44, 48, 50, 45, 46,
-	47, 51, -112, 23, -34, -217, -111, 157, -110, 23,
-	-108, 61, -192, -102, 187, 57, -89, 206, -90, -94,
-	289, 291, 86, 119, -107, -102, 61, 29, 31, -211,
-	27, -189, -188, -189, -99, 184, -199, 197, 78, 58,
-	58, -161, -102, -164, 139, -163, -162, -56, -56, -56,
-	-56, -56, -218, 61, 56, 63, 63, 360, -108, 16,
-	-218, -162, -163, -163, 41, -33, 11, -32, 377, 85,
-	-190, -85, 157, -46, -85, 55, -34, -46, -88, -92,
-	-69, -35, -36, -36, -35, -36, 44, 44, 44, 49,
-	44, 49, 44, -43, -108, -218, -49, 52, 134, 53,
-	-217, -110, 19, -93, -90, 57, 290, 292, 293, 54,
-	74, -32, -103, -131, -102, 85, 377, 377, 85, 204,
-	185, -200, 198, 197, -163, -163, 58, -218, -46, -162,
-	-218, -218, -218, -218, -26, 96, 343, -149, 119, -207,
-	-208, -32, -162, -50, -34, 85, -54, 31, 36, -2,
-	-217, -217, -50, -34, -50, -50, 57, 86, -39, -38,
-	54, 55, -40, 54, -38, 44, 44, -196, 343, 130,
-	130, 130, -86, -102, -2, -94, -95, 294, 291, 297,
-	86, 85, 84, -189, 200, 199, -162, -162, 56, -218,
-	341, 51, 346, 58, -103, -218, -76, 57, -74, 13,
-	-87, 54, -88, -64, -66, -65, -217, -2, -82, -102,
-	-86, -76, -50, -76, -92, -32, -32, 56, -32, 56,
-	-217, -217, -217, -218, 57, 291, 295, 296, -32, 135,
-	204, 377, -149, 41, 342, 347, -218, -208, -75, 14,
-	16, 28, -87, 57, -218, -218, -218, 57, 119, -218,
-	-80, -80, -83, -195, -197, 366, 367, 368, 369, 370,
-	371, -83, -83, -83, -111, -102, -189, 85, 58, 41,
-	-32, -63, 147, -66, 36, -2, -217, -102, -102, 58,
-	58, 57, -218, -218, -218, -49, 85, 343, 9, -64,
-	-2, 119, -197, -196, 346, -88, -218, -102, 347,
+	313, 314, 315, 316, 317, 318, 319, -108, -108, -108,
+	-203, 38, 191, 192, 193, -53, -58, -34, -53, -205,
+	-240, -108, 105, 86, 61, -146, 57, 56, -220, 362,
+	363, 367, 55, 136, -34, -187, 78, -164, -165, -174,
+	-81, -174, 9, 10, 56, 56, -173, 22, -238, 58,
+	-86, -173, -186, 59, 78, 336, 58, 57, -34, -185,
+	54, 58, -190, 58, 58, -58, 277, -224, -237, 119,
+	-238, -238, -238, -238, -238, -238, 57, -238, 19, -238,
+	57, -238, 19, -237, -29, 335, -34, -76, 13, -34,
+	57, -44, -46, -45, -47, 44, 48, 50, 45, 46,
+	47, 51, -119, 23, -36, -237, -118, 157, -117, 23,
+	-115, 61, -86, -172, -173, -220, 56, 58, -108, -178,
+	-176, -108, 63, -200, 54, 74, 63, -200, -200, -200,
+	-200, -200, -52, -2, -182, 55, 185, 59, -105, 204,
+	59, -34, -209, -48, 379, -82, -98, 11, -43, -36,
+	57, -213, -124, 186, -91, -121, 206, -95, 288, 287,
+	-109, 298, -93, 286, 239, 285, -200, 57, -108, 11,
+	11, 11, 11, -209, 204, 83, 204, 59, 58, -240,
+	-108, -240, -240, -240, -240, -240, -173, 56, 56, 56,
+	22, 78, -108, 147, -238, 59, 86, -157, -157, -159,
+	-173, 58, 56, -185, -175, -175, 58, 59, 139, -108,
+	-238, 10, 9, -189, 58, 205, 356, 357, 156, 358,
+	168, 359, 360, -238, 157, -78, 107, -58, -58, -58,
+	-58, -58, -238, 61, -77, 14, 16, -37, -38, -38,
+	-37, -38, 44, 44, 44, 49, 44, 49, 44, -45,
+	-115, -238, -51, 52, 134, 53, -237, -117, -220, 58,
+	58, -52, -85, -86, -237, 58, 57, -175, -183, 343,
+	-34, -210, -208, -209, 59, 161, -103, 19, 85, -83,
+	-49, 27, -48, -48, -43, -239, 11, 55, 31, -211,
+	-108, 187, 57, -91, 206, -92, -96, 289, 291, 86,
+	119, -114, -108, 61, 29, 31, -231, 27, -208, -207,
+	-208, -210, 58, -173, -173, -173, 22, 56, 56, -187,
+	-165, -192, -192, 58, 58, -86, -173, -186, -174, -174,
+	-86, -48, -185, -157, -157, 343, 63, 16, 63, 63,
+	63, 63, 357, 156, 359, 16, 16, -238, -238, -238,
+	-238, -238, -28, 96, 343, -34, -65, -41, -40, 54,
+	55, -42, 54, -40, 44, 44, -215, 343, 130, 130,
+	130, -88, -108, -52, -86, -175, -175, 58, -220, -108,
+	-176, -174, 377, 379, -209, -48, -48, -104, 184, -87,
+	157, -48, -87, 55, -36, -90, -94, -71, 19, -95,
+	-92, 57, 290, 292, 293, 54, 74, -34, -109, -138,
+	-108, 85, 379, 379, 85, 58, 58, 58, -155, -154,
+	-108, -173, 139, -175, -175, 58, 56, 63, 63, 361,
+	-115, -227, -228, -34, -238, 341, 51, 346, -34, 56,
+	-34, 56, -237, -237, -237, -238, 57, -175, -220, -174,
+	-52, -238, -34, 85, -209, -237, -237, 204, 185, -56,
+	31, 36, -2, -237, -237, -52, -36, -52, -52, 57,
+	86, -2, -96, -97, 294, 291, 297, 86, 85, 84,
+	-86, -175, -175, 58, 57, 343, -108, 58, -48, -174,
+	-174, -86, -159, 119, -238, -78, 57, 41, 342, 347,
+	-85, -214, -216, 368, 369, 370, 371, 372, 373, -85,
+	-85, -85, -118, -108, -174, -52, -175, -32, -32, -208,
+	-89, 54, -90, -66, -68, -67, -237, -2, -84, -112,
+	-108, 34, -107, -88, -78, -52, -78, -94, -34, 291,
+	295, 296, -34, 135, 204, -218, 197, 78, -174, -174,
+	-52, -154, -156, 86, 91, 77, 343, 56, 58, -109,
+	-238, -228, 41, 58, 58, 57, -238, -238, -238, -51,
+	-175, -174, -238, -238, 379, 28, -89, 57, -238, -238,
+	-238, 57, 119, -238, -82, -82, -208, -219, 198, 197,
+	-218, -156, -159, 343, -216, -215, 85, 147, -68, 36,
+	-2, -237, -112, -108, -108, 85, 200, 199, -219, 58,
+	346, 9, -66, -2, 119, 347, -90, -238, -108,
 }
 
 var yyDef = [...]int16{
-	0, -2, 2, 4, 5, 0, -2, 778, 1, 3,
-	6, 149, 0, 0, 0, 0, 0, 0, 0, 0,
-	776, 397, 398, 399, 402, 0, 0, 779, 0, 150,
-	194, 194, 194, 780, 0, 0, 776, 0, 776, 0,
-	0, 0, 0, 509, 784, 785, 776, 0, 0, 403,
-	400, 401, 146, 0, 410, 0, 157, 322, 318, 161,
-	162, 163, 164, 165, 305, 241, 269, 270, 305, 293,
-	312, 305, 312, 276, 305, 312, 325, 325, 325, 325,
-	325, 284, 285, 286, 287, 288, 289, 290, 0, 0,
-	261, 305, 305, 305, 305, 305, 267, 268, 295, 296,
-	297, 298, 299, 300, 301, 302, 242, 243, 244, 245,
-	246, 247, 248, 249, 250, 251, 307, 259, 307, 309,
-	309, 257, 258, 158, 159, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 104, 105, 106, 0, 0,
-	0, 0, 0, 147, 0, 0, 0, 148, 412, 0,
-	415, 151, 152, 153, 154, 155, 156, 0, 404, 406,
-	0, 393, 0, 0, 0, 0, 0, 366, 367, 167,
-	0, 169, 0, 171, 0, 173, 174, 0, 176, 178,
-	404, 0, 0, 0, 0, 0, 0, 0, 166, 0,
-	324, 320, 319, 240, 0, 325, 305, 294, 325, 0,
-	325, 325, 277, 278, 328, 0, 328, 328, 328, 328,
-	0, 0, 315, 315, 264, 265, 266, 252, 0, 307,
-	260, 254, 255, 0, 256, 0, 0, 0, 0, 0,
-	0, 0, 91, 92, 0, 130, 0, 112, 108, 109,
-	110, 0, 107, 0, 21, 510, 786, 787, 823, 824,
-	825, 826, 827, 828, 829, 830, 831, 832, 833, 834,
-	835, 836, 837, 838, 839, 840, 841, 842, 843, 844,
-	845, 846, 847, 848, 849, 850, 851, 852, 853, 854,
-	855, 856, 857, 858, 859, 860, 861, 862, 863, 864,
-	865, 866, 867, 868, 869, 870, 871, 872, 873, 874,
-	875, 876, 877, 878, 879, 880, 881, 882, 883, 884,
-	885, 886, 887, 888, 889, 890, 891, 892, 893, 894,
-	895, 896, 897, 898, 899, 900, 901, 902, 903, 904,
-	905, 906, 907, 908, 909, 910, 911, 912, 913, 914,
-	915, 916, 917, 918, 919, 920, 921, 922, 923, 924,
-	925, 926, 927, 928, 929, 930, 931, 932, 933, 934,
-	935, 936, 937, 938, 939, 940, 941, 942, 943, 944,
-	945, 946, 947, 948, 949, 950, 0, 777, 143, 0,
-	0, 0, 416, 418, 781, 782, 783, 414, 0, 376,
-	0, 0, 0, 407, 357, 0, 362, -2, 0, 394,
-	395, 794, 951, 0, 0, 360, 393, 406, 168, 0,
-	0, 0, 175, 177, 0, 181, 182, 794, 0, 212,
-	0, 0, 195, 0, 198, -2, 201, 202, 203, 236,
-	205, 206, 207, 0, 209, 305, 305, 232, 0, 528,
-	529, 0, 0, 0, 0, -2, 210, 211, 323, 160,
-	321, 0, 328, 325, 328, 0, 0, 328, 328, 279,
-	329, 0, 0, 280, 281, 282, 283, 0, 303, 0,
-	262, 0, 0, 263, 0, 253, 0, 0, 0, 0,
-	0, 0, 0, 776, 0, 133, 0, 0, 0, 0,
-	0, 0, 0, 0, 406, 28, 144, 0, 0, 417,
-	413, 0, 370, 305, 305, 0, 0, 0, 0, 0,
-	393, 0, 0, 361, 0, 0, 519, 794, 524, 526,
-	0, 565, 566, 567, 568, 569, 570, 794, 794, 794,
-	794, 794, 794, 794, 596, 597, 598, 599, 0, 601,
-	-2, 709, 704, 711, 712, 713, 714, 715, 716, 717,
-	0, 0, 757, 794, 0, 0, 0, 0, 0, 0,
-	0, 0, -2, 0, 0, 0, 0, 0, 640, 640,
-	640, 640, 640, 640, 640, 640, 0, 0, 0, 0,
-	0, 795, 358, 359, 364, 393, 0, 407, 193, 170,
-	404, 172, 794, 0, 0, 0, 213, 0, 0, 0,
-	0, 200, 0, 204, 0, 228, 0, 230, 0, 0,
-	-2, 794, 794, 0, 306, 271, 328, 273, 313, 314,
-	274, 275, 330, 326, 327, 325, 0, 325, 0, 0,
-	0, 310, 0, 0, 0, 0, 0, 368, 369, 305,
-	0, 0, -2, 725, 0, 422, 0, 0, -2, 0,
-	0, 131, 132, 128, 113, 111, 475, 476, 0, 0,
-	95, 0, 30, 31, 407, 406, 29, 411, 419, 420,
-	421, 332, 0, 730, 374, 375, 373, 404, 383, 384,
-	0, 0, 404, 405, 406, 393, 0, 794, 0, 0,
-	234, 794, 794, 0, 952, 522, 794, 0, 0, 794,
-	794, 794, 794, 794, 794, 794, 794, 794, 794, 794,
-	794, 794, 794, 794, 0, 546, 547, 548, 549, 550,
-	551, 552, 553, 554, 555, 556, 525, 0, 539, 0,
-	0, 0, 587, 588, 589, 590, 591, 592, 593, 600,
-	0, 708, 710, 0, 0, 35, 0, 563, 794, 794,
-	794, 794, 794, 794, 794, 794, 432, 0, 694, 0,
-	0, 0, 0, 0, 631, 0, 632, 633, 634, 635,
-	636, 637, 638, 639, 685, 0, 687, 688, 689, 690,
-	691, 692, 794, -2, 794, 794, 365, 0, 0, 0,
-	0, 0, 794, 190, 0, 196, 0, 236, 199, 237,
-	238, 322, 208, 229, 231, 233, 0, 794, 0, 0,
-	438, 444, 440, 0, 0, 444, 0, 0, 272, 328,
-	304, 328, 316, 317, 0, 0, 0, 0, 0, 517,
-	951, 0, 0, 733, 0, 0, 426, 429, 424, 35,
-	0, 0, 134, 135, 136, 137, 138, 0, 700, 0,
-	0, 0, 22, 97, 0, 0, 407, 354, 333, 0,
-	335, 0, 350, 0, 0, 0, 0, 0, 0, 0,
-	0, 371, 372, 731, 732, 377, 0, 385, 386, 378,
-	0, 0, 0, 0, 0, 0, 332, 392, 0, 520,
-	521, 523, 540, 0, 542, 544, 530, 531, 559, 560,
-	561, 0, 794, 794, 794, 557, 535, 0, 571, 572,
-	573, 574, 575, 576, 577, 578, 579, 580, 581, 582,
-	585, 0, 595, 305, 0, 583, 236, 0, 584, 594,
-	0, 705, 0, -2, 707, 562, 794, 756, 35, 0,
-	0, 0, 0, -2, 305, 656, 305, 309, 659, 660,
-	661, 305, 664, 666, 667, 668, 669, 309, 671, 672,
-	673, 674, 675, 305, 305, 678, 679, 305, 305, 682,
-	305, 305, 0, 0, 0, 0, 794, 433, 702, 697,
-	794, 0, 704, 0, 0, 628, 629, 630, 641, 686,
-	0, 0, 437, 0, 0, 0, 408, 794, 234, 183,
-	186, 187, 0, 214, 0, 0, 239, 602, 0, 794,
-	449, 608, 441, 445, 0, 447, 448, 0, 449, 449,
-	-2, 291, 292, 308, 311, 517, 0, 0, 515, 0,
-	0, 515, 737, 794, 794, 725, 37, 0, 427, 428,
-	432, 430, 431, 423, 36, 0, 139, 0, 0, 794,
-	477, 18, 114, 116, 117, 118, 119, 120, 121, 122,
-	123, 124, 725, 422, 422, 422, 0, 422, 0, 0,
-	0, 69, 794, 794, 768, 41, 42, 0, 0, -2,
-	97, 97, -2, 97, 97, 0, 0, 0, 0, 0,
-	331, 0, 336, 0, 0, 0, 339, 0, 351, 341,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 234, 332, 354, 235, 541, 543, 545, 532,
-	557, 536, 0, 533, 794, 794, 0, 527, 0, 797,
-	236, 0, 564, -2, 609, 610, 0, 0, 794, 653,
-	325, 657, 658, 662, 663, 665, 670, 676, 677, 680,
-	681, 683, 684, 0, 794, 794, 794, 794, 0, 725,
-	0, 698, 794, 0, 626, 0, 627, 642, 643, 644,
-	645, 0, 0, 0, 179, 0, 0, 0, 192, 197,
-	603, 439, 604, 0, 446, 442, 0, 605, 606, 0,
-	515, 0, 0, 332, 794, 0, 517, 332, 32, 0,
-	0, 734, 726, 727, 730, 733, 35, 434, 425, -2,
-	141, 794, 129, 0, 701, 115, 733, 778, 0, 0,
-	57, 62, 59, 0, 0, 800, 802, 803, 804, 805,
-	806, 807, 808, 809, 810, 811, 812, 813, 814, 815,
-	816, 817, 818, 819, 820, 821, 822, 64, 65, 66,
-	0, 0, 0, 0, 0, 0, 0, 0, 519, 128,
-	96, 98, -2, 99, 100, 101, 102, 103, 0, 0,
-	0, 0, 0, 355, 0, 337, 342, 340, 343, 352,
-	353, 344, 345, 346, 347, 348, 349, 404, 404, 0,
-	0, 332, 391, 354, 390, 534, 794, 558, 537, 0,
-	796, 0, 799, 706, 0, 305, 0, 651, 652, 0,
-	654, 655, 0, 0, 0, 0, 0, 0, 695, 625,
-	703, 794, 705, 0, 409, 234, 0, 0, 188, 189,
-	191, 0, 0, 0, 0, 0, 0, 225, 0, 0,
-	0, 607, 332, 515, 332, 354, 516, 0, 515, 354,
-	738, 0, 794, 794, 794, 729, 737, 38, 794, 435,
-	16, 0, 140, 17, 126, 0, 0, 76, 737, 0,
-	0, 0, 49, 0, 456, 458, 459, 460, 490, 0,
-	492, 0, 0, 61, 63, 53, 0, 0, 761, 93,
-	94, 0, 0, 0, -2, 0, 772, 769, 0, 67,
-	70, 71, 72, 73, 74, 0, 0, 0, 700, 0,
-	23, 788, 0, 0, 0, 0, 334, 0, 379, 380,
-	0, 332, 354, 388, 538, 586, 798, 611, 614, 612,
-	613, 615, 794, 617, 794, 619, 794, 621, 794, 794,
-	0, 0, 699, 0, 180, 184, 185, 0, 216, 0,
-	218, 219, 220, 221, 222, 223, 224, 0, 450, 0,
-	0, 443, 354, 332, 10, 8, 518, 332, 12, 0,
-	735, 736, 728, 33, 454, 794, 0, 0, 77, 125,
-	51, 0, 508, -2, 0, 0, 0, 47, 48, 0,
-	0, 0, 0, 0, 0, 497, 0, 0, 500, 0,
-	0, 0, 0, 491, 0, 0, 511, 0, 493, 0,
-	495, 496, 60, 0, 0, 0, 54, 0, 56, 82,
-	0, 0, 794, 0, 328, 773, 774, 775, 771, 801,
-	0, 0, 0, 0, 0, 0, 791, 789, 0, 332,
-	332, 0, 0, 338, 0, 354, 389, 0, 0, 0,
-	0, 646, 624, 696, 0, 215, 217, 226, 0, 794,
-	452, 7, 11, 354, 739, 515, 0, 142, 0, 19,
-	78, 0, 0, 507, 515, 0, 515, 50, 515, 758,
-	0, 457, 486, 488, 0, 483, 498, 499, 501, 0,
-	503, 0, 505, 506, 461, 462, 463, 0, 0, 0,
-	0, 494, 0, 762, 55, 0, 0, 85, 86, 763,
-	764, 765, 0, 767, 68, 75, 0, 0, 80, 0,
-	129, 25, 0, 790, 354, 354, 24, 356, 0, 387,
-	616, 618, 620, 622, 0, 0, 0, 0, 0, 0,
-	722, 724, 9, 718, 455, 127, 750, 0, 0, -2,
-	0, 0, 725, 515, 46, 725, 0, 794, 480, 487,
-	794, 0, 481, 794, 482, 502, 504, 473, 0, 0,
-	0, 0, 0, 478, -2, 83, 84, 0, 0, 90,
-	794, 0, 0, 0, 792, 793, 26, 27, 0, 623,
-	0, 0, 0, 382, 227, 451, 0, 794, 720, 0,
-	39, 0, 750, 740, 752, 754, 794, 35, 0, 746,
-	0, 733, 45, 733, 759, 760, 484, 0, 489, 0,
-	0, 0, 0, 492, 0, 87, 88, 89, 766, 79,
-	0, 0, 0, 647, 0, 650, 453, 723, 34, 794,
-	794, 0, 40, 0, 755, -2, 0, 0, 0, 52,
-	44, 43, 0, 0, 465, 467, 468, 469, 470, 471,
-	472, 0, 0, 0, 511, 479, 0, 20, 381, 648,
-	721, 719, 0, 753, 0, -2, 0, 748, 747, 485,
-	464, 0, 512, 513, 514, 463, 81, 0, 0, 743,
-	35, 0, 466, 474, 0, 751, -2, 749, 649,
+	0, -2, 2, 4, 5, 6, 7, 0, -2, 886,
+	0, 0, 1, 3, 8, 0, -2, -2, 0, 830,
+	0, 509, 0, 0, 481, 0, 0, 0, 0, 0,
+	0, 0, 0, 884, 482, 483, 486, 0, 0, 0,
+	0, 887, 0, 49, 49, 0, 49, 9, 598, 893,
+	894, 895, 933, 934, 0, 0, 209, 256, 256, 256,
+	256, 888, -2, 1064, 838, 0, 0, 513, 516, 511,
+	72, 0, 0, 0, 884, 0, 884, 0, 0, 0,
+	36, 0, 0, 884, 0, 0, 487, 484, 485, 204,
+	0, 0, 0, 0, 0, 0, 0, 0, 49, 0,
+	0, 0, 494, 0, 216, 396, 392, 221, 222, 223,
+	224, 225, 379, 315, 343, 344, 379, 367, 386, 379,
+	386, 350, 379, 386, 399, 399, 399, 399, 399, 358,
+	359, 360, 361, 362, 363, 364, 0, 0, 335, 379,
+	379, 379, 379, 379, 341, 342, 369, 370, 371, 372,
+	373, 374, 375, 376, 316, 317, 318, 319, 320, 321,
+	322, 323, 324, 325, 381, 333, 381, 383, 383, 331,
+	332, 217, 218, 219, 842, 904, 904, 830, 74, 0,
+	514, 515, 519, 517, 518, 510, 73, 1065, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 141,
+	142, 143, 0, 0, 0, 256, 0, 0, 0, 0,
+	0, 205, 0, 0, 0, 0, 0, 0, 0, 806,
+	807, -2, 809, 0, -2, 51, 86, 50, 51, 0,
+	51, 86, 599, 896, 897, 935, 936, 937, 938, 939,
+	940, 941, 942, 943, 944, 945, 946, 947, 948, 949,
+	950, 951, 952, 953, 954, 955, 956, 957, 958, 959,
+	960, 961, 962, 963, 964, 965, 966, 967, 968, 969,
+	970, 971, 972, 973, 974, 975, 976, 977, 978, 979,
+	980, 981, 982, 983, 984, 985, 986, 987, 988, 989,
+	990, 991, 992, 993, 994, 995, 996, 997, 998, 999,
+	1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009,
+	1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018, 1019,
+	1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
+	1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038, 1039,
+	1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048, 1049,
+	1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058, 1059,
+	1060, 1061, 1062, 1063, 10, 206, 496, 0, 502, 210,
+	211, 212, 213, 214, 215, 0, 0, 488, 490, 0,
+	477, 0, 0, 0, 441, 442, 0, 227, 0, 229,
+	0, 231, 0, 233, 234, 0, 238, 240, 488, 0,
+	244, 0, 0, 0, 0, 0, 0, 226, 0, 398,
+	394, 393, 314, 0, 399, 379, 368, 399, 0, 399,
+	399, 351, 352, 402, 0, 402, 402, 402, 402, 0,
+	0, 389, 389, 338, 339, 340, 326, 0, 381, 334,
+	328, 329, 0, 330, 69, 0, 0, 839, 618, 904,
+	623, 625, 0, 664, 665, 666, 667, 668, 669, 904,
+	904, 904, 904, 904, 904, 904, 695, 696, 697, 698,
+	0, 700, -2, 814, 806, 816, 817, 818, 819, 820,
+	821, 822, 627, 628, 0, 0, 865, 904, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, -2, 0,
+	0, 0, 0, 0, 739, 739, 739, 739, 739, 739,
+	739, 739, 0, 0, 0, 0, 0, 0, 0, 0,
+	905, 831, 832, 835, 838, 72, 521, 520, 512, 0,
+	0, 0, 0, 0, 0, 0, 0, 128, 129, 0,
+	188, 0, 149, 145, 146, 147, 0, 144, 0, 0,
+	33, 0, 0, 0, 0, 31, 208, 0, 0, 885,
+	201, 0, 0, 0, 0, 0, 0, 0, 0, 44,
+	0, 52, 53, 45, 51, 47, 0, 888, 0, 0,
+	1062, 503, 505, 889, 890, 891, 892, 501, 0, 477,
+	453, 0, 0, 0, 491, 432, 0, 437, -2, 0,
+	0, 478, 479, 904, 0, 0, 435, 490, 228, 245,
+	0, 0, 0, 235, 239, 0, 243, 246, 904, 0,
+	286, 0, 0, 257, 0, 260, -2, 264, 265, 266,
+	310, 268, 269, 270, 0, 272, 0, 379, 379, 306,
+	0, 0, 0, 280, 281, 397, 220, 395, 0, 402,
+	399, 402, 0, 0, 402, 402, 353, 403, 0, 0,
+	354, 355, 356, 357, 0, 377, 0, 336, 0, 0,
+	337, 0, 327, 0, 843, 0, 904, 904, 0, 904,
+	904, 621, 904, 0, 0, 904, 904, 904, 904, 904,
+	904, 904, 904, 904, 904, 904, 904, 904, 904, 904,
+	0, 645, 646, 647, 648, 649, 650, 651, 652, 653,
+	654, 655, 624, 0, 638, 0, 0, 0, 686, 687,
+	688, 689, 690, 691, 692, 699, 0, 813, 0, -2,
+	815, 0, 0, 72, 0, 662, 904, 904, 904, 904,
+	904, 904, 904, 904, 904, 904, 519, 0, 796, 0,
+	0, 0, 0, 0, 730, 0, 731, 732, 733, 734,
+	735, 736, 737, 738, 787, 0, 789, 790, 791, 792,
+	793, 794, 904, -2, 904, 904, 904, 904, 904, 904,
+	834, 836, 837, 842, 75, 904, 522, 0, 0, 0,
+	0, 0, 0, 0, 884, 0, 191, 0, 0, 0,
+	0, 0, 0, 0, 0, 164, 166, 167, 168, 169,
+	170, 171, 172, 173, 174, 175, 176, 177, 178, 179,
+	180, 256, 37, 38, 0, 0, 208, 0, 0, 490,
+	59, 202, 0, 0, 0, 0, 904, 66, 40, 41,
+	42, 43, 810, 0, -2, -2, 87, 46, 48, 0,
+	0, 504, 497, 0, 0, 445, 379, 379, 379, 904,
+	478, 439, 477, 0, 0, 0, 0, 0, 477, 0,
+	0, 436, 0, 0, 0, 433, 434, 0, 491, 255,
+	230, 488, 232, 236, 237, 904, 0, 0, 0, 287,
+	0, 0, 0, 0, 0, -2, 0, 278, 263, 267,
+	0, 0, 302, 0, 304, 0, 0, 0, 380, 345,
+	402, 347, 387, 388, 348, 349, 404, 400, 401, 399,
+	0, 399, 0, 0, 0, 384, 0, 0, 619, 620,
+	622, 639, 0, 641, 643, 840, 841, 629, 630, 658,
+	659, 660, 0, 904, 904, 904, 656, 634, 0, 670,
+	671, 672, 673, 674, 675, 676, 677, 678, 679, 680,
+	681, 684, 0, 694, 379, 0, 682, 310, 0, 683,
+	693, 0, 311, 312, 396, 0, 904, 0, 0, 525,
+	531, 527, 0, 810, 812, 661, 904, 864, 72, 0,
+	531, 0, 0, 0, 0, 0, -2, 379, 758, 379,
+	383, 761, 762, 763, 379, 766, 768, 769, 770, 771,
+	383, 773, 774, 775, 776, 777, 379, 379, 780, 781,
+	379, 379, 784, 379, 379, 0, 0, 0, 0, 904,
+	804, 799, 904, 0, 0, 727, 728, 729, 740, 788,
+	0, 0, 524, 0, 0, 0, 0, 0, 833, 70,
+	541, 0, 0, 0, 0, 443, 444, 379, -2, 0,
+	407, 0, -2, 0, -2, 0, 0, 189, 190, 183,
+	150, 151, 148, 562, 563, 564, 0, 0, 166, 165,
+	34, 0, 32, 0, 132, 0, 67, 68, 491, 62,
+	63, 490, 60, 0, 0, 0, 0, 495, 506, 507,
+	508, 0, 0, 407, 0, 835, 450, 452, 835, 449,
+	0, 407, 440, 488, 460, 461, 0, 0, 488, 489,
+	490, 477, 0, 0, 904, 0, 0, 0, 308, 0,
+	0, 0, 0, 282, 904, 252, 0, 258, 0, 310,
+	261, 262, 904, 904, 904, 904, 271, 273, 303, 305,
+	307, 0, 346, 402, 378, 402, 390, 391, 0, 0,
+	844, 640, 642, 644, 631, 656, 635, 0, 632, 904,
+	904, 0, 626, 0, 907, 310, 313, 701, 0, 904,
+	536, 707, 528, 532, 0, 534, 535, 0, -2, 663,
+	-2, 536, 536, 708, 709, 0, 0, 904, 755, 1064,
+	399, 759, 760, 764, 765, 767, 772, 778, 779, 782,
+	783, 785, 786, 0, 904, 904, 904, 904, 0, 830,
+	0, 800, 904, 0, 725, 726, 741, 742, 743, 744,
+	745, 746, 747, 611, 0, 0, 0, 0, 613, 0,
+	429, 408, 0, 410, 0, 425, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 192, 193, 194,
+	195, 196, 0, 802, 0, 0, 0, 29, 181, 0,
+	154, 155, 156, 157, 158, 159, 160, 161, 162, 830,
+	509, 509, 509, 0, 509, 0, 0, 0, 106, 904,
+	904, 876, 78, 79, 0, 35, 39, 134, 0, 615,
+	0, 491, 904, 472, 811, 207, 498, 0, 0, 429,
+	446, 447, 448, 835, 429, 454, 0, 462, 463, 455,
+	0, 0, 0, 0, 0, 0, 0, 613, 0, 474,
+	0, 0, 492, 904, 308, 247, 250, 283, 284, 285,
+	0, 288, 0, 0, 274, 275, 276, 277, 365, 366,
+	382, 385, 633, 904, 657, 636, 0, 906, 0, 909,
+	702, 526, 703, 0, 533, 529, 0, 0, 704, 705,
+	0, 379, 758, 379, 379, 0, 753, 754, 0, 756,
+	757, 0, 0, 0, 0, 0, 0, 797, 724, 805,
+	904, 823, 904, 542, 543, 545, 546, 547, 579, 0,
+	581, 613, 0, 0, 615, 0, 0, 18, 0, 411,
+	0, 0, 0, 414, 0, 426, 416, 0, 0, 0,
+	0, 0, 0, 0, 611, 0, 197, 0, 0, 904,
+	565, 566, 26, 152, 0, 0, 838, 886, 0, 0,
+	94, 99, 96, 0, 0, 910, 912, 913, 914, 915,
+	916, 917, 918, 919, 920, 921, 922, 923, 924, 925,
+	926, 927, 928, 929, 930, 931, 932, 101, 102, 103,
+	0, 0, 0, 0, 0, 0, 0, 0, 618, 0,
+	0, -2, 134, 134, -2, 134, 134, 0, 0, 0,
+	0, 0, 0, 0, 0, 65, 0, 0, 499, 405,
+	451, 406, 0, 0, 0, 0, 0, 0, 308, 407,
+	407, 0, 471, 475, 0, 309, 0, 0, 0, 241,
+	0, 282, 0, 254, 259, 637, 685, 908, 0, 0,
+	706, 710, 713, 711, 712, 714, 904, 716, 904, 718,
+	904, 720, 904, 904, 0, 0, 801, 825, 0, 612,
+	0, 0, 0, 0, 0, 586, 0, 0, 589, 0,
+	0, 0, 0, 580, 0, 0, 600, 0, 582, 0,
+	584, 585, 615, 0, 0, 611, 0, 613, 430, 0,
+	412, 417, 415, 418, 427, 428, 419, 420, 421, 422,
+	423, 424, 407, -2, 199, 904, 184, 185, 25, 0,
+	0, 803, 153, 183, 0, 842, 0, 0, 0, 0,
+	0, 0, 98, 100, 90, 0, 0, 869, 130, 131,
+	0, 0, 0, -2, 0, 880, 877, 0, 104, 107,
+	108, 109, 110, 111, 0, 0, 0, 166, 133, 135,
+	-2, 136, 137, 138, 139, 140, 0, 0, 0, 0,
+	616, 0, 0, 0, 472, 473, 0, 488, 488, 0,
+	0, 613, 0, 474, 429, 429, 613, 476, 0, 493,
+	308, 0, 0, 251, 253, 0, 0, 0, 0, 0,
+	0, 299, 0, 537, 0, 0, 530, 0, 0, 0,
+	0, 748, 723, 798, 71, 904, 904, 544, 575, 577,
+	0, 572, 587, 588, 590, 0, 592, 0, 594, 595,
+	548, 549, 550, 0, 0, 0, 0, 583, 611, 613,
+	407, 407, 0, 615, 0, 409, 0, 429, 23, 0,
+	198, 24, 0, 113, 0, 0, 802, 0, 182, 163,
+	88, 0, 597, -2, 0, 0, 84, 85, 0, 97,
+	0, 0, 0, 91, 0, 93, 119, 0, 0, 904,
+	0, 402, 881, 882, 883, 879, 911, 0, 0, 0,
+	0, 30, 54, 0, 0, 0, 617, 0, 0, 64,
+	500, 456, 457, 0, 407, 407, 0, 470, 465, 468,
+	469, 0, 242, 248, 249, 0, 290, 0, 292, 293,
+	294, 295, 296, 297, 298, 0, 904, 539, 715, 717,
+	719, 721, 0, 0, 0, 826, 824, 569, 576, 904,
+	0, 570, 904, 571, 591, 593, 560, 0, 0, 0,
+	0, 0, 567, 407, 615, 16, 429, 614, 611, 0,
+	413, 19, 904, 0, 114, 0, 0, 0, 0, 0,
+	0, 596, 611, 0, 611, 611, 866, 0, 0, 870,
+	92, 0, 0, 122, 123, 871, 872, 873, 0, 875,
+	105, 112, 0, 0, 117, 613, 407, 407, 0, 604,
+	0, 0, 0, 429, 429, 613, 0, 289, 291, 300,
+	0, 0, 827, 829, 722, 0, 0, 0, 573, 0,
+	578, 0, 0, 0, 0, 581, 0, 429, 611, 14,
+	407, 431, 200, 27, 115, -2, -2, 0, 184, 858,
+	0, 0, -2, 0, 0, 830, 611, 83, 830, 0,
+	904, -2, 120, 121, 0, 0, 127, 904, 0, 0,
+	898, 429, 429, 611, 0, 0, 0, 55, 0, 464,
+	466, 467, 0, 0, 538, 0, 904, 749, 0, 752,
+	0, 0, 552, 554, 555, 556, 557, 558, 559, 0,
+	0, 0, 600, 568, 13, 407, 429, 0, 0, 0,
+	76, 0, 858, 845, 860, 862, 904, 72, 0, 851,
+	855, 856, 857, 0, 838, 82, 838, 867, 868, 124,
+	125, 126, 874, 116, 0, 901, 899, 0, 57, 58,
+	898, 605, 606, 608, 609, 610, 0, 0, 459, 301,
+	540, 828, 750, 574, 551, 0, 601, 602, 603, 550,
+	17, 15, 186, 187, 0, 0, 77, 0, 863, -2,
+	0, 0, 0, 89, 81, 80, 0, 56, 0, 900,
+	901, 607, 0, 0, 553, 561, 28, 0, 861, 0,
+	-2, 0, 853, 855, 852, 118, 902, 903, 61, 458,
+	0, 0, 848, 72, 0, 751, 859, -2, 854,
 }
 
 var yyTok1 = [...]int16{
@@ -2577,11 +3035,11 @@ var yyTok1 = [...]int16{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 79, 3, 3, 3, 110, 102, 3,
 	56, 58, 107, 105, 57, 106, 119, 108, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 377,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 379,
 	87, 86, 88, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 378, 3, 379, 112, 3, 3, 3, 3, 3,
+	3, 380, 3, 381, 112, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 101, 3, 113,
@@ -2637,7 +3095,7 @@ var yyTok3 = [...]uint16{
 	57685, 360, 57686, 361, 57687, 362, 57688, 363, 57689, 364,
 	57690, 365, 57691, 366, 57692, 367, 57693, 368, 57694, 369,
 	57695, 370, 57696, 371, 57697, 372, 57698, 373, 57699, 374,
-	57700, 375, 57701, 376, 0,
+	57700, 375, 57701, 376, 57702, 377, 57703, 378, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -2979,96 +3437,127 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:406
+//line parser/parser.y:415
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:411
+//line parser/parser.y:420
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:412
+//line parser/parser.y:421
 		{
 		}
-	case 6:
+	case 8:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:420
+//line parser/parser.y:431
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 7:
-		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:425
+	case 9:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:437
+		{
+			yyDollar[1].ddl.Like = &yyDollar[3].tableName
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 10:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser/parser.y:442
+		{
+			yyDollar[1].ddl.Like = &yyDollar[4].tableName
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 11:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:448
+		{
+			yyDollar[1].ddl.Select = yyDollar[2].selStmt
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 12:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:453
+		{
+			yyDollar[1].ddl.Select = yyDollar[3].selStmt
+			yyVAL.statement = yyDollar[1].ddl
+		}
+	case 13:
+		yyDollar = yyS[yypt-14 : yypt+1]
+//line parser/parser.y:458
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndex,
 				Table:   yyDollar[6].tableName,
 				NewName: yyDollar[6].tableName,
 				IndexSpec: &IndexSpec{
-					Name:      yyDollar[4].colIdent,
-					Type:      NewColIdent(""),
-					Unique:    bool(yyDollar[2].boolVals[0]),
-					Clustered: bool(yyDollar[2].boolVals[1]),
-					Included:  yyDollar[10].columns,
-					Where:     NewWhere(WhereStr, yyDollar[11].expr),
-					Options:   yyDollar[12].indexOptions,
-					Partition: yyDollar[13].indexPartition,
+					Name:             yyDollar[4].colIdent,
+					Type:             NewColIdent(""),
+					Unique:           bool(yyDollar[2].boolVals[0]),
+					Clustered:        bool(yyDollar[2].boolVals[1]),
+					Included:         yyDollar[10].columns,
+					NullsNotDistinct: bool(yyDollar[11].boolVal),
+					Where:            NewWhere(WhereStr, yyDollar[12].expr),
+					Options:          yyDollar[13].indexOptions,
+					Partition:        yyDollar[14].indexPartition,
 				},
 				IndexCols: yyDollar[8].indexColumnsOrExpression.IndexCols,
 				IndexExpr: yyDollar[8].indexColumnsOrExpression.IndexExpr,
 			}
 		}
-	case 8:
-		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser/parser.y:445
+	case 14:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser/parser.y:479
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndex,
 				Table:   yyDollar[5].tableName,
 				NewName: yyDollar[5].tableName,
 				IndexSpec: &IndexSpec{
-					Name:      NewColIdent(""),
-					Type:      NewColIdent(""),
-					Unique:    bool(yyDollar[2].boolVals[0]),
-					Clustered: bool(yyDollar[2].boolVals[1]),
-					Included:  yyDollar[9].columns,
-					Where:     NewWhere(WhereStr, yyDollar[10].expr),
-					Options:   yyDollar[11].indexOptions,
-					Partition: yyDollar[12].indexPartition,
+					Name:             NewColIdent(""),
+					Type:             NewColIdent(""),
+					Unique:           bool(yyDollar[2].boolVals[0]),
+					Clustered:        bool(yyDollar[2].boolVals[1]),
+					Included:         yyDollar[9].columns,
+					NullsNotDistinct: bool(yyDollar[10].boolVal),
+					Where:            NewWhere(WhereStr, yyDollar[11].expr),
+					Options:          yyDollar[12].indexOptions,
+					Partition:        yyDollar[13].indexPartition,
 				},
 				IndexCols: yyDollar[7].indexColumnsOrExpression.IndexCols,
 				IndexExpr: yyDollar[7].indexColumnsOrExpression.IndexExpr,
 			}
 		}
-	case 9:
-		yyDollar = yyS[yypt-14 : yypt+1]
-//line parser/parser.y:465
+	case 15:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line parser/parser.y:500
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndex,
 				Table:   yyDollar[7].tableName,
 				NewName: yyDollar[7].tableName,
 				IndexSpec: &IndexSpec{
-					Name:      yyDollar[5].colIdent,
-					Type:      NewColIdent(""),
-					Unique:    bool(yyDollar[2].boolVals[0]),
-					Clustered: bool(yyDollar[2].boolVals[1]),
-					Included:  yyDollar[11].columns,
-					Where:     NewWhere(WhereStr, yyDollar[12].expr),
-					Options:   yyDollar[13].indexOptions,
-					Partition: yyDollar[14].indexPartition,
+					Name:             yyDollar[5].colIdent,
+					Type:             NewColIdent(""),
+					Unique:           bool(yyDollar[2].boolVals[0]),
+					Clustered:        bool(yyDollar[2].boolVals[1]),
+					Included:         yyDollar[11].columns,
+					NullsNotDistinct: bool(yyDollar[12].boolVal),
+					Where:            NewWhere(WhereStr, yyDollar[13].expr),
+					Options:          yyDollar[14].indexOptions,
+					Partition:        yyDollar[15].indexPartition,
 				},
 				IndexCols: yyDollar[9].indexColumnsOrExpression.IndexCols,
 				IndexExpr: yyDollar[9].indexColumnsOrExpression.IndexExpr,
 			}
 		}
-	case 10:
+	case 16:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser/parser.y:486
+//line parser/parser.y:522
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndex,
@@ -3083,27 +3572,48 @@ yydefault:
 				IndexCols: yyDollar[10].indexColumns,
 			}
 		}
-	case 11:
-		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:502
+	case 17:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line parser/parser.y:538
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndex,
 				Table:   yyDollar[6].tableName,
 				NewName: yyDollar[6].tableName,
 				IndexSpec: &IndexSpec{
-					Name:   yyDollar[4].colIdent,
-					Type:   yyDollar[8].colIdent,
-					Unique: bool(yyDollar[2].boolVals[0]),
-					Where:  NewWhere(WhereStr, yyDollar[12].expr),
+					Name:             yyDollar[4].colIdent,
+					Type:             yyDollar[8].colIdent,
+					Unique:           bool(yyDollar[2].boolVals[0]),
+					Included:         yyDollar[12].columns,
+					NullsNotDistinct: bool(yyDollar[13].boolVal),
+					Where:            NewWhere(WhereStr, yyDollar[14].expr),
 				},
 				IndexCols: yyDollar[10].indexColumnsOrExpression.IndexCols,
 				IndexExpr: yyDollar[10].indexColumnsOrExpression.IndexExpr,
 			}
 		}
-	case 12:
+	case 18:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser/parser.y:557
+		{
+			yyVAL.statement = &DDL{
+				Action:  CreateIndex,
+				Table:   yyDollar[7].tableName,
+				NewName: yyDollar[7].tableName,
+				IndexSpec: &IndexSpec{
+					Name:        yyDollar[5].colIdent,
+					Type:        NewColIdent(""),
+					Unique:      false,
+					Clustered:   true,
+					ColumnStore: true,
+					Options:     yyDollar[8].indexOptions,
+					Partition:   yyDollar[9].indexPartition,
+				},
+			}
+		}
+	case 19:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line parser/parser.y:519
+//line parser/parser.y:575
 		{
 			yyVAL.statement = &DDL{
 				Action:  CreateIndex,
@@ -3122,9 +3632,9 @@ yydefault:
 				},
 			}
 		}
-	case 13:
+	case 20:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:538
+//line parser/parser.y:594
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateView,
@@ -3135,9 +3645,9 @@ yydefault:
 				},
 			}
 		}
-	case 14:
+	case 21:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser/parser.y:549
+//line parser/parser.y:605
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateView,
@@ -3149,9 +3659,9 @@ yydefault:
 				},
 			}
 		}
-	case 15:
+	case 22:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:561
+//line parser/parser.y:617
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateView,
@@ -3162,9 +3672,9 @@ yydefault:
 				},
 			}
 		}
-	case 16:
+	case 23:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line parser/parser.y:572
+//line parser/parser.y:628
 		{
 			yyVAL.statement = &DDL{
 				Action: CreatePolicy,
@@ -3179,9 +3689,9 @@ yydefault:
 				},
 			}
 		}
-	case 17:
+	case 24:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line parser/parser.y:588
+//line parser/parser.y:644
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateTrigger,
@@ -3190,29 +3700,47 @@ yydefault:
 					TableName: yyDollar[7].tableName,
 					Time:      yyDollar[4].str,
 					Event:     yyDollar[5].strs,
+					Level:     "ROW",
 					Body:      []Statement{yyDollar[11].statement},
 				},
 			}
 		}
-	case 18:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:602
+	case 25:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser/parser.y:659
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateTrigger,
 				Trigger: &Trigger{
 					Name:      yyDollar[3].colIdent,
-					TableName: yyDollar[5].tableName,
-					Time:      yyDollar[6].str,
-					Event:     yyDollar[7].strs,
-					Body:      yyDollar[9].blockStatement,
+					TableName: yyDollar[7].tableName,
+					Time:      yyDollar[4].str,
+					Event:     yyDollar[5].strs,
+					Level:     yyDollar[8].str,
+					When:      yyDollar[9].expr,
+					Function:  yyDollar[10].expr,
 				},
 			}
 		}
-	case 19:
-		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:616
-		{
+	case 26:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser/parser.y:675
+		{
+			yyVAL.statement = &DDL{
+				Action: CreateTrigger,
+				Trigger: &Trigger{
+					Name:      yyDollar[3].colIdent,
+					TableName: yyDollar[5].tableName,
+					Time:      yyDollar[6].str,
+					Event:     yyDollar[7].strs,
+					Body:      yyDollar[9].blockStatement,
+				},
+			}
+		}
+	case 27:
+		yyDollar = yyS[yypt-13 : yypt+1]
+//line parser/parser.y:689
+		{
 			yyVAL.statement = &DDL{
 				Action: CreateTrigger,
 				Trigger: &Trigger{
@@ -3220,13 +3748,15 @@ yydefault:
 					TableName: yyDollar[7].tableName,
 					Time:      yyDollar[4].str,
 					Event:     yyDollar[5].strs,
+					Level:     yyDollar[8].str,
+					When:      yyDollar[9].expr,
 					Body:      yyDollar[11].blockStatement,
 				},
 			}
 		}
-	case 20:
+	case 28:
 		yyDollar = yyS[yypt-16 : yypt+1]
-//line parser/parser.y:629
+//line parser/parser.y:704
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateTrigger,
@@ -3235,13 +3765,49 @@ yydefault:
 					TableName: yyDollar[10].tableName,
 					Time:      yyDollar[7].str,
 					Event:     yyDollar[8].strs,
+					Level:     yyDollar[11].str,
+					When:      yyDollar[12].expr,
 					Body:      yyDollar[14].blockStatement,
 				},
 			}
 		}
-	case 21:
+	case 29:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser/parser.y:720
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "event" || strings.ToLower(string(yyDollar[5].bytes)) != "schedule" || strings.ToLower(string(yyDollar[7].bytes)) != "do" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action: CreateEvent,
+				Event: &Event{
+					Name:    yyDollar[3].colIdent,
+					Clauses: yyDollar[6].strs,
+					Body:    yyDollar[8].statement,
+				},
+			}
+		}
+	case 30:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser/parser.y:735
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "event" || strings.ToLower(string(yyDollar[8].bytes)) != "schedule" || strings.ToLower(string(yyDollar[10].bytes)) != "do" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action: CreateEvent,
+				Event: &Event{
+					Name:    yyDollar[6].colIdent,
+					Clauses: yyDollar[9].strs,
+					Body:    yyDollar[11].statement,
+				},
+			}
+		}
+	case 31:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:643
+//line parser/parser.y:751
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateType,
@@ -3251,15 +3817,208 @@ yydefault:
 				},
 			}
 		}
-	case 22:
+	case 32:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser/parser.y:761
+		{
+			yyVAL.statement = &DDL{
+				Action: CreateType,
+				Type: &Type{
+					Name:       yyDollar[3].tableName,
+					Attributes: yyDollar[6].TableSpec.Columns,
+				},
+			}
+		}
+	case 33:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser/parser.y:772
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "domain" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action: CreateType,
+				Type:   newDomain(TableName{Name: NewTableIdent(yyDollar[3].colIdent.String())}, yyDollar[5].columnType),
+			}
+		}
+	case 34:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser/parser.y:783
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "domain" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action: CreateType,
+				Type:   newDomain(TableName{Schema: NewTableIdent(yyDollar[3].colIdent.String()), Name: yyDollar[5].tableIdent}, yyDollar[7].columnType),
+			}
+		}
+	case 35:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:654
+//line parser/parser.y:795
 		{
-			yyVAL.statement = &DDL{Action: CreateTable, NewName: yyDollar[5].tableName, TableSpec: &TableSpec{}}
+			yyVAL.statement = &DDL{
+				Action: CreateType,
+				Type: &Type{
+					Name:      yyDollar[3].tableName,
+					TableSpec: yyDollar[7].TableSpec,
+				},
+			}
 		}
-	case 23:
+	case 36:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:805
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "publication" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action: CreatePublication,
+				Publication: &Publication{
+					Name: yyDollar[3].colIdent,
+				},
+			}
+		}
+	case 37:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:818
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "publication" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action: CreatePublication,
+				Publication: &Publication{
+					Name:   yyDollar[3].colIdent,
+					Tables: yyDollar[6].tableNames,
+				},
+			}
+		}
+	case 38:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:832
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "publication" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action: CreatePublication,
+				Publication: &Publication{
+					Name:      yyDollar[3].colIdent,
+					AllTables: true,
+				},
+			}
+		}
+	case 39:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser/parser.y:847
+		{
+			yyVAL.statement = &DDL{Action: CreateTable, NewName: yyDollar[5].tableName, TableSpec: &TableSpec{Virtual: true}}
+		}
+	case 40:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:854
+		{
+			yyVAL.statement = &DDL{
+				Action:  CommentOn,
+				Comment: &Comment{ObjectType: "TABLE", Object: joinNameParts(yyDollar[4].tableName.Schema.String(), yyDollar[4].tableName.Name.String()), Comment: string(yyDollar[6].bytes)},
+			}
+		}
+	case 41:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:861
+		{
+			yyVAL.statement = &DDL{
+				Action:  CommentOn,
+				Comment: &Comment{ObjectType: "TABLE", Object: joinNameParts(yyDollar[4].tableName.Schema.String(), yyDollar[4].tableName.Name.String()), Comment: string(yyDollar[6].bytes)},
+			}
+		}
+	case 42:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:868
+		{
+			yyVAL.statement = &DDL{
+				Action:  CommentOn,
+				Comment: &Comment{ObjectType: "COLUMN", Object: joinNameParts(yyDollar[4].colName.Qualifier.Schema.String(), yyDollar[4].colName.Qualifier.Name.String(), yyDollar[4].colName.Name.String()), Comment: string(yyDollar[6].bytes)},
+			}
+		}
+	case 43:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:875
+		{
+			yyVAL.statement = &DDL{
+				Action:  CommentOn,
+				Comment: &Comment{ObjectType: "COLUMN", Object: joinNameParts(yyDollar[4].colName.Qualifier.Schema.String(), yyDollar[4].colName.Qualifier.Name.String(), yyDollar[4].colName.Name.String()), Comment: string(yyDollar[6].bytes)},
+			}
+		}
+	case 44:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser/parser.y:885
+		{
+			yyVAL.statement = &DDL{Action: DropTable, IfExists: bool(yyDollar[3].boolVal), Drop: &Drop{Names: yyDollar[4].tableNames}}
+		}
+	case 45:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser/parser.y:889
+		{
+			yyVAL.statement = &DDL{Action: DropView, IfExists: bool(yyDollar[3].boolVal), Drop: &Drop{Names: yyDollar[4].tableNames}}
+		}
+	case 46:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:893
+		{
+			yyVAL.statement = &DDL{Action: DropView, IfExists: bool(yyDollar[4].boolVal), Drop: &Drop{Names: yyDollar[5].tableNames}}
+		}
+	case 47:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser/parser.y:898
+		{
+			yyVAL.statement = &DDL{Action: DropIndex, IfExists: bool(yyDollar[3].boolVal), Drop: &Drop{Names: yyDollar[4].tableNames}}
+		}
+	case 48:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:903
+		{
+			yyVAL.statement = &DDL{Action: DropIndex, Table: yyDollar[6].tableName, IfExists: bool(yyDollar[3].boolVal), Drop: &Drop{Names: TableNames{yyDollar[4].tableName}}}
+		}
+	case 49:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser/parser.y:908
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 50:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:910
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 51:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser/parser.y:913
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 52:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:915
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 53:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:917
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 54:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line parser/parser.y:660
+//line parser/parser.y:921
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddIndex,
@@ -3273,9 +4032,9 @@ yydefault:
 				IndexCols: yyDollar[10].indexColumns,
 			}
 		}
-	case 24:
+	case 55:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:674
+//line parser/parser.y:935
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddPrimaryKey,
@@ -3289,30 +4048,32 @@ yydefault:
 				IndexCols: yyDollar[12].indexColumns,
 			}
 		}
-	case 25:
-		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:688
+	case 56:
+		yyDollar = yyS[yypt-15 : yypt+1]
+//line parser/parser.y:949
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddIndex,
 				Table:   yyDollar[4].tableName,
 				NewName: yyDollar[4].tableName,
 				IndexSpec: &IndexSpec{
-					Name:       yyDollar[7].colIdent,
-					Unique:     true,
-					Primary:    false,
-					Constraint: true,
+					Name:             yyDollar[7].colIdent,
+					Unique:           true,
+					Primary:          false,
+					Constraint:       true,
+					Included:         yyDollar[13].columns,
+					NullsNotDistinct: bool(yyDollar[9].boolVal),
 					ConstraintOptions: &ConstraintOptions{
-						Deferrable:        bool(yyDollar[12].boolVal),
-						InitiallyDeferred: bool(yyDollar[13].boolVal),
+						Deferrable:        bool(yyDollar[14].boolVal),
+						InitiallyDeferred: bool(yyDollar[15].boolVal),
 					},
 				},
-				IndexCols: yyDollar[10].indexColumns,
+				IndexCols: yyDollar[11].indexColumns,
 			}
 		}
-	case 26:
+	case 57:
 		yyDollar = yyS[yypt-14 : yypt+1]
-//line parser/parser.y:708
+//line parser/parser.y:971
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddIndex,
@@ -3330,9 +4091,9 @@ yydefault:
 				IndexCols: yyDollar[11].indexColumns,
 			}
 		}
-	case 27:
+	case 58:
 		yyDollar = yyS[yypt-14 : yypt+1]
-//line parser/parser.y:726
+//line parser/parser.y:989
 		{
 			yyVAL.statement = &DDL{
 				Action:  AddIndex,
@@ -3350,9 +4111,9 @@ yydefault:
 				IndexCols: yyDollar[11].indexColumns,
 			}
 		}
-	case 28:
+	case 59:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:744
+//line parser/parser.y:1007
 		{
 			yyVAL.statement = &DDL{
 				Action:     AddForeignKey,
@@ -3361,9 +4122,9 @@ yydefault:
 				ForeignKey: yyDollar[6].foreignKeyDefinition,
 			}
 		}
-	case 29:
+	case 60:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:753
+//line parser/parser.y:1016
 		{
 			yyVAL.statement = &DDL{
 				Action:     AddForeignKey,
@@ -3372,9 +4133,135 @@ yydefault:
 				ForeignKey: yyDollar[7].foreignKeyDefinition,
 			}
 		}
-	case 32:
+	case 61:
+		yyDollar = yyS[yypt-16 : yypt+1]
+//line parser/parser.y:1026
+		{
+			if strings.ToLower(string(yyDollar[8].bytes)) != "exclude" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[8].bytes)))
+				return 1
+			}
+			var where *Where
+			if yyDollar[14].expr != nil {
+				where = NewWhere(WhereStr, yyDollar[14].expr)
+			}
+			yyVAL.statement = &DDL{
+				Action:  AddExclusion,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				Exclusion: &ExclusionDefinition{
+					ConstraintName: yyDollar[7].colIdent,
+					IndexType:      yyDollar[10].colIdent.String(),
+					Exclusions:     yyDollar[12].exclusionPairs,
+					Where:          where,
+					ConstraintOptions: &ConstraintOptions{
+						Deferrable:        bool(yyDollar[15].boolVal),
+						InitiallyDeferred: bool(yyDollar[16].boolVal),
+					},
+				},
+			}
+		}
+	case 62:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser/parser.y:1052
+		{
+			if strings.ToLower(string(yyDollar[5].bytes)) != "owner" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[5].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action:  AlterOwner,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				Owner: &Owner{
+					ObjectType: "TABLE",
+					Role:       yyDollar[7].colIdent.String(),
+				},
+			}
+		}
+	case 63:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser/parser.y:1068
+		{
+			if strings.ToLower(string(yyDollar[5].bytes)) != "cluster" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[5].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action:  ClusterOn,
+				Table:   yyDollar[4].tableName,
+				NewName: yyDollar[4].tableName,
+				IndexSpec: &IndexSpec{
+					Name: yyDollar[7].colIdent,
+				},
+			}
+		}
+	case 64:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line parser/parser.y:1084
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "domain" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action: AddDomainConstraint,
+				Type: &Type{
+					Name:   yyDollar[3].tableName,
+					Domain: true,
+					Checks: []*CheckDefinition{
+						{
+							Where:          *NewWhere(WhereStr, yyDollar[9].expr),
+							ConstraintName: yyDollar[6].colIdent,
+							NotValid:       bool(yyDollar[11].boolVal),
+						},
+					},
+				},
+			}
+		}
+	case 65:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser/parser.y:1105
+		{
+			if strings.ToLower(string(yyDollar[2].bytes)) != "domain" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action: AddDomainConstraint,
+				Type: &Type{
+					Name:   yyDollar[3].tableName,
+					Domain: true,
+					Checks: []*CheckDefinition{
+						{
+							Where:    *NewWhere(WhereStr, yyDollar[7].expr),
+							NotValid: bool(yyDollar[9].boolVal),
+						},
+					},
+				},
+			}
+		}
+	case 66:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:1125
+		{
+			if strings.ToLower(string(yyDollar[4].bytes)) != "owner" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[4].bytes)))
+				return 1
+			}
+			yyVAL.statement = &DDL{
+				Action:  AlterOwner,
+				Table:   yyDollar[3].tableName,
+				NewName: yyDollar[3].tableName,
+				Owner: &Owner{
+					ObjectType: "VIEW",
+					Role:       yyDollar[6].colIdent.String(),
+				},
+			}
+		}
+	case 69:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:768
+//line parser/parser.y:1147
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3382,45 +4269,45 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 33:
+	case 70:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:776
+//line parser/parser.y:1155
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 34:
+	case 71:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser/parser.y:783
+//line parser/parser.y:1162
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
-	case 35:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:789
+//line parser/parser.y:1168
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 36:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:793
+//line parser/parser.y:1172
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 37:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:799
+//line parser/parser.y:1178
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 38:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:803
+//line parser/parser.y:1182
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 39:
+	case 76:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:810
+//line parser/parser.y:1189
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3432,9 +4319,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 40:
+	case 77:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:822
+//line parser/parser.y:1201
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3444,109 +4331,109 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 41:
+	case 78:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:834
+//line parser/parser.y:1213
 		{
 			yyVAL.str = InsertStr
 		}
-	case 42:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:838
+//line parser/parser.y:1217
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 43:
+	case 80:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:844
+//line parser/parser.y:1223
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 44:
+	case 81:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:850
+//line parser/parser.y:1229
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 45:
+	case 82:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:854
+//line parser/parser.y:1233
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 46:
+	case 83:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:858
+//line parser/parser.y:1237
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 47:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:863
+//line parser/parser.y:1242
 		{
 		}
-	case 48:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:864
+//line parser/parser.y:1243
 		{
 		}
-	case 49:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:868
+//line parser/parser.y:1247
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 50:
+	case 87:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:872
+//line parser/parser.y:1251
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 51:
+	case 88:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:877
+//line parser/parser.y:1256
 		{
 			yyVAL.partitions = nil
 		}
-	case 52:
+	case 89:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:881
+//line parser/parser.y:1260
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 53:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:887
+//line parser/parser.y:1266
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 54:
+	case 91:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:891
+//line parser/parser.y:1270
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 55:
+	case 92:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:895
+//line parser/parser.y:1274
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 56:
+	case 93:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:899
+//line parser/parser.y:1278
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 57:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:905
+//line parser/parser.y:1284
 		{
 			yyVAL.statement = &Declare{Type: declareVariable, Variables: yyDollar[2].localVariables}
 		}
-	case 58:
+	case 95:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:909
+//line parser/parser.y:1288
 		{
 			yyVAL.statement = &Declare{
 				Type: declareCursor,
@@ -3557,66 +4444,66 @@ yydefault:
 				},
 			}
 		}
-	case 59:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:922
+//line parser/parser.y:1301
 		{
 			yyVAL.localVariables = []*LocalVariable{yyDollar[1].localVariable}
 		}
-	case 60:
+	case 97:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:926
+//line parser/parser.y:1305
 		{
 			yyVAL.localVariables = append(yyVAL.localVariables, yyDollar[3].localVariable)
 		}
-	case 61:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:932
+//line parser/parser.y:1311
 		{
 			yyVAL.localVariable = &LocalVariable{Name: yyDollar[1].colIdent, DataType: yyDollar[2].columnType}
 		}
-	case 62:
+	case 99:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:937
+//line parser/parser.y:1316
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 63:
+	case 100:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:941
+//line parser/parser.y:1320
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 64:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:947
+//line parser/parser.y:1326
 		{
 			yyVAL.statement = &Cursor{
 				Action:     OpenStr,
 				CursorName: yyDollar[2].colIdent,
 			}
 		}
-	case 65:
+	case 102:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:954
+//line parser/parser.y:1333
 		{
 			yyVAL.statement = &Cursor{
 				Action:     CloseStr,
 				CursorName: yyDollar[2].colIdent,
 			}
 		}
-	case 66:
+	case 103:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:961
+//line parser/parser.y:1340
 		{
 			yyVAL.statement = &Cursor{
 				Action:     DeallocateStr,
 				CursorName: yyDollar[2].colIdent,
 			}
 		}
-	case 67:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:968
+//line parser/parser.y:1347
 		{
 			yyVAL.statement = &Cursor{
 				Action:     FetchStr,
@@ -3624,9 +4511,9 @@ yydefault:
 				CursorName: yyDollar[3].colIdent,
 			}
 		}
-	case 68:
+	case 105:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:976
+//line parser/parser.y:1355
 		{
 			yyVAL.statement = &Cursor{
 				Action:     FetchStr,
@@ -3635,48 +4522,48 @@ yydefault:
 				Into:       yyDollar[5].colIdent,
 			}
 		}
-	case 69:
+	case 106:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:986
+//line parser/parser.y:1365
 		{
 			yyVAL.str = ""
 		}
-	case 70:
+	case 107:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:990
+//line parser/parser.y:1369
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 71:
+	case 108:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:994
+//line parser/parser.y:1373
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 72:
+	case 109:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:998
+//line parser/parser.y:1377
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 73:
+	case 110:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1002
+//line parser/parser.y:1381
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 74:
+	case 111:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1008
+//line parser/parser.y:1387
 		{
 			yyVAL.statement = &While{
 				Condition:  yyDollar[2].expr,
 				Statements: []Statement{yyDollar[3].statement},
 			}
 		}
-	case 75:
+	case 112:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:1015
+//line parser/parser.y:1394
 		{
 			yyVAL.statement = &While{
 				Condition:  yyDollar[2].expr,
@@ -3684,27 +4571,27 @@ yydefault:
 				Keyword:    string(yyDollar[3].bytes),
 			}
 		}
-	case 76:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1025
+//line parser/parser.y:1404
 		{
 			yyVAL.blockStatement = []Statement{yyDollar[1].statement}
 		}
-	case 77:
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1029
+//line parser/parser.y:1408
 		{
 			yyVAL.blockStatement = append(yyVAL.blockStatement, yyDollar[2].statement)
 		}
-	case 78:
+	case 115:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1033
+//line parser/parser.y:1412
 		{
 			yyVAL.blockStatement = append(yyVAL.blockStatement, yyDollar[3].statement)
 		}
-	case 79:
+	case 116:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:1040
+//line parser/parser.y:1419
 		{
 			yyVAL.statement = &If{
 				Condition:    yyDollar[2].expr,
@@ -3712,9 +4599,9 @@ yydefault:
 				Keyword:      string(yyDollar[3].bytes),
 			}
 		}
-	case 80:
+	case 117:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:1049
+//line parser/parser.y:1428
 		{
 			yyVAL.statement = &If{
 				Condition:    yyDollar[2].expr,
@@ -3722,9 +4609,9 @@ yydefault:
 				Keyword:      string(yyDollar[3].bytes),
 			}
 		}
-	case 81:
+	case 118:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:1057
+//line parser/parser.y:1436
 		{
 			yyVAL.statement = &If{
 				Condition:      yyDollar[2].expr,
@@ -3733,437 +4620,593 @@ yydefault:
 				Keyword:        string(yyDollar[3].bytes),
 			}
 		}
-	case 82:
+	case 119:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1068
+//line parser/parser.y:1447
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 83:
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1072
+//line parser/parser.y:1451
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 84:
+	case 121:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1078
+//line parser/parser.y:1457
 		{
 			yyVAL.setExpr = yyDollar[3].setExpr
 		}
-	case 85:
+	case 122:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1082
+//line parser/parser.y:1461
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("0"))}
 		}
-	case 86:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1086
+//line parser/parser.y:1465
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_read_only"), Expr: NewIntVal([]byte("1"))}
 		}
-	case 87:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1092
+//line parser/parser.y:1471
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("repeatable read"))}
 		}
-	case 88:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1096
+//line parser/parser.y:1475
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read committed"))}
 		}
-	case 89:
+	case 126:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1100
+//line parser/parser.y:1479
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("read uncommitted"))}
 		}
-	case 90:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1104
+//line parser/parser.y:1483
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("tx_isolation"), Expr: NewStrVal([]byte("serializable"))}
 		}
-	case 91:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1110
+//line parser/parser.y:1489
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 92:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1114
+//line parser/parser.y:1493
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 93:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1120
+//line parser/parser.y:1499
 		{
 			yyVAL.str = SessionStr
 		}
-	case 94:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1124
+//line parser/parser.y:1503
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 95:
+	case 132:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1129
+//line parser/parser.y:1508
 		{
 		}
-	case 96:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1130
+//line parser/parser.y:1509
 		{
 		}
-	case 97:
+	case 134:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1134
+//line parser/parser.y:1513
 		{
 		}
-	case 98:
+	case 135:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1135
+//line parser/parser.y:1514
 		{
 		}
-	case 99:
+	case 136:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:1515
+		{
+		}
+	case 137:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:1516
+		{
+		}
+	case 138:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1136
+//line parser/parser.y:1517
+		{
+		}
+	case 139:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:1518
+		{
+		}
+	case 140:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:1519
+		{
+		}
+	case 141:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1523
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 142:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1527
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 143:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1531
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 144:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:1535
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+		}
+	case 145:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1541
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 146:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1545
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 147:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1549
+		{
+			yyVAL.str = string(yyDollar[1].bytes)
+		}
+	case 148:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:1554
+		{
+			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes) + " " + String(yyDollar[3].columns)
+		}
+	case 149:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1560
+		{
+			yyVAL.strs = []string{string(yyDollar[1].str)}
+		}
+	case 150:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:1564
+		{
+			yyVAL.strs = append(yyVAL.strs, string(yyDollar[3].str))
+		}
+	case 151:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:1568
+		{
+			yyVAL.strs = append(yyVAL.strs, string(yyDollar[3].str))
+		}
+	case 152:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1574
+		{
+			yyVAL.blockStatement = []Statement{yyDollar[1].statement}
+		}
+	case 153:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:1578
+		{
+			yyVAL.blockStatement = append(yyVAL.blockStatement, yyDollar[2].statement)
+		}
+	case 154:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1584
 		{
+			yyVAL.statement = yyDollar[1].statement
 		}
-	case 100:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1137
+	case 163:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser/parser.y:1596
 		{
+			sel := yyDollar[1].selStmt.(*Select)
+			sel.OrderBy = yyDollar[2].orderBy
+			sel.Limit = yyDollar[3].limit
+			sel.Lock = yyDollar[4].str
+			yyVAL.statement = sel
 		}
-	case 101:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1138
+	case 164:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1606
 		{
+			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 102:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1139
+//line parser/parser.y:1610
 		{
+			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 103:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1140
+	case 166:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1616
 		{
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 104:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1144
+//line parser/parser.y:1620
 		{
-			yyVAL.str = string(yyDollar[1].bytes)
+			yyVAL.str = "'" + strings.ReplaceAll(string(yyDollar[1].bytes), "'", "''") + "'"
 		}
-	case 105:
+	case 168:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1148
+//line parser/parser.y:1624
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 106:
+	case 169:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1152
+//line parser/parser.y:1628
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 107:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1156
+	case 170:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1632
 		{
-			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 108:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1162
+//line parser/parser.y:1636
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 109:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1166
+//line parser/parser.y:1640
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 110:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1170
+//line parser/parser.y:1644
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 111:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1175
+	case 174:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1648
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 112:
+	case 175:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1181
+//line parser/parser.y:1652
 		{
-			yyVAL.strs = []string{string(yyDollar[1].str)}
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 113:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1185
+	case 176:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1656
 		{
-			yyVAL.strs = append(yyVAL.strs, string(yyDollar[3].str))
+			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 114:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1191
+//line parser/parser.y:1660
 		{
-			yyVAL.blockStatement = []Statement{yyDollar[1].statement}
+			yyVAL.str = "+"
 		}
-	case 115:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1195
+	case 178:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1664
 		{
-			yyVAL.blockStatement = append(yyVAL.blockStatement, yyDollar[2].statement)
+			yyVAL.str = "-"
 		}
-	case 116:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1201
+//line parser/parser.y:1668
 		{
-			yyVAL.statement = yyDollar[1].statement
+			yyVAL.str = "("
 		}
-	case 125:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1213
+	case 180:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:1672
 		{
-			sel := yyDollar[1].selStmt.(*Select)
-			sel.OrderBy = yyDollar[2].orderBy
-			sel.Limit = yyDollar[3].limit
-			sel.Lock = yyDollar[4].str
-			yyVAL.statement = sel
+			yyVAL.str = ")"
 		}
-	case 127:
+	case 182:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1225
+//line parser/parser.y:1680
 		{
 			yyVAL.statement = &BeginEnd{
 				Statements: []Statement{yyDollar[2].statement},
 			}
 		}
-	case 128:
+	case 183:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1232
+//line parser/parser.y:1687
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = ""
 		}
-	case 129:
+	case 184:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1234
+//line parser/parser.y:1691
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.str = "ROW"
 		}
-	case 130:
+	case 185:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:1695
+		{
+			if strings.ToLower(string(yyDollar[3].bytes)) != "statement" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[3].bytes)))
+				return 1
+			}
+			yyVAL.str = "STATEMENT"
+		}
+	case 186:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:1706
+		{
+			if strings.ToLower(string(yyDollar[1].bytes)) != "execute" || strings.ToLower(string(yyDollar[2].bytes)) != "function" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[1].bytes)))
+				return 1
+			}
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[3].tableName.Schema, Name: NewColIdent(yyDollar[3].tableName.Name.String()), Exprs: yyDollar[5].selectExprs}
+		}
+	case 187:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:1714
+		{
+			if strings.ToLower(string(yyDollar[1].bytes)) != "execute" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[1].bytes)))
+				return 1
+			}
+			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[3].tableName.Schema, Name: NewColIdent(yyDollar[3].tableName.Name.String()), Exprs: yyDollar[5].selectExprs}
+		}
+	case 188:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1237
+//line parser/parser.y:1723
 		{
 			yyVAL.bytes = nil
 		}
-	case 131:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1241
+//line parser/parser.y:1727
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 132:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1245
+//line parser/parser.y:1731
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 133:
+	case 191:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1250
+//line parser/parser.y:1736
 		{
 			yyVAL.bytes = nil
 		}
-	case 134:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1254
+//line parser/parser.y:1740
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 135:
+	case 193:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1258
+//line parser/parser.y:1744
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 136:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1262
+//line parser/parser.y:1748
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 137:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1266
+//line parser/parser.y:1752
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 138:
+	case 196:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1270
+//line parser/parser.y:1756
 		{
 			yyVAL.bytes = yyDollar[2].bytes
 		}
-	case 139:
+	case 197:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1275
+//line parser/parser.y:1761
 		{
 			yyVAL.expr = nil
 		}
-	case 140:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1279
+//line parser/parser.y:1765
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 141:
+	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1284
+//line parser/parser.y:1770
 		{
 			yyVAL.expr = nil
 		}
-	case 142:
+	case 200:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1288
+//line parser/parser.y:1774
 		{
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 143:
+	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1293
+//line parser/parser.y:1779
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 144:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1297
+//line parser/parser.y:1783
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 145:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1302
+//line parser/parser.y:1788
 		{
 			yyVAL.bytes = nil
 		}
-	case 146:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1306
+//line parser/parser.y:1792
 		{
 			yyVAL.bytes = nil
 		}
-	case 147:
+	case 205:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1312
+//line parser/parser.y:1798
 		{
 			yyVAL.ddl = &DDL{Action: CreateTable, NewName: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 148:
+	case 206:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1319
+//line parser/parser.y:1805
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].tableOptions
 		}
-	case 149:
+	case 207:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser/parser.y:1811
+		{
+			if strings.ToLower(string(yyDollar[5].bytes)) != "inherits" {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[5].bytes)))
+				return 1
+			}
+			yyVAL.TableSpec = yyDollar[2].TableSpec
+			yyVAL.TableSpec.Options = yyDollar[4].tableOptions
+			yyVAL.TableSpec.Inherits = yyDollar[7].tableNames
+		}
+	case 208:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1325
+//line parser/parser.y:1822
 		{
 			yyVAL.TableSpec = &TableSpec{}
 		}
-	case 150:
+	case 209:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1329
+//line parser/parser.y:1826
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.addColumn(yyDollar[1].columnDefinition)
 		}
-	case 151:
+	case 210:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1334
+//line parser/parser.y:1831
 		{
 			yyVAL.TableSpec.addColumn(yyDollar[3].columnDefinition)
 		}
-	case 152:
+	case 211:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1338
+//line parser/parser.y:1835
 		{
 			yyVAL.TableSpec.addIndex(yyDollar[3].indexDefinition)
 		}
-	case 153:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1342
+//line parser/parser.y:1839
 		{
 			yyVAL.TableSpec.addForeignKey(yyDollar[3].foreignKeyDefinition)
 		}
-	case 154:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1346
+//line parser/parser.y:1843
 		{
 			yyVAL.TableSpec.addIndex(yyDollar[3].indexDefinition)
 		}
-	case 155:
+	case 214:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1350
+//line parser/parser.y:1847
 		{
 			yyVAL.TableSpec.addIndex(yyDollar[3].indexDefinition)
 		}
-	case 156:
+	case 215:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1354
+//line parser/parser.y:1851
 		{
 			yyVAL.TableSpec.addCheck(yyDollar[3].checkDefinition)
 		}
-	case 157:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1360
+//line parser/parser.y:1857
 		{
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 158:
+	case 217:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:1862
+		{
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1365
+//line parser/parser.y:1867
 		{
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 159:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1370
+//line parser/parser.y:1871
 		{
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 160:
+	case 220:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1376
+//line parser/parser.y:1877
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 165:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1387
+//line parser/parser.y:1888
 		{
 			yyVAL.columnType = ColumnType{Type: yyDollar[1].colIdent.val}
 		}
-	case 166:
+	case 226:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1393
+//line parser/parser.y:1894
 		{
 			yyDollar[1].columnType.NotNull = nil
 			yyDollar[1].columnType.Default = nil
@@ -4176,93 +5219,108 @@ yydefault:
 			yyDollar[1].columnType.Array = yyDollar[2].boolVal
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 167:
+	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1406
+//line parser/parser.y:1907
 		{
 			yyDollar[1].columnType.NotNull = NewBoolVal(false)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 168:
+	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1411
+//line parser/parser.y:1912
 		{
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 169:
+	case 229:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1416
+//line parser/parser.y:1917
 		{
 			yyDollar[1].columnType.Default = &DefaultDefinition{ValueOrExpression: yyDollar[2].defaultValueOrExpression}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 170:
+	case 230:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1421
+//line parser/parser.y:1922
 		{
 			yyDollar[1].columnType.Default = &DefaultDefinition{ConstraintName: yyDollar[3].colIdent, ValueOrExpression: yyDollar[4].defaultValueOrExpression}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 171:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1427
+//line parser/parser.y:1928
 		{
 			yyDollar[1].columnType.Srid = &SridDefinition{Value: yyDollar[2].optVal}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 172:
+	case 232:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1432
+//line parser/parser.y:1933
 		{
 			yyDollar[1].columnType.OnUpdate = yyDollar[4].optVal
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 173:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1437
+//line parser/parser.y:1938
 		{
 			yyDollar[1].columnType.Autoincrement = BoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 174:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1442
+//line parser/parser.y:1943
 		{
 			yyDollar[1].columnType.Autoincrement = BoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 175:
+	case 235:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1447
+//line parser/parser.y:1948
 		{
 			yyDollar[1].columnType.KeyOpt = colKeyPrimary
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 176:
+	case 236:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser/parser.y:1954
+		{
+			yyDollar[1].columnType.KeyOpt = colKeyPrimary
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 237:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser/parser.y:1959
+		{
+			yyDollar[1].columnType.KeyOpt = colKeyPrimary
+			yyDollar[1].columnType.NonClustered = BoolVal(true)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 238:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1452
+//line parser/parser.y:1965
 		{
 			yyDollar[1].columnType.KeyOpt = colKey
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 177:
+	case 239:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1457
+//line parser/parser.y:1970
 		{
 			yyDollar[1].columnType.KeyOpt = colKeyUniqueKey
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 178:
+	case 240:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1462
+//line parser/parser.y:1975
 		{
 			yyDollar[1].columnType.KeyOpt = colKeyUnique
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 179:
+	case 241:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:1467
+//line parser/parser.y:1980
 		{
 			yyDollar[1].columnType.Check = &CheckDefinition{
 				Where:             *NewWhere(WhereStr, yyDollar[5].expr),
@@ -4271,9 +5329,9 @@ yydefault:
 			}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 180:
+	case 242:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:1476
+//line parser/parser.y:1989
 		{
 			yyDollar[1].columnType.Check = &CheckDefinition{
 				ConstraintName:    yyDollar[3].colIdent,
@@ -4283,1255 +5341,1361 @@ yydefault:
 			}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 181:
+	case 243:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:1999
+		{
+			yyDollar[1].columnType.Comment = NewStrVal(yyDollar[3].bytes)
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 244:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:2005
+		{
+			switch strings.ToLower(string(yyDollar[2].bytes)) {
+			case "invisible":
+				yyDollar[1].columnType.Invisible = true
+			case "visible":
+				yyDollar[1].columnType.Invisible = false
+			case "enforced":
+				if yyDollar[1].columnType.Check == nil {
+					yylex.Error("syntax error around 'ENFORCED' without CHECK")
+					return 1
+				}
+				yyDollar[1].columnType.Check.NotEnforced = false
+			default:
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.columnType = yyDollar[1].columnType
+		}
+	case 245:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1486
+//line parser/parser.y:2025
 		{
-			yyDollar[1].columnType.Comment = NewStrVal(yyDollar[3].bytes)
+			if !strings.EqualFold(string(yyDollar[3].bytes), "enforced") || yyDollar[1].columnType.Check == nil {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[3].bytes)))
+				return 1
+			}
+			yyDollar[1].columnType.Check.NotEnforced = true
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 182:
+	case 246:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1491
+//line parser/parser.y:2034
 		{
 			yyDollar[1].columnType.References = String(yyDollar[3].tableName)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 183:
+	case 247:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1496
+//line parser/parser.y:2039
 		{
 			yyDollar[1].columnType.References = String(yyDollar[3].tableName)
 			yyDollar[1].columnType.ReferenceNames = yyDollar[5].columns
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 184:
+	case 248:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:1503
+//line parser/parser.y:2046
 		{
 			yyDollar[1].columnType.References = String(yyDollar[3].tableName)
 			yyDollar[1].columnType.ReferenceNames = yyDollar[5].columns
 			yyDollar[1].columnType.ReferenceOnDelete = yyDollar[9].colIdent
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 185:
+	case 249:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:1510
+//line parser/parser.y:2053
 		{
 			yyDollar[1].columnType.References = String(yyDollar[3].tableName)
 			yyDollar[1].columnType.ReferenceNames = yyDollar[5].columns
 			yyDollar[1].columnType.ReferenceOnUpdate = yyDollar[9].colIdent
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 186:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1518
-		{
-			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[4].expr, GeneratedType: "VIRTUAL"}
-			yyVAL.columnType = yyDollar[1].columnType
-		}
-	case 187:
+	case 250:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1523
-		{
-			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[4].expr, GeneratedType: "STORED"}
-			yyVAL.columnType = yyDollar[1].columnType
-		}
-	case 188:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:1528
+//line parser/parser.y:2061
 		{
-			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[6].expr, GeneratedType: "VIRTUAL"}
+			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[4].expr, GeneratedType: yyDollar[6].str}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 189:
+	case 251:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:1533
+//line parser/parser.y:2066
 		{
-			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[6].expr, GeneratedType: "STORED"}
+			yyDollar[1].columnType.Generated = &GeneratedColumn{Expr: yyDollar[6].expr, GeneratedType: yyDollar[8].str}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 190:
+	case 252:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:1539
+//line parser/parser.y:2072
 		{
 			yyDollar[1].columnType.Identity = &IdentityOpt{Behavior: yyDollar[3].str}
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 191:
+	case 253:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:1545
+//line parser/parser.y:2078
 		{
 			yyDollar[1].columnType.Identity = &IdentityOpt{Behavior: yyDollar[3].str, Sequence: yyDollar[7].sequence}
 			yyDollar[1].columnType.NotNull = NewBoolVal(true)
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 192:
+	case 254:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:1551
+//line parser/parser.y:2084
 		{
 			yyDollar[1].columnType.Identity = &IdentityOpt{Sequence: &Sequence{StartWith: NewIntVal(yyDollar[4].bytes), IncrementBy: NewIntVal(yyDollar[6].bytes)}, NotForReplication: false}
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 193:
+	case 255:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1557
+//line parser/parser.y:2090
 		{
 			yyDollar[1].columnType.Identity.NotForReplication = true
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 194:
+	case 256:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1563
+//line parser/parser.y:2096
 		{
 			yyVAL.columnType = ColumnType{Type: ""}
 		}
-	case 195:
+	case 257:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1569
+//line parser/parser.y:2102
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Value: yyDollar[2].optVal}
 		}
-	case 196:
+	case 258:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1573
+//line parser/parser.y:2106
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Value: yyDollar[3].optVal}
 		}
-	case 197:
+	case 259:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1577
+//line parser/parser.y:2110
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Value: yyDollar[4].optVal}
 		}
-	case 198:
+	case 260:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1581
+//line parser/parser.y:2114
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Expr: yyDollar[2].expr}
 		}
-	case 199:
+	case 261:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1585
+//line parser/parser.y:2118
 		{
 			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Expr: yyDollar[3].expr}
 		}
-	case 200:
+	case 262:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser/parser.y:2123
+		{
+			yyVAL.defaultValueOrExpression = DefaultValueOrExpression{Expr: yyDollar[3].expr}
+		}
+	case 263:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1591
+//line parser/parser.y:2129
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[1].bytes)
 		}
-	case 201:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1595
+//line parser/parser.y:2133
 		{
 			yyVAL.optVal = NewUnicodeStrVal(yyDollar[1].bytes)
 		}
-	case 202:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1599
+//line parser/parser.y:2137
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[1].bytes)
 		}
-	case 203:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1603
+//line parser/parser.y:2141
 		{
 			yyVAL.optVal = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 204:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1607
+//line parser/parser.y:2145
 		{
 			yyVAL.optVal = NewValArg(yyDollar[1].bytes)
 		}
-	case 205:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1611
+//line parser/parser.y:2149
 		{
 			yyVAL.optVal = yyDollar[1].optVal
 		}
-	case 206:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1615
+//line parser/parser.y:2153
 		{
 			yyVAL.optVal = NewBitVal(yyDollar[1].bytes)
 		}
-	case 207:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1619
+//line parser/parser.y:2157
 		{
 			yyVAL.optVal = NewBoolSQLVal(bool(yyDollar[1].boolVal))
 		}
-	case 208:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1623
+//line parser/parser.y:2161
 		{
 			yyVAL.optVal = NewBitVal(yyDollar[1].bytes)
 		}
-	case 209:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1629
+//line parser/parser.y:2167
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 210:
+	case 273:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:2171
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 274:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:2177
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
+		}
+	case 275:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:2181
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
+		}
+	case 276:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:2185
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
+		}
+	case 277:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:2189
+		{
+			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
+		}
+	case 278:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:2196
+		{
+			yyVAL.expr = &ColName{Name: yyDollar[1].colIdent}
+		}
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1635
+//line parser/parser.y:2203
 		{
 			yyVAL.optVal = yyDollar[2].optVal
 		}
-	case 211:
+	case 281:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1641
+//line parser/parser.y:2209
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[1].bytes)
 		}
-	case 212:
+	case 282:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser/parser.y:2215
+		{
+			yyVAL.str = "VIRTUAL"
+		}
+	case 283:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:2219
+		{
+			yyVAL.str = "VIRTUAL"
+		}
+	case 284:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:2223
+		{
+			yyVAL.str = "STORED"
+		}
+	case 285:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:2227
+		{
+			yyVAL.str = "STORED"
+		}
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1647
+//line parser/parser.y:2233
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 213:
+	case 287:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1651
+//line parser/parser.y:2237
 		{
 			yyVAL.str = string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 214:
+	case 288:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1656
+//line parser/parser.y:2242
 		{
 			yyVAL.sequence = &Sequence{}
 		}
-	case 215:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1660
+//line parser/parser.y:2246
 		{
 			yyDollar[1].sequence.StartWith = NewIntVal(yyDollar[4].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 216:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1665
+//line parser/parser.y:2251
 		{
 			yyDollar[1].sequence.StartWith = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 217:
+	case 291:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1670
+//line parser/parser.y:2256
 		{
 			yyDollar[1].sequence.IncrementBy = NewIntVal(yyDollar[4].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 218:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1675
+//line parser/parser.y:2261
 		{
 			yyDollar[1].sequence.IncrementBy = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 219:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1680
+//line parser/parser.y:2266
 		{
 			yyDollar[1].sequence.MinValue = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 220:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1685
+//line parser/parser.y:2271
 		{
 			yyDollar[1].sequence.MaxValue = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 221:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1690
+//line parser/parser.y:2276
 		{
 			yyDollar[1].sequence.Cache = NewIntVal(yyDollar[3].bytes)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 222:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1695
+//line parser/parser.y:2281
 		{
 			yyDollar[1].sequence.NoMinValue = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 223:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1700
+//line parser/parser.y:2286
 		{
 			yyDollar[1].sequence.NoMaxValue = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 224:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1705
+//line parser/parser.y:2291
 		{
 			yyDollar[1].sequence.NoCycle = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 225:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1710
+//line parser/parser.y:2296
 		{
 			yyDollar[1].sequence.Cycle = NewBoolVal(true)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 226:
+	case 300:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1715
+//line parser/parser.y:2301
 		{
 			yyDollar[1].sequence.OwnedBy = "NONE"
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 227:
+	case 301:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1720
+//line parser/parser.y:2306
 		{
 			yyDollar[1].sequence.OwnedBy = string(yyDollar[4].tableIdent.v) + "." + string(yyDollar[6].colIdent.val)
 			yyVAL.sequence = yyDollar[1].sequence
 		}
-	case 228:
+	case 302:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1727
+//line parser/parser.y:2313
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, yyDollar[2].optVal)
 		}
-	case 229:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1731
+//line parser/parser.y:2317
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
-	case 230:
+	case 304:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1735
+//line parser/parser.y:2321
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, yyDollar[2].optVal)
 		}
-	case 231:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1739
+//line parser/parser.y:2325
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
-	case 232:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1743
+//line parser/parser.y:2329
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
-	case 233:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1747
+//line parser/parser.y:2333
 		{
 			yyVAL.optVal = NewValArgWithOpt(yyDollar[1].bytes, nil)
 		}
-	case 234:
+	case 308:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1752
+//line parser/parser.y:2338
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 235:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1756
+//line parser/parser.y:2342
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 236:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1761
+//line parser/parser.y:2347
 		{
 			yyVAL.bytes = nil
 		}
-	case 240:
+	case 314:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1770
+//line parser/parser.y:2356
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.DisplayWidth = yyDollar[2].optVal
 		}
-	case 241:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1775
+//line parser/parser.y:2361
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 242:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1781
+//line parser/parser.y:2367
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 243:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1785
+//line parser/parser.y:2371
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 244:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1789
+//line parser/parser.y:2375
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 245:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1793
+//line parser/parser.y:2379
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 246:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1797
+//line parser/parser.y:2383
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 247:
+	case 321:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1801
+//line parser/parser.y:2387
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 248:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1805
+//line parser/parser.y:2391
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 249:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1809
+//line parser/parser.y:2395
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 250:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1813
+//line parser/parser.y:2399
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 251:
+	case 325:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1817
+//line parser/parser.y:2403
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 252:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1823
+//line parser/parser.y:2409
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 253:
+	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1829
+//line parser/parser.y:2415
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + yyDollar[2].str}
 			yyVAL.columnType.Length = yyDollar[3].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[3].LengthScaleOption.Scale
 		}
-	case 254:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1835
+//line parser/parser.y:2421
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 255:
+	case 329:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1841
+//line parser/parser.y:2427
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 256:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1847
+//line parser/parser.y:2433
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 257:
+	case 331:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1853
+//line parser/parser.y:2439
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 258:
+	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1857
+//line parser/parser.y:2443
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 259:
+	case 333:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:1862
+//line parser/parser.y:2448
 		{
 			yyVAL.str = ""
 		}
-	case 260:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1866
+//line parser/parser.y:2452
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes)
 		}
-	case 261:
+	case 335:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1872
+//line parser/parser.y:2458
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 262:
+	case 336:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1876
+//line parser/parser.y:2462
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Timezone: yyDollar[3].boolVal}
 		}
-	case 263:
+	case 337:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1880
+//line parser/parser.y:2466
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Timezone: yyDollar[3].boolVal}
 		}
-	case 264:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1884
+//line parser/parser.y:2470
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 265:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1888
+//line parser/parser.y:2474
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 266:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1892
+//line parser/parser.y:2478
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 267:
+	case 341:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1896
+//line parser/parser.y:2482
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 268:
+	case 342:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1900
+//line parser/parser.y:2486
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 269:
+	case 343:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1906
+//line parser/parser.y:2492
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 270:
+	case 344:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1910
+//line parser/parser.y:2496
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 271:
+	case 345:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1916
+//line parser/parser.y:2502
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 272:
+	case 346:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:1920
+//line parser/parser.y:2506
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes) + yyDollar[2].str, Length: yyDollar[3].optVal, Charset: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
-	case 273:
+	case 347:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1924
+//line parser/parser.y:2510
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 274:
+	case 348:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1928
+//line parser/parser.y:2514
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 275:
+	case 349:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:1932
+//line parser/parser.y:2518
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 276:
+	case 350:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1936
+//line parser/parser.y:2522
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 277:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1940
+//line parser/parser.y:2526
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 278:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:1944
+//line parser/parser.y:2530
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 279:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1948
+//line parser/parser.y:2534
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 280:
+	case 354:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1952
+//line parser/parser.y:2538
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 281:
+	case 355:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1956
+//line parser/parser.y:2542
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 282:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1960
+//line parser/parser.y:2546
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 283:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:1964
+//line parser/parser.y:2550
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 284:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1968
+//line parser/parser.y:2554
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 285:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1972
+//line parser/parser.y:2558
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 286:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1976
+//line parser/parser.y:2562
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 287:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1980
+//line parser/parser.y:2566
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 288:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1984
+//line parser/parser.y:2570
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 289:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1988
+//line parser/parser.y:2574
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 290:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:1992
+//line parser/parser.y:2578
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 291:
+	case 365:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:1996
+//line parser/parser.y:2582
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 292:
+	case 366:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:2001
+//line parser/parser.y:2587
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 293:
+	case 367:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2006
+//line parser/parser.y:2592
 		{
 			yyVAL.str = ""
 		}
-	case 294:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2010
+//line parser/parser.y:2596
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes)
 		}
-	case 295:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2016
+//line parser/parser.y:2602
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 296:
+	case 370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2020
+//line parser/parser.y:2606
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 297:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2024
+//line parser/parser.y:2610
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 298:
+	case 372:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2028
+//line parser/parser.y:2614
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 299:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2032
+//line parser/parser.y:2618
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 300:
+	case 374:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2036
+//line parser/parser.y:2622
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 301:
+	case 375:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2040
+//line parser/parser.y:2626
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 302:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2044
+//line parser/parser.y:2630
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 303:
+	case 377:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2050
+//line parser/parser.y:2636
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 304:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2055
+//line parser/parser.y:2641
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 305:
+	case 379:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2060
+//line parser/parser.y:2646
 		{
 			yyVAL.optVal = nil
 		}
-	case 306:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2064
+//line parser/parser.y:2650
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 307:
+	case 381:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2069
+//line parser/parser.y:2655
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 308:
+	case 382:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2073
+//line parser/parser.y:2659
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 309:
+	case 383:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2081
+//line parser/parser.y:2667
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 310:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2085
+//line parser/parser.y:2671
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 311:
+	case 385:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2091
+//line parser/parser.y:2677
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 312:
+	case 386:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2099
+//line parser/parser.y:2685
 		{
 			yyVAL.optVal = nil
 		}
-	case 313:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2103
+//line parser/parser.y:2689
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 314:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2107
+//line parser/parser.y:2693
 		{
 			if strings.ToLower(string(yyDollar[2].bytes)) != "max" {
 				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
 			}
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 315:
+	case 389:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2116
+//line parser/parser.y:2702
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 316:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2120
+//line parser/parser.y:2706
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 317:
+	case 391:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2124
+//line parser/parser.y:2710
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 318:
+	case 392:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2129
+//line parser/parser.y:2715
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 319:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2133
+//line parser/parser.y:2719
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 320:
+	case 394:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2138
+//line parser/parser.y:2724
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 321:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2142
+//line parser/parser.y:2728
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 322:
+	case 396:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2147
+//line parser/parser.y:2733
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 323:
+	case 397:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2151
+//line parser/parser.y:2737
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 324:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2155
+//line parser/parser.y:2741
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 325:
+	case 399:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2160
+//line parser/parser.y:2746
 		{
 			yyVAL.str = ""
 		}
-	case 326:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2164
+//line parser/parser.y:2750
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 327:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2168
+//line parser/parser.y:2754
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 328:
+	case 402:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2173
+//line parser/parser.y:2759
 		{
 			yyVAL.str = ""
 		}
-	case 329:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2177
+//line parser/parser.y:2763
 		{
 			yyVAL.str = string(yyDollar[1].bytes) // Set pseudo collation "binary" for BINARY attribute (deprecated in future MySQL versions)
 		}
-	case 330:
+	case 404:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2181
+//line parser/parser.y:2767
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 331:
+	case 405:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:2187
+//line parser/parser.y:2773
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions, Partition: yyDollar[6].indexPartition}
 		}
-	case 332:
+	case 406:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:2778
+		{
+			yyVAL.indexDefinition = &IndexDefinition{
+				Info:      &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Clustered: BoolVal(true), ColumnStore: true},
+				Options:   yyDollar[5].indexOptions,
+				Partition: yyDollar[6].indexPartition,
+			}
+		}
+	case 407:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2192
+//line parser/parser.y:2787
 		{
 			yyVAL.indexOptions = []*IndexOption{}
 		}
-	case 333:
+	case 408:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2196
+//line parser/parser.y:2791
 		{
 			yyVAL.indexOptions = yyDollar[1].indexOptions
 		}
-	case 334:
+	case 409:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2200
+//line parser/parser.y:2795
 		{
 			yyVAL.indexOptions = yyDollar[3].indexOptions
 		}
-	case 335:
+	case 410:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2206
+//line parser/parser.y:2801
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 336:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2210
+//line parser/parser.y:2805
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 337:
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2216
+//line parser/parser.y:2811
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 338:
+	case 413:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2220
+//line parser/parser.y:2815
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[3].indexOption)
 		}
-	case 339:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2226
+//line parser/parser.y:2821
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 340:
+	case 415:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2230
+//line parser/parser.y:2825
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 341:
+	case 416:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2235
+//line parser/parser.y:2830
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 342:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2239
+//line parser/parser.y:2834
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[2].bytes), Value: NewStrVal([]byte(yyDollar[3].colIdent.String()))}
 		}
-	case 343:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2243
+//line parser/parser.y:2838
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 344:
+	case 419:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2247
+//line parser/parser.y:2842
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 345:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2251
+//line parser/parser.y:2846
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 346:
+	case 421:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2255
+//line parser/parser.y:2850
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 347:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2259
+//line parser/parser.y:2854
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 348:
+	case 423:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2263
+//line parser/parser.y:2858
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 349:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2267
+//line parser/parser.y:2862
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: yyDollar[3].optVal}
 		}
-	case 350:
+	case 425:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2273
+//line parser/parser.y:2868
 		{
 			yyVAL.str = ""
 		}
-	case 351:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2277
+//line parser/parser.y:2872
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 352:
+	case 427:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2283
+//line parser/parser.y:2878
 		{
 			yyVAL.optVal = NewBoolSQLVal(true)
 		}
-	case 353:
+	case 428:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2287
+//line parser/parser.y:2882
 		{
 			yyVAL.optVal = NewBoolSQLVal(false)
 		}
-	case 354:
+	case 429:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2293
+//line parser/parser.y:2888
 		{
 			yyVAL.indexPartition = nil
 		}
-	case 355:
+	case 430:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2297
+//line parser/parser.y:2892
 		{
 			yyVAL.indexPartition = &IndexPartition{Name: yyDollar[2].colIdent.String()}
 		}
-	case 356:
+	case 431:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2301
+//line parser/parser.y:2896
 		{
 			yyVAL.indexPartition = &IndexPartition{Name: yyDollar[2].colIdent.String(), Column: yyDollar[4].colIdent.String()}
 		}
-	case 357:
+	case 432:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2307
+//line parser/parser.y:2902
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 358:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2311
+//line parser/parser.y:2906
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Spatial: true, Unique: false}
 		}
-	case 359:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2315
+//line parser/parser.y:2910
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Fulltext: true}
 		}
-	case 360:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2319
+//line parser/parser.y:2914
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Fulltext: true}
 		}
-	case 361:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2323
+//line parser/parser.y:2918
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
 		}
-	case 362:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2327
+//line parser/parser.y:2922
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
 		}
-	case 363:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2331
+//line parser/parser.y:2926
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(""), Unique: true}
 		}
-	case 364:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2335
+//line parser/parser.y:2930
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false, Clustered: yyDollar[3].boolVal}
 		}
-	case 365:
+	case 440:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2339
+//line parser/parser.y:2934
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true, Clustered: yyDollar[4].boolVal}
 		}
-	case 366:
+	case 441:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2345
+//line parser/parser.y:2940
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 367:
+	case 442:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2349
+//line parser/parser.y:2944
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 368:
+	case 443:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2355
+//line parser/parser.y:2950
 		{
 			yyVAL.indexColumnsOrExpression = IndexColumnsOrExpression{IndexCols: yyDollar[1].indexColumns}
 		}
-	case 369:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2360
+//line parser/parser.y:2955
 		{
 			yyVAL.indexColumnsOrExpression = IndexColumnsOrExpression{IndexExpr: yyDollar[1].expr}
 		}
-	case 370:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2366
+//line parser/parser.y:2961
 		{
 			yyVAL.indexColumns = []IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 371:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2370
+//line parser/parser.y:2965
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 372:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2376
+//line parser/parser.y:2971
 		{
 			yyVAL.indexColumn = IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal, Direction: yyDollar[3].str}
 		}
-	case 373:
+	case 448:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:2975
+		{
+			yyVAL.indexColumn = IndexColumn{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].optVal, Direction: yyDollar[3].str}
+		}
+	case 449:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2381
+//line parser/parser.y:2980
 		{
 			yyVAL.indexColumn = IndexColumn{Column: NewColIdent(string(yyDollar[1].bytes)), Length: yyDollar[2].optVal}
 		}
-	case 374:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2385
+//line parser/parser.y:2984
+		{
+			yyVAL.indexColumn = IndexColumn{Column: yyDollar[1].colIdent, OperatorClass: string(yyDollar[2].bytes)}
+		}
+	case 451:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser/parser.y:2989
 		{
-			yyVAL.indexColumn = IndexColumn{Column: yyDollar[1].colIdent, OperatorClass: string(yyDollar[2].bytes)}
+			yyVAL.indexColumn = IndexColumn{Expression: yyDollar[2].expr, Direction: yyDollar[4].str}
 		}
-	case 376:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2395
+//line parser/parser.y:2999
 		{
 			yyDollar[1].foreignKeyDefinition.NotForReplication = bool(yyDollar[2].boolVal)
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 377:
+	case 454:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2400
+//line parser/parser.y:3004
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = NewColIdent("")
 			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[4].colIdent
 			yyDollar[1].foreignKeyDefinition.NotForReplication = bool(yyDollar[5].boolVal)
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 378:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2407
+//line parser/parser.y:3011
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[4].colIdent
 			yyDollar[1].foreignKeyDefinition.OnDelete = NewColIdent("")
 			yyDollar[1].foreignKeyDefinition.NotForReplication = bool(yyDollar[5].boolVal)
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 379:
+	case 456:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:2414
+//line parser/parser.y:3018
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[7].colIdent
 			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[4].colIdent
 			yyDollar[1].foreignKeyDefinition.NotForReplication = bool(yyDollar[8].boolVal)
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 380:
+	case 457:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:2421
+//line parser/parser.y:3025
 		{
 			yyDollar[1].foreignKeyDefinition.OnUpdate = yyDollar[4].colIdent
 			yyDollar[1].foreignKeyDefinition.OnDelete = yyDollar[7].colIdent
 			yyDollar[1].foreignKeyDefinition.NotForReplication = bool(yyDollar[8].boolVal)
 			yyVAL.foreignKeyDefinition = yyDollar[1].foreignKeyDefinition
 		}
-	case 381:
+	case 458:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line parser/parser.y:2430
+//line parser/parser.y:3034
 		{
 			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{
 				ConstraintName:   yyDollar[2].colIdent,
@@ -5541,9 +6705,9 @@ yydefault:
 				ReferenceColumns: yyDollar[12].colIdents,
 			}
 		}
-	case 382:
+	case 459:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line parser/parser.y:2441
+//line parser/parser.y:3045
 		{
 			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{
 				IndexName:        yyDollar[3].colIdent,
@@ -5552,33 +6716,33 @@ yydefault:
 				ReferenceColumns: yyDollar[10].colIdents,
 			}
 		}
-	case 383:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2452
+//line parser/parser.y:3056
 		{
 			yyVAL.colIdent = NewColIdent("RESTRICT")
 		}
-	case 384:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2456
+//line parser/parser.y:3060
 		{
 			yyVAL.colIdent = NewColIdent("CASCADE")
 		}
-	case 385:
+	case 462:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2460
+//line parser/parser.y:3064
 		{
 			yyVAL.colIdent = NewColIdent("SET NULL")
 		}
-	case 386:
+	case 463:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2464
+//line parser/parser.y:3068
 		{
 			yyVAL.colIdent = NewColIdent("NO ACTION")
 		}
-	case 387:
+	case 464:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line parser/parser.y:2470
+//line parser/parser.y:3074
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:      &IndexInfo{Type: string(yyDollar[3].bytes) + " " + string(yyDollar[4].bytes), Name: yyDollar[2].colIdent, Primary: true, Unique: true, Clustered: yyDollar[5].boolVal},
@@ -5587,9 +6751,9 @@ yydefault:
 				Partition: yyDollar[10].indexPartition,
 			}
 		}
-	case 388:
+	case 465:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:2480
+//line parser/parser.y:3084
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:      &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Primary: true, Unique: true, Clustered: yyDollar[3].boolVal},
@@ -5598,696 +6762,801 @@ yydefault:
 				Partition: yyDollar[8].indexPartition,
 			}
 		}
-	case 389:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:2491
+	case 466:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser/parser.y:3095
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:      &IndexInfo{Type: string(yyDollar[3].bytes), Name: yyDollar[2].colIdent, Primary: false, Unique: true, Clustered: yyDollar[4].boolVal},
 				Columns:   yyDollar[6].indexColumns,
-				Options:   yyDollar[8].indexOptions,
-				Partition: yyDollar[9].indexPartition,
+				Included:  yyDollar[8].columns,
+				Options:   yyDollar[9].indexOptions,
+				Partition: yyDollar[10].indexPartition,
 			}
 		}
-	case 390:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:2501
+	case 467:
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser/parser.y:3106
+		{
+			yyVAL.indexDefinition = &IndexDefinition{
+				Info:             &IndexInfo{Type: string(yyDollar[3].bytes), Name: yyDollar[2].colIdent, Primary: false, Unique: true},
+				Columns:          yyDollar[8].indexColumns,
+				Included:         yyDollar[10].columns,
+				NullsNotDistinct: true,
+			}
+		}
+	case 468:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser/parser.y:3116
 		{
 			yyVAL.indexDefinition = &IndexDefinition{
 				Info:      &IndexInfo{Type: string(yyDollar[1].bytes), Primary: false, Unique: true, Clustered: yyDollar[2].boolVal},
 				Columns:   yyDollar[4].indexColumns,
-				Options:   yyDollar[6].indexOptions,
-				Partition: yyDollar[7].indexPartition,
+				Included:  yyDollar[6].columns,
+				Options:   yyDollar[7].indexOptions,
+				Partition: yyDollar[8].indexPartition,
 			}
 		}
-	case 391:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:2512
+	case 469:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser/parser.y:3127
+		{
+			yyVAL.indexDefinition = &IndexDefinition{
+				Info:             &IndexInfo{Type: string(yyDollar[1].bytes), Primary: false, Unique: true},
+				Columns:          yyDollar[6].indexColumns,
+				Included:         yyDollar[8].columns,
+				NullsNotDistinct: true,
+			}
+		}
+	case 470:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser/parser.y:3138
 		{
 			yyVAL.checkDefinition = &CheckDefinition{
 				ConstraintName: yyDollar[2].colIdent,
 				Where:          *NewWhere(WhereStr, yyDollar[5].expr),
 				NoInherit:      yyDollar[7].boolVal,
+				NotEnforced:    bool(yyDollar[8].boolVal),
 			}
 		}
-	case 392:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2521
+	case 471:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser/parser.y:3148
 		{
 			yyVAL.checkDefinition = &CheckDefinition{
-				Where:     *NewWhere(WhereStr, yyDollar[3].expr),
-				NoInherit: yyDollar[5].boolVal,
+				Where:       *NewWhere(WhereStr, yyDollar[3].expr),
+				NoInherit:   yyDollar[5].boolVal,
+				NotEnforced: bool(yyDollar[6].boolVal),
 			}
 		}
-	case 393:
+	case 472:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2530
+//line parser/parser.y:3158
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 394:
+	case 473:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:3162
+		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), "valid") {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 474:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser/parser.y:3172
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2534
+//line parser/parser.y:3176
+		{
+			if !strings.EqualFold(string(yyDollar[1].bytes), "enforced") {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[1].bytes)))
+				return 1
+			}
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 476:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:3184
 		{
+			if !strings.EqualFold(string(yyDollar[2].bytes), "enforced") {
+				yylex.Error(fmt.Sprintf("syntax error around '%s'", string(yyDollar[2].bytes)))
+				return 1
+			}
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 395:
+	case 477:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser/parser.y:3194
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2538
+//line parser/parser.y:3198
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 479:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:3202
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 396:
+	case 480:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2544
+//line parser/parser.y:3208
 		{
 			yyVAL.boolVals = []BoolVal{false, false}
 		}
-	case 397:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2548
+//line parser/parser.y:3212
 		{
 			yyVAL.boolVals = []BoolVal{false, true}
 		}
-	case 398:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2552
+//line parser/parser.y:3216
 		{
 			yyVAL.boolVals = []BoolVal{false, false}
 		}
-	case 399:
+	case 483:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2556
+//line parser/parser.y:3220
 		{
 			yyVAL.boolVals = []BoolVal{true, false}
 		}
-	case 400:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2560
+//line parser/parser.y:3224
 		{
 			yyVAL.boolVals = []BoolVal{true, true}
 		}
-	case 401:
+	case 485:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2564
+//line parser/parser.y:3228
 		{
 			yyVAL.boolVals = []BoolVal{true, false}
 		}
-	case 402:
+	case 486:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2570
+//line parser/parser.y:3234
 		{
 		}
-	case 403:
+	case 487:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2571
+//line parser/parser.y:3235
 		{
 		}
-	case 404:
+	case 488:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2575
+//line parser/parser.y:3239
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 405:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2579
+//line parser/parser.y:3243
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 406:
+	case 490:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2584
+//line parser/parser.y:3248
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 408:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2591
+//line parser/parser.y:3255
 		{
 			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
 		}
-	case 409:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2595
+//line parser/parser.y:3259
 		{
 			yyVAL.colIdents = append(yyDollar[1].colIdents, yyDollar[3].colIdent)
 		}
-	case 410:
+	case 494:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2602
+//line parser/parser.y:3266
 		{
 			yyVAL.tableOptions = map[string]string{}
 		}
-	case 411:
+	case 495:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2606
+//line parser/parser.y:3270
 		{
 			yyVAL.tableOptions = yyDollar[1].tableOptions
 			yyVAL.tableOptions[string(yyDollar[2].str)] = string(yyDollar[4].str)
 		}
-	case 412:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2612
+//line parser/parser.y:3276
 		{
-			yyVAL.tableOptions = map[string]string{}
+			yyVAL.tableOptions = map[string]string{yyDollar[1].str: ""}
 		}
-	case 413:
+	case 497:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2616
+//line parser/parser.y:3280
 		{
 			yyVAL.tableOptions = yyDollar[1].tableOptions
+			yyVAL.tableOptions[yyDollar[3].str] = ""
 		}
-	case 414:
+	case 498:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser/parser.y:3286
+		{
+			yyVAL.tableOptions = yyDollar[1].tableOptions
+			for key, value := range yyDollar[4].tableOptions {
+				yyVAL.tableOptions[key] = value
+			}
+		}
+	case 499:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:3295
+		{
+			yyVAL.tableOptions = map[string]string{strings.ToUpper(yyDollar[1].str): strings.ToUpper(yyDollar[3].str)}
+		}
+	case 500:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser/parser.y:3299
+		{
+			yyVAL.tableOptions = yyDollar[1].tableOptions
+			yyVAL.tableOptions[strings.ToUpper(yyDollar[3].str)] = strings.ToUpper(yyDollar[5].str)
+		}
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2621
+//line parser/parser.y:3306
 		{
+			yyVAL.str = "WITHOUT ROWID"
 		}
-	case 415:
+	case 502:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2622
+//line parser/parser.y:3310
 		{
+			yyVAL.str = "STRICT"
 		}
-	case 416:
+	case 503:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2626
+//line parser/parser.y:3316
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 417:
+	case 504:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2630
+//line parser/parser.y:3320
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].colIdent.String()
 		}
-	case 418:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2634
+//line parser/parser.y:3324
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 419:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2640
+//line parser/parser.y:3330
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 420:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2644
+//line parser/parser.y:3334
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 421:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2648
+//line parser/parser.y:3338
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 422:
+	case 509:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2653
+//line parser/parser.y:3343
 		{
 			setAllowComments(yylex, true)
 		}
-	case 423:
+	case 510:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2657
+//line parser/parser.y:3347
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 424:
+	case 511:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2663
+//line parser/parser.y:3353
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 425:
+	case 512:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2667
+//line parser/parser.y:3357
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 426:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2673
+//line parser/parser.y:3363
 		{
 			yyVAL.str = UnionStr
 		}
-	case 427:
+	case 514:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2677
+//line parser/parser.y:3367
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 428:
+	case 515:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2681
+//line parser/parser.y:3371
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 429:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2686
+//line parser/parser.y:3376
 		{
 			yyVAL.str = ""
 		}
-	case 430:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2690
+//line parser/parser.y:3380
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 431:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2694
+//line parser/parser.y:3384
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 432:
+	case 519:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2699
+//line parser/parser.y:3389
 		{
 			yyVAL.str = ""
 		}
-	case 433:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2703
+//line parser/parser.y:3393
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 434:
+	case 521:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2708
+//line parser/parser.y:3398
 		{
 			yyVAL.str = ""
 		}
-	case 435:
+	case 522:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2712
+//line parser/parser.y:3402
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 436:
+	case 523:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2717
+//line parser/parser.y:3407
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 437:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2721
+//line parser/parser.y:3411
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 438:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2727
+//line parser/parser.y:3417
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 439:
+	case 526:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2731
+//line parser/parser.y:3421
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 440:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2737
+//line parser/parser.y:3427
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 441:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2741
+//line parser/parser.y:3431
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 442:
+	case 529:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2745
+//line parser/parser.y:3435
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 443:
+	case 530:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:2749
+//line parser/parser.y:3439
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Schema: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 444:
+	case 531:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2754
+//line parser/parser.y:3444
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 445:
+	case 532:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2758
+//line parser/parser.y:3448
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 446:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2762
+//line parser/parser.y:3452
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 448:
+	case 535:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2769
+//line parser/parser.y:3459
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 449:
+	case 536:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2774
+//line parser/parser.y:3464
 		{
 			yyVAL.overExpr = nil
 		}
-	case 450:
+	case 537:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2778
+//line parser/parser.y:3468
 		{
 			yyVAL.overExpr = &OverExpr{}
 		}
-	case 451:
+	case 538:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:2782
+//line parser/parser.y:3472
 		{
 			yyVAL.overExpr = &OverExpr{PartitionBy: yyDollar[5].partitionBy}
 		}
-	case 452:
+	case 539:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2786
+//line parser/parser.y:3476
 		{
 			yyVAL.overExpr = &OverExpr{OrderBy: yyDollar[3].orderBy}
 		}
-	case 453:
+	case 540:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:2790
+//line parser/parser.y:3480
 		{
 			yyVAL.overExpr = &OverExpr{PartitionBy: yyDollar[5].partitionBy, OrderBy: yyDollar[6].orderBy}
 		}
-	case 454:
+	case 541:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2795
+//line parser/parser.y:3485
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 455:
+	case 542:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2799
+//line parser/parser.y:3489
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 456:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2805
+//line parser/parser.y:3495
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 457:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2809
+//line parser/parser.y:3499
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 460:
+	case 547:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2819
+//line parser/parser.y:3509
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 461:
+	case 548:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2823
+//line parser/parser.y:3513
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 462:
+	case 549:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2827
+//line parser/parser.y:3517
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 463:
+	case 550:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2832
+//line parser/parser.y:3522
 		{
 			yyVAL.strs = []string{}
 		}
-	case 464:
+	case 551:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2836
+//line parser/parser.y:3526
 		{
 			yyVAL.strs = yyDollar[3].strs
 		}
-	case 465:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2842
+//line parser/parser.y:3532
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 466:
+	case 553:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2846
+//line parser/parser.y:3536
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 467:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2852
+//line parser/parser.y:3542
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 468:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2856
+//line parser/parser.y:3546
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 469:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2860
+//line parser/parser.y:3550
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 470:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2864
+//line parser/parser.y:3554
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 471:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2868
+//line parser/parser.y:3558
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 472:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2872
+//line parser/parser.y:3562
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 473:
+	case 560:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2878
+//line parser/parser.y:3568
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, IndexHints: yyDollar[3].indexHints, TableHints: yyDollar[4].strs}
 		}
-	case 474:
+	case 561:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:2882
+//line parser/parser.y:3572
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, IndexHints: yyDollar[7].indexHints, TableHints: yyDollar[8].strs}
 		}
-	case 475:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2888
+//line parser/parser.y:3578
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 476:
+	case 563:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2893
+//line parser/parser.y:3583
 		{
 			yyVAL.columns = Columns{NewColIdent(string(yyDollar[1].bytes))}
 		}
-	case 477:
+	case 564:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:3587
+		{
+			yyVAL.columns = Columns{NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 565:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2897
+//line parser/parser.y:3591
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 478:
+	case 566:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:3595
+		{
+			yyVAL.columns = append(yyVAL.columns, NewColIdent(string(yyDollar[3].bytes)))
+		}
+	case 567:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2903
+//line parser/parser.y:3601
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 479:
+	case 568:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2907
+//line parser/parser.y:3605
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 480:
+	case 569:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2920
+//line parser/parser.y:3618
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 481:
+	case 570:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2924
+//line parser/parser.y:3622
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 482:
+	case 571:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2928
+//line parser/parser.y:3626
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 483:
+	case 572:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:2932
+//line parser/parser.y:3630
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 484:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2938
+//line parser/parser.y:3636
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 485:
+	case 574:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:2940
+//line parser/parser.y:3638
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 486:
+	case 575:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2944
+//line parser/parser.y:3642
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 487:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2946
+//line parser/parser.y:3644
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 488:
+	case 577:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2950
+//line parser/parser.y:3648
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 489:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2952
+//line parser/parser.y:3650
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 490:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2955
+//line parser/parser.y:3653
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 491:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2957
+//line parser/parser.y:3655
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 492:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:2960
+//line parser/parser.y:3658
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 493:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2964
+//line parser/parser.y:3662
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 494:
+	case 583:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2968
+//line parser/parser.y:3666
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 496:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2975
+//line parser/parser.y:3673
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 497:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2981
+//line parser/parser.y:3679
 		{
 			yyVAL.str = JoinStr
 		}
-	case 498:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2985
+//line parser/parser.y:3683
 		{
 			yyVAL.str = JoinStr
 		}
-	case 499:
+	case 588:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:2989
+//line parser/parser.y:3687
 		{
 			yyVAL.str = JoinStr
 		}
-	case 500:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:2995
+//line parser/parser.y:3693
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 501:
+	case 590:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3001
+//line parser/parser.y:3699
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 502:
+	case 591:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3005
+//line parser/parser.y:3703
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 503:
+	case 592:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3009
+//line parser/parser.y:3707
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 504:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3013
+//line parser/parser.y:3711
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 505:
+	case 594:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3019
+//line parser/parser.y:3717
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 506:
+	case 595:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3023
+//line parser/parser.y:3721
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -6295,501 +7564,561 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 507:
+	case 596:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3033
+//line parser/parser.y:3731
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 508:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3037
+//line parser/parser.y:3735
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 509:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3043
+//line parser/parser.y:3741
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 510:
+	case 599:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3047
+//line parser/parser.y:3745
 		{
 			yyVAL.tableName = TableName{Schema: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 511:
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3052
+//line parser/parser.y:3750
 		{
 			yyVAL.indexHints = nil
 		}
-	case 512:
+	case 601:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3056
+//line parser/parser.y:3754
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 513:
+	case 602:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3060
+//line parser/parser.y:3758
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 514:
+	case 603:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3064
+//line parser/parser.y:3762
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 515:
+	case 604:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:3768
+		{
+			yyVAL.exclusionPairs = []ExclusionPair{yyDollar[1].exclusionPair}
+		}
+	case 605:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:3772
+		{
+			yyVAL.exclusionPairs = append(yyDollar[1].exclusionPairs, yyDollar[3].exclusionPair)
+		}
+	case 606:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:3778
+		{
+			yyVAL.exclusionPair = ExclusionPair{Column: yyDollar[1].colIdent, Operator: yyDollar[3].str}
+		}
+	case 607:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser/parser.y:3782
+		{
+			yyVAL.exclusionPair = ExclusionPair{Column: yyDollar[1].colIdent, OpClass: yyDollar[2].colIdent.String(), Operator: yyDollar[4].str}
+		}
+	case 608:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:3788
+		{
+			yyVAL.str = "="
+		}
+	case 609:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:3792
+		{
+			yyVAL.str = "<>"
+		}
+	case 610:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:3796
+		{
+			yyVAL.str = "&&"
+		}
+	case 611:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3069
+//line parser/parser.y:3801
 		{
 			yyVAL.expr = nil
 		}
-	case 516:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3073
+//line parser/parser.y:3805
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 517:
+	case 613:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3078
+//line parser/parser.y:3810
 		{
 			yyVAL.columns = nil
 		}
-	case 518:
+	case 614:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3082
+//line parser/parser.y:3814
 		{
 			yyVAL.columns = yyDollar[3].columns
 		}
-	case 519:
+	case 615:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser/parser.y:3820
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 616:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser/parser.y:3824
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 617:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser/parser.y:3828
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3088
+//line parser/parser.y:3834
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 520:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3092
+//line parser/parser.y:3838
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 521:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3096
+//line parser/parser.y:3842
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 522:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3100
+//line parser/parser.y:3846
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 523:
+	case 622:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3104
+//line parser/parser.y:3850
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 524:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3108
+//line parser/parser.y:3854
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 525:
+	case 624:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3112
+//line parser/parser.y:3858
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 526:
+	case 625:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3118
+//line parser/parser.y:3864
 		{
 			yyVAL.str = ""
 		}
-	case 527:
+	case 626:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3122
+//line parser/parser.y:3868
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 528:
+	case 627:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3128
+//line parser/parser.y:3874
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 529:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3132
+//line parser/parser.y:3878
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 530:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3138
+//line parser/parser.y:3884
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 531:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3142
+//line parser/parser.y:3888
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 532:
+	case 631:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3146
+//line parser/parser.y:3892
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 533:
+	case 632:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3150
+//line parser/parser.y:3896
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 534:
+	case 633:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3154
+//line parser/parser.y:3900
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 535:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3158
+//line parser/parser.y:3904
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 536:
+	case 635:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3162
+//line parser/parser.y:3908
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 537:
+	case 636:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3166
+//line parser/parser.y:3912
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 538:
+	case 637:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3170
+//line parser/parser.y:3916
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 539:
+	case 638:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3174
+//line parser/parser.y:3920
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 540:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3180
+//line parser/parser.y:3926
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 541:
+	case 640:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3184
+//line parser/parser.y:3930
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 542:
+	case 641:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3188
+//line parser/parser.y:3934
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 543:
+	case 642:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3192
+//line parser/parser.y:3938
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 544:
+	case 643:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3196
+//line parser/parser.y:3942
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 545:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3200
+//line parser/parser.y:3946
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 546:
+	case 645:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3206
+//line parser/parser.y:3952
 		{
 			yyVAL.str = EqualStr
 		}
-	case 547:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3210
+//line parser/parser.y:3956
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 548:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3214
+//line parser/parser.y:3960
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 549:
+	case 648:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3218
+//line parser/parser.y:3964
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 550:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3222
+//line parser/parser.y:3968
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 551:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3226
+//line parser/parser.y:3972
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 552:
+	case 651:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3230
+//line parser/parser.y:3976
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 553:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3234
+//line parser/parser.y:3980
 		{
 			yyVAL.str = PosixRegexStr
 		}
-	case 554:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3238
+//line parser/parser.y:3984
 		{
 			yyVAL.str = PosixRegexCiStr
 		}
-	case 555:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3242
+//line parser/parser.y:3988
 		{
 			yyVAL.str = PosixNotRegexStr
 		}
-	case 556:
+	case 655:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3246
+//line parser/parser.y:3992
 		{
 			yyVAL.str = PosixNotRegexCiStr
 		}
-	case 557:
+	case 656:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3251
+//line parser/parser.y:3997
 		{
 			yyVAL.expr = nil
 		}
-	case 558:
+	case 657:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3255
+//line parser/parser.y:4001
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 559:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3261
+//line parser/parser.y:4007
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 560:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3265
+//line parser/parser.y:4011
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 561:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3269
+//line parser/parser.y:4015
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 562:
+	case 661:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3275
+//line parser/parser.y:4021
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 563:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3281
+//line parser/parser.y:4027
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 564:
+	case 663:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3285
+//line parser/parser.y:4031
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 565:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3291
+//line parser/parser.y:4037
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 566:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3295
+//line parser/parser.y:4041
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 567:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3299
+//line parser/parser.y:4045
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 568:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3303
+//line parser/parser.y:4049
 		{
 			yyVAL.expr = yyDollar[1].newQualifierColName
 		}
-	case 569:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3307
+//line parser/parser.y:4053
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 570:
+	case 669:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3311
+//line parser/parser.y:4057
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 571:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3315
+//line parser/parser.y:4061
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 572:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3319
+//line parser/parser.y:4065
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 573:
+	case 672:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3323
+//line parser/parser.y:4069
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 574:
+	case 673:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3327
+//line parser/parser.y:4073
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 575:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3331
+//line parser/parser.y:4077
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 576:
+	case 675:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3335
+//line parser/parser.y:4081
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 577:
+	case 676:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3339
+//line parser/parser.y:4085
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 578:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3343
+//line parser/parser.y:4089
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 579:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3347
+//line parser/parser.y:4093
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 580:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3351
+//line parser/parser.y:4097
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 581:
+	case 680:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3355
+//line parser/parser.y:4101
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 582:
+	case 681:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3359
+//line parser/parser.y:4105
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 583:
+	case 682:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3363
+//line parser/parser.y:4109
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 584:
+	case 683:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3367
+//line parser/parser.y:4113
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 585:
+	case 684:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3371
+//line parser/parser.y:4117
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr}
 		}
-	case 586:
+	case 685:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3375
+//line parser/parser.y:4121
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr}
 		}
-	case 587:
+	case 686:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3379
+//line parser/parser.y:4125
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 588:
+	case 687:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3383
+//line parser/parser.y:4129
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 589:
+	case 688:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3387
+//line parser/parser.y:4133
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6797,9 +8126,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 590:
+	case 689:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3395
+//line parser/parser.y:4141
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6813,21 +8142,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 591:
+	case 690:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3409
+//line parser/parser.y:4155
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 592:
+	case 691:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3413
+//line parser/parser.y:4159
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 593:
+	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3417
+//line parser/parser.y:4163
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6835,9 +8164,9 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr}
 		}
-	case 594:
+	case 693:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3425
+//line parser/parser.y:4171
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6845,950 +8174,998 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 595:
+	case 694:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3433
+//line parser/parser.y:4179
 		{
 			yyVAL.expr = &CastExpr{Expr: yyDollar[1].expr, Type: yyDollar[3].convertType}
 		}
-	case 600:
+	case 699:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3441
+//line parser/parser.y:4187
 		{
 			yyVAL.expr = yyDollar[2].arrayConstructor
 		}
-	case 601:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3445
+//line parser/parser.y:4191
 		{
 			yyVAL.expr = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
-	case 602:
+	case 701:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3455
+//line parser/parser.y:4201
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 603:
+	case 702:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3459
+//line parser/parser.y:4205
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 604:
+	case 703:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3463
+//line parser/parser.y:4209
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overExpr}
 		}
-	case 605:
+	case 704:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3467
+//line parser/parser.y:4213
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overExpr}
 		}
-	case 606:
+	case 705:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3471
+//line parser/parser.y:4217
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overExpr}
 		}
-	case 607:
+	case 706:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3475
+//line parser/parser.y:4221
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 608:
+	case 707:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3479
+//line parser/parser.y:4225
 		{
 			yyVAL.expr = &FuncCallExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].exprs}
 		}
-	case 609:
+	case 708:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3489
+//line parser/parser.y:4235
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 610:
+	case 709:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3493
+//line parser/parser.y:4239
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 611:
+	case 710:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3497
+//line parser/parser.y:4243
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 612:
+	case 711:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3501
+//line parser/parser.y:4247
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[5].expr, Type: yyDollar[3].convertType}
 		}
-	case 613:
+	case 712:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3505
+//line parser/parser.y:4251
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 614:
+	case 713:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3509
+//line parser/parser.y:4255
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 615:
+	case 714:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3513
+//line parser/parser.y:4259
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: nil}
 		}
-	case 616:
+	case 715:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:3517
+//line parser/parser.y:4263
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 617:
+	case 716:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3521
+//line parser/parser.y:4267
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: nil}
 		}
-	case 618:
+	case 717:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:3525
+//line parser/parser.y:4271
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 619:
+	case 718:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3529
+//line parser/parser.y:4275
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: nil}
 		}
-	case 620:
+	case 719:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:3533
+//line parser/parser.y:4279
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 621:
+	case 720:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:3537
+//line parser/parser.y:4283
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: nil}
 		}
-	case 622:
+	case 721:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser/parser.y:3541
+//line parser/parser.y:4287
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].selectExpr, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 623:
+	case 722:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser/parser.y:3545
+//line parser/parser.y:4291
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 624:
+	case 723:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:3549
+//line parser/parser.y:4295
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 625:
+	case 724:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3553
+//line parser/parser.y:4299
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 626:
+	case 725:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3557
+//line parser/parser.y:4303
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 627:
+	case 726:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3562
+//line parser/parser.y:4308
 		{
 			yyVAL.expr = &NextSeqValExpr{SequenceName: yyDollar[4].tableIdent}
 		}
-	case 628:
+	case 727:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3566
+//line parser/parser.y:4312
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
-	case 629:
+	case 728:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3570
+//line parser/parser.y:4316
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
-	case 630:
+	case 729:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3574
+//line parser/parser.y:4320
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
-	case 631:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3584
+//line parser/parser.y:4330
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 632:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3588
+//line parser/parser.y:4334
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 633:
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3592
+//line parser/parser.y:4338
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 634:
+	case 733:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3596
+//line parser/parser.y:4342
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 635:
+	case 734:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3601
+//line parser/parser.y:4347
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 636:
+	case 735:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3606
+//line parser/parser.y:4352
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 637:
+	case 736:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3611
+//line parser/parser.y:4357
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 638:
+	case 737:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3616
+//line parser/parser.y:4362
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 639:
+	case 738:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3620
+//line parser/parser.y:4366
 		{
 			yyVAL.expr = &ConvertExpr{Type: yyDollar[2].convertType}
 		}
-	case 642:
+	case 741:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3634
+//line parser/parser.y:4380
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 643:
+	case 742:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3638
+//line parser/parser.y:4384
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 644:
+	case 743:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3642
+//line parser/parser.y:4388
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 645:
+	case 744:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3646
+//line parser/parser.y:4392
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 646:
+	case 745:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser/parser.y:4397
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("date"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 746:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser/parser.y:4401
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("time"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 747:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser/parser.y:4405
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("datetime"), Exprs: yyDollar[3].selectExprs}
+		}
+	case 748:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3652
+//line parser/parser.y:4411
 		{
 			yyVAL.str = ""
 		}
-	case 647:
+	case 749:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3656
+//line parser/parser.y:4415
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 648:
+	case 750:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3660
+//line parser/parser.y:4419
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 649:
+	case 751:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser/parser.y:3664
+//line parser/parser.y:4423
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 650:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3668
+//line parser/parser.y:4427
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 651:
+	case 753:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3674
+//line parser/parser.y:4433
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 652:
+	case 754:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3678
+//line parser/parser.y:4437
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 653:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3684
+//line parser/parser.y:4443
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 654:
+	case 756:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3688
+//line parser/parser.y:4447
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 655:
+	case 757:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3692
+//line parser/parser.y:4451
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 656:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3696
+//line parser/parser.y:4455
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 657:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3700
+//line parser/parser.y:4459
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 658:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3704
+//line parser/parser.y:4463
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 659:
+	case 761:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3710
+//line parser/parser.y:4469
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 660:
+	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3714
+//line parser/parser.y:4473
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 661:
+	case 763:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3718
+//line parser/parser.y:4477
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 662:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3722
+//line parser/parser.y:4481
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 663:
+	case 765:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3726
+//line parser/parser.y:4485
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 664:
+	case 766:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3730
+//line parser/parser.y:4489
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 665:
+	case 767:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3734
+//line parser/parser.y:4493
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 666:
+	case 768:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3738
+//line parser/parser.y:4497
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 667:
+	case 769:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3742
+//line parser/parser.y:4501
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 668:
+	case 770:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3746
+//line parser/parser.y:4505
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 669:
+	case 771:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3750
+//line parser/parser.y:4509
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 670:
+	case 772:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3754
+//line parser/parser.y:4513
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].LengthScaleOption.Length, Scale: yyDollar[2].LengthScaleOption.Scale}
 		}
-	case 671:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3758
+//line parser/parser.y:4517
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 672:
+	case 774:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3762
+//line parser/parser.y:4521
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 673:
+	case 775:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3766
+//line parser/parser.y:4525
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 674:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3770
+//line parser/parser.y:4529
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 675:
+	case 777:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3774
+//line parser/parser.y:4533
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 676:
+	case 778:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3778
+//line parser/parser.y:4537
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 677:
+	case 779:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3782
+//line parser/parser.y:4541
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 678:
+	case 780:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3786
+//line parser/parser.y:4545
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 679:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3790
+//line parser/parser.y:4549
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 680:
+	case 782:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3794
+//line parser/parser.y:4553
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 681:
+	case 783:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3798
+//line parser/parser.y:4557
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 682:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3802
+//line parser/parser.y:4561
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 683:
+	case 785:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3806
+//line parser/parser.y:4565
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 684:
+	case 786:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3810
+//line parser/parser.y:4569
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 685:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3816
+//line parser/parser.y:4575
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 686:
+	case 788:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3820
+//line parser/parser.y:4579
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)}
 		}
-	case 687:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3824
+//line parser/parser.y:4583
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 688:
+	case 790:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3828
+//line parser/parser.y:4587
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 689:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3832
+//line parser/parser.y:4591
 		{
 			yyVAL.convertType = &ConvertType{Type: yyDollar[1].columnType.Type}
 		}
-	case 690:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3836
+//line parser/parser.y:4595
 		{
 			yyVAL.convertType = &ConvertType{Type: yyDollar[1].columnType.Type}
 		}
-	case 691:
+	case 793:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3840
+//line parser/parser.y:4599
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 692:
+	case 794:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3844
+//line parser/parser.y:4603
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 693:
+	case 795:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3849
+//line parser/parser.y:4608
 		{
 			yyVAL.expr = nil
 		}
-	case 694:
+	case 796:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3853
+//line parser/parser.y:4612
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 695:
+	case 797:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3858
+//line parser/parser.y:4617
 		{
 			yyVAL.str = string("")
 		}
-	case 696:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3862
+//line parser/parser.y:4621
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 697:
+	case 799:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3868
+//line parser/parser.y:4627
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 698:
+	case 800:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3872
+//line parser/parser.y:4631
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 699:
+	case 801:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:3878
+//line parser/parser.y:4637
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 700:
+	case 802:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3883
+//line parser/parser.y:4642
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.expr = nil
 		}
-	case 701:
+	case 803:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3885
+//line parser/parser.y:4646
 		{
-			yyVAL.empty = struct{}{}
+			yyVAL.expr = yyDollar[2].expr
 		}
-	case 702:
+	case 804:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3888
+//line parser/parser.y:4651
 		{
 			yyVAL.expr = nil
 		}
-	case 703:
+	case 805:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3892
+//line parser/parser.y:4655
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 704:
+	case 806:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3898
+//line parser/parser.y:4661
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 705:
+	case 807:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:4666
+		{
+			yyVAL.colName = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 808:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:4670
+		{
+			yyVAL.colName = &ColName{Name: NewColIdent(string(yyDollar[1].bytes))}
+		}
+	case 809:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:4675
+		{
+			yyVAL.colName = &ColName{Name: NewColIdent("VALUE")}
+		}
+	case 810:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3902
+//line parser/parser.y:4679
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 706:
+	case 811:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:3906
+//line parser/parser.y:4683
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Schema: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 707:
+	case 812:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3912
+//line parser/parser.y:4689
 		{
 			yyVAL.newQualifierColName = &NewQualifierColName{Name: yyDollar[3].colIdent}
 		}
-	case 708:
+	case 813:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3918
+//line parser/parser.y:4695
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 709:
+	case 814:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3922
+//line parser/parser.y:4699
 		{
 			yyVAL.expr = NewUnicodeStrVal(yyDollar[1].bytes)
 		}
-	case 710:
+	case 815:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3927
+//line parser/parser.y:4704
 		{
 			// Ignoring _charset_name as a workaround
 			yyVAL.expr = NewStrVal(yyDollar[2].bytes)
 		}
-	case 711:
+	case 816:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3932
+//line parser/parser.y:4709
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 712:
+	case 817:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3936
+//line parser/parser.y:4713
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 713:
+	case 818:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3940
+//line parser/parser.y:4717
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 714:
+	case 819:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3944
+//line parser/parser.y:4721
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 715:
+	case 820:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3948
+//line parser/parser.y:4725
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 716:
+	case 821:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3952
+//line parser/parser.y:4729
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 717:
+	case 822:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3956
+//line parser/parser.y:4733
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 718:
+	case 823:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3961
+//line parser/parser.y:4738
 		{
 			yyVAL.exprs = nil
 		}
-	case 719:
+	case 824:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3965
+//line parser/parser.y:4742
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 720:
+	case 825:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3970
+//line parser/parser.y:4747
 		{
 			yyVAL.expr = nil
 		}
-	case 721:
+	case 826:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:3974
+//line parser/parser.y:4751
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 722:
+	case 827:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3980
+//line parser/parser.y:4757
 		{
 			yyVAL.partitionBy = PartitionBy{yyDollar[1].partition}
 		}
-	case 723:
+	case 828:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3984
+//line parser/parser.y:4761
 		{
 			yyVAL.partitionBy = append(yyDollar[1].partitionBy, yyDollar[3].partition)
 		}
-	case 724:
+	case 829:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:3990
+//line parser/parser.y:4767
 		{
 			yyVAL.partition = &Partition{Expr: yyDollar[1].expr}
 		}
-	case 725:
+	case 830:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:3995
+//line parser/parser.y:4772
 		{
 			yyVAL.orderBy = nil
 		}
-	case 726:
+	case 831:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:3999
+//line parser/parser.y:4776
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 727:
+	case 832:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4005
+//line parser/parser.y:4782
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 728:
+	case 833:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4009
+//line parser/parser.y:4786
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 729:
+	case 834:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4015
+//line parser/parser.y:4792
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 730:
+	case 835:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4020
+//line parser/parser.y:4797
 		{
 			yyVAL.str = AscScr
 		}
-	case 731:
+	case 836:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4024
+//line parser/parser.y:4801
 		{
 			yyVAL.str = AscScr
 		}
-	case 732:
+	case 837:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4028
+//line parser/parser.y:4805
 		{
 			yyVAL.str = DescScr
 		}
-	case 733:
+	case 838:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4033
+//line parser/parser.y:4810
 		{
 			yyVAL.limit = nil
 		}
-	case 734:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4037
+//line parser/parser.y:4814
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 735:
+	case 840:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4041
+//line parser/parser.y:4818
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 736:
+	case 841:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4045
+//line parser/parser.y:4822
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 737:
+	case 842:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4050
+//line parser/parser.y:4827
 		{
 			yyVAL.str = ""
 		}
-	case 738:
+	case 843:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4054
+//line parser/parser.y:4831
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 739:
+	case 844:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4058
+//line parser/parser.y:4835
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 740:
+	case 845:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4071
+//line parser/parser.y:4848
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 741:
+	case 846:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4075
+//line parser/parser.y:4852
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 742:
+	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4079
+//line parser/parser.y:4856
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 743:
+	case 848:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:4084
+//line parser/parser.y:4861
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 744:
+	case 849:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4088
+//line parser/parser.y:4865
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 745:
+	case 850:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser/parser.y:4092
+//line parser/parser.y:4869
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 746:
+	case 851:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4099
+//line parser/parser.y:4876
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 747:
+	case 852:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4103
+//line parser/parser.y:4880
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 748:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4107
+//line parser/parser.y:4884
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 749:
+	case 854:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:4111
+//line parser/parser.y:4888
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 750:
+	case 856:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:4896
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 857:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:4900
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 858:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4116
+//line parser/parser.y:4905
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 751:
+	case 859:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:4120
+//line parser/parser.y:4909
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 752:
+	case 860:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4126
+//line parser/parser.y:4915
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 753:
+	case 861:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4130
+//line parser/parser.y:4919
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 754:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4136
+//line parser/parser.y:4925
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 755:
+	case 863:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4140
+//line parser/parser.y:4929
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 756:
+	case 864:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4146
+//line parser/parser.y:4935
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 757:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4152
+//line parser/parser.y:4941
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -7796,258 +9173,270 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 758:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4162
+//line parser/parser.y:4951
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 759:
+	case 867:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4166
+//line parser/parser.y:4955
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 760:
+	case 868:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4172
+//line parser/parser.y:4961
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 761:
+	case 869:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4178
+//line parser/parser.y:4967
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 762:
+	case 870:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4182
+//line parser/parser.y:4971
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 763:
+	case 871:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4188
+//line parser/parser.y:4977
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 764:
+	case 872:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4192
+//line parser/parser.y:4981
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 765:
+	case 873:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4196
+//line parser/parser.y:4985
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 766:
+	case 874:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser/parser.y:4201
+//line parser/parser.y:4990
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent("NEW." + yyDollar[3].colIdent.val), Expr: yyDollar[5].expr}
 		}
-	case 767:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4205
+//line parser/parser.y:4994
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 768:
+	case 876:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4211
+//line parser/parser.y:5000
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 769:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4217
+//line parser/parser.y:5006
 		{
 			yyVAL.statement = &SetBoolOption{OptionNames: yyDollar[2].strs, Value: yyDollar[3].optVal}
 		}
-	case 771:
+	case 879:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4224
+//line parser/parser.y:5013
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 773:
+	case 881:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4231
+//line parser/parser.y:5020
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 774:
+	case 882:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4235
+//line parser/parser.y:5024
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 775:
+	case 883:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4239
+//line parser/parser.y:5028
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 776:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4244
+//line parser/parser.y:5033
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 777:
+	case 885:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4246
+//line parser/parser.y:5035
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 778:
+	case 886:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4249
+//line parser/parser.y:5038
 		{
 			yyVAL.str = ""
 		}
-	case 779:
+	case 887:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4251
+//line parser/parser.y:5040
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 780:
+	case 888:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4255
+//line parser/parser.y:5044
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 782:
+	case 890:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4262
+//line parser/parser.y:5051
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 783:
+	case 891:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4266
+//line parser/parser.y:5055
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 784:
+	case 892:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:5059
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
+		}
+	case 893:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4272
+//line parser/parser.y:5065
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 785:
+	case 894:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4277
+//line parser/parser.y:5069
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 787:
+	case 895:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4284
+//line parser/parser.y:5074
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 788:
+	case 897:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser/parser.y:5081
+		{
+			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
+		}
+	case 898:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4290
+//line parser/parser.y:5087
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 789:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4294
+//line parser/parser.y:5091
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 790:
+	case 900:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4298
+//line parser/parser.y:5095
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 791:
+	case 901:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4304
+//line parser/parser.y:5101
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 792:
+	case 902:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4308
+//line parser/parser.y:5105
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 793:
+	case 903:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4312
+//line parser/parser.y:5109
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 794:
+	case 904:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser/parser.y:4318
+//line parser/parser.y:5115
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 795:
+	case 905:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4322
+//line parser/parser.y:5119
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 796:
+	case 906:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser/parser.y:4329
+//line parser/parser.y:5126
 		{
 			yyVAL.arrayConstructor = &ArrayConstructor{Elements: yyDollar[3].arrayElements}
 		}
-	case 797:
+	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4336
+//line parser/parser.y:5133
 		{
 			yyVAL.arrayElements = ArrayElements{yyDollar[1].arrayElement}
 		}
-	case 798:
+	case 908:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4340
+//line parser/parser.y:5137
 		{
 			yyVAL.arrayElements = append(yyVAL.arrayElements, yyDollar[3].arrayElement)
 		}
-	case 799:
+	case 909:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser/parser.y:4347
+//line parser/parser.y:5144
 		{
 			yyVAL.arrayElement = NewStrVal(yyDollar[1].bytes)
 		}
-	case 800:
+	case 910:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4353
+//line parser/parser.y:5150
 		{
 			yyVAL.strs = []string{string(yyDollar[1].bytes)}
 		}
-	case 801:
+	case 911:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser/parser.y:4357
+//line parser/parser.y:5154
 		{
 			yyVAL.strs = append(yyVAL.strs, string(yyDollar[3].bytes))
 		}
-	case 951:
+	case 1064:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4523
+//line parser/parser.y:5328
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 952:
+	case 1065:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser/parser.y:4532
+//line parser/parser.y:5337
 		{
 			decNesting(yylex)
 		}
@@ -117,6 +117,8 @@ func forceEOF(yylex interface{}) {
   indexColumns             []IndexColumn
   indexColumnsOrExpression IndexColumnsOrExpression
   foreignKeyDefinition     *ForeignKeyDefinition
+  exclusionPair            ExclusionPair
+  exclusionPairs           []ExclusionPair
   partDefs                 []*PartitionDefinition
   partDef                  *PartitionDefinition
   partSpec                 *PartitionSpec
@@ -242,7 +244,7 @@ func forceEOF(yylex interface{}) {
 %token <bytes> UNUSED
 
 // MySQL PostgreSQL GENERATED ALWAYS AS
-%token <bytes> VIRTUAL STORED
+%token <bytes> VIRTUAL STORED PERSISTENT
 // PostgreSQL GENERATED AS IDENTITY
 %token <bytes> GENERATED ALWAYS IDENTITY
 // sequence
@@ -255,7 +257,7 @@ func forceEOF(yylex interface{}) {
 // SQL SERVER COLUMNSTORE
 %token <bytes> COLUMNSTORE
 // index
-%token <bytes> INCLUDE
+%token <bytes> INCLUDE NULLS
 
 // table hint
 %token <bytes> HOLDLOCK NOLOCK NOWAIT PAGLOCK ROWLOCK TABLELOCK
@@ -266,7 +268,7 @@ func forceEOF(yylex interface{}) {
 %type <statement> statement
 %type <selStmt> select_statement base_select union_lhs union_rhs
 %type <statement> insert_statement update_statement delete_statement set_statement declare_statement cursor_statement while_statement if_statement
-%type <statement> create_statement alter_statement
+%type <statement> create_statement alter_statement comment_statement drop_statement
 %type <statement> set_option_statement set_bool_option_statement
 %type <ddl> create_table_prefix
 %type <bytes2> comment_opt comment_list
@@ -319,9 +321,12 @@ func forceEOF(yylex interface{}) {
 %type <updateExpr> update_expression
 %type <setExpr> set_expression transaction_char isolation_level
 %type <str> ignore_opt default_opt
-%type <empty> not_exists_opt when_expression_opt for_each_row_opt
-%type <bytes> reserved_keyword
-%type <colIdent> sql_id reserved_sql_id col_alias as_ci_opt
+%type <empty> not_exists_opt drop_behavior_opt
+%type <boolVal> exists_opt
+%type <str> for_each_row_opt
+%type <expr> when_expression_opt execute_function
+%type <bytes> reserved_keyword non_reserved_keyword
+%type <colIdent> sql_id reserved_sql_id col_alias as_ci_opt ins_column
 %type <boolVal> unique_opt
 %type <expr> charset_value
 %type <tableIdent> table_id reserved_table_id table_alias as_opt_id
@@ -342,14 +347,17 @@ func forceEOF(yylex interface{}) {
 %type <indexDefinition> index_definition primary_key_definition unique_definition
 %type <checkDefinition> check_definition
 %type <foreignKeyDefinition> foreign_key_definition foreign_key_without_options
+%type <exclusionPair> exclusion_element
+%type <exclusionPairs> exclusion_element_list
+%type <str> exclusion_operator
 %type <colIdent> reference_option
 %type <colIdent> sql_id_opt
 %type <colIdents> sql_id_list
 %type <str> index_or_key
 %type <str> equal_opt
 %type <TableSpec> table_spec table_column_list
-%type <str> table_opt_name table_opt_value
-%type <tableOptions> table_option_list
+%type <str> table_opt_name table_opt_value sqlite3_table_opt
+%type <tableOptions> table_option_list mssql_table_option_list
 %type <indexInfo> index_info
 %type <indexColumn> index_column
 %type <bytes> operator_class
@@ -363,17 +371,18 @@ func forceEOF(yylex interface{}) {
 %type <expr> using_opt with_check_opt
 %left <bytes> TYPECAST CHECK
 %type <bytes> or_replace_opt
-%type <boolVal> no_inherit_opt
-%type <str> identity_behavior
+%type <boolVal> no_inherit_opt not_enforced_opt not_valid_opt
+%type <str> identity_behavior generated_type
 %type <sequence> sequence_opt
 %type <boolVal> clustered_opt not_for_replication_opt
 %type <defaultValueOrExpression> default_definition
 %type <optVal> default_val
-%type <expr> default_expression
+%type <expr> default_expression default_operation default_operand
 %type <optVal> srid_definition srid_val
 %type <optVal> on_off
 %type <str> trigger_time trigger_event fetch_opt
-%type <strs> trigger_event_list
+%type <strs> trigger_event_list event_clause_list
+%type <str> event_clause
 %type <blockStatement> trigger_statements statement_block
 %type <statement> trigger_statement trigger_statement_start
 %type <localVariable> local_variable
@@ -382,7 +391,7 @@ func forceEOF(yylex interface{}) {
 %type <strs> table_hint_list table_hint_opt
 %type <str> table_hint
 %type <newQualifierColName> new_qualifier_column_name
-%type <boolVal> deferrable_opt initially_deferred_opt
+%type <boolVal> deferrable_opt initially_deferred_opt nulls_not_distinct_opt
 %type <boolVal> variadic_opt
 %type <arrayConstructor> array_constructor
 %type <arrayElements> array_element_list
@@ -414,6 +423,8 @@ semicolon_opt:
 statement:
   create_statement
 | alter_statement
+| comment_statement
+| drop_statement
 
 create_statement:
   create_table_prefix table_spec
@@ -421,7 +432,29 @@ create_statement:
     $1.TableSpec = $2
     $$ = $1
   }
-| CREATE unique_clustered_opt INDEX sql_id ON table_name '(' index_column_list_or_expression ')' include_columns_opt where_expression_opt index_option_opt index_partition_opt
+/* For MySQL, CREATE TABLE ... LIKE other */
+| create_table_prefix LIKE table_name
+  {
+    $1.Like = &$3
+    $$ = $1
+  }
+| create_table_prefix '(' LIKE table_name ')'
+  {
+    $1.Like = &$4
+    $$ = $1
+  }
+/* For MySQL, CREATE TABLE ... SELECT, which is parsed to be rejected */
+| create_table_prefix select_statement
+  {
+    $1.Select = $2
+    $$ = $1
+  }
+| create_table_prefix AS select_statement
+  {
+    $1.Select = $3
+    $$ = $1
+  }
+| CREATE unique_clustered_opt INDEX sql_id ON table_name '(' index_column_list_or_expression ')' include_columns_opt nulls_not_distinct_opt where_expression_opt index_option_opt index_partition_opt
   {
     $$ = &DDL{
       Action: CreateIndex,
@@ -433,15 +466,16 @@ create_statement:
         Unique: bool($2[0]),
         Clustered: bool($2[1]),
         Included: $10,
-        Where: NewWhere(WhereStr, $11),
-        Options: $12,
-        Partition: $13,
+        NullsNotDistinct: bool($11),
+        Where: NewWhere(WhereStr, $12),
+        Options: $13,
+        Partition: $14,
       },
       IndexCols: $8.IndexCols,
       IndexExpr: $8.IndexExpr,
     }
   }
-| CREATE unique_clustered_opt INDEX ON table_name '(' index_column_list_or_expression ')' include_columns_opt where_expression_opt index_option_opt index_partition_opt
+| CREATE unique_clustered_opt INDEX ON table_name '(' index_column_list_or_expression ')' include_columns_opt nulls_not_distinct_opt where_expression_opt index_option_opt index_partition_opt
   {
     $$ = &DDL{
       Action: CreateIndex,
@@ -453,15 +487,16 @@ create_statement:
         Unique: bool($2[0]),
         Clustered: bool($2[1]),
         Included: $9,
-        Where: NewWhere(WhereStr, $10),
-        Options: $11,
-        Partition: $12,
+        NullsNotDistinct: bool($10),
+        Where: NewWhere(WhereStr, $11),
+        Options: $12,
+        Partition: $13,
       },
       IndexCols: $7.IndexCols,
       IndexExpr: $7.IndexExpr,
     }
   }
-| CREATE unique_clustered_opt INDEX CONCURRENTLY sql_id ON table_name '(' index_column_list_or_expression ')' include_columns_opt where_expression_opt index_option_opt index_partition_opt
+| CREATE unique_clustered_opt INDEX CONCURRENTLY sql_id ON table_name '(' index_column_list_or_expression ')' include_columns_opt nulls_not_distinct_opt where_expression_opt index_option_opt index_partition_opt
   {
     $$ = &DDL{
       Action: CreateIndex,
@@ -473,9 +508,10 @@ create_statement:
         Unique: bool($2[0]),
         Clustered: bool($2[1]),
         Included: $11,
-        Where: NewWhere(WhereStr, $12),
-        Options: $13,
-        Partition: $14,
+        NullsNotDistinct: bool($12),
+        Where: NewWhere(WhereStr, $13),
+        Options: $14,
+        Partition: $15,
       },
       IndexCols: $9.IndexCols,
       IndexExpr: $9.IndexExpr,
@@ -498,7 +534,7 @@ create_statement:
     }
   }
 /* For PostgreSQL */
-| CREATE unique_clustered_opt INDEX sql_id ON table_name USING sql_id '(' index_column_list_or_expression ')' where_expression_opt index_option_opt
+| CREATE unique_clustered_opt INDEX sql_id ON table_name USING sql_id '(' index_column_list_or_expression ')' include_columns_opt nulls_not_distinct_opt where_expression_opt index_option_opt
   {
     $$ = &DDL{
       Action: CreateIndex,
@@ -508,13 +544,33 @@ create_statement:
         Name: $4,
         Type: $8,
         Unique: bool($2[0]),
-        Where: NewWhere(WhereStr, $12),
+        Included: $12,
+        NullsNotDistinct: bool($13),
+        Where: NewWhere(WhereStr, $14),
       },
       IndexCols: $10.IndexCols,
       IndexExpr: $10.IndexExpr,
     }
   }
 /* For SQL Server */
+| CREATE CLUSTERED COLUMNSTORE INDEX sql_id ON table_name index_option_opt index_partition_opt
+  {
+    $$ = &DDL{
+      Action: CreateIndex,
+      Table: $7,
+      NewName: $7,
+      IndexSpec: &IndexSpec{
+        Name: $5,
+        Type: NewColIdent(""),
+        Unique: false,
+        Clustered: true,
+        ColumnStore: true,
+        Options: $8,
+        Partition: $9,
+      },
+    }
+  }
+/* For SQL Server */
 | CREATE nonclustered_columnstore INDEX sql_id ON table_name '(' column_list ')' where_expression_opt index_option_opt index_partition_opt
   {
     $$ = &DDL{
@@ -593,10 +649,27 @@ create_statement:
         TableName: $7,
         Time: $4,
         Event: $5,
+        Level: "ROW",
         Body: []Statement{$11},
       },
     }
   }
+/* For PostgreSQL */
+| CREATE TRIGGER sql_id trigger_time trigger_event_list ON table_name for_each_row_opt when_expression_opt execute_function
+  {
+    $$ = &DDL{
+      Action: CreateTrigger,
+      Trigger: &Trigger{
+        Name: $3,
+        TableName: $7,
+        Time: $4,
+        Event: $5,
+        Level: $8,
+        When: $9,
+        Function: $10,
+      },
+    }
+  }
 /* For MSSQL */
 | CREATE TRIGGER sql_id ON table_name trigger_time trigger_event_list AS trigger_statements
   {
@@ -621,6 +694,8 @@ create_statement:
         TableName: $7,
         Time: $4,
         Event: $5,
+        Level: $8,
+        When: $9,
         Body: $11,
       },
     }
@@ -634,10 +709,43 @@ create_statement:
         TableName: $10,
         Time: $7,
         Event: $8,
+        Level: $11,
+        When: $12,
         Body: $14,
       },
     }
   }
+/* For MySQL */
+| CREATE ID sql_id ON ID event_clause_list ID trigger_statement_start
+  {
+    if strings.ToLower(string($2)) != "event" || strings.ToLower(string($5)) != "schedule" || strings.ToLower(string($7)) != "do" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: CreateEvent,
+      Event: &Event{
+        Name: $3,
+        Clauses: $6,
+        Body: $8,
+      },
+    }
+  }
+| CREATE ID IF NOT EXISTS sql_id ON ID event_clause_list ID trigger_statement_start
+  {
+    if strings.ToLower(string($2)) != "event" || strings.ToLower(string($8)) != "schedule" || strings.ToLower(string($10)) != "do" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: CreateEvent,
+      Event: &Event{
+        Name: $6,
+        Clauses: $9,
+        Body: $11,
+      },
+    }
+  }
 /* For PostgreSQL */
 | CREATE TYPE table_name AS column_type
   {
@@ -649,12 +757,165 @@ create_statement:
       },
     }
   }
-/* For SQLite3, only to parse because alternation is not supported. // The Virtual Table Mechanism Of SQLite https://www.sqlite.org/vtab.html */
+| CREATE TYPE table_name AS '(' table_column_list ')'
+  {
+    $$ = &DDL{
+      Action: CreateType,
+      Type: &Type{
+        Name: $3,
+        Attributes: $6.Columns,
+      },
+    }
+  }
+/* For PostgreSQL: CREATE DOMAIN, whose name is sql_id instead of table_name not to conflict with CREATE PUBLICATION */
+| CREATE ID sql_id AS column_definition_type
+  {
+    if strings.ToLower(string($2)) != "domain" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: CreateType,
+      Type: newDomain(TableName{Name: NewTableIdent($3.String())}, $5),
+    }
+  }
+| CREATE ID sql_id '.' reserved_table_id AS column_definition_type
+  {
+    if strings.ToLower(string($2)) != "domain" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: CreateType,
+      Type: newDomain(TableName{Schema: NewTableIdent($3.String()), Name: $5}, $7),
+    }
+  }
+/* For SQL Server */
+| CREATE TYPE table_name AS TABLE '(' table_column_list ')'
+  {
+    $$ = &DDL{
+      Action: CreateType,
+      Type: &Type{
+        Name: $3,
+        TableSpec: $7,
+      },
+    }
+  }
+| CREATE ID sql_id
+  {
+    if strings.ToLower(string($2)) != "publication" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: CreatePublication,
+      Publication: &Publication{
+        Name: $3,
+      },
+    }
+  }
+| CREATE ID sql_id FOR TABLE table_name_list
+  {
+    if strings.ToLower(string($2)) != "publication" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: CreatePublication,
+      Publication: &Publication{
+        Name: $3,
+        Tables: $6,
+      },
+    }
+  }
+| CREATE ID sql_id FOR ALL TABLES
+  {
+    if strings.ToLower(string($2)) != "publication" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: CreatePublication,
+      Publication: &Publication{
+        Name: $3,
+        AllTables: true,
+      },
+    }
+  }
+/* For SQLite3, whose module arguments are compared as written. // The Virtual Table Mechanism Of SQLite https://www.sqlite.org/vtab.html */
 | CREATE VIRTUAL TABLE not_exists_opt table_name USING sql_id module_arguments_opt
   {
-    $$ = &DDL{Action: CreateTable, NewName: $5, TableSpec: &TableSpec{}}
+    $$ = &DDL{Action: CreateTable, NewName: $5, TableSpec: &TableSpec{Virtual: true}}
   }
 
+/* COMMENT ON of PostgreSQL, which is used to declare MS_Description of SQL Server */
+comment_statement:
+  COMMENT_KEYWORD ON TABLE table_name IS STRING
+  {
+    $$ = &DDL{
+      Action: CommentOn,
+      Comment: &Comment{ObjectType: "TABLE", Object: joinNameParts($4.Schema.String(), $4.Name.String()), Comment: string($6)},
+    }
+  }
+| COMMENT_KEYWORD ON TABLE table_name IS UNICODE_STRING
+  {
+    $$ = &DDL{
+      Action: CommentOn,
+      Comment: &Comment{ObjectType: "TABLE", Object: joinNameParts($4.Schema.String(), $4.Name.String()), Comment: string($6)},
+    }
+  }
+| COMMENT_KEYWORD ON COLUMN column_name IS STRING
+  {
+    $$ = &DDL{
+      Action: CommentOn,
+      Comment: &Comment{ObjectType: "COLUMN", Object: joinNameParts($4.Qualifier.Schema.String(), $4.Qualifier.Name.String(), $4.Name.String()), Comment: string($6)},
+    }
+  }
+| COMMENT_KEYWORD ON COLUMN column_name IS UNICODE_STRING
+  {
+    $$ = &DDL{
+      Action: CommentOn,
+      Comment: &Comment{ObjectType: "COLUMN", Object: joinNameParts($4.Qualifier.Schema.String(), $4.Qualifier.Name.String(), $4.Name.String()), Comment: string($6)},
+    }
+  }
+
+/* DROP in the desired SQL, e.g. DROP TABLE IF EXISTS of a schema dump, which removes the objects declared before it */
+drop_statement:
+  DROP TABLE exists_opt table_name_list drop_behavior_opt
+  {
+    $$ = &DDL{Action: DropTable, IfExists: bool($3), Drop: &Drop{Names: $4}}
+  }
+| DROP VIEW exists_opt table_name_list drop_behavior_opt
+  {
+    $$ = &DDL{Action: DropView, IfExists: bool($3), Drop: &Drop{Names: $4}}
+  }
+| DROP MATERIALIZED VIEW exists_opt table_name_list drop_behavior_opt
+  {
+    $$ = &DDL{Action: DropView, IfExists: bool($4), Drop: &Drop{Names: $5}}
+  }
+/* For PostgreSQL and SQLite3 */
+| DROP INDEX exists_opt table_name_list drop_behavior_opt
+  {
+    $$ = &DDL{Action: DropIndex, IfExists: bool($3), Drop: &Drop{Names: $4}}
+  }
+/* For MySQL and SQL Server */
+| DROP INDEX exists_opt table_name ON table_name
+  {
+    $$ = &DDL{Action: DropIndex, Table: $6, IfExists: bool($3), Drop: &Drop{Names: TableNames{$4}}}
+  }
+
+exists_opt:
+  { $$ = BoolVal(false) }
+| IF EXISTS
+  { $$ = BoolVal(true) }
+
+drop_behavior_opt:
+  { $$ = struct{}{} }
+| CASCADE
+  { $$ = struct{}{} }
+| RESTRICT
+  { $$ = struct{}{} }
+
 alter_statement:
   ALTER ignore_opt TABLE table_name ADD unique_opt alter_object_type_index sql_id '(' index_column_list ')'
   {
@@ -684,7 +945,7 @@ alter_statement:
       IndexCols: $12,
     }
   }
-| ALTER ignore_opt TABLE table_name ADD CONSTRAINT sql_id UNIQUE '(' index_column_list ')' deferrable_opt initially_deferred_opt
+| ALTER ignore_opt TABLE table_name ADD CONSTRAINT sql_id UNIQUE nulls_not_distinct_opt '(' index_column_list ')' include_columns_opt deferrable_opt initially_deferred_opt
   {
     $$ = &DDL{
       Action: AddIndex,
@@ -695,12 +956,14 @@ alter_statement:
         Unique: true,
         Primary: false,
         Constraint: true,
+        Included: $13,
+        NullsNotDistinct: bool($9),
         ConstraintOptions: &ConstraintOptions{
-          Deferrable: bool($12),
-          InitiallyDeferred: bool($13),
+          Deferrable: bool($14),
+          InitiallyDeferred: bool($15),
         },
       },
-      IndexCols: $10,
+      IndexCols: $11,
     }
   }
 /* For SQL Server */
@@ -758,6 +1021,122 @@ alter_statement:
       ForeignKey: $7,
     }
   }
+/* For PostgreSQL */
+| ALTER ignore_opt TABLE table_name ADD CONSTRAINT sql_id ID USING sql_id '(' exclusion_element_list ')' where_expression_opt deferrable_opt initially_deferred_opt
+  {
+    if strings.ToLower(string($8)) != "exclude" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($8)))
+      return 1
+    }
+    var where *Where
+    if $14 != nil {
+      where = NewWhere(WhereStr, $14)
+    }
+    $$ = &DDL{
+      Action: AddExclusion,
+      Table: $4,
+      NewName: $4,
+      Exclusion: &ExclusionDefinition{
+        ConstraintName: $7,
+        IndexType: $10.String(),
+        Exclusions: $12,
+        Where: where,
+        ConstraintOptions: &ConstraintOptions{
+          Deferrable: bool($15),
+          InitiallyDeferred: bool($16),
+        },
+      },
+    }
+  }
+| ALTER ignore_opt TABLE table_name ID TO sql_id
+  {
+    if strings.ToLower(string($5)) != "owner" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($5)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: AlterOwner,
+      Table: $4,
+      NewName: $4,
+      Owner: &Owner{
+        ObjectType: "TABLE",
+        Role: $7.String(),
+      },
+    }
+  }
+| ALTER ignore_opt TABLE table_name ID ON sql_id
+  {
+    if strings.ToLower(string($5)) != "cluster" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($5)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: ClusterOn,
+      Table: $4,
+      NewName: $4,
+      IndexSpec: &IndexSpec{
+        Name: $7,
+      },
+    }
+  }
+/* For PostgreSQL: ALTER DOMAIN ... ADD CONSTRAINT */
+| ALTER ID table_name ADD CONSTRAINT sql_id CHECK openb expression closeb not_valid_opt
+  {
+    if strings.ToLower(string($2)) != "domain" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: AddDomainConstraint,
+      Type: &Type{
+        Name: $3,
+        Domain: true,
+        Checks: []*CheckDefinition{
+          {
+            Where: *NewWhere(WhereStr, $9),
+            ConstraintName: $6,
+            NotValid: bool($11),
+          },
+        },
+      },
+    }
+  }
+| ALTER ID table_name ADD CHECK openb expression closeb not_valid_opt
+  {
+    if strings.ToLower(string($2)) != "domain" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: AddDomainConstraint,
+      Type: &Type{
+        Name: $3,
+        Domain: true,
+        Checks: []*CheckDefinition{
+          {
+            Where: *NewWhere(WhereStr, $7),
+            NotValid: bool($9),
+          },
+        },
+      },
+    }
+  }
+| ALTER VIEW table_name ID TO sql_id
+  {
+    if strings.ToLower(string($4)) != "owner" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($4)))
+      return 1
+    }
+    $$ = &DDL{
+      Action: AlterOwner,
+      Table: $3,
+      NewName: $3,
+      Owner: &Owner{
+        ObjectType: "VIEW",
+        Role: $6.String(),
+      },
+    }
+  }
 
 alter_object_type_index:
   INDEX
@@ -1173,7 +1552,7 @@ trigger_event:
 /* For SQLite3 */
 | UPDATE OF column_list
   {
-    $$ = string($1)
+    $$ = string($1) + " " + string($2) + " " + String($3)
   }
 
 trigger_event_list:
@@ -1185,37 +1564,113 @@ trigger_event_list:
   {
     $$ = append($$, string($3))
   }
+| trigger_event_list OR trigger_event
+  {
+    $$ = append($$, string($3))
+  }
 
 trigger_statements:
   trigger_statement
   {
-    $$ = []Statement{$1}
+    $$ = []Statement{$1}
+  }
+| trigger_statements trigger_statement
+  {
+    $$ = append($$, $2)
+  }
+
+trigger_statement:
+  insert_statement
+  {
+    $$ = $1
+  }
+| delete_statement
+| update_statement
+| declare_statement
+| set_statement
+| cursor_statement
+| while_statement
+| if_statement
+| set_option_statement
+| base_select order_by_opt limit_opt lock_opt
+  {
+    sel := $1.(*Select)
+    sel.OrderBy = $2
+    sel.Limit = $3
+    sel.Lock = $4
+    $$ = sel
+  }
+
+event_clause_list:
+  event_clause
+  {
+    $$ = []string{$1}
+  }
+| event_clause_list event_clause
+  {
+    $$ = append($1, $2)
+  }
+
+event_clause:
+  ID
+  {
+    $$ = string($1)
+  }
+| STRING
+  {
+    $$ = "'" + strings.ReplaceAll(string($1), "'", "''") + "'"
+  }
+| INTEGRAL
+  {
+    $$ = string($1)
+  }
+| FLOAT
+  {
+    $$ = string($1)
+  }
+| INTERVAL
+  {
+    $$ = string($1)
+  }
+| ON
+  {
+    $$ = string($1)
+  }
+| NOT
+  {
+    $$ = string($1)
+  }
+| COMMENT_KEYWORD
+  {
+    $$ = string($1)
+  }
+| CURRENT_TIMESTAMP
+  {
+    $$ = string($1)
+  }
+| NOW
+  {
+    $$ = string($1)
+  }
+| YEAR
+  {
+    $$ = string($1)
+  }
+| '+'
+  {
+    $$ = "+"
   }
-| trigger_statements trigger_statement
+| '-'
   {
-    $$ = append($$, $2)
+    $$ = "-"
   }
-
-trigger_statement:
-  insert_statement
+| '('
   {
-    $$ = $1
+    $$ = "("
   }
-| delete_statement
-| update_statement
-| declare_statement
-| set_statement
-| cursor_statement
-| while_statement
-| if_statement
-| set_option_statement
-| base_select order_by_opt limit_opt lock_opt
+| ')'
   {
-    sel := $1.(*Select)
-    sel.OrderBy = $2
-    sel.Limit = $3
-    sel.Lock = $4
-    $$ = sel
+    $$ = ")"
   }
 
 /* TODO: should be a part of trigger_statement */
@@ -1229,9 +1684,40 @@ trigger_statement_start:
   }
 
 for_each_row_opt:
-  { $$ = struct{}{} }
+  {
+    $$ = ""
+  }
 | FOR EACH ROW
-  { $$ = struct{}{} }
+  {
+    $$ = "ROW"
+  }
+| FOR EACH ID
+  {
+    if strings.ToLower(string($3)) != "statement" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($3)))
+      return 1
+    }
+    $$ = "STATEMENT"
+  }
+
+/* EXECUTE { FUNCTION | PROCEDURE } of PostgreSQL */
+execute_function:
+  ID ID table_name openb select_expression_list_opt closeb
+  {
+    if strings.ToLower(string($1)) != "execute" || strings.ToLower(string($2)) != "function" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($1)))
+      return 1
+    }
+    $$ = &FuncExpr{Qualifier: $3.Schema, Name: NewColIdent($3.Name.String()), Exprs: $5}
+  }
+| ID PROCEDURE table_name openb select_expression_list_opt closeb
+  {
+    if strings.ToLower(string($1)) != "execute" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($1)))
+      return 1
+    }
+    $$ = &FuncExpr{Qualifier: $3.Schema, Name: NewColIdent($3.Name.String()), Exprs: $5}
+  }
 
 policy_as_opt:
   {
@@ -1320,6 +1806,17 @@ table_spec:
     $$ = $2
     $$.Options = $4
   }
+/* For PostgreSQL, INHERITS (parent, ...) */
+| '(' table_column_list ')' table_option_list ID openb table_name_list closeb
+  {
+    if strings.ToLower(string($5)) != "inherits" {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($5)))
+      return 1
+    }
+    $$ = $2
+    $$.Options = $4
+    $$.Inherits = $7
+  }
 
 table_column_list:
   {
@@ -1370,6 +1867,10 @@ column_definition:
   {
     $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: $2}
   }
+| non_reserved_keyword column_definition_type
+  {
+    $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: $2}
+  }
 
 column_type:
   numeric_type unsigned_opt zero_fill_opt
@@ -1448,6 +1949,18 @@ column_definition_type:
     $1.KeyOpt = colKeyPrimary
     $$ = $1
   }
+/* For SQL Server */
+| column_definition_type PRIMARY KEY CLUSTERED
+  {
+    $1.KeyOpt = colKeyPrimary
+    $$ = $1
+  }
+| column_definition_type PRIMARY KEY NONCLUSTERED
+  {
+    $1.KeyOpt = colKeyPrimary
+    $1.NonClustered = BoolVal(true)
+    $$ = $1
+  }
 | column_definition_type KEY
   {
     $1.KeyOpt = colKey
@@ -1487,6 +2000,36 @@ column_definition_type:
     $1.Comment = NewStrVal($3)
     $$ = $1
   }
+// for MySQL: INVISIBLE / VISIBLE, and ENFORCED of CHECK
+| column_definition_type ID
+  {
+    switch strings.ToLower(string($2)) {
+    case "invisible":
+      $1.Invisible = true
+    case "visible":
+      $1.Invisible = false
+    case "enforced":
+      if $1.Check == nil {
+        yylex.Error("syntax error around 'ENFORCED' without CHECK")
+        return 1
+      }
+      $1.Check.NotEnforced = false
+    default:
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = $1
+  }
+// for MySQL: NOT ENFORCED of CHECK
+| column_definition_type NOT ID
+  {
+    if !strings.EqualFold(string($3), "enforced") || $1.Check == nil {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($3)))
+      return 1
+    }
+    $1.Check.NotEnforced = true
+    $$ = $1
+  }
 | column_definition_type REFERENCES table_name
   {
     $1.References = String($3)
@@ -1514,24 +2057,14 @@ column_definition_type:
     $$ = $1
   }
 // for MySQL and PostgreSQL
-| column_definition_type AS '(' expression ')' VIRTUAL
+| column_definition_type AS '(' expression ')' generated_type
   {
-    $1.Generated = &GeneratedColumn{Expr: $4, GeneratedType: "VIRTUAL"}
+    $1.Generated = &GeneratedColumn{Expr: $4, GeneratedType: $6}
     $$ = $1
   }
-| column_definition_type AS '(' expression ')' STORED
+| column_definition_type GENERATED identity_behavior AS '(' expression ')' generated_type
   {
-    $1.Generated = &GeneratedColumn{Expr: $4, GeneratedType: "STORED"}
-    $$ = $1
-  }
-| column_definition_type GENERATED identity_behavior AS '(' expression ')' VIRTUAL
-  {
-    $1.Generated = &GeneratedColumn{Expr: $6, GeneratedType: "VIRTUAL"}
-    $$ = $1
-  }
-| column_definition_type GENERATED identity_behavior AS '(' expression ')' STORED
-  {
-    $1.Generated = &GeneratedColumn{Expr: $6, GeneratedType: "STORED"}
+    $1.Generated = &GeneratedColumn{Expr: $6, GeneratedType: $8}
     $$ = $1
   }
 // for PostgreSQL
@@ -1585,6 +2118,11 @@ default_definition:
   {
     $$ = DefaultValueOrExpression{Expr: $3}
   }
+/* For MySQL: expression default values */
+| DEFAULT '(' default_operation ')'
+  {
+    $$ = DefaultValueOrExpression{Expr: $3}
+  }
 
 default_val:
   STRING character_cast_opt
@@ -1629,6 +2167,36 @@ default_expression:
   {
     $$ = $1
   }
+| UUID openb closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent(string($1))}
+  }
+
+default_operation:
+  default_operand '+' value_expression
+  {
+    $$ = &BinaryExpr{Left: $1, Operator: PlusStr, Right: $3}
+  }
+| default_operand '-' value_expression
+  {
+    $$ = &BinaryExpr{Left: $1, Operator: MinusStr, Right: $3}
+  }
+| default_operand '*' value_expression
+  {
+    $$ = &BinaryExpr{Left: $1, Operator: MultStr, Right: $3}
+  }
+| default_operand '/' value_expression
+  {
+    $$ = &BinaryExpr{Left: $1, Operator: DivStr, Right: $3}
+  }
+
+// Not a value or a parenthesized expression, which conflict with default_val
+default_operand:
+  sql_id
+  {
+    $$ = &ColName{Name: $1}
+  }
+| function_call_generic
 
 srid_definition:
   SRID srid_val
@@ -1642,6 +2210,24 @@ srid_val:
     $$ = NewIntVal($1)
   }
 
+/* A generated column is VIRTUAL unless it's specified, and PERSISTENT is a synonym of STORED in MariaDB */
+generated_type:
+  {
+    $$ = "VIRTUAL"
+  }
+| VIRTUAL
+  {
+    $$ = "VIRTUAL"
+  }
+| STORED
+  {
+    $$ = "STORED"
+  }
+| PERSISTENT
+  {
+    $$ = "STORED"
+  }
+
 identity_behavior:
   ALWAYS
   {
@@ -2187,6 +2773,15 @@ index_definition:
   {
     $$ = &IndexDefinition{Info: $1, Columns: $3, Options: $5, Partition: $6}
   }
+/* For SQL Server */
+| index_or_key ID CLUSTERED COLUMNSTORE index_option_opt index_partition_opt
+  {
+    $$ = &IndexDefinition{
+      Info: &IndexInfo{Type: string($1), Name: NewColIdent(string($2)), Clustered: BoolVal(true), ColumnStore: true},
+      Options: $5,
+      Partition: $6,
+    }
+  }
 
 index_option_opt:
   {
@@ -2376,6 +2971,10 @@ index_column:
   {
     $$ = IndexColumn{Column: $1, Length: $2, Direction: $3}
   }
+| non_reserved_keyword length_opt asc_desc_opt
+  {
+    $$ = IndexColumn{Column: NewColIdent(string($1)), Length: $2, Direction: $3}
+  }
 /* For PostgreSQL */
 | KEY length_opt
   {
@@ -2385,6 +2984,11 @@ index_column:
   {
     $$ = IndexColumn{Column: $1, OperatorClass: string($2)}
   }
+/* For MySQL: functional key parts */
+| '(' expression ')' asc_desc_opt
+  {
+    $$ = IndexColumn{Expression: $2, Direction: $4}
+  }
 
 // https://www.postgresql.org/docs/9.5/brin-builtin-opclasses.html
 operator_class:
@@ -2487,42 +3091,102 @@ primary_key_definition:
   }
 
 unique_definition:
-  CONSTRAINT sql_id UNIQUE clustered_opt '(' index_column_list ')' index_option_opt index_partition_opt
+  CONSTRAINT sql_id UNIQUE clustered_opt '(' index_column_list ')' include_columns_opt index_option_opt index_partition_opt
   {
     $$ = &IndexDefinition{
       Info: &IndexInfo{Type: string($3), Name: $2, Primary: false, Unique: true, Clustered: $4},
       Columns: $6,
-      Options: $8,
-      Partition: $9,
+      Included: $8,
+      Options: $9,
+      Partition: $10,
+    }
+  }
+/* For PostgreSQL */
+| CONSTRAINT sql_id UNIQUE NULLS NOT DISTINCT '(' index_column_list ')' include_columns_opt
+  {
+    $$ = &IndexDefinition{
+      Info: &IndexInfo{Type: string($3), Name: $2, Primary: false, Unique: true},
+      Columns: $8,
+      Included: $10,
+      NullsNotDistinct: true,
     }
   }
 /* For PostgreSQL and SQLite3 */
-| UNIQUE clustered_opt '(' index_column_list ')' index_option_opt index_partition_opt
+| UNIQUE clustered_opt '(' index_column_list ')' include_columns_opt index_option_opt index_partition_opt
   {
     $$ = &IndexDefinition{
       Info: &IndexInfo{Type: string($1), Primary: false, Unique: true, Clustered: $2},
       Columns: $4,
-      Options: $6,
-      Partition: $7,
+      Included: $6,
+      Options: $7,
+      Partition: $8,
+    }
+  }
+/* For PostgreSQL */
+| UNIQUE NULLS NOT DISTINCT '(' index_column_list ')' include_columns_opt
+  {
+    $$ = &IndexDefinition{
+      Info: &IndexInfo{Type: string($1), Primary: false, Unique: true},
+      Columns: $6,
+      Included: $8,
+      NullsNotDistinct: true,
     }
   }
 
 check_definition:
-  CONSTRAINT sql_id CHECK openb expression closeb no_inherit_opt
+  CONSTRAINT sql_id CHECK openb expression closeb no_inherit_opt not_enforced_opt
   {
     $$ = &CheckDefinition{
       ConstraintName: $2,
       Where: *NewWhere(WhereStr, $5),
       NoInherit: $7,
+      NotEnforced: bool($8),
     }
   }
 /* For SQLite3 // SQLite Syntax: table-options https://www.sqlite.org/syntax/table-options.html */
-| CHECK openb expression closeb no_inherit_opt
+| CHECK openb expression closeb no_inherit_opt not_enforced_opt
   {
     $$ = &CheckDefinition{
       Where: *NewWhere(WhereStr, $3),
       NoInherit: $5,
+      NotEnforced: bool($6),
+    }
+  }
+
+/* For PostgreSQL: NOT VALID of constraints */
+not_valid_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| NOT ID
+  {
+    if !strings.EqualFold(string($2), "valid") {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
+    }
+    $$ = BoolVal(true)
+  }
+
+/* For MySQL: [NOT] ENFORCED, which is not a keyword */
+not_enforced_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| ID
+  {
+    if !strings.EqualFold(string($1), "enforced") {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($1)))
+      return 1
+    }
+    $$ = BoolVal(false)
+  }
+| NOT ID
+  {
+    if !strings.EqualFold(string($2), "enforced") {
+      yylex.Error(fmt.Sprintf("syntax error around '%s'", string($2)))
+      return 1
     }
+    $$ = BoolVal(true)
   }
 
 /* For SQL Server */
@@ -2610,16 +3274,42 @@ table_option_list:
 /* For SQLite3 // SQLite Syntax: table-options https://www.sqlite.org/syntax/table-options.html */
 | sqlite3_table_opt
   {
-    $$ = map[string]string{}
+    $$ = map[string]string{$1: ""}
   }
 | table_option_list ',' sqlite3_table_opt
   {
     $$ = $1
+    $$[$3] = ""
+  }
+/* For SQL Server, e.g. WITH (MEMORY_OPTIMIZED = ON, DURABILITY = SCHEMA_AND_DATA) */
+| table_option_list WITH '(' mssql_table_option_list ')'
+  {
+    $$ = $1
+    for key, value := range $4 {
+      $$[key] = value
+    }
+  }
+
+mssql_table_option_list:
+  table_opt_name '=' table_opt_value
+  {
+    $$ = map[string]string{strings.ToUpper($1): strings.ToUpper($3)}
+  }
+| mssql_table_option_list ',' table_opt_name '=' table_opt_value
+  {
+    $$ = $1
+    $$[strings.ToUpper($3)] = strings.ToUpper($5)
   }
 
 sqlite3_table_opt:
-  WITHOUT ROWID {}
-| STRICT {}
+  WITHOUT ROWID
+  {
+    $$ = "WITHOUT ROWID"
+  }
+| STRICT
+  {
+    $$ = "STRICT"
+  }
 
 table_opt_name:
   reserved_sql_id
@@ -2893,10 +3583,18 @@ column_list:
   {
     $$ = Columns{NewColIdent(string($1))}
   }
+| non_reserved_keyword
+  {
+    $$ = Columns{NewColIdent(string($1))}
+  }
 | column_list ',' sql_id
   {
     $$ = append($$, $3)
   }
+| column_list ',' non_reserved_keyword
+  {
+    $$ = append($$, NewColIdent(string($3)))
+  }
 
 partition_list:
   sql_id
@@ -3065,6 +3763,40 @@ index_hint_list:
     $$ = &IndexHints{Type: ForceStr, Indexes: $4}
   }
 
+exclusion_element_list:
+  exclusion_element
+  {
+    $$ = []ExclusionPair{$1}
+  }
+| exclusion_element_list ',' exclusion_element
+  {
+    $$ = append($1, $3)
+  }
+
+exclusion_element:
+  sql_id WITH exclusion_operator
+  {
+    $$ = ExclusionPair{Column: $1, Operator: $3}
+  }
+| sql_id sql_id WITH exclusion_operator
+  {
+    $$ = ExclusionPair{Column: $1, OpClass: $2.String(), Operator: $4}
+  }
+
+exclusion_operator:
+  '='
+  {
+    $$ = "="
+  }
+| NE
+  {
+    $$ = "<>"
+  }
+| AND
+  {
+    $$ = "&&"
+  }
+
 where_expression_opt:
   {
     $$ = nil
@@ -3083,6 +3815,20 @@ include_columns_opt:
     $$ = $3
   }
 
+/* For PostgreSQL 15 */
+nulls_not_distinct_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| NULLS DISTINCT
+  {
+    $$ = BoolVal(false)
+  }
+| NULLS NOT DISTINCT
+  {
+    $$ = BoolVal(true)
+  }
+
 expression:
   condition
   {
@@ -3646,6 +4392,19 @@ function_call_conflict:
   {
     $$ = &FuncExpr{Name: NewColIdent("replace"), Exprs: $3}
   }
+/* For SQLite3 */
+| DATE openb select_expression_list closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("date"), Exprs: $3}
+  }
+| TIME openb select_expression_list closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("time"), Exprs: $3}
+  }
+| DATETIME openb select_expression_list closeb
+  {
+    $$ = &FuncExpr{Name: NewColIdent("datetime"), Exprs: $3}
+  }
 
 match_option:
   /* empty */
@@ -3880,9 +4639,13 @@ when_expression:
   }
 
 when_expression_opt:
-  { $$ = struct{}{} }
+  {
+    $$ = nil
+  }
 | WHEN expression
-  { $$ = struct{}{} }
+  {
+    $$ = $2
+  }
 
 else_expression_opt:
   {
@@ -3898,6 +4661,20 @@ column_name:
   {
     $$ = &ColName{Name: $1}
   }
+/* For SQLite3 */
+| ROWID
+  {
+    $$ = &ColName{Name: NewColIdent(string($1))}
+  }
+| non_reserved_keyword
+  {
+    $$ = &ColName{Name: NewColIdent(string($1))}
+  }
+/* For PostgreSQL: VALUE in CHECK of domains */
+| VALUE
+  {
+    $$ = &ColName{Name: NewColIdent("VALUE")}
+  }
 | table_id '.' reserved_sql_id
   {
     $$ = &ColName{Qualifier: TableName{Name: $1}, Name: $3}
@@ -4095,7 +4872,7 @@ insert_data:
   }
 
 ins_column_list:
-  sql_id
+  ins_column
   {
     $$ = Columns{$1}
   }
@@ -4103,7 +4880,7 @@ ins_column_list:
   {
     $$ = Columns{$3}
   }
-| ins_column_list ',' sql_id
+| ins_column_list ',' ins_column
   {
     $$ = append($$, $3)
   }
@@ -4112,6 +4889,18 @@ ins_column_list:
     $$ = append($$, $5)
   }
 
+ins_column:
+  sql_id
+/* For SQLite3 */
+| ROWID
+  {
+    $$ = NewColIdent(string($1))
+  }
+| non_reserved_keyword
+  {
+    $$ = NewColIdent(string($1))
+  }
+
 on_dup_opt:
   {
     $$ = nil
@@ -4258,6 +5047,10 @@ sql_id:
 
 reserved_sql_id:
   sql_id
+| non_reserved_keyword
+  {
+    $$ = NewColIdent(string($1))
+  }
 | CHARSET
   {
     $$ = NewColIdent(string($1))
@@ -4272,6 +5065,10 @@ table_id:
   {
     $$ = NewTableIdent(string($1))
   }
+| non_reserved_keyword
+  {
+    $$ = NewTableIdent(string($1))
+  }
 /* For SQLite3 https://www.sqlite.org/lang_keywords.html */
 | STRING
   {
@@ -4381,6 +5178,13 @@ bool_option_name:
 | REMOTE_PROC_TRANSACTIONS
 | XACT_ABORT
 
+/*
+ * Keywords which are used only in places where an identifier can't be, so that they can still be identifiers.
+ */
+non_reserved_keyword:
+  NULLS
+| PERSISTENT
+
 /*
  * These are not all necessarily reserved in MySQL, but some are.
  *
@@ -4489,6 +5293,7 @@ reserved_keyword:
 | REPLACE
 | RIGHT
 | ROW
+| ROWID
 | ROWLOCK
 | SCHEMA
 | SCROLL
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"regexp"
 	"strings"
 	"unicode"
 )
@@ -39,15 +40,58 @@ const (
 
 // The main parser function for sqldef.
 func ParseDDL(sql string, mode ParserMode) (Statement, error) {
+	var definer string
+	if mode == ParserModeMysql {
+		sql, definer = splitDefiner(sql)
+	}
 	tokenizer := NewTokenizer(sql, mode)
 	if yyParse(tokenizer) != 0 {
 		return nil, fmt.Errorf(
 			"found syntax error when parsing DDL \"%s\": %v", sql, tokenizer.LastError,
 		)
 	}
+	if ddl, ok := tokenizer.ParseTree.(*DDL); ok && definer != "" {
+		switch {
+		case ddl.View != nil:
+			ddl.View.Definer = definer
+		case ddl.Trigger != nil:
+			ddl.Trigger.Definer = definer
+		case ddl.Event != nil:
+			ddl.Event.Definer = definer
+		}
+	}
 	return tokenizer.ParseTree, nil
 }
 
+// DefinerClause matches the DEFINER clause of a view, a trigger, a routine, or an event of MySQL, e.g.
+// DEFINER=`root`@`%`, whose user@host can't be tokenized since @ is a part of identifiers.
+var DefinerClause = regexp.MustCompile("(?i)\\bDEFINER\\s*=\\s*(`[^`]*`|'[^']*'|\"[^\"]*\"|[\\w.$%-]+)" +
+	"(?:\\s*@\\s*(`[^`]*`|'[^']*'|\"[^\"]*\"|[\\w.$%-]+))?(?:\\s*\\(\\s*\\))?\\s*")
+
+// The beginning of CREATE statements which may be followed by a DEFINER clause.
+var createPrefix = regexp.MustCompile(`(?is)^\s*CREATE\s+(?:OR\s+REPLACE\s+)?`)
+
+// Split the DEFINER clause from the SQL, and return the SQL without it and the definer normalized as `user`@`host`.
+func splitDefiner(sql string) (string, string) {
+	prefix := createPrefix.FindStringIndex(sql)
+	if prefix == nil {
+		return sql, ""
+	}
+	clause := sql[prefix[1]:]
+	match := DefinerClause.FindStringSubmatchIndex(clause)
+	if match == nil || match[0] != 0 {
+		return sql, ""
+	}
+	user := strings.Trim(clause[match[2]:match[3]], "`'\"")
+	definer := "CURRENT_USER"
+	if match[4] >= 0 {
+		definer = fmt.Sprintf("`%s`@`%s`", user, strings.Trim(clause[match[4]:match[5]], "`'\""))
+	} else if !strings.EqualFold(user, "CURRENT_USER") {
+		definer = fmt.Sprintf("`%s`", user)
+	}
+	return sql[:prefix[1]] + clause[match[1]:], definer
+}
+
 // Tokenizer is the struct used to generate SQL
 // tokens for the parser.
 type Tokenizer struct {
@@ -317,6 +361,7 @@ var keywords = map[string]int{
 	"no_write_to_binlog":     UNUSED,
 	"ntext":                  NTEXT,
 	"null":                   NULL,
+	"nulls":                  NULLS,
 	"numeric":                NUMERIC,
 	"nvarchar":               NVARCHAR,
 	"of":                     OF,
@@ -339,6 +384,7 @@ var keywords = map[string]int{
 	"parser":                 PARSER,
 	"partition":              PARTITION,
 	"permissive":             PERMISSIVE,
+	"persistent":             PERSISTENT,
 	"point":                  POINT,
 	"policy":                 POLICY,
 	"polygon":                POLYGON,
@@ -1156,3 +1202,9 @@ type tokenBuffer struct {
 func (buf *tokenBuffer) Bytes() []byte {
 	return []byte(buf.String())
 }
+
+// IsKeyword returns true if the word is a keyword of the parser, e.g. "create" or "key".
+func IsKeyword(word string) bool {
+	_, ok := keywords[strings.ToLower(word)]
+	return ok
+}
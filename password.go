@@ -0,0 +1,41 @@
+package sqldef
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"syscall"
+
+	"golang.org/x/term"
+)
+
+// ReadPassword returns the password to connect to the database. The password typed at --password-prompt comes first,
+// then the first line of --password-file, the first environment variable of envs which is set, and --password. A file or
+// an environment variable keeps the password out of the shell history and the process list, also on Windows.
+func ReadPassword(password string, passwordFile string, prompt bool, envs ...string) (string, error) {
+	if prompt {
+		fmt.Fprint(os.Stderr, "Enter Password: ")
+		pass, err := term.ReadPassword(int(syscall.Stdin))
+		if err != nil {
+			return "", err
+		}
+		return string(pass), nil
+	}
+
+	if len(passwordFile) > 0 {
+		content, err := os.ReadFile(passwordFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read the password file: %w", err)
+		}
+		// The line ending may be CRLF of a file written on Windows.
+		line, _, _ := strings.Cut(string(content), "\n")
+		return strings.TrimSuffix(line, "\r"), nil
+	}
+
+	for _, env := range envs {
+		if value, ok := os.LookupEnv(env); ok {
+			return value, nil
+		}
+	}
+	return password, nil
+}
@@ -0,0 +1,268 @@
+package sqldef
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const (
+	planPublicKeyType = "PUBLIC KEY"
+	planSignatureType = "SQLDEF PLAN SIGNATURE"
+)
+
+// Render the plan the same way for --sign-plan and --verify-plan so that the signature covers exactly what is applied.
+func formatPlan(ddls []string, enableDropTable bool, beforeApply string, ddlSuffix string) string {
+	var plan strings.Builder
+	if len(beforeApply) > 0 {
+		plan.WriteString(beforeApply + "\n")
+	}
+	for _, ddl := range ddls {
+		if !enableDropTable && strings.Contains(ddl, "DROP TABLE") {
+			fmt.Fprintf(&plan, "-- Skipped: %s;\n", ddl)
+			continue
+		}
+		fmt.Fprintf(&plan, "%s;\n", ddl)
+		plan.WriteString(ddlSuffix)
+	}
+	return plan.String()
+}
+
+// A plan saved by --save-plan for a later --compare-plan
+type savedPlan struct {
+	Hash       string   `json:"hash"`
+	Statements []string `json:"statements"`
+}
+
+func newSavedPlan(plan string, ddls []string) savedPlan {
+	digest := sha256.Sum256([]byte(plan))
+	return savedPlan{
+		Hash:       hex.EncodeToString(digest[:]),
+		Statements: append([]string{}, ddls...),
+	}
+}
+
+// SavePlan writes the plan's hash and statements to the file as JSON.
+func SavePlan(plan string, ddls []string, planFile string) error {
+	buf, err := json.MarshalIndent(newSavedPlan(plan, ddls), "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(planFile, append(buf, '\n'), 0644)
+}
+
+// ComparePlan returns a report of how the plan changed since the plan saved in the file.
+func ComparePlan(plan string, ddls []string, planFile string) (string, error) {
+	buf, err := os.ReadFile(planFile)
+	if err != nil {
+		return "", err
+	}
+	var previous savedPlan
+	if err := json.Unmarshal(buf, &previous); err != nil {
+		return "", fmt.Errorf("failed to parse saved plan '%s': %w", planFile, err)
+	}
+
+	current := newSavedPlan(plan, ddls)
+	if current.Hash == previous.Hash {
+		return fmt.Sprintf("-- Plan is unchanged since %s --\n", planFile), nil
+	}
+
+	var report strings.Builder
+	fmt.Fprintf(&report, "-- Plan changed since %s --\n", planFile)
+	for _, line := range diffStatements(previous.Statements, current.Statements) {
+		report.WriteString(line + "\n")
+	}
+	return report.String(), nil
+}
+
+// Line-based diff of statements using the longest common subsequence.
+func diffStatements(a []string, b []string) []string {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var lines []string
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, fmt.Sprintf("  %s;", a[i]))
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, fmt.Sprintf("- %s;", a[i]))
+			i++
+		default:
+			lines = append(lines, fmt.Sprintf("+ %s;", b[j]))
+			j++
+		}
+	}
+	return lines
+}
+
+// SignPlan returns a plan artifact: the plan itself followed by the PEM-encoded public key and signature.
+func SignPlan(plan string, keyFile string) (string, error) {
+	buf, err := os.ReadFile(keyFile)
+	if err != nil {
+		return "", err
+	}
+	key, err := parsePrivateKey(buf)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse private key '%s': %w", keyFile, err)
+	}
+
+	var signature []byte
+	if _, ok := key.(ed25519.PrivateKey); ok {
+		signature, err = key.Sign(rand.Reader, []byte(plan), crypto.Hash(0))
+	} else {
+		digest := sha256.Sum256([]byte(plan))
+		signature, err = key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return "", err
+	}
+
+	var artifact strings.Builder
+	artifact.WriteString(plan)
+	if err := pem.Encode(&artifact, &pem.Block{Type: planPublicKeyType, Bytes: publicKey}); err != nil {
+		return "", err
+	}
+	if err := pem.Encode(&artifact, &pem.Block{Type: planSignatureType, Bytes: signature}); err != nil {
+		return "", err
+	}
+	return artifact.String(), nil
+}
+
+// VerifyPlan checks that the artifact is signed by the trusted public key in keyFile and that its plan is identical to
+// the given plan. The public key embedded in the artifact only tells which key signed it, since anyone can re-sign a
+// tampered plan with their own key.
+func VerifyPlan(plan string, artifactFile string, keyFile string) error {
+	keyBuf, err := os.ReadFile(keyFile)
+	if err != nil {
+		return err
+	}
+	trustedKey, err := parsePublicKey(keyBuf)
+	if err != nil {
+		return fmt.Errorf("failed to parse public key '%s': %w", keyFile, err)
+	}
+
+	buf, err := os.ReadFile(artifactFile)
+	if err != nil {
+		return err
+	}
+	artifact := strings.ReplaceAll(string(buf), "\r\n", "\n")
+
+	// The artifact ends with the trailer of the public key and the signature, so look for its last occurrence, since the
+	// plan itself may contain "-----BEGIN ", e.g. in a comment or a string literal.
+	pemStart := strings.LastIndex(artifact, "-----BEGIN "+planPublicKeyType+"-----\n")
+	if pemStart < 0 {
+		return fmt.Errorf("signed plan '%s' has no signature", artifactFile)
+	}
+	signedPlan := artifact[:pemStart]
+
+	publicKeyBlock, rest := pem.Decode([]byte(artifact[pemStart:]))
+	signatureBlock, rest := pem.Decode(rest)
+	if publicKeyBlock == nil || signatureBlock == nil || signatureBlock.Type != planSignatureType || len(bytes.TrimSpace(rest)) > 0 {
+		return fmt.Errorf("signed plan '%s' must end with %s and %s blocks", artifactFile, planPublicKeyType, planSignatureType)
+	}
+	publicKey, signature := publicKeyBlock.Bytes, signatureBlock.Bytes
+
+	trustedPublicKey, err := x509.MarshalPKIXPublicKey(trustedKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(publicKey, trustedPublicKey) {
+		return fmt.Errorf("signed plan '%s' is signed by a key other than '%s'", artifactFile, keyFile)
+	}
+	if err := verifySignature(trustedKey, []byte(signedPlan), signature); err != nil {
+		return fmt.Errorf("signature verification of '%s' failed: %w", artifactFile, err)
+	}
+
+	if signedPlan != plan {
+		return fmt.Errorf("the generated plan differs from the signed plan '%s'.\n\nsigned plan:\n%s\ngenerated plan:\n%s", artifactFile, signedPlan, plan)
+	}
+	return nil
+}
+
+func parsePublicKey(buf []byte) (any, error) {
+	block, _ := pem.Decode(buf)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block is found")
+	}
+
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported PEM block type: %s", block.Type)
+}
+
+func parsePrivateKey(buf []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(buf)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM block is found")
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type: %T", key)
+		}
+		return signer, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported PEM block type: %s", block.Type)
+}
+
+func verifySignature(key any, plan []byte, signature []byte) error {
+	digest := sha256.Sum256(plan)
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		if !ed25519.Verify(key, plan, signature) {
+			return fmt.Errorf("invalid ed25519 signature")
+		}
+		return nil
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(key, digest[:], signature) {
+			return fmt.Errorf("invalid ecdsa signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	default:
+		return fmt.Errorf("unsupported public key type: %T", key)
+	}
+}
@@ -0,0 +1,109 @@
+package sqldef
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/sqldef/sqldef/schema"
+)
+
+// ALTER TABLE that adds or drops something, e.g. a column or a constraint, rather than changing it
+var alterTableAddOrDrop = regexp.MustCompile("(?is)^ALTER TABLE (?:ONLY )?(?:\"[^\"]*\"|`[^`]*`|\\[[^\\]]*\\]|[\\w$.])+ (ADD|DROP) ")
+
+// Kinds of changes shown by --pretty, with their marks and ANSI colors
+const (
+	prettyAdd    = "add"
+	prettyChange = "change"
+	prettyDrop   = "drop"
+)
+
+var prettyMarks = map[string]string{prettyAdd: "+", prettyChange: "~", prettyDrop: "-"}
+
+var prettyColors = map[string]string{prettyAdd: "\x1b[32m", prettyChange: "\x1b[33m", prettyDrop: "\x1b[31m"}
+
+type prettyGroup struct {
+	title string
+	lines []string
+}
+
+// Same as showDDLs, but group the DDLs by the objects they touch, mark them as additions (+), modifications (~), or
+// drops (-), and show the summary. The marks are colored when stdout is a terminal and NO_COLOR isn't set.
+func showDDLsPretty(generatorMode schema.GeneratorMode, ddls []string, currentSchema []schema.DDL, enableDropTable bool, beforeApply string, impact bool) {
+	color := isTerminal(os.Stdout) && os.Getenv("NO_COLOR") == ""
+
+	var groups []*prettyGroup
+	groupsByTitle := map[string]*prettyGroup{}
+	counts := map[string]int{}
+	skipped := 0
+	for _, ddl := range ddls {
+		kind, name := schema.DDLObject(ddl, currentSchema)
+		title := "other"
+		if kind != "" {
+			title = kind + " " + name
+		}
+		group, ok := groupsByTitle[title]
+		if !ok {
+			group = &prettyGroup{title: title}
+			groupsByTitle[title] = group
+			groups = append(groups, group)
+		}
+
+		if !enableDropTable && strings.Contains(ddl, "DROP TABLE") {
+			group.lines = append(group.lines, fmt.Sprintf("-- Skipped: %s;", ddl))
+			skipped++
+			continue
+		}
+		if impact {
+			if notes := schema.AnalyzeImpact(generatorMode, ddl).String(); notes != "" {
+				group.lines = append(group.lines, "-- Impact: "+notes)
+			}
+		}
+		change := classifyDDL(ddl)
+		counts[change]++
+		line := prettyMarks[change] + " " + strings.ReplaceAll(ddl, "\n", "\n  ") + ";"
+		if color {
+			line = prettyColors[change] + line + "\x1b[0m"
+		}
+		group.lines = append(group.lines, line)
+	}
+
+	fmt.Println("-- dry run --")
+	if len(beforeApply) > 0 {
+		fmt.Println(beforeApply)
+	}
+	for _, group := range groups {
+		fmt.Printf("-- %s --\n", group.title)
+		for _, line := range group.lines {
+			fmt.Println(line)
+		}
+	}
+	fmt.Printf("-- %d to add, %d to change, %d to drop, %d skipped in %d objects --\n",
+		counts[prettyAdd], counts[prettyChange], counts[prettyDrop], skipped, len(groups))
+}
+
+// Classify a generated DDL into an addition, a modification, or a drop.
+func classifyDDL(ddl string) string {
+	ddl = strings.ToUpper(strings.TrimSpace(ddl))
+	switch {
+	case strings.HasPrefix(ddl, "CREATE OR "):
+		return prettyChange
+	case strings.HasPrefix(ddl, "CREATE "):
+		return prettyAdd
+	case strings.HasPrefix(ddl, "DROP "):
+		return prettyDrop
+	}
+	if match := alterTableAddOrDrop.FindStringSubmatch(ddl); match != nil {
+		if match[1] == "ADD" {
+			return prettyAdd
+		}
+		return prettyDrop
+	}
+	return prettyChange
+}
+
+func isTerminal(f *os.File) bool {
+	stat, err := f.Stat()
+	return err == nil && (stat.Mode()&os.ModeCharDevice) != 0
+}
@@ -0,0 +1,54 @@
+package sqldef
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqldef/sqldef/database"
+)
+
+// Dump the privileges of the tables and views of a PostgreSQL database granted to the roles other than their owners as
+// GRANT statements for --report-privileges, since the dump of the schema doesn't include them.
+func dumpTablePrivileges(db database.Database) (string, error) {
+	rows, err := db.DB().Query(`
+		SELECT n.nspname, c.relname, CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE pg_get_userbyid(a.grantee) END,
+		  a.is_grantable, string_agg(a.privilege_type, ', ' ORDER BY a.privilege_type)
+		FROM pg_catalog.pg_class c
+		JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+		CROSS JOIN LATERAL aclexplode(c.relacl) a
+		WHERE c.relkind IN ('r', 'p', 'v', 'm', 'f')
+		  AND n.nspname NOT IN ('pg_catalog', 'information_schema') AND n.nspname NOT LIKE 'pg\_toast%'
+		  AND a.grantee <> c.relowner
+		  AND a.privilege_type IN ('SELECT', 'INSERT', 'UPDATE', 'DELETE', 'TRUNCATE', 'REFERENCES', 'TRIGGER')
+		GROUP BY 1, 2, 3, 4
+		ORDER BY 1, 2, 3, 4`)
+	if err != nil {
+		return "", err
+	}
+	defer rows.Close()
+
+	var grants []string
+	for rows.Next() {
+		var schemaName, tableName, grantee, privileges string
+		var grantable bool
+		if err := rows.Scan(&schemaName, &tableName, &grantee, &grantable, &privileges); err != nil {
+			return "", err
+		}
+		if grantee != "PUBLIC" {
+			grantee = quoteIdentifier(grantee)
+		}
+		var grantOption string
+		if grantable {
+			grantOption = " WITH GRANT OPTION"
+		}
+		grants = append(grants, fmt.Sprintf("GRANT %s ON TABLE %s.%s TO %s%s;\n", privileges, quoteIdentifier(schemaName), quoteIdentifier(tableName), grantee, grantOption))
+	}
+	if err := rows.Err(); err != nil {
+		return "", err
+	}
+	return strings.Join(grants, ""), nil
+}
+
+func quoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -9,6 +9,7 @@ type DDL interface {
 type CreateTable struct {
 	statement string
 	table     Table
+	like      string // for MySQL `CREATE TABLE ... LIKE`, the table to copy
 }
 
 type CreateIndex struct {
@@ -36,20 +37,39 @@ type AddForeignKey struct {
 	foreignKey ForeignKey
 }
 
+type AddExclusion struct {
+	statement string
+	tableName string
+	exclusion Exclusion
+}
+
 type AddPolicy struct {
 	statement string
 	tableName string
 	policy    Policy
 }
 
+// ALTER DOMAIN ... ADD CONSTRAINT of Postgres
+type AddDomainConstraint struct {
+	statement string
+	typeName  string
+	check     CheckDefinition
+}
+
 type Table struct {
 	name        string
 	columns     []Column
 	indexes     []Index
 	checks      []CheckDefinition
 	foreignKeys []ForeignKey
+	exclusions  []Exclusion
 	policies    []Policy
 	options     map[string]string
+	owner       string   // for Postgres `ALTER TABLE ... OWNER TO`
+	clusterOn   string   // for Postgres `ALTER TABLE ... CLUSTER ON`
+	unlogged    bool     // for Postgres `CREATE UNLOGGED TABLE`
+	inherits    []string // for Postgres `INHERITS (parent, ...)`
+	virtual     string   // for SQLite3 `CREATE VIRTUAL TABLE ... USING`, the module and its arguments, e.g. "fts5(title, body)"
 }
 
 type Column struct {
@@ -77,6 +97,8 @@ type Column struct {
 	identity      *Identity
 	sequence      *Sequence
 	generated     *Generated
+	invisible     bool // for MySQL `INVISIBLE`
+	nonClustered  bool // for MSSQL `PRIMARY KEY NONCLUSTERED`
 	// TODO: keyopt
 	// XXX: zerofill?
 }
@@ -90,16 +112,20 @@ type Index struct {
 	constraint        bool // for Postgres/MSSQL `ADD CONSTRAINT UNIQUE`
 	constraintOptions *ConstraintOptions
 	where             string         // for Postgres `Partial Indexes`
-	included          []string       // for MSSQL
+	included          []string       // for MSSQL and Postgres
+	nullsNotDistinct  bool           // for Postgres, `UNIQUE NULLS NOT DISTINCT`
 	clustered         bool           // for MSSQL
+	columnStore       bool           // for MSSQL
 	partition         IndexPartition // for MSSQL
 	options           []IndexOption
+	nameGenerated     bool // for Postgres, the name is omitted in SQL and chosen by the database
 }
 
 type IndexColumn struct {
-	column    string
-	length    *int
-	direction string
+	column        string
+	length        *int
+	direction     string
+	operatorClass string // for Postgres, e.g. gin_trgm_ops
 }
 
 // IndexColumn.direction
@@ -135,6 +161,20 @@ type ForeignKey struct {
 	constraintOptions *ConstraintOptions
 }
 
+type Exclusion struct {
+	constraintName    string
+	indexType         string
+	exclusions        []ExclusionPair
+	where             string
+	constraintOptions *ConstraintOptions
+}
+
+type ExclusionPair struct {
+	column   string
+	opClass  string
+	operator string
+}
+
 type Policy struct {
 	name          string
 	referenceName string
@@ -149,10 +189,14 @@ type View struct {
 	statement    string
 	viewType     string
 	securityType string
+	definer      string // `user`@`host` of MySQL, or empty if omitted
 	name         string
 	definition   string
 	indexes      []Index
 	columns      []string
+	owner        string   // for Postgres `ALTER VIEW ... OWNER TO`
+	tables       []string // tables and views in the FROM clauses of the definition
+	references   []string // column names used by the definition, and "*" for `SELECT *`
 }
 
 type Trigger struct {
@@ -161,9 +205,26 @@ type Trigger struct {
 	tableName string
 	time      string
 	event     []string
+	definer   string // `user`@`host` of MySQL, or empty if omitted
+	level     string // ROW or STATEMENT, or empty if omitted
+	condition string // WHEN clause without the outermost parentheses
 	body      []string
 }
 
+type Event struct {
+	statement string
+	name      string
+	definer   string // `user`@`host`, or empty if omitted
+	at        string
+	every     string
+	starts    string
+	ends      string
+	preserve  bool
+	status    string
+	comment   string
+	body      string
+}
+
 type Value struct {
 	valueType ValueType
 	raw       []byte
@@ -235,6 +296,8 @@ type CheckDefinition struct {
 	constraintName    string
 	notForReplication bool
 	noInherit         bool
+	notValid          bool // for Postgres `NOT VALID`
+	notEnforced       bool // for MySQL `NOT ENFORCED`
 }
 
 // TODO: include type information
@@ -242,11 +305,20 @@ type Type struct {
 	name       string
 	statement  string
 	enumValues []string
+	attributes []Column // for composite types
+	table      *Table   // for table types of SQL Server
+	domain     *Domain  // for domains of Postgres
+}
+
+type Domain struct {
+	baseType Column // the data type with DEFAULT and NOT NULL
+	checks   []CheckDefinition
 }
 
 type Generated struct {
-	expr          string
-	generatedType GeneratedType
+	expr           string
+	normalizedExpr parser.Expr // compared instead of expr, see normalizeGeneratedExpr
+	generatedType  GeneratedType
 }
 
 type GeneratedType int
@@ -261,6 +333,19 @@ type Comment struct {
 	comment   parser.Comment
 }
 
+type ClusterOn struct {
+	statement string
+	tableName string
+	indexName string
+}
+
+type Owner struct {
+	statement  string
+	objectType string
+	tableName  string
+	owner      string
+}
+
 type Extension struct {
 	statement string
 	extension parser.Extension
@@ -271,6 +356,36 @@ type Schema struct {
 	schema    parser.Schema
 }
 
+type Publication struct {
+	statement string
+	name      string
+	tables    []string
+	allTables bool
+}
+
+type Role struct {
+	statement string
+	role      parser.Role
+}
+
+// Grant is only reported by --report-privileges, and never generates GRANT or REVOKE.
+type Grant struct {
+	statement   string
+	privileges  []string
+	tables      []string
+	grantees    []string
+	grantOption bool
+}
+
+// Drop is DROP TABLE, VIEW, or INDEX in the desired SQL, e.g. DROP TABLE IF EXISTS of a schema dump. ParseDDLs removes
+// the objects declared before it instead of returning it.
+type Drop struct {
+	statement  string
+	objectType string   // TABLE, VIEW, or INDEX
+	names      []string // the table or view names, or the index names
+	tableName  string   // the table of DROP INDEX ... ON for MySQL and SQL Server
+}
+
 func (c *CreateTable) Statement() string {
 	return c.statement
 }
@@ -291,10 +406,18 @@ func (a *AddForeignKey) Statement() string {
 	return a.statement
 }
 
+func (a *AddExclusion) Statement() string {
+	return a.statement
+}
+
 func (a *AddPolicy) Statement() string {
 	return a.statement
 }
 
+func (a *AddDomainConstraint) Statement() string {
+	return a.statement
+}
+
 func (v *View) Statement() string {
 	return v.statement
 }
@@ -303,6 +426,10 @@ func (t *Trigger) Statement() string {
 	return t.statement
 }
 
+func (e *Event) Statement() string {
+	return e.statement
+}
+
 func (t *Type) Statement() string {
 	return t.statement
 }
@@ -311,6 +438,14 @@ func (t *Comment) Statement() string {
 	return t.statement
 }
 
+func (c *ClusterOn) Statement() string {
+	return c.statement
+}
+
+func (o *Owner) Statement() string {
+	return o.statement
+}
+
 func (t *Extension) Statement() string {
 	return t.statement
 }
@@ -327,11 +462,15 @@ func (t *Table) PrimaryKey() *Index {
 	}
 
 	primaryColumns := []IndexColumn{}
+	clustered := true
 	for _, column := range t.columns {
 		if column.keyOption == ColumnKeyPrimary {
 			primaryColumns = append(primaryColumns, IndexColumn{
 				column: column.name,
 			})
+			if column.nonClustered {
+				clustered = false
+			}
 		}
 	}
 
@@ -345,10 +484,26 @@ func (t *Table) PrimaryKey() *Index {
 		columns:   primaryColumns,
 		primary:   true,
 		unique:    true,
-		clustered: true,
+		clustered: clustered,
 	}
 }
 
 func (keyOption ColumnKeyOption) isUnique() bool {
 	return keyOption == ColumnKeyUnique || keyOption == ColumnKeyUniqueKey
 }
+
+func (p *Publication) Statement() string {
+	return p.statement
+}
+
+func (r *Role) Statement() string {
+	return r.statement
+}
+
+func (g *Grant) Statement() string {
+	return g.statement
+}
+
+func (d *Drop) Statement() string {
+	return d.statement
+}
@@ -0,0 +1,596 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ConvertDDLs converts the DDLs parsed in a dialect into the DDLs of another dialect on a best-effort basis, e.g. to
+// migrate a schema between databases. Tables, indexes, foreign keys, and views are converted, with the data types
+// mapped to the closest ones of the target dialect. It also returns notes of the features that are dropped or changed
+// because the target dialect doesn't support them. Expressions, e.g. of defaults, checks, and views, are copied as is.
+func ConvertDDLs(from GeneratorMode, to GeneratorMode, ddls []DDL, fromSchema string, toSchema string) ([]string, []string) {
+	c := converter{
+		from:       from,
+		to:         to,
+		fromSchema: fromSchema,
+		generator:  &Generator{mode: to, defaultSchema: toSchema},
+		enumTypes:  map[string][]string{},
+	}
+	if expanded, err := expandCreateTableLike(ddls, nil); err == nil {
+		ddls = expanded
+	}
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*Type); ok && len(stmt.enumValues) > 0 {
+			_, name := splitTableName(stmt.name, fromSchema)
+			c.enumTypes[name] = stmt.enumValues
+		}
+	}
+
+	var converted []string
+	for _, ddl := range ddls {
+		converted = append(converted, c.convertDDL(ddl)...)
+	}
+	return converted, c.notes
+}
+
+type converter struct {
+	from       GeneratorMode
+	to         GeneratorMode
+	fromSchema string
+	generator  *Generator
+	enumTypes  map[string][]string // enum types of PostgreSQL, which are converted to CHECK constraints in the others
+	notes      []string
+}
+
+func (c *converter) note(format string, args ...interface{}) {
+	c.notes = append(c.notes, fmt.Sprintf(format, args...))
+}
+
+func (c *converter) convertDDL(ddl DDL) []string {
+	g := c.generator
+	switch stmt := ddl.(type) {
+	case *CreateTable:
+		if stmt.like != "" {
+			c.note("%s: LIKE %s is not converted since the table isn't defined before it", DescribeDDL(ddl), stmt.like)
+			return nil
+		}
+		return c.convertTable(stmt.table)
+	case *CreateIndex:
+		return c.convertIndex(stmt.tableName, stmt.index)
+	case *AddIndex:
+		return c.convertIndex(stmt.tableName, stmt.index)
+	case *AddPrimaryKey:
+		if c.to == GeneratorModeSQLite3 {
+			c.note("%s: SQLite3 can't add a primary key to an existing table", DescribeDDL(ddl))
+			return nil
+		}
+		return []string{fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (%s)", c.tableName(stmt.tableName), strings.Join(c.indexColumns(DescribeDDL(ddl), stmt.index.columns), ", "))}
+	case *AddForeignKey:
+		if c.to == GeneratorModeSQLite3 {
+			c.note("%s: SQLite3 can't add a foreign key to an existing table", DescribeDDL(ddl))
+			return nil
+		}
+		return []string{fmt.Sprintf("ALTER TABLE %s ADD %s", c.tableName(stmt.tableName), c.foreignKeyDefinition(stmt.tableName, stmt.foreignKey))}
+	case *View:
+		if stmt.viewType != "VIEW" && c.to != GeneratorModePostgres {
+			c.note("%s: not supported", DescribeDDL(ddl))
+			return nil
+		}
+		c.note("%s: the definition is copied as is", DescribeDDL(ddl))
+		return []string{fmt.Sprintf("CREATE %s %s AS %s", stmt.viewType, c.tableName(stmt.name), stmt.definition)}
+	case *Type:
+		if len(stmt.enumValues) == 0 {
+			c.note("%s: not supported", DescribeDDL(ddl))
+			return nil
+		}
+		if c.to == GeneratorModePostgres {
+			return []string{fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", c.tableName(stmt.name), strings.Join(stmt.enumValues, ", "))}
+		}
+		c.note("%s: converted to CHECK constraints of the columns", DescribeDDL(ddl))
+		return nil
+	case *Comment:
+		objectType := strings.TrimPrefix(strings.ToUpper(stmt.comment.ObjectType), "OBJECT_")
+		if c.to != GeneratorModePostgres && c.to != GeneratorModeMssql || (objectType != "TABLE" && objectType != "COLUMN") {
+			c.note("%s: not supported", DescribeDDL(ddl))
+			return nil
+		}
+		if objectType == "TABLE" {
+			return []string{c.commentOn("TABLE", c.tableName(stmt.comment.Object), stmt.comment.Comment)}
+		}
+		i := strings.LastIndex(stmt.comment.Object, ".")
+		if i < 0 {
+			c.note("%s: the table of the column is unknown", DescribeDDL(ddl))
+			return nil
+		}
+		object := c.tableName(stmt.comment.Object[:i]) + "." + g.escapeSQLName(stmt.comment.Object[i+1:])
+		return []string{c.commentOn("COLUMN", object, stmt.comment.Comment)}
+	default:
+		c.note("%s: not supported", DescribeDDL(ddl))
+		return nil
+	}
+}
+
+func (c *converter) convertTable(table Table) []string {
+	g := c.generator
+	description := "table " + table.name
+	tableName := c.tableName(table.name)
+	if table.virtual != "" {
+		if c.to == GeneratorModeSQLite3 {
+			return []string{fmt.Sprintf("CREATE VIRTUAL TABLE %s USING %s", tableName, table.virtual)}
+		}
+		c.note("%s: the virtual table is dropped", description)
+		return nil
+	}
+
+	var definitions, primaryKey, comments []string
+	for _, column := range table.columns {
+		if column.keyOption == ColumnKeyPrimary {
+			primaryKey = append(primaryKey, g.escapeSQLName(column.name))
+		}
+		converted := c.convertColumn(description, column)
+		definition, err := g.generateColumnDefinition(converted, true)
+		if err != nil {
+			c.note("%s: column %s is not converted: %s", description, column.name, err)
+			continue
+		}
+		definitions = append(definitions, definition)
+
+		if column.comment != nil && c.to != GeneratorModeMysql {
+			if c.to == GeneratorModePostgres || c.to == GeneratorModeMssql {
+				comments = append(comments, c.commentOn("COLUMN", tableName+"."+g.escapeSQLName(column.name), column.comment.strVal))
+			} else {
+				c.note("%s: the comment of column %s is dropped", description, column.name)
+			}
+		}
+	}
+
+	var indexDDLs []string
+	for _, index := range table.indexes {
+		switch {
+		case index.primary:
+			primaryKey = c.indexColumns(description, index.columns)
+		case index.unique && index.where == "" && len(index.included) == 0 && !index.nullsNotDistinct:
+			definitions = append(definitions, fmt.Sprintf("CONSTRAINT %s UNIQUE (%s)", g.escapeSQLName(index.name), strings.Join(c.indexColumns(description, index.columns), ", ")))
+		default:
+			indexDDLs = append(indexDDLs, c.convertIndex(table.name, index)...)
+		}
+	}
+	if len(primaryKey) > 0 {
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(primaryKey, ", ")))
+	}
+	for _, foreignKey := range table.foreignKeys {
+		definitions = append(definitions, c.foreignKeyDefinition(table.name, foreignKey))
+	}
+	for _, check := range table.checks {
+		definition := fmt.Sprintf("CHECK (%s)", check.definition)
+		if check.constraintName != "" {
+			definition = fmt.Sprintf("CONSTRAINT %s %s", g.escapeSQLName(check.constraintName), definition)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	for _, exclusion := range table.exclusions {
+		c.note("%s: exclusion constraint %s is dropped", description, exclusion.constraintName)
+	}
+	for _, policy := range table.policies {
+		c.note("%s: policy %s is dropped", description, policy.name)
+	}
+	if table.unlogged && c.to != GeneratorModePostgres {
+		c.note("%s: UNLOGGED is dropped", description)
+	}
+	if len(table.inherits) > 0 {
+		c.note("%s: INHERITS is dropped", description)
+	}
+	var options []string
+	for name, value := range table.options {
+		if strings.ToLower(name) == "comment" && (c.to == GeneratorModePostgres || c.to == GeneratorModeMssql) {
+			comments = append([]string{c.commentOn("TABLE", tableName, strings.Trim(value, "'"))}, comments...)
+		} else if c.to != c.from {
+			options = append(options, name+"="+value)
+		}
+	}
+	if len(options) > 0 {
+		sort.Strings(options)
+		c.note("%s: table options are dropped: %s", description, strings.Join(options, ", "))
+	}
+
+	ddls := []string{fmt.Sprintf("CREATE TABLE %s (\n  %s\n)", tableName, strings.Join(definitions, ",\n  "))}
+	ddls = append(ddls, indexDDLs...)
+	return append(ddls, comments...)
+}
+
+// Convert a column to the target dialect, e.g. the data type, auto increment, and the attributes it doesn't support.
+func (c *converter) convertColumn(description string, column Column) Column {
+	description = fmt.Sprintf("%s: column %s", description, column.name)
+	typeName := strings.ToLower(column.typeName)
+
+	autoIncrement := column.autoIncrement || column.identity != nil
+	switch typeName {
+	case "serial", "serial4", "bigserial", "serial8", "smallserial", "serial2":
+		autoIncrement = true
+	}
+	column.autoIncrement, column.identity, column.sequence = false, nil, nil
+
+	if values, ok := c.enumTypes[typeName[strings.LastIndex(typeName, ".")+1:]]; ok && c.from == GeneratorModePostgres {
+		column.typeName, column.enumValues = "enum", values
+	}
+	if c.from != c.to {
+		c.convertType(description, &column)
+	}
+
+	switch {
+	case !autoIncrement:
+	case c.to == GeneratorModeMysql:
+		column.autoIncrement = true
+	case c.to == GeneratorModePostgres:
+		switch column.typeName {
+		case "smallint", "int2", "smallserial", "serial2":
+			column.typeName = "smallserial"
+		case "bigint", "int8", "bigserial", "serial8":
+			column.typeName = "bigserial"
+		default:
+			column.typeName = "serial"
+		}
+	case c.to == GeneratorModeMssql:
+		one := 1
+		column.identity = &Identity{}
+		column.sequence = &Sequence{StartWith: &one, IncrementBy: &one}
+	case c.to == GeneratorModeSQLite3:
+		// INTEGER PRIMARY KEY is an alias of the ROWID, which is assigned automatically.
+		column.typeName = "integer"
+	}
+
+	if c.to != GeneratorModeMysql {
+		if column.unsigned {
+			c.note("%s: UNSIGNED is dropped", description)
+			column.unsigned = false
+		}
+		if column.charset != "" || column.collate != "" {
+			c.note("%s: CHARACTER SET and COLLATE are dropped", description)
+			column.charset, column.collate = "", ""
+		}
+		if column.onUpdate != nil {
+			c.note("%s: ON UPDATE %s is dropped", description, string(column.onUpdate.raw))
+			column.onUpdate = nil
+		}
+		if column.sridDef != nil {
+			c.note("%s: SRID is dropped", description)
+			column.sridDef = nil
+		}
+		if column.invisible {
+			c.note("%s: INVISIBLE is dropped", description)
+			column.invisible = false
+		}
+		switch column.keyOption {
+		case ColumnKeyUniqueKey:
+			column.keyOption = ColumnKeyUnique
+		case ColumnKeySpatialKey, ColumnKey:
+			c.note("%s: the index declared with the column is dropped", description)
+			column.keyOption = ColumnKeyNone
+		}
+		column.displayWidth = nil
+		column.comment = nil
+	}
+
+	if column.generated != nil {
+		switch {
+		case c.to == GeneratorModeMssql:
+			c.note("%s: GENERATED ALWAYS AS (%s) is dropped", description, column.generated.expr)
+			column.generated = nil
+		case c.to == GeneratorModePostgres && column.generated.generatedType == GeneratedTypeVirtual:
+			c.note("%s: the VIRTUAL generated column is converted to STORED", description)
+			column.generated.generatedType = GeneratedTypeStored
+		}
+	}
+
+	if column.check != nil {
+		check := *column.check
+		if c.to != GeneratorModeMysql {
+			check.notEnforced = false
+		}
+		if c.to != GeneratorModePostgres {
+			check.noInherit = false
+		}
+		if c.to != GeneratorModeMssql {
+			check.notForReplication = false
+		}
+		column.check = &check
+	}
+
+	if column.defaultDef != nil {
+		defaultDef := *column.defaultDef
+		if c.to != GeneratorModeMssql {
+			defaultDef.constraintName = ""
+		}
+		if value := defaultDef.value; value != nil {
+			switch {
+			case value.valueType == ValueTypeBit && strings.EqualFold(string(value.raw), "now"):
+				// The parser keeps NOW() as a bit value
+				defaultDef.value = &Value{valueType: ValueTypeValArg, raw: []byte("current_timestamp")}
+			case value.valueType == ValueTypeBool && c.to == GeneratorModeMssql:
+				defaultDef.value = newIntValue(boolToInt(strings.EqualFold(value.strVal, "true")))
+			case value.valueType == ValueTypeInt && column.typeName == "boolean" && c.to == GeneratorModePostgres:
+				defaultDef.value = &Value{valueType: ValueTypeBool, strVal: strconv.FormatBool(value.intVal != 0)}
+			}
+		}
+		column.defaultDef = &defaultDef
+	}
+	column.references = ""
+	return column
+}
+
+// Map the data type of a column to the closest one of the target dialect.
+func (c *converter) convertType(description string, column *Column) {
+	typeName := strings.ToLower(column.typeName)
+	unlimited := column.length != nil && strings.EqualFold(string(column.length.raw), "max")
+
+	// Canonicalize the types of the source dialect
+	switch typeName {
+	case "int2", "smallserial", "serial2", "year":
+		typeName = "smallint"
+	case "int4", "integer", "serial", "serial4":
+		typeName = "int"
+	case "int8", "bigserial", "serial8":
+		typeName = "bigint"
+	case "bool":
+		typeName = "boolean"
+	case "bit":
+		if c.from == GeneratorModeMssql {
+			typeName = "boolean"
+		}
+	case "float4":
+		typeName = "real"
+	case "float8", "double precision":
+		typeName = "double"
+	case "float":
+		if c.from != GeneratorModeMysql {
+			typeName = "double"
+		}
+	case "numeric":
+		typeName = "decimal"
+	case "money", "smallmoney":
+		typeName = "decimal"
+		column.length, column.scale = newIntValue(19), newIntValue(4)
+	case "character varying", "nvarchar":
+		typeName = "varchar"
+	case "character", "bpchar", "nchar":
+		typeName = "char"
+	case "tinytext", "mediumtext", "longtext", "ntext", "citext", "clob":
+		typeName = "text"
+	case "bytea", "image", "tinyblob", "mediumblob", "longblob":
+		typeName = "blob"
+	case "datetime2", "smalldatetime":
+		typeName = "datetime"
+	case "timestamp":
+		if !column.timezone {
+			typeName = "datetime"
+		}
+	case "timestamptz", "datetimeoffset":
+		typeName, column.timezone = "timestamp", true
+	case "jsonb":
+		typeName = "json"
+	case "uniqueidentifier":
+		typeName = "uuid"
+	}
+	if unlimited {
+		column.length = nil
+		switch typeName {
+		case "varchar":
+			typeName = "text"
+		case "varbinary":
+			typeName = "blob"
+		}
+	}
+	if typeName == "varchar" && column.length == nil {
+		typeName = "text"
+	}
+
+	if column.array && c.to != GeneratorModePostgres {
+		c.note("%s: the array is converted to text", description)
+		typeName, column.array, column.length, column.scale = "text", false, nil, nil
+	}
+	if (typeName == "enum" || typeName == "set") && c.to != GeneratorModeMysql {
+		if typeName == "enum" && column.check == nil {
+			column.check = &CheckDefinition{definition: fmt.Sprintf("%s IN (%s)", c.generator.escapeSQLName(column.name), strings.Join(column.enumValues, ", "))}
+			c.note("%s: the enum is converted to CHECK", description)
+		} else {
+			c.note("%s: the %s is converted to text", description, typeName)
+		}
+		typeName, column.enumValues = "text", nil
+	}
+	if column.timezone && c.to != GeneratorModePostgres && c.to != GeneratorModeMssql {
+		c.note("%s: the time zone is dropped", description)
+	}
+
+	// Render the canonical type for the target dialect
+	switch c.to {
+	case GeneratorModeMysql:
+		switch typeName {
+		case "blob":
+			typeName = "longblob"
+		case "uuid":
+			typeName, column.length = "char", newIntValue(36)
+		case "timestamp":
+			if !column.timezone {
+				typeName = "datetime"
+			}
+		}
+		column.timezone = false
+	case GeneratorModePostgres:
+		switch typeName {
+		case "tinyint":
+			typeName = "smallint"
+		case "int", "mediumint":
+			typeName = "integer"
+		case "double":
+			typeName = "double precision"
+		case "blob", "binary", "varbinary":
+			typeName, column.length = "bytea", nil
+		case "datetime":
+			typeName = "timestamp"
+		}
+	case GeneratorModeSQLite3:
+		switch typeName {
+		case "tinyint", "smallint", "mediumint", "int", "bigint":
+			typeName = "integer"
+		case "double":
+			typeName = "real"
+		case "json", "uuid":
+			typeName = "text"
+		case "timestamp":
+			typeName = "datetime"
+		}
+		column.timezone = false
+	case GeneratorModeMssql:
+		switch typeName {
+		case "mediumint":
+			typeName = "int"
+		case "boolean":
+			typeName = "bit"
+		case "double":
+			typeName = "float"
+		case "varchar", "text", "json":
+			// nvarchar(n) is limited to 4000 characters
+			if typeName != "varchar" || column.length == nil || column.length.intVal > 4000 {
+				column.length = &Value{valueType: ValueTypeValArg, raw: []byte("max")}
+			}
+			typeName = "nvarchar"
+		case "char":
+			typeName = "nchar"
+		case "blob":
+			typeName, column.length = "varbinary", &Value{valueType: ValueTypeValArg, raw: []byte("max")}
+		case "datetime":
+			typeName = "datetime2"
+		case "timestamp":
+			typeName = "datetimeoffset"
+		case "uuid":
+			typeName = "uniqueidentifier"
+		}
+		column.timezone = false
+	}
+	column.typeName = typeName
+}
+
+func (c *converter) convertIndex(tableName string, index Index) []string {
+	g := c.generator
+	description := fmt.Sprintf("index %s on %s", index.name, tableName)
+	switch strings.ToLower(index.indexType) {
+	case "fulltext", "fulltext key", "fulltext index", "spatial", "spatial key", "spatial index":
+		if c.to != GeneratorModeMysql {
+			c.note("%s: %s is not supported", description, strings.ToUpper(index.indexType))
+			return nil
+		}
+	}
+	if index.primary {
+		return []string{fmt.Sprintf("ALTER TABLE %s ADD PRIMARY KEY (%s)", c.tableName(tableName), strings.Join(c.indexColumns(description, index.columns), ", "))}
+	}
+
+	ddl := "CREATE INDEX"
+	if index.unique {
+		ddl = "CREATE UNIQUE INDEX"
+	}
+	ddl += fmt.Sprintf(" %s ON %s (%s)", g.escapeSQLName(index.name), c.tableName(tableName), strings.Join(c.indexColumns(description, index.columns), ", "))
+	if len(index.included) > 0 {
+		if c.to == GeneratorModePostgres || c.to == GeneratorModeMssql {
+			var included []string
+			for _, column := range index.included {
+				included = append(included, g.escapeSQLName(column))
+			}
+			ddl += fmt.Sprintf(" INCLUDE (%s)", strings.Join(included, ", "))
+		} else {
+			c.note("%s: INCLUDE is dropped", description)
+		}
+	}
+	if index.nullsNotDistinct {
+		if c.to == GeneratorModePostgres {
+			ddl += " NULLS NOT DISTINCT"
+		} else {
+			c.note("%s: NULLS NOT DISTINCT is dropped", description)
+		}
+	}
+	if index.where != "" {
+		if c.to == GeneratorModeMysql {
+			c.note("%s: the partial index is converted to a full index", description)
+		} else {
+			ddl += " WHERE " + index.where
+		}
+	}
+	return []string{ddl}
+}
+
+func (c *converter) indexColumns(description string, indexColumns []IndexColumn) []string {
+	g := c.generator
+	var columns []string
+	for _, indexColumn := range indexColumns {
+		column := indexColumn.column
+		if !strings.HasPrefix(column, "(") { // not a functional key part
+			column = g.escapeSQLName(column)
+		}
+		if indexColumn.length != nil {
+			if c.to == GeneratorModeMysql {
+				column += fmt.Sprintf("(%d)", *indexColumn.length)
+			} else {
+				c.note("%s: the prefix length of %s is dropped", description, indexColumn.column)
+			}
+		}
+		if indexColumn.operatorClass != "" && c.to == GeneratorModePostgres {
+			column += " " + indexColumn.operatorClass
+		}
+		if indexColumn.direction == DescScr {
+			column += " DESC"
+		}
+		columns = append(columns, column)
+	}
+	return columns
+}
+
+func (c *converter) foreignKeyDefinition(tableName string, foreignKey ForeignKey) string {
+	if foreignKey.constraintName == "" {
+		_, name := splitTableName(tableName, c.fromSchema)
+		foreignKey.constraintName = PostgresConstraintName(name, foreignKey.indexColumns, "fkey")
+	}
+	if c.to != GeneratorModeMysql {
+		foreignKey.indexName = ""
+	}
+	if c.to != GeneratorModeMssql {
+		foreignKey.notForReplication = false
+	}
+	foreignKey.referenceName = c.targetTableName(foreignKey.referenceName)
+	return c.generator.generateForeignKeyDefinition(foreignKey)
+}
+
+// Name a table in the target dialect. The default schema of the source dialect is left to the target dialect, and
+// schemas are dropped for MySQL and SQLite3, where a schema means another database.
+func (c *converter) targetTableName(name string) string {
+	schema, table := splitTableName(name, c.fromSchema)
+	if schema == "" || schema == c.fromSchema || c.to == GeneratorModeMysql || c.to == GeneratorModeSQLite3 {
+		return table
+	}
+	return schema + "." + table
+}
+
+func (c *converter) tableName(name string) string {
+	return c.generator.escapeTableName(c.targetTableName(name))
+}
+
+func (c *converter) commentOn(objectType string, object string, comment string) string {
+	value := StringConstant(comment)
+	if c.to == GeneratorModeMssql {
+		value = unicodeStringConstant(comment)
+	}
+	return fmt.Sprintf("COMMENT ON %s %s IS %s", objectType, object, value)
+}
+
+func newIntValue(i int) *Value {
+	return &Value{valueType: ValueTypeInt, raw: []byte(strconv.Itoa(i)), intVal: i}
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConvertDDLsMysqlToPostgres(t *testing.T) {
+	ddls, err := ParseDDLs(GeneratorModeMysql, database.NewParser(parser.ParserModeMysql),
+		"CREATE TABLE users (\n"+
+			"  id bigint unsigned NOT NULL AUTO_INCREMENT,\n"+
+			"  name varchar(20) NOT NULL DEFAULT '',\n"+
+			"  kind enum('a', 'b'),\n"+
+			"  updated_at datetime DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,\n"+
+			"  PRIMARY KEY (id),\n"+
+			"  KEY index_name (name)\n"+
+			") ENGINE=InnoDB;", "")
+	assert.NoError(t, err)
+
+	converted, notes := ConvertDDLs(GeneratorModeMysql, GeneratorModePostgres, ddls, "", "public")
+	assert.Equal(t, []string{
+		"CREATE TABLE \"public\".\"users\" (\n" +
+			"  \"id\" bigserial NOT NULL,\n" +
+			"  \"name\" varchar(20) NOT NULL DEFAULT '',\n" +
+			"  \"kind\" text CHECK (\"kind\" IN ('a', 'b')),\n" +
+			"  \"updated_at\" timestamp DEFAULT current_timestamp,\n" +
+			"  PRIMARY KEY (\"id\")\n" +
+			")",
+		"CREATE INDEX \"index_name\" ON \"public\".\"users\" (\"name\")",
+	}, converted)
+	assert.Equal(t, []string{
+		"table users: column id: UNSIGNED is dropped",
+		"table users: column kind: the enum is converted to CHECK",
+		"table users: column updated_at: ON UPDATE current_timestamp is dropped",
+		"table users: table options are dropped: ENGINE=InnoDB",
+	}, notes)
+}
+
+func TestConvertDDLsPostgresToMysql(t *testing.T) {
+	ddls, err := ParseDDLs(GeneratorModePostgres, database.NewParser(parser.ParserModePostgres),
+		"CREATE TABLE users (id serial PRIMARY KEY, created_at timestamp with time zone DEFAULT now(), tags text[]);", "public")
+	assert.NoError(t, err)
+
+	converted, notes := ConvertDDLs(GeneratorModePostgres, GeneratorModeMysql, ddls, "public", "")
+	assert.Equal(t, []string{
+		"CREATE TABLE `users` (\n" +
+			"  `id` int NOT NULL AUTO_INCREMENT,\n" +
+			"  `created_at` timestamp DEFAULT current_timestamp,\n" +
+			"  `tags` text,\n" +
+			"  PRIMARY KEY (`id`)\n" +
+			")",
+	}, converted)
+	assert.Equal(t, []string{
+		"table public.users: column created_at: the time zone is dropped",
+		"table public.users: column tags: the array is converted to text",
+	}, notes)
+}
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// GenerateDestroyDDLs returns the DDLs to drop all the objects of the current schema, for --destroy. Objects are dropped
+// before the ones they depend on: views before tables, a table before the tables it refers to by foreign keys, tables
+// before the functions of their triggers and the sequences of their defaults, and domains before types. Indexes,
+// constraints, and triggers are dropped with their tables.
+func GenerateDestroyDDLs(mode GeneratorMode, currentDDLs []DDL, defaultSchema string) ([]string, error) {
+	tables, views, triggers, types, _, _, _, publications, events, _, err := aggregateDDLsToSchema(currentDDLs)
+	if err != nil {
+		return nil, err
+	}
+	g := Generator{mode: mode, defaultSchema: defaultSchema}
+
+	ddls := []string{}
+	for _, event := range events {
+		ddls = append(ddls, fmt.Sprintf("DROP EVENT %s", g.escapeSQLName(event.name)))
+	}
+	for _, publication := range publications {
+		ddls = append(ddls, fmt.Sprintf("DROP PUBLICATION %s", g.escapeSQLName(publication.name)))
+	}
+
+	// A view may refer to the views defined before it.
+	for i := len(views) - 1; i >= 0; i-- {
+		if views[i].viewType == "MATERIALIZED VIEW" {
+			ddls = append(ddls, fmt.Sprintf("DROP MATERIALIZED VIEW %s", g.escapeTableName(views[i].name)))
+		} else {
+			ddls = append(ddls, fmt.Sprintf("DROP VIEW %s", g.escapeTableName(views[i].name)))
+		}
+	}
+
+	// Order the tables as they would be created, i.e. the referred tables first, and drop them in reverse.
+	var ordered []*Table
+	added := map[string]bool{}
+	var addTable func(table *Table)
+	addTable = func(table *Table) {
+		if added[table.name] {
+			return
+		}
+		added[table.name] = true
+		for _, foreignKey := range table.foreignKeys {
+			if referenced := findTableByName(tables, foreignKey.referenceName); referenced != nil {
+				addTable(referenced)
+			}
+		}
+		ordered = append(ordered, table)
+	}
+	for _, table := range tables {
+		addTable(table)
+	}
+	for i := len(ordered) - 1; i >= 0; i-- {
+		ddls = append(ddls, fmt.Sprintf("DROP TABLE %s", g.escapeTableName(ordered[i].name)))
+	}
+
+	// The functions and the sequences may be left by the tables, e.g. a sequence OWNED BY a column is dropped with it.
+	dropped := map[string]bool{}
+	for _, trigger := range triggers {
+		for _, line := range trigger.body {
+			if function, ok := strings.CutPrefix(line, "EXECUTE FUNCTION "); ok {
+				name, _, _ := strings.Cut(function, "(")
+				if ddl := fmt.Sprintf("DROP FUNCTION IF EXISTS %s", g.escapeTableName(name)); !dropped[ddl] {
+					dropped[ddl] = true
+					ddls = append(ddls, ddl)
+				}
+			}
+		}
+	}
+	for _, table := range ordered {
+		for _, column := range table.columns {
+			if column.defaultDef == nil {
+				continue
+			}
+			for _, match := range nextvalPattern.FindAllStringSubmatch(column.defaultDef.expression, -1) {
+				name := strings.ReplaceAll(strings.ReplaceAll(match[1], "''", "'"), `"`, "")
+				if ddl := fmt.Sprintf("DROP SEQUENCE IF EXISTS %s", g.escapeTableName(name)); !dropped[ddl] {
+					dropped[ddl] = true
+					ddls = append(ddls, ddl)
+				}
+			}
+		}
+	}
+
+	// A domain may be based on a type, e.g. an enum.
+	for _, typ := range types {
+		if typ.domain != nil {
+			ddls = append(ddls, fmt.Sprintf("DROP DOMAIN %s", g.escapeTableName(typ.name)))
+		}
+	}
+	for _, typ := range types {
+		if typ.domain == nil {
+			ddls = append(ddls, fmt.Sprintf("DROP TYPE %s", g.escapeTableName(typ.name)))
+		}
+	}
+	return ddls, nil
+}
+
+// The sequence of a default, e.g. nextval('users_id_seq'::regclass).
+var nextvalPattern = regexp.MustCompile(`(?i)\bnextval\('((?:[^']|'')+)'(?:::regclass)?\)`)
@@ -0,0 +1,38 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateDestroyDDLs(t *testing.T) {
+	sql := "CREATE TYPE public.mood AS ENUM ('happy', 'sad');\n" +
+		"CREATE DOMAIN public.positive AS integer CHECK (VALUE > 0);\n" +
+		"CREATE TABLE public.comments (id bigint PRIMARY KEY, post_id bigint);\n" +
+		"CREATE TABLE public.users (id bigint PRIMARY KEY DEFAULT nextval('users_id_seq'::regclass), mood mood, age positive);\n" +
+		"CREATE TRIGGER users_updated BEFORE UPDATE ON public.users FOR EACH ROW EXECUTE FUNCTION public.set_updated_at();\n" +
+		"CREATE TABLE public.posts (id bigint PRIMARY KEY, user_id bigint, CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users (id));\n" +
+		"ALTER TABLE ONLY public.comments ADD CONSTRAINT comments_post_id_fkey FOREIGN KEY (post_id) REFERENCES public.posts (id);\n" +
+		"CREATE INDEX index_posts_user_id ON public.posts (user_id);\n" +
+		"CREATE VIEW public.user_ids AS SELECT id FROM public.users;\n" +
+		"CREATE VIEW public.first_user_id AS SELECT min(id) FROM public.user_ids;\n"
+	ddls, err := ParseDDLs(GeneratorModePostgres, database.NewParser(parser.ParserModePostgres), sql, "public")
+	assert.NoError(t, err)
+
+	destroyDDLs, err := GenerateDestroyDDLs(GeneratorModePostgres, ddls, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`DROP VIEW "public"."first_user_id"`,
+		`DROP VIEW "public"."user_ids"`,
+		`DROP TABLE "public"."comments"`,
+		`DROP TABLE "public"."posts"`,
+		`DROP TABLE "public"."users"`,
+		`DROP FUNCTION IF EXISTS "public"."set_updated_at"`,
+		`DROP SEQUENCE IF EXISTS "public"."users_id_seq"`,
+		`DROP DOMAIN "public"."positive"`,
+		`DROP TYPE "public"."mood"`,
+	}, destroyDDLs)
+}
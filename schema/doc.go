@@ -0,0 +1,179 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sqldef/sqldef/database"
+)
+
+var mermaidUnsafeChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// GenerateDocument renders the desired schema as Markdown, with a Mermaid ER diagram of tables and foreign keys.
+func GenerateDocument(mode GeneratorMode, sqlParser database.Parser, desiredSQL string, config database.GeneratorConfig, defaultSchema string) (string, error) {
+	ddls, err := ParseDDLs(mode, sqlParser, desiredSQL, defaultSchema)
+	if err != nil {
+		return "", err
+	}
+	ddls = FilterTables(ddls, config)
+
+	tables, views, _, _, comments, _, _, _, _, _, err := aggregateDDLsToSchema(ddls)
+	if err != nil {
+		return "", err
+	}
+	g := Generator{mode: mode, defaultSchema: defaultSchema}
+
+	var doc strings.Builder
+	doc.WriteString("# Schema\n")
+
+	if len(tables) > 0 {
+		doc.WriteString("\n```mermaid\nerDiagram\n")
+		for _, table := range tables {
+			fmt.Fprintf(&doc, "    %s {\n", g.mermaidName(table.name))
+			for _, column := range table.columns {
+				fmt.Fprintf(&doc, "        %s %s", mermaidUnsafeChars.ReplaceAllString(column.typeName, "_"), mermaidUnsafeChars.ReplaceAllString(column.name, "_"))
+				if keys := columnKeys(column, *table); len(keys) > 0 {
+					fmt.Fprintf(&doc, " %s", strings.Join(keys, ", "))
+				}
+				doc.WriteString("\n")
+			}
+			doc.WriteString("    }\n")
+		}
+		for _, table := range tables {
+			for _, foreignKey := range table.foreignKeys {
+				fmt.Fprintf(&doc, "    %s ||--o{ %s : %q\n", g.mermaidName(foreignKey.referenceName), g.mermaidName(table.name), strings.Join(foreignKey.indexColumns, ", "))
+			}
+		}
+		doc.WriteString("```\n")
+	}
+
+	for _, table := range tables {
+		fmt.Fprintf(&doc, "\n## %s\n", g.documentName(table.name))
+		if comment := tableComment(*table, comments); comment != "" {
+			fmt.Fprintf(&doc, "\n%s\n", comment)
+		}
+
+		doc.WriteString("\n| Column | Type | Nullable | Default | Comment |\n")
+		doc.WriteString("| --- | --- | --- | --- | --- |\n")
+		for _, column := range table.columns {
+			nullable := "YES"
+			if g.notNull(column) || isPrimaryKey(column, *table) {
+				nullable = "NO"
+			}
+			var defaultValue string
+			if column.defaultDef != nil {
+				if def, err := g.generateDefaultDefinition(*column.defaultDef); err == nil {
+					defaultValue = strings.TrimPrefix(def, "DEFAULT ")
+				}
+			}
+			fmt.Fprintf(&doc, "| %s | %s | %s | %s | %s |\n",
+				markdownCell(column.name), markdownCell(generateDataType(column)), nullable,
+				markdownCell(defaultValue), markdownCell(columnComment(*table, column, comments)))
+		}
+
+		if len(table.indexes) > 0 {
+			doc.WriteString("\n| Index | Columns | Unique |\n")
+			doc.WriteString("| --- | --- | --- |\n")
+			for _, index := range table.indexes {
+				columns := []string{}
+				for _, indexColumn := range index.columns {
+					columns = append(columns, indexColumn.column)
+				}
+				unique := "NO"
+				if index.unique || index.primary {
+					unique = "YES"
+				}
+				fmt.Fprintf(&doc, "| %s | %s | %s |\n", markdownCell(index.name), markdownCell(strings.Join(columns, ", ")), unique)
+			}
+		}
+
+		if len(table.foreignKeys) > 0 {
+			doc.WriteString("\n| Foreign key | Columns | References |\n")
+			doc.WriteString("| --- | --- | --- |\n")
+			for _, foreignKey := range table.foreignKeys {
+				references := fmt.Sprintf("%s (%s)", g.documentName(foreignKey.referenceName), strings.Join(foreignKey.referenceColumns, ", "))
+				fmt.Fprintf(&doc, "| %s | %s | %s |\n", markdownCell(foreignKey.constraintName), markdownCell(strings.Join(foreignKey.indexColumns, ", ")), markdownCell(references))
+			}
+		}
+	}
+
+	if len(views) > 0 {
+		doc.WriteString("\n## Views\n\n")
+		for _, view := range views {
+			fmt.Fprintf(&doc, "- %s\n", g.documentName(view.name))
+		}
+	}
+
+	return doc.String(), nil
+}
+
+// Omit the default schema so that documents of schema-less databases and Postgres' public schema look alike.
+func (g *Generator) documentName(name string) string {
+	if schema, table := splitTableName(name, g.defaultSchema); schema == g.defaultSchema {
+		return table
+	}
+	return name
+}
+
+func (g *Generator) mermaidName(name string) string {
+	return mermaidUnsafeChars.ReplaceAllString(g.documentName(name), "_")
+}
+
+func columnKeys(column Column, table Table) []string {
+	keys := []string{}
+	if isPrimaryKey(column, table) {
+		keys = append(keys, "PK")
+	}
+	for _, foreignKey := range table.foreignKeys {
+		if containsString(foreignKey.indexColumns, column.name) {
+			keys = append(keys, "FK")
+			break
+		}
+	}
+	if column.keyOption.isUnique() {
+		keys = append(keys, "UK")
+	}
+	return keys
+}
+
+func tableComment(table Table, comments []*Comment) string {
+	if comment, ok := table.options["comment"]; ok {
+		return unquoteComment(comment)
+	}
+	for _, comment := range comments {
+		if (comment.comment.ObjectType == "OBJECT_TABLE" || comment.comment.ObjectType == "TABLE") && sameCommentObject(comment.comment.Object, table.name) {
+			return comment.comment.Comment
+		}
+	}
+	return ""
+}
+
+func columnComment(table Table, column Column, comments []*Comment) string {
+	if column.comment != nil {
+		return column.comment.strVal
+	}
+	for _, comment := range comments {
+		if (comment.comment.ObjectType == "OBJECT_COLUMN" || comment.comment.ObjectType == "COLUMN") && sameCommentObject(comment.comment.Object, table.name+"."+column.name) {
+			return comment.comment.Comment
+		}
+	}
+	return ""
+}
+
+// COMMENT ON may omit the schema of the object, e.g. `users.name` for `public.users.name`.
+func sameCommentObject(object string, name string) bool {
+	return object == name || strings.HasSuffix(name, "."+object)
+}
+
+func unquoteComment(comment string) string {
+	if len(comment) >= 2 && comment[0] == '\'' && comment[len(comment)-1] == '\'' {
+		return strings.ReplaceAll(comment[1:len(comment)-1], "''", "'")
+	}
+	return comment
+}
+
+func markdownCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.ReplaceAll(value, "\n", " ")
+}
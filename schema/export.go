@@ -0,0 +1,365 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+)
+
+// SplitChangedDDLs splits exported DDLs into the ones that are changed or added since the snapshot, i.e. a previous
+// export, and the unchanged ones. DDLs are compared by their statements with whitespace normalized.
+func SplitChangedDDLs(ddls []DDL, snapshotDDLs []DDL) ([]DDL, []DDL) {
+	snapshot := map[string]bool{}
+	for _, ddl := range snapshotDDLs {
+		snapshot[normalizeStatement(ddl.Statement())] = true
+	}
+
+	var changed, unchanged []DDL
+	for _, ddl := range ddls {
+		if snapshot[normalizeStatement(ddl.Statement())] {
+			unchanged = append(unchanged, ddl)
+		} else {
+			changed = append(changed, ddl)
+		}
+	}
+	return changed, unchanged
+}
+
+func normalizeStatement(statement string) string {
+	return strings.Join(strings.Fields(statement), " ")
+}
+
+// DescribeDDL returns the kind and the name of the object defined by the DDL, e.g. "index index_name on users".
+func DescribeDDL(ddl DDL) string {
+	switch stmt := ddl.(type) {
+	case *CreateTable:
+		return fmt.Sprintf("table %s", stmt.table.name)
+	case *CreateIndex:
+		return fmt.Sprintf("index %s on %s", stmt.index.name, stmt.tableName)
+	case *AddIndex:
+		return fmt.Sprintf("index %s on %s", stmt.index.name, stmt.tableName)
+	case *AddPrimaryKey:
+		return fmt.Sprintf("primary key on %s", stmt.tableName)
+	case *AddForeignKey:
+		return fmt.Sprintf("foreign key %s on %s", stmt.foreignKey.constraintName, stmt.tableName)
+	case *AddExclusion:
+		return fmt.Sprintf("exclusion constraint %s on %s", stmt.exclusion.constraintName, stmt.tableName)
+	case *AddPolicy:
+		return fmt.Sprintf("policy %s on %s", stmt.policy.name, stmt.tableName)
+	case *View:
+		return fmt.Sprintf("%s %s", strings.ToLower(stmt.viewType), stmt.name)
+	case *Trigger:
+		return fmt.Sprintf("trigger %s on %s", stmt.name, stmt.tableName)
+	case *Event:
+		return fmt.Sprintf("event %s", stmt.name)
+	case *Type:
+		if stmt.domain != nil {
+			return fmt.Sprintf("domain %s", stmt.name)
+		}
+		return fmt.Sprintf("type %s", stmt.name)
+	case *AddDomainConstraint:
+		return fmt.Sprintf("constraint %s on %s", stmt.check.constraintName, stmt.typeName)
+	case *Comment:
+		switch objectType := commentObjectType(stmt.comment); objectType {
+		case "TABLE", "COLUMN":
+			return fmt.Sprintf("comment on %s", stmt.comment.Object)
+		case "TABCONSTRAINT":
+			return fmt.Sprintf("comment on constraint %s", stmt.comment.Object)
+		default:
+			return fmt.Sprintf("comment on %s %s", strings.ToLower(objectType), stmt.comment.Object)
+		}
+	case *ClusterOn:
+		return fmt.Sprintf("cluster of %s", stmt.tableName)
+	case *Owner:
+		return fmt.Sprintf("owner of %s", stmt.tableName)
+	case *Extension:
+		return fmt.Sprintf("extension %s", stmt.extension.Name)
+	case *Schema:
+		return fmt.Sprintf("schema %s", stmt.schema.Name)
+	case *Publication:
+		return fmt.Sprintf("publication %s", stmt.name)
+	case *Role:
+		return fmt.Sprintf("role %s", stmt.role.Name)
+	case *Grant:
+		return fmt.Sprintf("grant on %s to %s", strings.Join(stmt.tables, ", "), strings.Join(stmt.grantees, ", "))
+	default:
+		return normalizeStatement(ddl.Statement())
+	}
+}
+
+// SortTablesByDependencies returns the DDLs with each CREATE TABLE moved before the first CREATE TABLE that refers to
+// it by a foreign key or inherits it, so that the DDLs can be run in order on an empty database. The other DDLs keep
+// their positions, which are still after the tables they depend on. Tables in a cycle of foreign keys are kept in the
+// given order.
+func SortTablesByDependencies(ddls []DDL) []DDL {
+	tables := map[string]*CreateTable{}
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*CreateTable); ok {
+			tables[stmt.table.name] = stmt
+		}
+	}
+
+	var result []DDL
+	added := map[string]bool{}
+	var addTable func(stmt *CreateTable)
+	addTable = func(stmt *CreateTable) {
+		if added[stmt.table.name] {
+			return
+		}
+		added[stmt.table.name] = true
+		for _, foreignKey := range stmt.table.foreignKeys {
+			if referenced, ok := tables[foreignKey.referenceName]; ok {
+				addTable(referenced)
+			}
+		}
+		for _, parent := range stmt.table.inherits {
+			if inherited, ok := tables[parent]; ok {
+				addTable(inherited)
+			}
+		}
+		result = append(result, stmt)
+	}
+
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*CreateTable); ok {
+			addTable(stmt)
+		} else {
+			result = append(result, ddl)
+		}
+	}
+	return result
+}
+
+// Keywords that can start a column constraint, before which NOT NULL is inserted by ExplicitNotNull.
+var columnConstraintKeywords = map[string]bool{
+	"primary": true, "unique": true, "references": true, "default": true, "check": true, "constraint": true,
+	"collate": true, "generated": true, "identity": true, "auto_increment": true, "comment": true,
+}
+
+// ExplicitNotNull returns the statement of the DDL with NOT NULL written on the columns of its primary key, which imply
+// NOT NULL without it, for export_explicit_not_null. The statements of the other DDLs are returned as is.
+func ExplicitNotNull(ddl DDL) string {
+	stmt, ok := ddl.(*CreateTable)
+	if !ok {
+		return ddl.Statement()
+	}
+	primaryKey := stmt.table.PrimaryKey()
+	if primaryKey == nil {
+		return stmt.statement
+	}
+	implicitColumns := map[string]bool{}
+	for _, indexColumn := range primaryKey.columns {
+		if column := findColumnByName(stmt.table.columns, indexColumn.column); column != nil && column.notNull == nil {
+			implicitColumns[strings.ToLower(column.name)] = true
+		}
+	}
+	if len(implicitColumns) == 0 {
+		return stmt.statement
+	}
+
+	// Insert NOT NULL into the column definitions, which are separated by the commas in the outermost parentheses.
+	statement := stmt.statement
+	var result strings.Builder
+	start := strings.IndexByte(statement, '(') + 1
+	if start == 0 {
+		return statement
+	}
+	result.WriteString(statement[:start])
+	depth := 0
+	for i := start; i < len(statement); i++ {
+		switch statement[i] {
+		case '\'', '"', '`':
+			i = scanQuoted(statement, i, statement[i]) - 1
+		case '(':
+			depth++
+		case ')', ',':
+			if statement[i] == ')' && depth > 0 {
+				depth--
+				continue
+			}
+			if depth > 0 {
+				continue
+			}
+			result.WriteString(explicitNotNullColumn(statement[start:i], implicitColumns))
+			if statement[i] == ')' {
+				result.WriteString(statement[i:])
+				return result.String()
+			}
+			result.WriteByte(',')
+			start = i + 1
+		}
+	}
+	return statement
+}
+
+// Insert NOT NULL into the column definition if the column is one of the columns, e.g. "id integer PRIMARY KEY" to
+// "id integer NOT NULL PRIMARY KEY".
+func explicitNotNullColumn(definition string, columns map[string]bool) string {
+	trimmed := strings.TrimLeft(definition, " \t\r\n")
+	offset := len(definition) - len(trimmed)
+	if trimmed == "" {
+		return definition
+	}
+
+	var name string
+	var i int
+	switch trimmed[0] {
+	case '"', '`':
+		i = scanQuoted(trimmed, 0, trimmed[0])
+		name = trimmed[1 : i-1]
+	case '[':
+		i = strings.IndexByte(trimmed, ']') + 1
+		if i == 0 {
+			return definition
+		}
+		name = trimmed[1 : i-1]
+	default:
+		for i < len(trimmed) && isIdentifierPart(trimmed[i]) {
+			i++
+		}
+		name = trimmed[:i]
+	}
+	if !columns[strings.ToLower(name)] {
+		return definition
+	}
+
+	depth := 0
+	for i < len(trimmed) {
+		c := trimmed[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			i = scanQuoted(trimmed, i, c)
+		case c == '(':
+			depth++
+			i++
+		case c == ')':
+			depth--
+			i++
+		case isIdentifierStart(c):
+			end := i
+			for end < len(trimmed) && isIdentifierPart(trimmed[end]) {
+				end++
+			}
+			if depth == 0 && columnConstraintKeywords[strings.ToLower(trimmed[i:end])] {
+				return definition[:offset+i] + "NOT NULL " + definition[offset+i:]
+			}
+			i = end
+		default:
+			i++
+		}
+	}
+	body := strings.TrimRight(definition, " \t\r\n")
+	return body + " NOT NULL" + definition[len(body):]
+}
+
+// Table options removed by export_strip_auto_increment, e.g. AUTO_INCREMENT=12345, which differs by environment.
+var autoIncrementTableOption = regexp.MustCompile(`(?i),?\s+AUTO_INCREMENT\s*=?\s*\d+\b`)
+
+// Table options removed by export_canonicalize_defaults, which only restate the defaults of MySQL.
+var defaultTableOptions = regexp.MustCompile(`(?i),?\s+(ENGINE\s*=?\s*InnoDB|ROW_FORMAT\s*=?\s*(DEFAULT|DYNAMIC))\b`)
+
+// NormalizeExport returns the statement of the DDL without the environment-specific table options that the config
+// strips, so that --export writes the same statement for the same table in every environment.
+func NormalizeExport(ddl DDL, statement string, config database.GeneratorConfig) string {
+	if _, ok := ddl.(*CreateTable); !ok {
+		return statement
+	}
+	if config.StripAutoIncrement {
+		statement = removeTableOptions(statement, autoIncrementTableOption)
+	}
+	if config.CanonicalizeDefaults {
+		statement = removeTableOptions(statement, defaultTableOptions)
+	}
+	return statement
+}
+
+// StripDefiners returns the SQL without DEFINER clauses, which depend on the user that created the objects.
+// SQL SECURITY DEFINER is kept.
+func StripDefiners(sql string) string {
+	return parser.DefinerClause.ReplaceAllString(sql, "")
+}
+
+// OverrideDefiners returns the SQL with every DEFINER clause replaced by the definer of override_definer, e.g. app@%,
+// so that objects created by different users in different environments are compared and exported as the same.
+func OverrideDefiners(sql string, definer string) string {
+	if !strings.EqualFold(definer, "CURRENT_USER") && !strings.HasPrefix(definer, "`") {
+		if i := strings.LastIndex(definer, "@"); i >= 0 {
+			definer = fmt.Sprintf("`%s`@`%s`", definer[:i], definer[i+1:])
+		} else {
+			definer = fmt.Sprintf("`%s`", definer)
+		}
+	}
+	return parser.DefinerClause.ReplaceAllLiteralString(sql, "DEFINER="+definer+" ")
+}
+
+// Remove the table options matched by the pattern from a CREATE TABLE statement. The column definitions and quoted
+// strings after them, e.g. COMMENT 'AUTO_INCREMENT=1', are kept as is.
+func removeTableOptions(statement string, pattern *regexp.Regexp) string {
+	start := strings.IndexByte(statement, '(')
+	if start < 0 {
+		return statement
+	}
+	depth := 0
+	end := -1
+	for i := start; i < len(statement) && end < 0; i++ {
+		switch statement[i] {
+		case '\'', '"', '`':
+			i = scanQuoted(statement, i, statement[i]) - 1
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				end = i + 1
+			}
+		}
+	}
+	if end < 0 {
+		return statement
+	}
+
+	var result strings.Builder
+	result.WriteString(statement[:end])
+	options := statement[end:]
+	for len(options) > 0 {
+		quote := strings.IndexAny(options, "'\"`")
+		if quote < 0 {
+			result.WriteString(pattern.ReplaceAllString(options, ""))
+			break
+		}
+		closing := scanQuoted(options, quote, options[quote])
+		result.WriteString(pattern.ReplaceAllString(options[:quote], ""))
+		result.WriteString(options[quote:closing])
+		options = options[closing:]
+	}
+	return result.String()
+}
+
+// Return the index next to the closing quote of the quoted string starting at start. A doubled quote and, for
+// MySQL, a backslash escape the quote.
+func scanQuoted(str string, start int, quote byte) int {
+	for i := start + 1; i < len(str); i++ {
+		switch {
+		case str[i] == '\\' && quote == '\'':
+			i++
+		case str[i] == quote:
+			if i+1 < len(str) && str[i+1] == quote {
+				i++
+			} else {
+				return i + 1
+			}
+		}
+	}
+	return len(str)
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || c == '$' || (c >= '0' && c <= '9')
+}
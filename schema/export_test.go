@@ -0,0 +1,82 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortTablesByDependencies(t *testing.T) {
+	sql := "CREATE TABLE posts (id integer, user_id integer, FOREIGN KEY (user_id) REFERENCES users (id));\n" +
+		"CREATE INDEX index_posts_user_id ON posts (user_id);\n" +
+		"CREATE TABLE comments (id integer, post_id integer, FOREIGN KEY (post_id) REFERENCES posts (id));\n" +
+		"CREATE TABLE users (id integer PRIMARY KEY);\n"
+	ddls, err := ParseDDLs(GeneratorModeSQLite3, database.NewParser(parser.ParserModeSQLite3), sql, "")
+	assert.NoError(t, err)
+
+	var names []string
+	for _, ddl := range SortTablesByDependencies(ddls) {
+		names = append(names, DescribeDDL(ddl))
+	}
+	assert.Equal(t, []string{
+		DescribeDDL(ddls[3]),
+		DescribeDDL(ddls[0]),
+		DescribeDDL(ddls[1]),
+		DescribeDDL(ddls[2]),
+	}, names)
+}
+
+func TestSortTablesByInheritance(t *testing.T) {
+	sql := "CREATE TABLE public.capitals (state text) INHERITS (public.cities);\n" +
+		"CREATE TABLE public.cities (name text);\n"
+	ddls, err := ParseDDLs(GeneratorModePostgres, database.NewParser(parser.ParserModePostgres), sql, "public")
+	assert.NoError(t, err)
+
+	var names []string
+	for _, ddl := range SortTablesByDependencies(ddls) {
+		names = append(names, DescribeDDL(ddl))
+	}
+	assert.Equal(t, []string{DescribeDDL(ddls[1]), DescribeDDL(ddls[0])}, names)
+}
+
+func TestNormalizeExport(t *testing.T) {
+	sql := "CREATE TABLE `users` (\n" +
+		"  `id` bigint NOT NULL AUTO_INCREMENT,\n" +
+		"  PRIMARY KEY (`id`)\n" +
+		") ENGINE=InnoDB AUTO_INCREMENT=12345 DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='AUTO_INCREMENT=1'"
+	ddls, err := ParseDDLs(GeneratorModeMysql, database.NewParser(parser.ParserModeMysql), sql, "")
+	assert.NoError(t, err)
+
+	assert.Equal(t, sql, NormalizeExport(ddls[0], ddls[0].Statement(), database.GeneratorConfig{}))
+	assert.Equal(t, "CREATE TABLE `users` (\n"+
+		"  `id` bigint NOT NULL AUTO_INCREMENT,\n"+
+		"  PRIMARY KEY (`id`)\n"+
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 ROW_FORMAT=DYNAMIC COMMENT='AUTO_INCREMENT=1'",
+		NormalizeExport(ddls[0], ddls[0].Statement(), database.GeneratorConfig{StripAutoIncrement: true}))
+	assert.Equal(t, "CREATE TABLE `users` (\n"+
+		"  `id` bigint NOT NULL AUTO_INCREMENT,\n"+
+		"  PRIMARY KEY (`id`)\n"+
+		") DEFAULT CHARSET=utf8mb4 COMMENT='AUTO_INCREMENT=1'",
+		NormalizeExport(ddls[0], ddls[0].Statement(), database.GeneratorConfig{StripAutoIncrement: true, CanonicalizeDefaults: true}))
+}
+
+func TestStripDefiners(t *testing.T) {
+	assert.Equal(t,
+		"CREATE SQL SECURITY DEFINER VIEW v AS SELECT 1;\nCREATE TRIGGER t BEFORE INSERT ON users FOR EACH ROW SET NEW.id = 1;",
+		StripDefiners("CREATE DEFINER=`root`@`%` SQL SECURITY DEFINER VIEW v AS SELECT 1;\n"+
+			"CREATE DEFINER = 'app'@'10.0.0.%' TRIGGER t BEFORE INSERT ON users FOR EACH ROW SET NEW.id = 1;"))
+	assert.Equal(t, "CREATE EVENT e ON SCHEDULE EVERY 1 DAY DO SELECT 1;",
+		StripDefiners("CREATE DEFINER=CURRENT_USER() EVENT e ON SCHEDULE EVERY 1 DAY DO SELECT 1;"))
+}
+
+func TestOverrideDefiners(t *testing.T) {
+	assert.Equal(t,
+		"CREATE DEFINER=`app`@`%` SQL SECURITY DEFINER VIEW v AS SELECT 1;\nCREATE DEFINER=`app`@`%` TRIGGER t BEFORE INSERT ON users FOR EACH ROW SET NEW.id = 1;",
+		OverrideDefiners("CREATE DEFINER=`root`@`%` SQL SECURITY DEFINER VIEW v AS SELECT 1;\n"+
+			"CREATE DEFINER = 'admin'@'10.0.0.%' TRIGGER t BEFORE INSERT ON users FOR EACH ROW SET NEW.id = 1;", "app@%"))
+	assert.Equal(t, "CREATE DEFINER=`app`@`localhost` EVENT e ON SCHEDULE EVERY 1 DAY DO SELECT 1;",
+		OverrideDefiners("CREATE DEFINER=CURRENT_USER() EVENT e ON SCHEDULE EVERY 1 DAY DO SELECT 1;", "`app`@`localhost`"))
+	assert.Equal(t, "CREATE VIEW v AS SELECT 1;", OverrideDefiners("CREATE VIEW v AS SELECT 1;", "app@%"))
+}
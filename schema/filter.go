@@ -0,0 +1,120 @@
+package schema
+
+import (
+	"regexp"
+	"strings"
+)
+
+// A possibly qualified and quoted name, e.g. "public"."users", `users`, or [dbo].[users]
+const qualifiedNamePattern = "((?:\"[^\"]*\"|`[^`]*`|\\[[^\\]]*\\]|[\\w$]+)(?:\\s*\\.\\s*(?:\"[^\"]*\"|`[^`]*`|\\[[^\\]]*\\]|[\\w$]+))*)"
+
+// Patterns to find the table that a generated DDL touches. The column of COMMENT ON COLUMN is stripped later.
+var ddlTablePatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?is)^(?:CREATE|ALTER|DROP) TABLE (?:IF (?:NOT )?EXISTS )?(?:ONLY )?` + qualifiedNamePattern),
+	regexp.MustCompile(`(?is)^CREATE (?:UNIQUE )?(?:CLUSTERED |NONCLUSTERED )?(?:COLUMNSTORE )?INDEX .*? ON (?:ONLY )?` + qualifiedNamePattern),
+	regexp.MustCompile(`(?is)^(?:CREATE (?:OR (?:REPLACE|ALTER) )?(?:CONSTRAINT )?|DROP |ALTER )(?:TRIGGER|POLICY) .*? ON ` + qualifiedNamePattern),
+	regexp.MustCompile(`(?is)^DROP INDEX .*? ON ` + qualifiedNamePattern),
+	regexp.MustCompile(`(?is)^COMMENT ON (?:TABLE|COLUMN) ` + qualifiedNamePattern),
+}
+
+// An index touched without its table, e.g. DROP INDEX in PostgreSQL, whose table is looked up in the current schema
+var ddlIndexPattern = regexp.MustCompile(`(?is)^(?:DROP|ALTER) INDEX (?:CONCURRENTLY )?(?:IF EXISTS )?` + qualifiedNamePattern)
+
+// Patterns to find the object other than a table that a generated DDL touches, e.g. CREATE OR REPLACE VIEW
+var ddlObjectPattern = regexp.MustCompile(`(?is)^(?:CREATE|ALTER|DROP) (?:OR (?:REPLACE|ALTER) )?` +
+	`((?:MATERIALIZED )?VIEW|FUNCTION|PROCEDURE|TYPE|DOMAIN|SEQUENCE|EXTENSION|SCHEMA|EVENT|TRIGGER|POLICY|PUBLICATION|INDEX) ` +
+	`(?:CONCURRENTLY )?(?:IF (?:NOT )?EXISTS )?` + qualifiedNamePattern)
+
+// FilterDDLsByTables returns the generated DDLs that touch the tables matching one of the patterns, for --only-table.
+// A pattern is matched against both the qualified name of a table and the name without its schema. DDLs that don't
+// touch a table, e.g. CREATE VIEW, are filtered out as well.
+func FilterDDLsByTables(ddls []string, patterns []string, currentDDLs []DDL) []string {
+	indexTables := indexTablesOf(currentDDLs)
+	filtered := []string{}
+	for _, ddl := range ddls {
+		table := ddlTableName(strings.TrimSpace(ddl), indexTables)
+		if table == "" {
+			continue
+		}
+		bareTable := table[strings.LastIndex(table, ".")+1:]
+		if containsRegexpString(patterns, table) || containsRegexpString(patterns, bareTable) {
+			filtered = append(filtered, ddl)
+		}
+	}
+	return filtered
+}
+
+// DDLObject returns the kind and the unquoted name of the object that a generated DDL touches, e.g. "table" and
+// "public.users", for grouping DDLs by --pretty. A DDL touching a table, e.g. CREATE INDEX, is of the table.
+// Both are "" if it's unknown.
+func DDLObject(ddl string, currentDDLs []DDL) (string, string) {
+	ddl = strings.TrimSpace(ddl)
+	if table := ddlTableName(ddl, indexTablesOf(currentDDLs)); table != "" {
+		return "table", table
+	}
+	if match := ddlObjectPattern.FindStringSubmatch(ddl); match != nil {
+		return strings.ToLower(match[1]), strings.Join(splitQualifiedName(match[2]), ".")
+	}
+	return "", ""
+}
+
+// Return the map from the lowercased names of the indexes to the names of their tables.
+func indexTablesOf(currentDDLs []DDL) map[string]string {
+	indexTables := map[string]string{}
+	for _, ddl := range currentDDLs {
+		switch stmt := ddl.(type) {
+		case *CreateTable:
+			for _, index := range stmt.table.indexes {
+				indexTables[strings.ToLower(index.name)] = stmt.table.name
+			}
+		case *CreateIndex:
+			indexTables[strings.ToLower(stmt.index.name)] = stmt.tableName
+		case *AddIndex:
+			indexTables[strings.ToLower(stmt.index.name)] = stmt.tableName
+		}
+	}
+	return indexTables
+}
+
+// Return the unquoted name of the table that the DDL touches, or "" if it's unknown.
+func ddlTableName(ddl string, indexTables map[string]string) string {
+	for _, pattern := range ddlTablePatterns {
+		if match := pattern.FindStringSubmatch(ddl); match != nil {
+			names := splitQualifiedName(match[1])
+			if strings.HasPrefix(strings.ToUpper(ddl), "COMMENT ON COLUMN ") && len(names) > 1 {
+				names = names[:len(names)-1]
+			}
+			return strings.Join(names, ".")
+		}
+	}
+	if match := ddlIndexPattern.FindStringSubmatch(ddl); match != nil {
+		names := splitQualifiedName(match[1])
+		return indexTables[strings.ToLower(names[len(names)-1])]
+	}
+	return ""
+}
+
+// Split a qualified name into its unquoted parts, e.g. "public"."users" into public and users.
+func splitQualifiedName(name string) []string {
+	var names []string
+	for len(name) > 0 {
+		var part string
+		switch name[0] {
+		case '"', '`':
+			end := strings.IndexByte(name[1:], name[0]) + 1
+			part, name = name[1:end], name[end+1:]
+		case '[':
+			end := strings.IndexByte(name, ']')
+			part, name = name[1:end], name[end+1:]
+		default:
+			end := strings.IndexByte(name, '.')
+			if end < 0 {
+				end = len(name)
+			}
+			part, name = strings.TrimSpace(name[:end]), name[end:]
+		}
+		names = append(names, part)
+		name = strings.TrimLeft(name, " \t\r\n.")
+	}
+	return names
+}
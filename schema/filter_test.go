@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterDDLsByTables(t *testing.T) {
+	current, err := ParseDDLs(GeneratorModePostgres, database.NewParser(parser.ParserModePostgres),
+		"CREATE TABLE public.users (id integer, name text);\n"+
+			"CREATE INDEX index_users_name ON public.users (name);\n"+
+			"CREATE TABLE public.posts (id integer);\n", "public")
+	assert.NoError(t, err)
+
+	ddls := []string{
+		`ALTER TABLE "public"."users" ADD COLUMN "age" integer`,
+		`DROP INDEX "public"."index_users_name"`,
+		`COMMENT ON COLUMN "public"."users"."age" IS 'years'`,
+		`CREATE INDEX "index_users_age" ON "public"."users" ("age")`,
+		`CREATE TRIGGER "users_audit" AFTER INSERT ON "public"."users" FOR EACH ROW EXECUTE FUNCTION audit()`,
+		`ALTER TABLE "public"."posts" ADD COLUMN "title" text`,
+		`CREATE TABLE "public"."users_archive" (id integer)`,
+		`CREATE VIEW "public"."user_names" AS SELECT name FROM users`,
+	}
+	assert.Equal(t, ddls[:5], FilterDDLsByTables(ddls, []string{"users"}, current))
+	assert.Equal(t, []string{ddls[0], ddls[1], ddls[2], ddls[3], ddls[4], ddls[6]}, FilterDDLsByTables(ddls, []string{"public.users.*"}, current))
+	assert.Equal(t, []string{ddls[5]}, FilterDDLsByTables(ddls, []string{"posts"}, current))
+
+	mysqlDDLs := []string{
+		"ALTER TABLE `users` DROP INDEX `index_name`",
+		"CREATE TABLE `posts` (\n`id` int\n)",
+		"DROP TABLE `comments`",
+	}
+	assert.Equal(t, mysqlDDLs[1:], FilterDDLsByTables(mysqlDDLs, []string{"posts", "comments"}, nil))
+}
+
+func TestDDLObject(t *testing.T) {
+	current, err := ParseDDLs(GeneratorModePostgres, database.NewParser(parser.ParserModePostgres),
+		"CREATE TABLE public.users (id integer, name text);\n"+
+			"CREATE INDEX index_users_name ON public.users (name);\n", "public")
+	assert.NoError(t, err)
+
+	for ddl, expected := range map[string][2]string{
+		`ALTER TABLE "public"."users" ADD COLUMN "age" integer`:                  {"table", "public.users"},
+		`DROP INDEX "public"."index_users_name"`:                                 {"table", "public.users"},
+		`CREATE OR REPLACE VIEW "public"."user_names" AS SELECT name FROM users`: {"view", "public.user_names"},
+		`DROP MATERIALIZED VIEW "public"."user_counts"`:                          {"materialized view", "public.user_counts"},
+		`CREATE FUNCTION public.add(a integer) RETURNS integer AS 'select a'`:    {"function", "public.add"},
+		`GRANT SELECT ON "public"."users" TO "readonly"`:                         {"", ""},
+	} {
+		kind, name := DDLObject(ddl, current)
+		assert.Equal(t, expected, [2]string{kind, name}, ddl)
+	}
+}
@@ -0,0 +1,323 @@
+package schema
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/sqldef/sqldef/parser"
+)
+
+// FingerprintDDLs returns the statements of the DDLs with their identifiers and string literals consistently
+// pseudonymized, e.g. table1, col1, and 'str1', and comments replaced or removed, so that a schema can be shared to
+// reproduce an issue without its business names and values. Types, constraints, and the structure are kept as is.
+// Identifiers that are SQL keywords or data types are not pseudonymized since they can't be told from the syntax.
+func FingerprintDDLs(mode GeneratorMode, ddls []DDL, statements []string, defaultSchema string) []string {
+	f := newFingerprinter(mode, ddls, defaultSchema)
+
+	var result []string
+	for _, statement := range statements {
+		result = append(result, f.pseudonymize(statement))
+	}
+	return result
+}
+
+type fingerprinter struct {
+	mode          parser.ParserMode
+	defaultSchema string
+	reserved      map[string]bool   // lowercased words that are never pseudonymized
+	pseudonyms    map[string]string // lowercased identifier -> pseudonym
+	literals      map[string]string // value of a string literal -> pseudonym
+	types         map[string]bool   // lowercased unqualified names of the types defined by the DDLs
+	counts        map[string]int    // kind -> number of pseudonyms
+}
+
+func newFingerprinter(mode GeneratorMode, ddls []DDL, defaultSchema string) *fingerprinter {
+	f := &fingerprinter{
+		mode:          parserModeOf(mode),
+		defaultSchema: defaultSchema,
+		reserved:      map[string]bool{},
+		pseudonyms:    map[string]string{},
+		literals:      map[string]string{},
+		types:         map[string]bool{},
+		counts:        map[string]int{},
+	}
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*CreateTable); ok {
+			for _, column := range stmt.table.columns {
+				for _, word := range strings.Fields(column.typeName) {
+					f.reserved[strings.ToLower(word)] = true
+				}
+			}
+		}
+	}
+
+	// Tables are named first so that the other objects can't take their names.
+	for _, ddl := range ddls {
+		if stmt, ok := ddl.(*CreateTable); ok {
+			f.addQualified("table", stmt.table.name)
+		}
+	}
+	for _, ddl := range ddls {
+		switch stmt := ddl.(type) {
+		case *CreateTable:
+			f.addTable(stmt.table)
+		case *CreateIndex:
+			f.add("index", stmt.index.name)
+		case *AddIndex:
+			f.add("index", stmt.index.name)
+		case *AddForeignKey:
+			f.add("constraint", stmt.foreignKey.constraintName)
+		case *AddExclusion:
+			f.add("constraint", stmt.exclusion.constraintName)
+		case *AddPolicy:
+			f.add("policy", stmt.policy.name)
+			f.addRoles(stmt.policy.roles)
+		case *View:
+			f.addQualified("view", stmt.name)
+			for _, index := range stmt.indexes {
+				f.add("index", index.name)
+			}
+			f.addRoles([]string{stmt.owner})
+		case *Trigger:
+			f.add("trigger", stmt.name)
+			for _, line := range stmt.body {
+				// The function of EXECUTE FUNCTION of PostgreSQL, e.g. "EXECUTE FUNCTION public.set_updated_at()"
+				if function, ok := strings.CutPrefix(line, "EXECUTE FUNCTION "); ok {
+					name, _, _ := strings.Cut(function, "(")
+					f.addQualified("function", name)
+				}
+			}
+		case *Event:
+			f.add("event", stmt.name)
+		case *Type:
+			_, typeName := splitTableName(stmt.name, "")
+			f.types[strings.ToLower(strings.Trim(typeName, "\"`[]"))] = true
+			f.addQualified("type", stmt.name)
+			for _, attribute := range stmt.attributes {
+				f.add("col", attribute.name)
+			}
+			if stmt.domain != nil {
+				for _, check := range stmt.domain.checks {
+					f.add("constraint", check.constraintName)
+				}
+			}
+		case *AddDomainConstraint:
+			f.add("constraint", stmt.check.constraintName)
+		case *Comment:
+			// The object of FUNCTION has its argument types, e.g. "public.f(int4, text)".
+			if commentObjectType(stmt.comment) == "FUNCTION" {
+				name, _, _ := strings.Cut(stmt.comment.Object, "(")
+				f.addQualified("function", name)
+			}
+		case *Owner:
+			f.addRoles([]string{stmt.owner})
+		case *Publication:
+			f.add("publication", stmt.name)
+		case *Role:
+			f.addRoles([]string{stmt.role.Name})
+		case *Grant:
+			f.addRoles(stmt.grantees)
+		case *Schema:
+			f.addSchema(stmt.schema.Name)
+		}
+	}
+	return f
+}
+
+func (f *fingerprinter) addTable(table Table) {
+	for _, column := range table.columns {
+		f.add("col", column.name)
+		if column.check != nil {
+			f.add("constraint", column.check.constraintName)
+		}
+		if column.sequence != nil {
+			f.addQualified("seq", column.sequence.Name)
+		}
+	}
+	for _, index := range table.indexes {
+		f.add("index", index.name)
+	}
+	for _, check := range table.checks {
+		f.add("constraint", check.constraintName)
+	}
+	for _, foreignKey := range table.foreignKeys {
+		f.add("constraint", foreignKey.constraintName)
+		f.add("index", foreignKey.indexName)
+	}
+	for _, exclusion := range table.exclusions {
+		f.add("constraint", exclusion.constraintName)
+	}
+	for _, policy := range table.policies {
+		f.add("policy", policy.name)
+		f.addRoles(policy.roles)
+	}
+}
+
+// Add a possibly schema-qualified name, e.g. "public.users".
+func (f *fingerprinter) addQualified(kind string, name string) {
+	if schema, unqualified, ok := strings.Cut(name, "."); ok {
+		f.addSchema(strings.Trim(schema, "\"`[]"))
+		name = unqualified
+	}
+	f.add(kind, strings.Trim(name, "\"`[]"))
+}
+
+// The default schema is kept since it's not a business name, e.g. "public" or "dbo".
+func (f *fingerprinter) addSchema(schema string) {
+	if schema != f.defaultSchema {
+		f.add("schema", schema)
+	}
+}
+
+// PUBLIC and the roles of the session, e.g. CURRENT_USER, are kept since they're not business names.
+func (f *fingerprinter) addRoles(roles []string) {
+	for _, role := range roles {
+		switch strings.ToLower(role) {
+		case "public", "current_user", "current_role", "session_user":
+		default:
+			f.add("role", role)
+		}
+	}
+}
+
+func (f *fingerprinter) add(kind string, name string) {
+	key := strings.ToLower(name)
+	if key == "" || f.reserved[key] || parser.IsKeyword(key) {
+		return
+	}
+	if _, ok := f.pseudonyms[key]; ok {
+		return
+	}
+	f.counts[kind]++
+	f.pseudonyms[key] = fmt.Sprintf("%s%d", kind, f.counts[kind])
+}
+
+// Replace the identifier tokens of the statement with their pseudonyms, the string literals of comments with 'comment',
+// the sequence names of nextval('...') with theirs, and the other string literals with theirs as pseudonymizeLiteral
+// does. The comments of SQL are removed.
+func (f *fingerprinter) pseudonymize(statement string) string {
+	var result strings.Builder
+	tokenizer := parser.NewTokenizer(statement, f.mode)
+	var lastWords [2]string // the last two tokens, e.g. "nextval" and "("
+	previousEnd := 0
+	for {
+		typ, val := tokenizer.Scan()
+		if typ == 0 {
+			break
+		}
+		// The tokenizer reads a character ahead, and skips the blanks before a token.
+		end := min(tokenizer.Position-1, len(statement))
+		if end <= previousEnd {
+			continue
+		}
+		start := previousEnd
+		for start < end && strings.IndexByte(" \n\r\t", statement[start]) >= 0 {
+			start++
+		}
+		result.WriteString(statement[previousEnd:start])
+		raw := statement[start:end]
+		previousEnd = end
+
+		switch typ {
+		case parser.ID:
+			// A prefix of a string literal, e.g. E'...', is not an identifier.
+			if pseudonym, ok := f.pseudonyms[strings.ToLower(string(val))]; ok && !strings.HasPrefix(statement[end:], "'") {
+				switch raw[0] {
+				case '"', '`':
+					raw = string(raw[0]) + pseudonym + string(raw[0])
+				case '[':
+					raw = "[" + pseudonym + "]"
+				default:
+					raw = pseudonym
+				}
+			}
+		case parser.STRING, parser.UNICODE_STRING:
+			switch {
+			case lastWords[1] == "comment" || lastWords[1] == "is":
+				// Keep the prefix of the literal, e.g. N of N'...' of SQL Server.
+				raw = raw[:strings.IndexAny(raw, "'\"")] + "'comment'"
+			case lastWords[1] == "(" && (lastWords[0] == "nextval" || lastWords[0] == "currval" || lastWords[0] == "setval"):
+				raw = "'" + f.pseudonymizeSequence(string(val)) + "'"
+			default:
+				raw = f.pseudonymizeLiteral(raw, string(val), statement[end:])
+			}
+		case parser.COMMENT:
+			raw = ""
+			if strings.HasSuffix(string(val), "\n") {
+				raw = "\n"
+			}
+		}
+		result.WriteString(raw)
+
+		// A MySQL table option, e.g. COMMENT = '...', has the comment after "=".
+		if typ != '=' {
+			lastWords[0], lastWords[1] = lastWords[1], strings.ToLower(string(val))
+			if val == nil && typ < 256 {
+				lastWords[1] = string(rune(typ))
+			}
+		}
+	}
+	result.WriteString(statement[previousEnd:])
+	return result.String()
+}
+
+// The type of a cast following a string literal, e.g. "jsonb" of '{}'::jsonb
+var literalCastPattern = regexp.MustCompile(`^\s*::\s*((?:"[^"]*"|\w+)(?:\.(?:"[^"]*"|\w+))?)`)
+
+// Pseudonymize the string literal whose value is value, followed by rest, e.g. 'active' of DEFAULT, CHECK, and enum
+// labels. The same value always gets the same pseudonym, e.g. 'str1', so that a CHECK keeps matching a DEFAULT. Values
+// without letters, e.g. '0' and '{}', and the values cast to built-in types other than strings, e.g. '1 day'::interval,
+// are kept since they're not business values and the pseudonyms would be invalid for the types. A name cast to regclass
+// is pseudonymized like an identifier.
+func (f *fingerprinter) pseudonymizeLiteral(raw string, value string, rest string) string {
+	quoteIndex := strings.IndexAny(raw, "'\"")
+	prefix, quote := raw[:quoteIndex], string(raw[quoteIndex]) // e.g. N of N'...' of SQL Server
+	if match := literalCastPattern.FindStringSubmatch(rest); match != nil {
+		_, typeName := splitTableName(match[1], "")
+		typeName = strings.ToLower(strings.Trim(typeName, "\""))
+		switch typeName {
+		case "regclass":
+			return prefix + quote + f.pseudonymizeQualifiedName(value) + quote
+		case "text", "varchar", "character", "char", "bpchar", "name", "citext":
+		default:
+			if !f.types[typeName] {
+				return raw
+			}
+		}
+	}
+	if strings.IndexFunc(value, unicode.IsLetter) < 0 {
+		return raw
+	}
+
+	pseudonym, ok := f.literals[value]
+	if !ok {
+		f.counts["str"]++
+		pseudonym = fmt.Sprintf("str%d", f.counts["str"])
+		f.literals[value] = pseudonym
+	}
+	return prefix + quote + pseudonym + quote
+}
+
+// Pseudonymize the possibly schema-qualified sequence name in the literal of nextval('...'::regclass), whose parts
+// may be quoted, e.g. 'public."Users_id_seq"'.
+func (f *fingerprinter) pseudonymizeSequence(name string) string {
+	f.addQualified("seq", name)
+	return f.pseudonymizeQualifiedName(name)
+}
+
+// Replace the parts of the possibly schema-qualified name with their pseudonyms, keeping the parts without one.
+func (f *fingerprinter) pseudonymizeQualifiedName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		quoted := strings.HasPrefix(part, "\"")
+		if pseudonym, ok := f.pseudonyms[strings.ToLower(strings.Trim(part, "\""))]; ok {
+			if quoted {
+				pseudonym = "\"" + pseudonym + "\""
+			}
+			parts[i] = pseudonym
+		}
+	}
+	return strings.Join(parts, ".")
+}
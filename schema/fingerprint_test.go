@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func fingerprint(t *testing.T, mode GeneratorMode, parserMode parser.ParserMode, sql string, defaultSchema string) []string {
+	ddls, err := ParseDDLs(mode, database.NewParser(parserMode), sql, defaultSchema)
+	assert.NoError(t, err)
+	var statements []string
+	for _, ddl := range ddls {
+		statements = append(statements, ddl.Statement())
+	}
+	return FingerprintDDLs(mode, ddls, statements, defaultSchema)
+}
+
+func TestFingerprintPostgres(t *testing.T) {
+	sql := "CREATE TYPE public.user_state AS ENUM ('active', 'banned');\n" +
+		"CREATE TABLE public.users (id integer DEFAULT nextval('users_id_seq'::regclass) NOT NULL, name text DEFAULT 'users name' CHECK (name <> ''), " +
+		"state user_state DEFAULT 'active'::user_state CHECK (state <> 'banned'), settings jsonb DEFAULT '{\"theme\": \"dark\"}'::jsonb, parent regclass DEFAULT 'public.users'::regclass);\n" +
+		"CREATE TRIGGER users_updated BEFORE UPDATE ON public.users FOR EACH ROW EXECUTE FUNCTION public.set_updated_at();\n" +
+		"COMMENT ON TABLE public.users IS 'the users';\n" +
+		"ALTER TABLE public.users OWNER TO admin;\n" +
+		"CREATE POLICY p ON public.users TO app, PUBLIC USING (true);\n"
+
+	// The same string literal gets the same pseudonym, and the literals without letters or cast to built-in types are kept
+	assert.Equal(t, []string{
+		"CREATE TYPE public.user_state AS ENUM ('str1', 'str2')",
+		"CREATE TABLE public.table1 (col1 integer DEFAULT nextval('seq1'::regclass) NOT NULL, col2 text DEFAULT 'str3' CHECK (col2 <> ''), " +
+			"col3 user_state DEFAULT 'str1'::user_state CHECK (col3 <> 'str2'), col4 jsonb DEFAULT '{\"theme\": \"dark\"}'::jsonb, col5 regclass DEFAULT 'public.table1'::regclass)",
+		"CREATE TRIGGER trigger1 BEFORE UPDATE ON public.table1 FOR EACH ROW EXECUTE FUNCTION public.function1()",
+		"COMMENT ON TABLE public.table1 IS 'comment'",
+		"ALTER TABLE public.table1 OWNER TO role1",
+		"CREATE POLICY policy1 ON public.table1 TO role2, PUBLIC USING (true)",
+	}, fingerprint(t, GeneratorModePostgres, parser.ParserModePostgres, sql, "public"))
+}
+
+func TestFingerprintMysql(t *testing.T) {
+	sql := "CREATE TABLE `users` (`id` int, `name` varchar(10) DEFAULT \"users\" COMMENT 'secret users') COMMENT='users table';\n"
+
+	// A double-quoted string of MySQL is not an identifier
+	assert.Equal(t, []string{
+		"CREATE TABLE `table1` (`col1` int, `col2` varchar(10) DEFAULT \"str1\" COMMENT 'comment') COMMENT='comment'",
+	}, fingerprint(t, GeneratorModeMysql, parser.ParserModeMysql, sql, ""))
+}
@@ -0,0 +1,285 @@
+package schema
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/sqldef/sqldef/database"
+)
+
+// FormatSQL re-prints the statements of a schema file in the style sqldef prints DDLs, e.g. with the names quoted,
+// the keywords upper-cased, and a column or a constraint per line, to keep hand-edited schema files consistent.
+// A statement is re-printed only when parsing it back gives the same definition, so the other ones, e.g. views,
+// functions, and the options the printers don't cover, are kept as written. So are the comments between statements.
+func FormatSQL(mode GeneratorMode, sqlParser database.Parser, sql string, defaultSchema string) (string, error) {
+	statements, err := sqlParser.Parse(sql)
+	if err != nil {
+		return "", err
+	}
+	f := formatter{
+		mode:          mode,
+		sqlParser:     sqlParser,
+		defaultSchema: defaultSchema,
+		generator:     &Generator{mode: mode, defaultSchema: defaultSchema},
+	}
+
+	var result strings.Builder
+	rest := sql
+	for _, statement := range statements {
+		ddl := strings.TrimSpace(statement.DDL)
+		i := indexAtLineStart(rest, ddl)
+		if i < 0 {
+			// The statement has comments inside, so it's left in the rest as written.
+			continue
+		}
+		result.WriteString(rest[:i])
+		result.WriteString(f.formatStatement(ddl, statement))
+		rest = rest[i+len(ddl):]
+	}
+	result.WriteString(rest)
+	return result.String(), nil
+}
+
+// Return the index of the first occurrence of substr which starts a line, i.e. not in a comment like `-- CREATE ...`.
+func indexAtLineStart(s string, substr string) int {
+	offset := 0
+	for {
+		i := strings.Index(s[offset:], substr)
+		if i < 0 {
+			return -1
+		}
+		i += offset
+		lineStart := strings.LastIndex(s[:i], "\n") + 1
+		if strings.TrimFunc(s[lineStart:i], unicode.IsSpace) == "" {
+			return i
+		}
+		offset = i + 1
+	}
+}
+
+type formatter struct {
+	mode          GeneratorMode
+	sqlParser     database.Parser
+	defaultSchema string
+	generator     *Generator
+}
+
+// Return the statement re-printed, or as written if it can't be re-printed as the same definition.
+func (f *formatter) formatStatement(ddl string, statement database.DDLStatement) string {
+	parsed, err := parseDDL(f.mode, ddl, statement.Statement, f.defaultSchema)
+	if err != nil {
+		return ddl
+	}
+	formatted, ok := f.printDDL(parsed)
+	if !ok || formatted == ddl {
+		return ddl
+	}
+
+	statements, err := f.sqlParser.Parse(formatted)
+	if err != nil || len(statements) != 1 {
+		return ddl
+	}
+	reparsed, err := parseDDL(f.mode, statements[0].DDL, statements[0].Statement, f.defaultSchema)
+	if err != nil || !reflect.DeepEqual(withoutStatement(parsed), withoutStatement(reparsed)) {
+		return ddl
+	}
+	return formatted
+}
+
+// Print the DDL with the printers of the generator. It returns false for the statements they don't cover.
+func (f *formatter) printDDL(ddl DDL) (string, bool) {
+	g := f.generator
+	switch stmt := ddl.(type) {
+	case *CreateTable:
+		if stmt.like != "" {
+			return fmt.Sprintf("CREATE TABLE %s LIKE %s", g.escapeTableName(stmt.table.name), g.escapeTableName(stmt.like)), true
+		}
+		return f.printTable(stmt.table)
+	case *CreateIndex:
+		c := converter{from: f.mode, to: f.mode, fromSchema: f.defaultSchema, generator: g}
+		ddls := c.convertIndex(stmt.tableName, stmt.index)
+		if len(ddls) != 1 || len(c.notes) > 0 {
+			return "", false
+		}
+		if stmt.index.nameGenerated {
+			return strings.Replace(ddls[0], " "+g.escapeSQLName(stmt.index.name)+" ON ", " ON ", 1), true
+		}
+		return ddls[0], true
+	case *AddIndex:
+		return g.generateAddIndex(stmt.tableName, stmt.index), true
+	case *AddPrimaryKey:
+		return g.generateAddIndex(stmt.tableName, stmt.index), true
+	case *AddForeignKey:
+		return fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(stmt.tableName), g.generateForeignKeyDefinition(stmt.foreignKey)), true
+	default:
+		return "", false
+	}
+}
+
+func (f *formatter) printTable(table Table) (string, bool) {
+	g := f.generator
+	if len(table.exclusions) > 0 || len(table.policies) > 0 || len(table.inherits) > 0 || table.unlogged || table.virtual != "" {
+		return "", false
+	}
+
+	var definitions []string
+	inlinePrimaryKey := false
+	for _, column := range table.columns {
+		// The columns referring to another table are kept as written since the referenced columns aren't parsed.
+		if column.references != "" && !strings.HasSuffix(column.references, ".") {
+			return "", false
+		}
+		autoIncrement := column.autoIncrement && f.mode == GeneratorModeSQLite3
+		if autoIncrement {
+			column.autoIncrement = false
+		}
+		if column.defaultDef != nil && column.defaultDef.value != nil {
+			defaultDef := *column.defaultDef
+			switch value := defaultDef.value; {
+			case value.valueType == ValueTypeBit && strings.EqualFold(string(value.raw), "now"):
+				// The parser keeps NOW() as a bit value
+				defaultDef.value = &Value{valueType: ValueTypeValArg, raw: []byte("now()")}
+			case value.valueType == ValueTypeFloat:
+				// As written, instead of the fixed precision of the generator
+				defaultDef.value = &Value{valueType: ValueTypeValArg, raw: value.raw}
+			}
+			column.defaultDef = &defaultDef
+		}
+		primaryKey := column.keyOption == ColumnKeyPrimary
+		if primaryKey {
+			// Printed after the column instead of NOT NULL, which the column printer implies with PRIMARY KEY
+			column.keyOption = ColumnKeyNone
+		}
+		definition, err := g.generateColumnDefinition(column, true)
+		if err != nil {
+			return "", false
+		}
+		if primaryKey {
+			definition += " PRIMARY KEY"
+			inlinePrimaryKey = true
+		}
+		if autoIncrement {
+			definition += " AUTOINCREMENT"
+		}
+		definitions = append(definitions, definition)
+	}
+	for _, index := range table.indexes {
+		if index.primary && inlinePrimaryKey {
+			continue
+		}
+		definitions = append(definitions, f.printTableIndex(table.name, index))
+	}
+	for _, foreignKey := range table.foreignKeys {
+		definitions = append(definitions, g.generateForeignKeyDefinition(foreignKey))
+	}
+	for _, check := range table.checks {
+		definition := fmt.Sprintf("CHECK (%s)", check.definition)
+		if check.constraintName != "" {
+			definition = fmt.Sprintf("CONSTRAINT %s %s", g.escapeSQLName(check.constraintName), definition)
+		}
+		definitions = append(definitions, definition)
+	}
+
+	ddl := fmt.Sprintf("CREATE TABLE %s (\n  %s\n)", g.escapeTableName(table.name), strings.Join(definitions, ",\n  "))
+	var options []string
+	for name, value := range table.options {
+		if value == "" {
+			options = append(options, strings.ToUpper(name))
+		} else {
+			options = append(options, strings.ToUpper(name)+"="+value)
+		}
+	}
+	sort.Strings(options)
+	if len(options) > 0 {
+		separator := " "
+		if f.mode == GeneratorModeSQLite3 {
+			separator = ", "
+		}
+		ddl += " " + strings.Join(options, separator)
+	}
+	return ddl, true
+}
+
+// Print an index in CREATE TABLE, which is written like ALTER TABLE ... ADD of the generator for MySQL.
+func (f *formatter) printTableIndex(tableName string, index Index) string {
+	g := f.generator
+	if f.mode == GeneratorModeMysql {
+		return strings.TrimPrefix(g.generateAddIndex(tableName, index), fmt.Sprintf("ALTER TABLE %s ADD ", g.escapeTableName(tableName)))
+	}
+
+	var columns []string
+	for _, indexColumn := range index.columns {
+		column := g.escapeSQLName(indexColumn.column)
+		if indexColumn.direction == DescScr {
+			column += " DESC"
+		}
+		columns = append(columns, column)
+	}
+	definition := "UNIQUE"
+	if index.nullsNotDistinct {
+		definition += " NULLS NOT DISTINCT"
+	}
+	if index.primary {
+		definition = "PRIMARY KEY"
+	}
+	// The parser names a constraint without a name PRIMARY, or after its first column
+	if index.name != "PRIMARY" && index.name != index.columns[0].column {
+		definition = fmt.Sprintf("CONSTRAINT %s %s", g.escapeSQLName(index.name), definition)
+	}
+	definition = fmt.Sprintf("%s (%s)", definition, strings.Join(columns, ", "))
+	if len(index.included) > 0 {
+		var included []string
+		for _, column := range index.included {
+			included = append(included, g.escapeSQLName(column))
+		}
+		definition += fmt.Sprintf(" INCLUDE (%s)", strings.Join(included, ", "))
+	}
+	if f.mode == GeneratorModePostgres {
+		definition += g.generateIndexOptionDefinition(index.options)
+	}
+	return definition + g.generateConstraintOptions(index.constraintOptions)
+}
+
+// Return a copy of the DDL without the statement as written, to compare the definitions only.
+func withoutStatement(ddl DDL) DDL {
+	switch stmt := ddl.(type) {
+	case *CreateTable:
+		copied := *stmt
+		copied.statement = ""
+		// The generator compares the names of table options case-insensitively.
+		copied.table.options = map[string]string{}
+		for name, value := range stmt.table.options {
+			copied.table.options[strings.ToUpper(name)] = value
+		}
+		// A column without REFERENCES refers to `schema.` for Postgres, unless it's made a foreign key.
+		copied.table.columns = nil
+		for _, column := range stmt.table.columns {
+			if strings.HasSuffix(column.references, ".") {
+				column.references = ""
+			}
+			copied.table.columns = append(copied.table.columns, column)
+		}
+		return &copied
+	case *CreateIndex:
+		copied := *stmt
+		copied.statement = ""
+		return &copied
+	case *AddIndex:
+		copied := *stmt
+		copied.statement = ""
+		return &copied
+	case *AddPrimaryKey:
+		copied := *stmt
+		copied.statement = ""
+		return &copied
+	case *AddForeignKey:
+		copied := *stmt
+		copied.statement = ""
+		return &copied
+	default:
+		return ddl
+	}
+}
@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatSQLMysql(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModeMysql)
+	sql := "-- users\n" +
+		"create table users (\n" +
+		" id bigint not null auto_increment primary key, name varchar(40) default 'x', score float default 1.5,\n" +
+		" key idx_score (score), unique key uk_name (name)\n" +
+		") engine=InnoDB default charset=utf8mb4;\n" +
+		"\n" +
+		"-- create index idx_name on users (name(10));\n" +
+		"create index idx_name on users (name(10));\n" +
+		"create view adults as select * from users where score > 0;\n"
+
+	formatted, err := FormatSQL(GeneratorModeMysql, sqlParser, sql, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "-- users\n"+
+		"CREATE TABLE `users` (\n"+
+		"  `id` bigint NOT NULL AUTO_INCREMENT PRIMARY KEY,\n"+
+		"  `name` varchar(40) DEFAULT 'x',\n"+
+		"  `score` float DEFAULT 1.5,\n"+
+		"  KEY `idx_score` (`score`),\n"+
+		"  UNIQUE KEY `uk_name` (`name`)\n"+
+		") DEFAULT CHARSET=utf8mb4 ENGINE=InnoDB;\n"+
+		"\n"+
+		"-- create index idx_name on users (name(10));\n"+
+		"CREATE INDEX `idx_name` ON `users` (`name`(10));\n"+
+		"create view adults as select * from users where score > 0;\n", formatted)
+
+	again, err := FormatSQL(GeneratorModeMysql, sqlParser, formatted, "")
+	assert.NoError(t, err)
+	assert.Equal(t, formatted, again)
+}
+
+func TestFormatSQLPostgres(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModePostgres)
+	sql := "create table users (id bigserial primary key, name text not null, created_at timestamp default now(), unique (name));\n" +
+		"create table posts (id int generated always as identity, user_id bigint references users(id), constraint title_length check (length(title) < 100), title text);\n" +
+		"create index on posts (user_id) where user_id > 0;\n" +
+		"create view named_users as select * from users where name is not null;\n"
+
+	formatted, err := FormatSQL(GeneratorModePostgres, sqlParser, sql, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE TABLE \"public\".\"users\" (\n"+
+		"  \"id\" bigserial PRIMARY KEY,\n"+
+		"  \"name\" text NOT NULL,\n"+
+		"  \"created_at\" timestamp DEFAULT now(),\n"+
+		"  UNIQUE (\"name\")\n"+
+		");\n"+
+		"CREATE TABLE \"public\".\"posts\" (\n"+
+		"  \"id\" int GENERATED ALWAYS AS IDENTITY,\n"+
+		"  \"user_id\" bigint,\n"+
+		"  \"title\" text,\n"+
+		"  CONSTRAINT \"posts_user_id_fkey\" FOREIGN KEY (\"user_id\") REFERENCES \"public\".\"users\" (\"id\"),\n"+
+		"  CONSTRAINT \"title_length\" CHECK (length(title) < 100)\n"+
+		");\n"+
+		"CREATE INDEX ON \"public\".\"posts\" (\"user_id\") WHERE user_id > 0;\n"+
+		"create view named_users as select * from users where name is not null;\n", formatted)
+
+	again, err := FormatSQL(GeneratorModePostgres, sqlParser, formatted, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, formatted, again)
+}
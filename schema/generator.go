@@ -4,12 +4,14 @@ package schema
 import (
 	"fmt"
 	"log"
+	"math"
 	"reflect"
 	"regexp"
 	"sort"
 	"strings"
 
 	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
 )
 
 type GeneratorMode int
@@ -48,6 +50,7 @@ type Generator struct {
 	desiredTypes []*Type
 	currentTypes []*Type
 
+	desiredComments []*Comment
 	currentComments []*Comment
 
 	desiredExtensions []*Extension
@@ -56,50 +59,107 @@ type Generator struct {
 	desiredSchemas []*Schema
 	currentSchemas []*Schema
 
+	desiredPublications []*Publication
+	currentPublications []*Publication
+
+	desiredEvents []*Event
+	currentEvents []*Event
+
+	desiredRoles []*Role
+	currentRoles []*Role
+
 	defaultSchema string
 
-	algorithm string
-	lock      string
+	algorithm            string
+	lock                 string
+	algorithmTables      map[string]string
+	lockTables           map[string]string
+	managedRoles         []string
+	renamedTables        map[string]string
+	renamedColumns       map[string]map[string]string
+	renameSequences      bool
+	typeConversions      map[string]map[string]string
+	enableDrop           bool
+	keepColumnAttributes bool
+	ignoreColumnOrder    bool
+	safeNotNull          bool
+	restartIdentity      bool
+	autoCreateSchema     bool
+	mergeAlters          bool
+
+	progress func(Progress)
 }
 
 // Parse argument DDLs and call `generateDDLs()`
 func GenerateIdempotentDDLs(mode GeneratorMode, sqlParser database.Parser, desiredSQL string, currentSQL string, config database.GeneratorConfig, defaultSchema string) ([]string, error) {
 	// TODO: invalidate duplicated tables, columns
-	desiredDDLs, err := ParseDDLs(mode, sqlParser, desiredSQL, defaultSchema)
+	return GenerateIdempotentDDLsWithProgress(mode, sqlParser, desiredSQL, currentSQL, config, defaultSchema, nil)
+}
+
+// Same as GenerateIdempotentDDLs, but take DDLs that are already parsed and filtered.
+func GenerateIdempotentDDLsFromParsed(mode GeneratorMode, desiredDDLs []DDL, currentDDLs []DDL, config database.GeneratorConfig, defaultSchema string) ([]string, error) {
+	return generateIdempotentDDLsFromParsed(mode, desiredDDLs, currentDDLs, config, defaultSchema, nil)
+}
+
+func generateIdempotentDDLsFromParsed(mode GeneratorMode, desiredDDLs []DDL, currentDDLs []DDL, config database.GeneratorConfig, defaultSchema string, progress func(Progress)) ([]string, error) {
+	reportProgress(progress, Progress{Phase: ProgressAggregate})
+	desiredDDLs, err := applyReferenceSchemas(desiredDDLs, currentDDLs, config.ReferenceSchemas)
 	if err != nil {
 		return nil, err
 	}
-	desiredDDLs = FilterTables(desiredDDLs, config)
-
-	currentDDLs, err := ParseDDLs(mode, sqlParser, currentSQL, defaultSchema)
+	desiredDDLs, err = expandCreateTableLike(desiredDDLs, currentDDLs)
 	if err != nil {
 		return nil, err
 	}
-	currentDDLs = FilterTables(currentDDLs, config)
 
-	tables, views, triggers, types, comments, extensions, schemas, err := aggregateDDLsToSchema(currentDDLs)
+	tables, views, triggers, types, comments, extensions, schemas, publications, events, roles, err := aggregateDDLsToSchema(currentDDLs)
 	if err != nil {
 		return nil, err
 	}
+	if mode == GeneratorModeMysql {
+		ignoreGeneratedInvisiblePrimaryKeys(tables, desiredDDLs)
+	}
 
 	generator := Generator{
-		mode:              mode,
-		desiredTables:     []*Table{},
-		currentTables:     tables,
-		desiredViews:      []*View{},
-		currentViews:      views,
-		desiredTriggers:   []*Trigger{},
-		currentTriggers:   triggers,
-		desiredTypes:      []*Type{},
-		currentTypes:      types,
-		currentComments:   comments,
-		desiredExtensions: []*Extension{},
-		currentExtensions: extensions,
-		desiredSchemas:    []*Schema{},
-		currentSchemas:    schemas,
-		defaultSchema:     defaultSchema,
-		algorithm:         config.Algorithm,
-		lock:              config.Lock,
+		mode:                 mode,
+		desiredTables:        []*Table{},
+		currentTables:        tables,
+		desiredViews:         []*View{},
+		currentViews:         views,
+		desiredTriggers:      []*Trigger{},
+		currentTriggers:      triggers,
+		desiredTypes:         []*Type{},
+		currentTypes:         types,
+		desiredComments:      []*Comment{},
+		currentComments:      comments,
+		desiredExtensions:    []*Extension{},
+		currentExtensions:    extensions,
+		desiredSchemas:       []*Schema{},
+		currentSchemas:       schemas,
+		desiredPublications:  []*Publication{},
+		currentPublications:  publications,
+		desiredEvents:        []*Event{},
+		currentEvents:        events,
+		desiredRoles:         []*Role{},
+		currentRoles:         roles,
+		defaultSchema:        defaultSchema,
+		algorithm:            config.Algorithm,
+		lock:                 config.Lock,
+		algorithmTables:      config.AlgorithmTables,
+		lockTables:           config.LockTables,
+		managedRoles:         config.ManagedRoles,
+		renamedTables:        config.RenamedTables,
+		renamedColumns:       config.RenamedColumns,
+		renameSequences:      config.RenameSequences,
+		typeConversions:      config.TypeConversions,
+		enableDrop:           config.EnableDrop,
+		keepColumnAttributes: config.KeepColumnAttributes,
+		ignoreColumnOrder:    config.IgnoreColumnOrder,
+		safeNotNull:          config.SafeNotNull,
+		restartIdentity:      config.RestartIdentity,
+		autoCreateSchema:     config.AutoCreateSchema,
+		mergeAlters:          config.MergeAlters,
+		progress:             progress,
 	}
 	return generator.generateDDLs(desiredDDLs)
 }
@@ -107,20 +167,33 @@ func GenerateIdempotentDDLs(mode GeneratorMode, sqlParser database.Parser, desir
 // Main part of DDL genearation
 func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 	// These variables are used to control the output order of the DDL.
-	// `CREATE SCHEMA` should execute first, and DDLs that add indexes and foreign keys should execute last.
+	// `CREATE ROLE` and `CREATE SCHEMA` should execute first, and DDLs that add indexes and foreign keys should execute last.
 	// Other ddls are stored in interDDLs.
+	roleDDLs := []string{}
 	createExtensionDDLs := []string{}
+	commentDDLs := []string{} // comments on indexes and constraints, which are created after the other DDLs
 	createSchemaDDLs := []string{}
 	interDDLs := []string{}
 	indexDDLs := []string{}
+	clusterDDLs := []string{}
 	foreignKeyDDLs := []string{}
 
+	comparedTables, totalTables := 0, countTables(desiredDDLs)
+	reportProgress(g.progress, Progress{Phase: ProgressCompareTables, Total: totalTables})
+
 	// Incrementally examine desiredDDLs
 	for _, ddl := range desiredDDLs {
 		switch desired := ddl.(type) {
 		case *CreateTable:
+			renameDDLs, err := g.generateDDLsForRenameTable(desired.table.name, desiredDDLs)
+			if err != nil {
+				return nil, err
+			}
+			interDDLs = append(interDDLs, renameDDLs...)
+
 			if currentTable := findTableByName(g.currentTables, desired.table.name); currentTable != nil {
 				// Table already exists, guess required DDLs.
+				interDDLs = append(interDDLs, g.generateDDLsForRenameColumns(currentTable, desired.table)...)
 				tableDDLs, err := g.generateDDLsForCreateTable(*currentTable, *desired)
 				if err != nil {
 					return nil, err
@@ -129,12 +202,20 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				mergeTable(currentTable, desired.table)
 			} else {
 				// Table not found, create table.
+				for _, foreignKey := range desired.table.foreignKeys {
+					if err := g.checkForeignKeyNameCollision(desired.table.name, foreignKey.constraintName, desired.statement); err != nil {
+						return nil, err
+					}
+				}
 				interDDLs = append(interDDLs, desired.statement)
 				table := desired.table // copy table
 				g.currentTables = append(g.currentTables, &table)
 			}
 			table := desired.table // copy table
 			g.desiredTables = append(g.desiredTables, &table)
+
+			comparedTables++
+			reportProgress(g.progress, Progress{Phase: ProgressCompareTables, Done: comparedTables, Total: totalTables})
 		case *CreateIndex:
 			idxDDLs, err := g.generateDDLsForCreateIndex(desired.tableName, desired.index, "CREATE INDEX", ddl.Statement())
 			if err != nil {
@@ -153,6 +234,12 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				return nil, err
 			}
 			foreignKeyDDLs = append(foreignKeyDDLs, fkeyDDLs...)
+		case *AddExclusion:
+			exclusionDDLs, err := g.generateDDLsForAddExclusion(desired.tableName, desired.exclusion, "ALTER TABLE", ddl.Statement())
+			if err != nil {
+				return nil, err
+			}
+			indexDDLs = append(indexDDLs, exclusionDDLs...)
 		case *AddPolicy:
 			policyDDLs, err := g.generateDDLsForCreatePolicy(desired.tableName, desired.policy, "CREATE POLICY", ddl.Statement())
 			if err != nil {
@@ -171,18 +258,43 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				return nil, err
 			}
 			interDDLs = append(interDDLs, triggerDDLs...)
+		case *Event:
+			eventDDLs, err := g.generateDDLsForCreateEvent(desired)
+			if err != nil {
+				return nil, err
+			}
+			interDDLs = append(interDDLs, eventDDLs...)
 		case *Type:
 			typeDDLs, err := g.generateDDLsForCreateType(desired)
 			if err != nil {
 				return nil, err
 			}
 			interDDLs = append(interDDLs, typeDDLs...)
+		case *AddDomainConstraint:
+			interDDLs = append(interDDLs, g.generateDDLsForAddDomainConstraint(desired)...)
 		case *Comment:
-			commentDDLs, err := g.generateDDLsForComment(desired)
+			ddls, err := g.generateDDLsForComment(desired)
+			if err != nil {
+				return nil, err
+			}
+			if objectType := commentObjectType(desired.comment); objectType == "INDEX" || objectType == "TABCONSTRAINT" {
+				commentDDLs = append(commentDDLs, ddls...)
+			} else {
+				interDDLs = append(interDDLs, ddls...)
+			}
+			g.desiredComments = append(g.desiredComments, desired)
+		case *ClusterOn:
+			clusterOnDDLs, err := g.generateDDLsForClusterOn(desired)
 			if err != nil {
 				return nil, err
 			}
-			interDDLs = append(interDDLs, commentDDLs...)
+			clusterDDLs = append(clusterDDLs, clusterOnDDLs...)
+		case *Owner:
+			ownerDDLs, err := g.generateDDLsForOwner(desired)
+			if err != nil {
+				return nil, err
+			}
+			interDDLs = append(interDDLs, ownerDDLs...)
 		case *Extension:
 			extensionDDLs, err := g.generateDDLsForExtension(desired)
 			if err != nil {
@@ -195,19 +307,41 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				return nil, err
 			}
 			createSchemaDDLs = append(createSchemaDDLs, schemaDDLs...)
+		case *Publication:
+			publicationDDLs, err := g.generateDDLsForPublication(desired)
+			if err != nil {
+				return nil, err
+			}
+			interDDLs = append(interDDLs, publicationDDLs...)
+		case *Role:
+			ddls, err := g.generateDDLsForRole(desired)
+			if err != nil {
+				return nil, err
+			}
+			roleDDLs = append(roleDDLs, ddls...)
+		case *Grant:
+			// Privileges are only reported by --report-privileges
 		default:
 			return nil, fmt.Errorf("unexpected ddl type in generateDDLs: %v", desired)
 		}
 	}
 
+	if g.autoCreateSchema {
+		createSchemaDDLs = append(createSchemaDDLs, g.generateDDLsForReferencedSchemas(desiredDDLs)...)
+	}
+
 	ddls := []string{}
+	ddls = append(ddls, roleDDLs...)
 	ddls = append(ddls, createExtensionDDLs...)
 	ddls = append(ddls, createSchemaDDLs...)
 	ddls = append(ddls, interDDLs...)
 	ddls = append(ddls, indexDDLs...)
+	ddls = append(ddls, clusterDDLs...)
 	ddls = append(ddls, foreignKeyDDLs...)
+	ddls = append(ddls, commentDDLs...)
 
 	// Clean up obsoleted tables, indexes, columns
+	reportProgress(g.progress, Progress{Phase: ProgressCleanUp})
 	for _, currentTable := range g.currentTables {
 		desiredTable := findTableByName(g.desiredTables, currentTable.name)
 		if desiredTable == nil {
@@ -229,6 +363,10 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 			// TODO: simulate to remove foreign key from `currentTable.foreignKeys`?
 		}
 
+		if currentTable.clusterOn != "" && desiredTable.clusterOn == "" {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s SET WITHOUT CLUSTER", g.escapeTableName(currentTable.name)))
+		}
+
 		// Check indexes
 		for _, index := range currentTable.indexes {
 
@@ -241,6 +379,9 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 				containsString(convertForeignKeysToIndexNames(desiredTable.foreignKeys), index.name) {
 				continue // Index is expected to exist.
 			}
+			if g.mode == GeneratorModeMysql && isImplicitlyDroppedForeignKeyIndex(index, *currentTable, *desiredTable) {
+				continue // Index is dropped by MySQL when the desired index is added.
+			}
 
 			// The index seems obsoleted. Check and drop it as needed.
 			indexDDLs, err := g.generateDDLsForAbsentIndex(index, *currentTable, *desiredTable)
@@ -256,6 +397,9 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 			if containsString(convertColumnsToColumnNames(desiredTable.columns), column.name) {
 				continue // Column is expected to exist.
 			}
+			if g.isInheritedColumn(*desiredTable, column.name) {
+				continue // Column is managed by the parent table, e.g. before INHERIT is added to the table.
+			}
 
 			// Column is obsoleted. Drop column.
 			columnDDLs := g.generateDDLsForAbsentColumn(currentTable, column.name)
@@ -263,6 +407,14 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 			// TODO: simulate to remove column from `currentTable.columns`?
 		}
 
+		// Check exclusion constraints.
+		for _, exclusion := range currentTable.exclusions {
+			if containsString(convertExclusionsToConstraintNames(desiredTable.exclusions), exclusion.constraintName) {
+				continue
+			}
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(exclusion.constraintName)))
+		}
+
 		// Check policies.
 		for _, policy := range currentTable.policies {
 			if containsString(convertPolicyNames(desiredTable.policies), policy.name) {
@@ -282,6 +434,32 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 		}
 	}
 
+	// Clean up obsoleted constraints of domains.
+	for _, currentType := range g.currentTypes {
+		desiredType := findTypeByName(g.desiredTypes, currentType.name)
+		if currentType.domain == nil || desiredType == nil || desiredType.domain == nil {
+			continue
+		}
+		for _, check := range currentType.domain.checks {
+			if !containsString(convertCheckConstraintNames(desiredType.domain.checks), check.constraintName) {
+				ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s DROP CONSTRAINT %s", g.escapeTableName(currentType.name), g.escapeSQLName(check.constraintName)))
+			}
+		}
+	}
+
+	// Clean up obsoleted MS_Description of SQL Server. They are dropped with their tables and columns.
+	if g.mode == GeneratorModeMssql && g.enableDrop {
+		for _, currentComment := range g.currentComments {
+			if findCommentByObject(g.desiredComments, currentComment.comment) != nil {
+				continue
+			}
+			if !g.isCommentedObjectDesired(currentComment.comment.Object) {
+				continue
+			}
+			ddls = append(ddls, "EXEC sp_dropextendedproperty "+extendedPropertyArgs(currentComment.comment.Object, nil))
+		}
+	}
+
 	// Clean up obsoleted views
 	for _, currentView := range g.currentViews {
 		if containsString(convertViewNames(g.desiredViews), currentView.name) {
@@ -294,6 +472,14 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 		ddls = append(ddls, fmt.Sprintf("DROP VIEW %s", g.escapeTableName(currentView.name)))
 	}
 
+	// Clean up obsoleted publications
+	for _, currentPublication := range g.currentPublications {
+		if !g.enableDrop || containsString(convertPublicationNames(g.desiredPublications), currentPublication.name) {
+			continue
+		}
+		ddls = append(ddls, fmt.Sprintf("DROP PUBLICATION %s", g.escapeSQLName(currentPublication.name)))
+	}
+
 	// Clean up obsoleted extensions
 	for _, currentExtension := range g.currentExtensions {
 		if containsString(convertExtensionNames(g.desiredExtensions), currentExtension.extension.Name) {
@@ -314,19 +500,27 @@ func (g *Generator) generateDDLs(desiredDDLs []DDL) ([]string, error) {
 		}
 	}
 
-	if isValidAlgorithm(g.algorithm) {
-		for i := range ddls {
-			if strings.HasPrefix(ddls[i], "ALTER TABLE") {
-				ddls[i] += ", ALGORITHM=" + strings.ToUpper(g.algorithm)
-			}
+	// Clean up obsoleted events
+	for _, currentEvent := range g.currentEvents {
+		if g.enableDrop && findEventByName(g.desiredEvents, currentEvent.name) == nil {
+			ddls = append(ddls, fmt.Sprintf("DROP EVENT %s", g.escapeSQLName(currentEvent.name)))
 		}
 	}
 
-	if isValidLock(g.lock) {
-		for i := range ddls {
-			if strings.HasPrefix(ddls[i], "ALTER TABLE") {
-				ddls[i] += ", LOCK=" + strings.ToUpper(g.lock)
-			}
+	if g.mergeAlters {
+		ddls = mergeAlterTables(ddls)
+	}
+
+	for i := range ddls {
+		if !strings.HasPrefix(ddls[i], "ALTER TABLE") {
+			continue
+		}
+		table := alterTableName(ddls[i])
+		if algorithm := tableOrDefault(g.algorithmTables, table, g.algorithm); isValidAlgorithm(algorithm) {
+			ddls[i] += ", ALGORITHM=" + strings.ToUpper(algorithm)
+		}
+		if lock := tableOrDefault(g.lockTables, table, g.lock); isValidLock(lock) {
+			ddls[i] += ", LOCK=" + strings.ToUpper(lock)
 		}
 	}
 
@@ -350,10 +544,169 @@ func (g *Generator) generateDDLsForAbsentColumn(currentTable *Table, columnName
 	return append(ddls, ddl)
 }
 
+// Rename a table declared in the renames section of the config, if the old table still exists.
+func (g *Generator) generateDDLsForRenameTable(tableName string, desiredDDLs []DDL) ([]string, error) {
+	oldName := g.renamedTableFrom(tableName)
+	if oldName == "" || findTableByName(g.currentTables, tableName) != nil || containsString(convertDDLsToTableNames(desiredDDLs), oldName) {
+		return nil, nil
+	}
+	currentTable := findTableByName(g.currentTables, oldName)
+	if currentTable == nil {
+		return nil, nil
+	}
+
+	oldSchema, oldTable := splitTableName(oldName, g.defaultSchema)
+	newSchema, newTable := splitTableName(tableName, g.defaultSchema)
+	if (g.mode == GeneratorModePostgres || g.mode == GeneratorModeMssql) && oldSchema != newSchema {
+		return nil, fmt.Errorf("renaming table '%s' to another schema is not supported: '%s'", oldName, tableName)
+	}
+
+	var ddls []string
+	switch g.mode {
+	case GeneratorModeMssql:
+		// sp_rename takes the quoted object name but the new name as is.
+		ddls = append(ddls, fmt.Sprintf("EXEC sp_rename %s, %s", unicodeStringConstant(g.escapeTableName(oldName)), unicodeStringConstant(newTable)))
+	case GeneratorModePostgres:
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", g.escapeTableName(oldName), g.escapeSQLName(newTable)))
+		if g.renameSequences {
+			// The sequence of a serial column is named after the table, which is kept by renaming the table.
+			for _, column := range currentTable.columns {
+				if isSerial(column) {
+					oldSequence := PostgresConstraintName(oldTable, []string{column.name}, "seq")
+					newSequence := PostgresConstraintName(newTable, []string{column.name}, "seq")
+					ddls = append(ddls, fmt.Sprintf("ALTER SEQUENCE %s RENAME TO %s", g.escapeTableName(oldSchema+"."+oldSequence), g.escapeSQLName(newSequence)))
+				}
+			}
+		}
+	default:
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s RENAME TO %s", g.escapeTableName(oldName), g.escapeTableName(tableName)))
+	}
+
+	currentTable.name = tableName
+	for _, table := range g.currentTables {
+		for i := range table.foreignKeys {
+			if table.foreignKeys[i].referenceName == oldName {
+				table.foreignKeys[i].referenceName = tableName
+			}
+		}
+	}
+	return ddls, nil
+}
+
+// Rename columns declared in the renames section of the config, if the old columns still exist.
+func (g *Generator) generateDDLsForRenameColumns(currentTable *Table, desired Table) []string {
+	ddls := []string{}
+	renamedColumns := g.renamedColumnsOf(desired.name)
+	for _, desiredColumn := range desired.columns {
+		oldName, ok := renamedColumns[desiredColumn.name]
+		if !ok || findColumnByName(currentTable.columns, desiredColumn.name) != nil ||
+			findColumnByName(currentTable.columns, oldName) == nil || findColumnByName(desired.columns, oldName) != nil {
+			continue
+		}
+
+		switch g.mode {
+		case GeneratorModeMssql:
+			ddls = append(ddls, fmt.Sprintf("EXEC sp_rename %s, %s, 'COLUMN'", unicodeStringConstant(g.escapeTableName(currentTable.name)+"."+g.escapeSQLName(oldName)), unicodeStringConstant(desiredColumn.name)))
+		default:
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s RENAME COLUMN %s TO %s", g.escapeTableName(currentTable.name), g.escapeSQLName(oldName), g.escapeSQLName(desiredColumn.name)))
+		}
+		g.renameColumn(currentTable, oldName, desiredColumn.name)
+	}
+	return ddls
+}
+
+// Simulate a column rename, which the database also applies to indexes and foreign keys on the column.
+func (g *Generator) renameColumn(table *Table, oldName string, newName string) {
+	for i := range table.columns {
+		if table.columns[i].name == oldName {
+			table.columns[i].name = newName
+		}
+	}
+	for _, index := range table.indexes {
+		for i := range index.columns {
+			if index.columns[i].column == oldName {
+				index.columns[i].column = newName
+			}
+		}
+	}
+	for _, foreignKey := range table.foreignKeys {
+		for i := range foreignKey.indexColumns {
+			if foreignKey.indexColumns[i] == oldName {
+				foreignKey.indexColumns[i] = newName
+			}
+		}
+	}
+	for _, otherTable := range g.currentTables {
+		for _, foreignKey := range otherTable.foreignKeys {
+			if foreignKey.referenceName != table.name {
+				continue
+			}
+			for i := range foreignKey.referenceColumns {
+				if foreignKey.referenceColumns[i] == oldName {
+					foreignKey.referenceColumns[i] = newName
+				}
+			}
+		}
+	}
+}
+
+func (g *Generator) renamedTableFrom(tableName string) string {
+	for newName, oldName := range g.renamedTables {
+		if normalizedTable(g.mode, newName, g.defaultSchema) == tableName {
+			return normalizedTable(g.mode, oldName, g.defaultSchema)
+		}
+	}
+	return ""
+}
+
+func (g *Generator) renamedColumnsOf(tableName string) map[string]string {
+	for table, renamedColumns := range g.renamedColumns {
+		if normalizedTable(g.mode, table, g.defaultSchema) == tableName {
+			return renamedColumns
+		}
+	}
+	return nil
+}
+
+func (g *Generator) typeConversionsOf(tableName string) map[string]string {
+	for table, typeConversions := range g.typeConversions {
+		if normalizedTable(g.mode, table, g.defaultSchema) == tableName {
+			return typeConversions
+		}
+	}
+	return nil
+}
+
+// Return FIRST or AFTER of MySQL to place the i-th column of the table, or nothing with ignore_column_order of --config,
+// which appends an added column to the end instead.
+func (g *Generator) generateColumnPosition(table Table, i int) string {
+	if g.ignoreColumnOrder {
+		return ""
+	}
+	if i == 0 {
+		return " FIRST"
+	}
+	return " AFTER " + g.escapeSQLName(table.columns[i-1].name)
+}
+
 // In the caller, `mergeTable` manages `g.currentTables`.
 func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired CreateTable) ([]string, error) {
 	ddls := []string{}
 
+	// STRICT and WITHOUT ROWID can't be altered. Recreate the table to change them, which drops the current table.
+	if g.mode == GeneratorModeSQLite3 && g.enableDrop && !haveSameSQLite3TableOptions(currentTable, desired.table) {
+		return g.generateDDLsForRecreateTable(currentTable, desired)
+	}
+
+	// A virtual table can't be altered either, and its columns are defined by the module. Recreate it to change it.
+	if currentTable.virtual != "" || desired.table.virtual != "" {
+		if g.enableDrop && currentTable.virtual != desired.table.virtual {
+			ddls = append(ddls, fmt.Sprintf("DROP TABLE %s", g.escapeTableName(currentTable.name)))
+			ddls = append(ddls, desired.statement)
+		}
+		return ddls, nil
+	}
+
 	// Examine each column
 	for i, desiredColumn := range desired.table.columns {
 		currentColumn := findColumnByName(currentTable.columns, desiredColumn.name)
@@ -377,11 +730,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 			}
 
 			if g.mode == GeneratorModeMysql {
-				after := " FIRST"
-				if i > 0 {
-					after = " AFTER " + g.escapeSQLName(desired.table.columns[i-1].name)
-				}
-				ddl += after
+				ddl += g.generateColumnPosition(desired.table, i)
 			}
 
 			ddls = append(ddls, ddl)
@@ -389,12 +738,15 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 			// Change column data type or order as needed.
 			switch g.mode {
 			case GeneratorModeMysql:
+				if g.keepColumnAttributes {
+					desiredColumn = keepUnspecifiedAttributes(*currentColumn, desiredColumn)
+				}
 				currentPos := currentColumn.position
 				desiredPos := desiredColumn.position
-				changeOrder := currentPos > desiredPos && currentPos-desiredPos > len(currentTable.columns)-len(desired.table.columns)
+				changeOrder := !g.ignoreColumnOrder && currentPos > desiredPos && currentPos-desiredPos > len(currentTable.columns)-len(desired.table.columns)
 
 				// Change column type and orders, *except* AUTO_INCREMENT and UNIQUE KEY.
-				if !g.haveSameColumnDefinition(*currentColumn, desiredColumn) || !g.areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) || !g.areSameGenerated(currentColumn.generated, desiredColumn.generated) || changeOrder {
+				if !g.haveSameColumnDefinition(*currentColumn, desiredColumn, currentTable, desired.table) || !g.areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) || !g.areSameGenerated(currentColumn.generated, desiredColumn.generated) || changeOrder {
 					definition, err := g.generateColumnDefinition(desiredColumn, false)
 					if err != nil {
 						return ddls, err
@@ -403,20 +755,12 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 					if desiredColumn.generated != nil {
 						ddl1 := fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name))
 						ddl2 := fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s", g.escapeTableName(desired.table.name), definition)
-						after := " FIRST"
-						if i > 0 {
-							after = " AFTER " + g.escapeSQLName(desired.table.columns[i-1].name)
-						}
-						ddl2 += after
+						ddl2 += g.generateColumnPosition(desired.table, i)
 						ddls = append(ddls, ddl1, ddl2)
 					} else {
 						ddl := fmt.Sprintf("ALTER TABLE %s CHANGE COLUMN %s %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name), definition)
 						if changeOrder {
-							after := " FIRST"
-							if i > 0 {
-								after = " AFTER " + g.escapeSQLName(desired.table.columns[i-1].name)
-							}
-							ddl += after
+							ddl += g.generateColumnPosition(desired.table, i)
 						}
 						ddls = append(ddls, ddl)
 					}
@@ -429,36 +773,54 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 					ddls = append(ddls, ddl)
 				}
 			case GeneratorModePostgres:
-				if !g.haveSameDataType(*currentColumn, desiredColumn) {
+				if isSerial(desiredColumn) && !isSerial(*currentColumn) {
+					return ddls, fmt.Errorf("changing the column '%s' of '%s' to %s is not supported, since ALTER COLUMN can't make a serial column", desiredColumn.name, desired.table.name, desiredColumn.typeName)
+				}
+				serialToIdentity := isSerial(*currentColumn) && currentColumn.identity == nil && desiredColumn.identity != nil
+				if !g.haveSameDataType(*currentColumn, desiredColumn) && !(serialToIdentity && serialIntegerType(currentColumn.typeName) == postgresIntegerType(desiredColumn.typeName)) {
 					// Change type
 					ddl := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name), generateDataType(desiredColumn))
+					if using, ok := g.typeConversionsOf(desired.table.name)[desiredColumn.name]; ok {
+						ddl += " USING " + using
+					}
+					dropViewDDLs, createViewDDLs := g.generateDDLsForDependentViews(desired.table.name, currentColumn.name)
+					ddls = append(ddls, dropViewDDLs...)
 					ddls = append(ddls, ddl)
+					ddls = append(ddls, createViewDDLs...)
 				}
 
 				if !isPrimaryKey(*currentColumn, currentTable) { // Primary Key implies NOT NULL
 					if g.notNull(*currentColumn) && !g.notNull(desiredColumn) {
 						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP NOT NULL", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
 					} else if !g.notNull(*currentColumn) && g.notNull(desiredColumn) {
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL", g.escapeTableName(desired.table.name), g.escapeSQLName(currentColumn.name)))
+						ddls = append(ddls, g.generateDDLsForSetNotNull(desired.table.name, currentColumn.name)...)
 					}
 				}
 
 				// GENERATED AS IDENTITY
-				if !areSameIdentityDefinition(currentColumn.identity, desiredColumn.identity) {
+				if !g.areSameIdentityDefinition(*currentColumn, desiredColumn) {
 					if currentColumn.identity == nil {
 						// add
 						alter := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s ADD GENERATED %s AS IDENTITY", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name), desiredColumn.identity.behavior)
 						if desiredColumn.sequence != nil {
 							alter += " (" + generateSequenceClause(desiredColumn.sequence) + ")"
 						}
-						ddls = append(ddls, alter)
+						if serialToIdentity {
+							ddls = append(ddls, g.generateDDLsForSerialToIdentity(desired.table.name, desiredColumn.name, alter)...)
+						} else {
+							ddls = append(ddls, alter)
+						}
 					} else if desiredColumn.identity == nil {
 						// remove
 						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP IDENTITY IF EXISTS", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name)))
 					} else {
 						// set
-						// not support changing sequence
-						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET GENERATED %s", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name), desiredColumn.identity.behavior))
+						var clauses []string
+						if currentColumn.identity.behavior != desiredColumn.identity.behavior {
+							clauses = append(clauses, "SET GENERATED "+desiredColumn.identity.behavior)
+						}
+						clauses = append(clauses, g.generateIdentitySequenceAlters(*currentColumn, desiredColumn)...)
+						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredColumn.name), strings.Join(clauses, " ")))
 					}
 				}
 
@@ -536,7 +898,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				}
 
 				// IDENTITY
-				if !areSameIdentityDefinition(currentColumn.identity, desiredColumn.identity) {
+				if !g.areSameIdentityDefinition(*currentColumn, desiredColumn) {
 					if currentColumn.identity != nil {
 						// remove
 						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.name)))
@@ -551,7 +913,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				}
 
 				// DEFAULT
-				if !g.areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) {
+				if !g.areSameDefaultValue(currentColumn.defaultDef, desiredColumn.defaultDef) || !areSameDefaultConstraintName(currentColumn.defaultDef, desiredColumn.defaultDef) {
 					if currentColumn.defaultDef != nil {
 						// drop
 						ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentColumn.defaultDef.constraintName)))
@@ -625,7 +987,7 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 		if currentIndex := findIndexByName(currentTable.indexes, desiredIndex.name); currentIndex != nil {
 			// Drop and add index as needed.
 			if !g.areSameIndexes(*currentIndex, desiredIndex) {
-				ddls = append(ddls, g.generateDropIndex(desired.table.name, desiredIndex.name, desiredIndex.constraint))
+				ddls = append(ddls, g.generateDropIndex(desired.table.name, currentIndex.name, currentIndex.constraint))
 				ddls = append(ddls, g.generateAddIndex(desired.table.name, desiredIndex))
 			}
 		} else {
@@ -677,6 +1039,9 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 			// Foreign key not found, add foreign key.
 			definition := g.generateForeignKeyDefinition(desiredForeignKey)
 			ddl := fmt.Sprintf("ALTER TABLE %s ADD %s", g.escapeTableName(desired.table.name), definition)
+			if err := g.checkForeignKeyNameCollision(desired.table.name, desiredForeignKey.constraintName, ddl); err != nil {
+				return ddls, err
+			}
 			ddls = append(ddls, ddl)
 		}
 	}
@@ -688,12 +1053,25 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 				switch g.mode {
 				case GeneratorModePostgres:
 					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentCheck.constraintName)))
-					ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredCheck.constraintName), desiredCheck.definition))
+					ddls = append(ddls, g.generateAddCheck(desired.table.name, desiredCheck))
 				default:
 				}
+			} else if currentCheck.notValid && !desiredCheck.notValid {
+				// A NOT VALID constraint is validated only when the desired one is validated, i.e. doesn't have NOT VALID.
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s VALIDATE CONSTRAINT %s", g.escapeTableName(desired.table.name), g.escapeSQLName(currentCheck.constraintName)))
+			}
+			if g.mode == GeneratorModeMysql && currentCheck.notEnforced != desiredCheck.notEnforced {
+				ddls = append(ddls, g.generateAlterCheckEnforcement(desired.table.name, desiredCheck))
 			}
 		} else {
-			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(desired.table.name), g.escapeSQLName(desiredCheck.constraintName), desiredCheck.definition))
+			ddls = append(ddls, g.generateAddCheck(desired.table.name, desiredCheck))
+		}
+	}
+	if g.mode == GeneratorModeMysql {
+		for _, desiredCheck := range mysqlColumnChecks(desired.table) {
+			if currentCheck := findCheckByName(currentTable.checks, desiredCheck.constraintName); currentCheck != nil && currentCheck.notEnforced != desiredCheck.notEnforced {
+				ddls = append(ddls, g.generateAlterCheckEnforcement(desired.table.name, desiredCheck))
+			}
 		}
 	}
 
@@ -702,9 +1080,89 @@ func (g *Generator) generateDDLsForCreateTable(currentTable Table, desired Creat
 		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s COMMENT = %s", g.escapeTableName(desired.table.name), desired.table.options["comment"]))
 	}
 
+	// Examine ENGINE, which is changed only when the desired table specifies it since the change rebuilds the table.
+	if g.mode == GeneratorModeMysql {
+		if engine := tableOption(desired.table, "engine"); engine != "" && !strings.EqualFold(engine, tableOption(currentTable, "engine")) {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ENGINE=%s", g.escapeTableName(desired.table.name), engine))
+		}
+	}
+
+	// Examine UNLOGGED
+	if g.mode == GeneratorModePostgres && currentTable.unlogged != desired.table.unlogged {
+		if desired.table.unlogged {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s SET UNLOGGED", g.escapeTableName(desired.table.name)))
+		} else {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s SET LOGGED", g.escapeTableName(desired.table.name)))
+		}
+	}
+
+	// Examine INHERITS. The columns inherited from the parents aren't in either table, which are managed by the parents.
+	if g.mode == GeneratorModePostgres {
+		for _, parent := range currentTable.inherits {
+			if !containsString(desired.table.inherits, parent) {
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s NO INHERIT %s", g.escapeTableName(desired.table.name), g.escapeTableName(parent)))
+			}
+		}
+		for _, parent := range desired.table.inherits {
+			if !containsString(currentTable.inherits, parent) {
+				ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s INHERIT %s", g.escapeTableName(desired.table.name), g.escapeTableName(parent)))
+			}
+		}
+	}
+
 	return ddls, nil
 }
 
+// Return true if the column is inherited from a parent table of the desired schema, directly or indirectly.
+func (g *Generator) isInheritedColumn(table Table, columnName string) bool {
+	for _, parentName := range table.inherits {
+		parent := findTableByName(g.desiredTables, parentName)
+		if parent != nil && (findColumnByName(parent.columns, columnName) != nil || g.isInheritedColumn(*parent, columnName)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Generator) generateAddCheck(table string, check CheckDefinition) string {
+	ddl := fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(table), g.escapeSQLName(check.constraintName), check.definition)
+	if check.notValid {
+		ddl += " NOT VALID"
+	}
+	if check.notEnforced {
+		ddl += " NOT ENFORCED"
+	}
+	return ddl
+}
+
+// MySQL can change only the enforcement of a CHECK constraint without recreating it.
+func (g *Generator) generateAlterCheckEnforcement(table string, check CheckDefinition) string {
+	enforced := "ENFORCED"
+	if check.notEnforced {
+		enforced = "NOT ENFORCED"
+	}
+	return fmt.Sprintf("ALTER TABLE %s ALTER CHECK %s %s", g.escapeTableName(table), g.escapeSQLName(check.constraintName), enforced)
+}
+
+// MySQL turns a column-level CHECK into a table-level one, named <table>_chk_<n> unless it has a name, where n counts
+// the unnamed ones from 1. Return the column-level CHECKs with the names that MySQL gives them.
+func mysqlColumnChecks(table Table) []CheckDefinition {
+	checks := []CheckDefinition{}
+	n := 0
+	for _, column := range table.columns {
+		if column.check == nil {
+			continue
+		}
+		check := *column.check
+		if check.constraintName == "" {
+			n++
+			check.constraintName = fmt.Sprintf("%s_chk_%d", table.name, n)
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
 // Shared by `CREATE INDEX` and `ALTER TABLE ADD INDEX`.
 // This manages `g.currentTables` unlike `generateDDLsForCreateTable`...
 func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex Index, action string, statement string) ([]string, error) {
@@ -722,6 +1180,14 @@ func (g *Generator) generateDDLsForCreateIndex(tableName string, desiredIndex In
 		return ddls, nil
 	}
 
+	if g.mode == GeneratorModePostgres && findIndexByName(currentTable.indexes, desiredIndex.name) == nil {
+		name, err := g.resolveIndexNameCollision(tableName, desiredIndex, statement)
+		if err != nil {
+			return nil, err
+		}
+		desiredIndex.name = name
+	}
+
 	currentIndex := findIndexByName(currentTable.indexes, desiredIndex.name)
 	if currentIndex == nil {
 		// Index not found, add index.
@@ -758,6 +1224,9 @@ func (g *Generator) generateDDLsForAddForeignKey(tableName string, desiredForeig
 	currentForeignKey := findForeignKeyByName(currentTable.foreignKeys, desiredForeignKey.constraintName)
 	if currentForeignKey == nil {
 		// Foreign Key not found, add foreign key
+		if err := g.checkForeignKeyNameCollision(tableName, desiredForeignKey.constraintName, statement); err != nil {
+			return nil, err
+		}
 		ddls = append(ddls, statement)
 		currentTable.foreignKeys = append(currentTable.foreignKeys, desiredForeignKey)
 	} else {
@@ -778,6 +1247,108 @@ func (g *Generator) generateDDLsForAddForeignKey(tableName string, desiredForeig
 	return ddls, nil
 }
 
+// Index names are unique within a schema in PostgreSQL, where indexes share the namespace with the other relations,
+// i.e. tables, views, and sequences. An explicit name which collides with another relation fails on apply, and an
+// omitted name is disambiguated by PostgreSQL with a numeric suffix.
+func (g *Generator) resolveIndexNameCollision(tableName string, index Index, statement string) (string, error) {
+	schema, _ := splitTableName(tableName, g.defaultSchema)
+	name := index.name
+	for i := 1; ; i++ {
+		collided := g.findRelationInSchema(schema, tableName, name)
+		if collided == "" {
+			return name, nil
+		}
+		if !index.nameGenerated {
+			return "", fmt.Errorf("index '%s' of table '%s' collides with %s in the same schema: '%s'", name, tableName, collided, statement)
+		}
+		name = fmt.Sprintf("%s%d", index.name, i)
+	}
+}
+
+// Describe the relation named relationName in the schema: a table, a view, the sequence of a serial or identity column,
+// or an index of a table or view other than excludedTable. An empty string is returned if there's none.
+func (g *Generator) findRelationInSchema(schema string, excludedTable string, relationName string) string {
+	for _, table := range g.currentTables {
+		tableSchema, name := splitTableName(table.name, g.defaultSchema)
+		if tableSchema != schema {
+			continue
+		}
+		if name == relationName {
+			return fmt.Sprintf("table '%s'", table.name)
+		}
+		for _, column := range table.columns {
+			if (isSerial(column) || column.identity != nil) && PostgresConstraintName(name, []string{column.name}, "seq") == relationName {
+				return fmt.Sprintf("the sequence of column '%s' of table '%s'", column.name, table.name)
+			}
+		}
+		if table.name != excludedTable && findIndexByName(table.indexes, relationName) != nil {
+			return fmt.Sprintf("the index of table '%s'", table.name)
+		}
+	}
+	for _, view := range g.currentViews {
+		viewSchema, name := splitTableName(view.name, g.defaultSchema)
+		if viewSchema != schema {
+			continue
+		}
+		if name == relationName {
+			return fmt.Sprintf("view '%s'", view.name)
+		}
+		if view.name != excludedTable && findIndexByName(view.indexes, relationName) != nil {
+			return fmt.Sprintf("the index of view '%s'", view.name)
+		}
+	}
+	return ""
+}
+
+// Foreign key names are unique within a database in MySQL, so adding a foreign key whose name is used
+// by another table fails on apply. In PostgreSQL, a foreign key isn't a relation, and its name only needs to be unique
+// within the table, so it can't collide with the other tables or relations.
+func (g *Generator) checkForeignKeyNameCollision(tableName string, constraintName string, statement string) error {
+	if g.mode != GeneratorModeMysql || constraintName == "" {
+		return nil
+	}
+	for _, table := range g.currentTables {
+		if table.name != tableName && findForeignKeyByName(table.foreignKeys, constraintName) != nil {
+			return fmt.Errorf("foreign key '%s' of table '%s' collides with the foreign key of table '%s' in the same database: '%s'", constraintName, tableName, table.name, statement)
+		}
+	}
+	return nil
+}
+
+func (g *Generator) generateDDLsForAddExclusion(tableName string, desiredExclusion Exclusion, action string, statement string) ([]string, error) {
+	var ddls []string
+
+	currentTable := findTableByName(g.currentTables, tableName)
+	if currentTable == nil {
+		return nil, fmt.Errorf("%s is performed for inexistent table '%s': '%s'", action, tableName, statement)
+	}
+
+	currentExclusion := findExclusionByName(currentTable.exclusions, desiredExclusion.constraintName)
+	if currentExclusion == nil {
+		// Exclusion constraint not found, add exclusion constraint.
+		ddls = append(ddls, statement)
+		currentTable.exclusions = append(currentTable.exclusions, desiredExclusion)
+	} else {
+		// Exclusion constraint found. If it's different, drop and add exclusion constraint.
+		if !areSameExclusions(*currentExclusion, desiredExclusion) {
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", g.escapeTableName(currentTable.name), g.escapeSQLName(currentExclusion.constraintName)))
+			ddls = append(ddls, statement)
+		}
+	}
+
+	// Examine exclusion constraints in desiredTable to delete obsoleted ones later
+	desiredTable := findTableByName(g.desiredTables, tableName)
+	if desiredTable == nil {
+		return nil, fmt.Errorf("%s is performed before create table '%s': '%s'", action, tableName, statement)
+	}
+	if containsString(convertExclusionsToConstraintNames(desiredTable.exclusions), desiredExclusion.constraintName) {
+		return nil, fmt.Errorf("exclusion constraint '%s' is doubly created against table '%s': '%s'", desiredExclusion.constraintName, tableName, statement)
+	}
+	desiredTable.exclusions = append(desiredTable.exclusions, desiredExclusion)
+
+	return ddls, nil
+}
+
 func (g *Generator) generateDDLsForCreatePolicy(tableName string, desiredPolicy Policy, action string, statement string) ([]string, error) {
 	var ddls []string
 
@@ -850,18 +1421,20 @@ func (g *Generator) generateDDLsForCreateView(viewName string, desiredView *View
 		g.currentViews = append(g.currentViews, &view)
 	} else if desiredView.viewType == "VIEW" { // TODO: Fix the definition comparison for materialized views and enable this
 		// View found. If it's different, create or replace view.
-		if g.normalizeViewDefinition(currentView.definition) != g.normalizeViewDefinition(desiredView.definition) {
+		if g.normalizeViewDefinition(currentView.definition) != g.normalizeViewDefinition(desiredView.definition) ||
+			!isSameDefiner(currentView.definer, desiredView.definer) {
 			if g.shouldDropAndCreateView(currentView, desiredView) {
 				ddls = append(ddls, fmt.Sprintf("DROP %s %s", desiredView.viewType, g.escapeTableName(viewName)))
-				ddls = append(ddls, fmt.Sprintf("CREATE %s %s AS %s", desiredView.viewType, g.escapeTableName(viewName), desiredView.definition))
+				ddls = append(ddls, fmt.Sprintf("CREATE %s%s %s AS %s", formatDefiner(desiredView.definer), desiredView.viewType, g.escapeTableName(viewName), desiredView.definition))
 			} else {
-				ddls = append(ddls, fmt.Sprintf("CREATE OR REPLACE %s %s AS %s", desiredView.viewType, g.escapeTableName(viewName), desiredView.definition))
+				ddls = append(ddls, fmt.Sprintf("CREATE OR REPLACE %s%s %s AS %s", formatDefiner(desiredView.definer), desiredView.viewType, g.escapeTableName(viewName), desiredView.definition))
 			}
 		}
 	} else if desiredView.viewType == "SQL SECURITY" {
 		// VIEW with the specified security type found. If it's different, create or replace view.
-		if g.normalizeViewDefinition(currentView.securityType) != g.normalizeViewDefinition(desiredView.securityType) {
-			ddls = append(ddls, fmt.Sprintf("CREATE OR REPLACE SQL SECURITY %s VIEW %s AS %s", desiredView.securityType, g.escapeTableName(viewName), desiredView.definition))
+		if g.normalizeViewDefinition(currentView.securityType) != g.normalizeViewDefinition(desiredView.securityType) ||
+			!isSameDefiner(currentView.definer, desiredView.definer) {
+			ddls = append(ddls, fmt.Sprintf("CREATE OR REPLACE %sSQL SECURITY %s VIEW %s AS %s", formatDefiner(desiredView.definer), desiredView.securityType, g.escapeTableName(viewName), desiredView.definition))
 		}
 	}
 
@@ -874,9 +1447,44 @@ func (g *Generator) generateDDLsForCreateView(viewName string, desiredView *View
 	return ddls, nil
 }
 
-// Workaround for: jsonb_extract_path_text(payload, array['amount'])
-// generated by jsonb_extract_path_text(payload, 'amount')
-// and collate, etc.
+// PostgreSQL can't alter the type of a column used by a view, so drop the views depending on the column, and the
+// views depending on them, before ALTER COLUMN ... TYPE. The dropped views are removed from currentViews so that
+// they're created again by generateDDLsForCreateView, or recreated right after the type change when the desired
+// views have already been examined. The views which aren't desired are just dropped.
+func (g *Generator) generateDDLsForDependentViews(tableName string, columnName string) ([]string, []string) {
+	var dependentViews []*View
+	for _, view := range g.currentViews {
+		if containsString(view.tables, tableName) && (containsString(view.references, columnName) || containsString(view.references, "*")) {
+			dependentViews = append(dependentViews, view)
+		}
+	}
+	for i := 0; i < len(dependentViews); i++ {
+		for _, view := range g.currentViews {
+			if containsString(view.tables, dependentViews[i].name) && findViewByName(dependentViews, view.name) == nil {
+				dependentViews = append(dependentViews, view)
+			}
+		}
+	}
+
+	var dropDDLs, createDDLs []string
+	for i := len(dependentViews) - 1; i >= 0; i-- {
+		view := dependentViews[i]
+		dropDDLs = append(dropDDLs, fmt.Sprintf("DROP %s %s", view.viewType, g.escapeTableName(view.name)))
+		g.currentViews = removeViewByName(g.currentViews, view.name)
+	}
+	for _, view := range dependentViews {
+		if desiredView := findViewByName(g.desiredViews, view.name); desiredView != nil {
+			createDDLs = append(createDDLs, desiredView.statement)
+			view := *desiredView // copy view
+			g.currentViews = append(g.currentViews, &view)
+		}
+	}
+	return dropDDLs, createDDLs
+}
+
+// Workaround for: jsonb_extract_path_text(payload, array['amount'])
+// generated by jsonb_extract_path_text(payload, 'amount')
+// and collate, etc.
 func (g *Generator) normalizeViewDefinition(definition string) string {
 	definition = strings.ToLower(definition)
 	if g.mode == GeneratorModePostgres {
@@ -897,9 +1505,15 @@ func (g *Generator) generateDDLsForCreateTrigger(triggerName string, desiredTrig
 	case GeneratorModeMssql:
 		triggerDefinition += fmt.Sprintf("TRIGGER %s ON %s %s %s AS\n%s", g.escapeSQLName(desiredTrigger.name), g.escapeTableName(desiredTrigger.tableName), desiredTrigger.time, strings.Join(desiredTrigger.event, ", "), strings.Join(desiredTrigger.body, "\n"))
 	case GeneratorModeMysql:
-		triggerDefinition += fmt.Sprintf("TRIGGER %s %s %s ON %s FOR EACH ROW %s", g.escapeSQLName(desiredTrigger.name), desiredTrigger.time, strings.Join(desiredTrigger.event, ", "), g.escapeTableName(desiredTrigger.tableName), strings.Join(desiredTrigger.body, "\n"))
+		triggerDefinition += fmt.Sprintf("%sTRIGGER %s %s %s ON %s FOR EACH ROW %s", formatDefiner(desiredTrigger.definer), g.escapeSQLName(desiredTrigger.name), desiredTrigger.time, strings.Join(desiredTrigger.event, ", "), g.escapeTableName(desiredTrigger.tableName), strings.Join(desiredTrigger.body, "\n"))
 	case GeneratorModeSQLite3:
 		triggerDefinition = desiredTrigger.statement
+	case GeneratorModePostgres:
+		triggerDefinition += fmt.Sprintf("TRIGGER %s %s %s ON %s FOR EACH %s", g.escapeSQLName(desiredTrigger.name), desiredTrigger.time, strings.Join(desiredTrigger.event, " OR "), g.escapeTableName(desiredTrigger.tableName), desiredTrigger.level)
+		if desiredTrigger.condition != "" {
+			triggerDefinition += fmt.Sprintf(" WHEN (%s)", desiredTrigger.condition)
+		}
+		triggerDefinition += " " + strings.Join(desiredTrigger.body, "\n")
 	default:
 		return ddls, nil
 	}
@@ -913,8 +1527,10 @@ func (g *Generator) generateDDLsForCreateTrigger(triggerName string, desiredTrig
 		ddls = append(ddls, createPrefix+triggerDefinition)
 	} else {
 		// Trigger found. If it's different, create or replace trigger.
-		if !areSameTriggerDefinition(currentTrigger, desiredTrigger) {
-			if g.mode != GeneratorModeMssql {
+		if !areSameTriggerDefinition(g.mode, currentTrigger, desiredTrigger) {
+			if g.mode == GeneratorModePostgres {
+				ddls = append(ddls, fmt.Sprintf("DROP TRIGGER %s ON %s", g.escapeSQLName(triggerName), g.escapeTableName(currentTrigger.tableName)))
+			} else if g.mode != GeneratorModeMssql {
 				ddls = append(ddls, fmt.Sprintf("DROP TRIGGER %s", g.escapeSQLName(triggerName)))
 			}
 			var createPrefix string
@@ -932,12 +1548,77 @@ func (g *Generator) generateDDLsForCreateTrigger(triggerName string, desiredTrig
 	return ddls, nil
 }
 
+func (g *Generator) generateDDLsForCreateEvent(desired *Event) ([]string, error) {
+	ddls := []string{}
+
+	if findEventByName(g.desiredEvents, desired.name) != nil {
+		return nil, fmt.Errorf("event '%s' is doubly created: '%s'", desired.name, desired.statement)
+	}
+	g.desiredEvents = append(g.desiredEvents, desired)
+
+	currentEvent := findEventByName(g.currentEvents, desired.name)
+	if currentEvent == nil {
+		// Event not found, create event.
+		ddls = append(ddls, desired.statement)
+	} else if !areSameEvents(currentEvent, desired) {
+		// Event found. If it's different, alter event with all clauses so that omitted ones are reset.
+		var schedule string
+		if desired.at != "" {
+			schedule = "AT " + desired.at
+		} else {
+			schedule = "EVERY " + desired.every
+			if desired.starts != "" {
+				schedule += " STARTS " + desired.starts
+			}
+			if desired.ends != "" {
+				schedule += " ENDS " + desired.ends
+			}
+		}
+		completion := "NOT PRESERVE"
+		if desired.preserve {
+			completion = "PRESERVE"
+		}
+		comment := desired.comment
+		if comment == "" {
+			comment = "''"
+		}
+		ddls = append(ddls, fmt.Sprintf("ALTER %sEVENT %s ON SCHEDULE %s ON COMPLETION %s %s COMMENT %s DO %s",
+			formatDefiner(desired.definer), g.escapeSQLName(desired.name), schedule, completion, desired.status, comment, desired.body))
+	}
+	return ddls, nil
+}
+
 func (g *Generator) generateDDLsForCreateType(desired *Type) ([]string, error) {
 	ddls := []string{}
 
+	if desired.domain != nil {
+		// Copy the domain, to which the constraints of ALTER DOMAIN are added.
+		domain := *desired.domain
+		domain.checks = append([]CheckDefinition{}, desired.domain.checks...)
+		typ := *desired
+		typ.domain = &domain
+		desired = &typ
+	}
+
 	if currentType := findTypeByName(g.currentTypes, desired.name); currentType != nil {
-		// Type found. Add values if not present.
-		if currentType.enumValues != nil && len(currentType.enumValues) < len(desired.enumValues) {
+		if currentType.domain != nil || desired.domain != nil {
+			domainDDLs, err := g.generateDDLsForDomain(currentType, desired)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, domainDDLs...)
+		} else if currentType.table != nil || desired.table != nil {
+			// A table type can't be altered. Recreate it, which fails while a procedure refers to it.
+			if currentType.table == nil || desired.table == nil || !g.areSameTableTypes(*currentType.table, *desired.table) {
+				ddls = append(ddls, fmt.Sprintf("DROP TYPE %s", g.escapeTableName(currentType.name)), desired.statement)
+			}
+		} else if currentType.attributes != nil || desired.attributes != nil {
+			ddls = append(ddls, g.generateDDLsForTypeAttributes(currentType, desired)...)
+		} else if g.enableDrop && hasRemovedEnumValues(currentType.enumValues, desired.enumValues) {
+			// Enum values can't be removed. Recreate the type and convert the columns using it.
+			ddls = append(ddls, g.generateDDLsForRecreateEnum(currentType, desired)...)
+		} else if currentType.enumValues != nil && len(currentType.enumValues) < len(desired.enumValues) {
+			// Type found. Add values if not present.
 			for _, enumValue := range desired.enumValues {
 				if !containsString(currentType.enumValues, enumValue) {
 					ddl := fmt.Sprintf("ALTER TYPE %s ADD VALUE %s", currentType.name, enumValue)
@@ -954,10 +1635,225 @@ func (g *Generator) generateDDLsForCreateType(desired *Type) ([]string, error) {
 	return ddls, nil
 }
 
+func (g *Generator) generateDDLsForDomain(currentType *Type, desired *Type) ([]string, error) {
+	if currentType.domain == nil || desired.domain == nil {
+		return nil, fmt.Errorf("a type can't be changed from or to a domain: '%s'", desired.statement)
+	}
+	current, desiredDomain := currentType.domain, desired.domain
+	if !g.haveSameDataType(current.baseType, desiredDomain.baseType) {
+		return nil, fmt.Errorf("the data type of a domain can't be changed: '%s'", desired.statement)
+	}
+
+	ddls := []string{}
+	if !g.areSameDefaultValue(current.baseType.defaultDef, desiredDomain.baseType.defaultDef) {
+		if desiredDomain.baseType.defaultDef == nil {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s DROP DEFAULT", g.escapeTableName(desired.name)))
+		} else {
+			definition, err := g.generateDefaultDefinition(*desiredDomain.baseType.defaultDef)
+			if err != nil {
+				return ddls, err
+			}
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s SET %s", g.escapeTableName(desired.name), definition))
+		}
+	}
+	if g.notNull(current.baseType) != g.notNull(desiredDomain.baseType) {
+		if g.notNull(desiredDomain.baseType) {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s SET NOT NULL", g.escapeTableName(desired.name)))
+		} else {
+			ddls = append(ddls, fmt.Sprintf("ALTER DOMAIN %s DROP NOT NULL", g.escapeTableName(desired.name)))
+		}
+	}
+	for _, desiredCheck := range desiredDomain.checks {
+		ddls = append(ddls, g.generateDDLsForDomainCheck(currentType, desiredCheck)...)
+	}
+	return ddls, nil
+}
+
+// Constraints of domains are compared by their names like those of tables. A changed constraint is recreated, and
+// a NOT VALID one is validated when the desired one doesn't have NOT VALID.
+func (g *Generator) generateDDLsForDomainCheck(currentType *Type, desiredCheck CheckDefinition) []string {
+	currentCheck := findCheckByName(currentType.domain.checks, desiredCheck.constraintName)
+	if currentCheck == nil {
+		return []string{g.generateAddDomainCheck(currentType.name, desiredCheck)}
+	}
+	if currentCheck.definition != desiredCheck.definition {
+		return []string{
+			fmt.Sprintf("ALTER DOMAIN %s DROP CONSTRAINT %s", g.escapeTableName(currentType.name), g.escapeSQLName(currentCheck.constraintName)),
+			g.generateAddDomainCheck(currentType.name, desiredCheck),
+		}
+	}
+	if currentCheck.notValid && !desiredCheck.notValid {
+		return []string{fmt.Sprintf("ALTER DOMAIN %s VALIDATE CONSTRAINT %s", g.escapeTableName(currentType.name), g.escapeSQLName(currentCheck.constraintName))}
+	}
+	return []string{}
+}
+
+func (g *Generator) generateDDLsForAddDomainConstraint(desired *AddDomainConstraint) []string {
+	if desiredType := findTypeByName(g.desiredTypes, desired.typeName); desiredType != nil && desiredType.domain != nil {
+		desiredType.domain.checks = append(desiredType.domain.checks, desired.check)
+	}
+	currentType := findTypeByName(g.currentTypes, desired.typeName)
+	if currentType == nil || currentType.domain == nil {
+		// The domain is created by the desired SQL.
+		return []string{desired.statement}
+	}
+	return g.generateDDLsForDomainCheck(currentType, desired.check)
+}
+
+func (g *Generator) generateAddDomainCheck(typeName string, check CheckDefinition) string {
+	ddl := fmt.Sprintf("ALTER DOMAIN %s ADD CONSTRAINT %s CHECK (%s)", g.escapeTableName(typeName), g.escapeSQLName(check.constraintName), check.definition)
+	if check.notValid {
+		ddl += " NOT VALID"
+	}
+	return ddl
+}
+
+// The table name of CREATE TABLE, which may be quoted by double quotes, backquotes or brackets.
+var createTableName = regexp.MustCompile(`(?is)^\s*CREATE\s+TABLE\s+(?:IF\s+NOT\s+EXISTS\s+)?("[^"]*"|` + "`[^`]*`" + `|\[[^\]]*\]|[^\s(]+)`)
+
+// Recreate the table by the SQLite3 procedure to make schema changes that ALTER TABLE can't: create the desired table
+// with another name, copy the rows of the common columns, drop the current table, and rename the new one. Indexes and
+// triggers are dropped with the current table, so they are forgotten here to be created again.
+func (g *Generator) generateDDLsForRecreateTable(currentTable Table, desired CreateTable) ([]string, error) {
+	newName := desired.table.name + "__new"
+	loc := createTableName.FindStringSubmatchIndex(desired.statement)
+	if loc == nil {
+		return nil, fmt.Errorf("table name is not found to recreate table '%s': '%s'", desired.table.name, desired.statement)
+	}
+	createTable := desired.statement[:loc[2]] + g.escapeSQLName(newName) + desired.statement[loc[3]:]
+
+	var columns []string
+	for _, desiredColumn := range desired.table.columns {
+		currentColumn := findColumnByName(currentTable.columns, desiredColumn.name)
+		if currentColumn == nil || currentColumn.generated != nil || desiredColumn.generated != nil {
+			continue
+		}
+		columns = append(columns, g.escapeSQLName(desiredColumn.name))
+	}
+
+	ddls := []string{createTable}
+	if len(columns) > 0 {
+		ddls = append(ddls, fmt.Sprintf("INSERT INTO %s (%s) SELECT %s FROM %s", g.escapeSQLName(newName), strings.Join(columns, ", "), strings.Join(columns, ", "), g.escapeTableName(currentTable.name)))
+	}
+	ddls = append(ddls,
+		fmt.Sprintf("DROP TABLE %s", g.escapeTableName(currentTable.name)),
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s", g.escapeSQLName(newName), g.escapeSQLName(desired.table.name)),
+	)
+
+	if table := findTableByName(g.currentTables, currentTable.name); table != nil {
+		*table = desired.table
+		table.indexes = append([]Index{}, desired.table.indexes...)
+	}
+	var triggers []*Trigger
+	for _, trigger := range g.currentTriggers {
+		if trigger.tableName != currentTable.name {
+			triggers = append(triggers, trigger)
+		}
+	}
+	g.currentTriggers = triggers
+	return ddls, nil
+}
+
+func (g *Generator) generateDDLsForTypeAttributes(currentType *Type, desired *Type) []string {
+	ddls := []string{}
+	for _, desiredAttribute := range desired.attributes {
+		currentAttribute := findColumnByName(currentType.attributes, desiredAttribute.name)
+		if currentAttribute == nil {
+			ddls = append(ddls, fmt.Sprintf("ALTER TYPE %s ADD ATTRIBUTE %s %s", g.escapeTableName(currentType.name), g.escapeSQLName(desiredAttribute.name), generateDataType(desiredAttribute)))
+		} else if !g.haveSameDataType(*currentAttribute, desiredAttribute) {
+			ddls = append(ddls, fmt.Sprintf("ALTER TYPE %s ALTER ATTRIBUTE %s TYPE %s", g.escapeTableName(currentType.name), g.escapeSQLName(desiredAttribute.name), generateDataType(desiredAttribute)))
+		}
+	}
+	for _, currentAttribute := range currentType.attributes {
+		if g.enableDrop && findColumnByName(desired.attributes, currentAttribute.name) == nil {
+			ddls = append(ddls, fmt.Sprintf("ALTER TYPE %s DROP ATTRIBUTE %s", g.escapeTableName(currentType.name), g.escapeSQLName(currentAttribute.name)))
+		}
+	}
+	return ddls
+}
+
+// Compare the columns and the primary key of table types. The names of the primary keys are ignored since SQL Server
+// generates them.
+func (g *Generator) areSameTableTypes(current Table, desired Table) bool {
+	if len(current.columns) != len(desired.columns) {
+		return false
+	}
+	for i, desiredColumn := range desired.columns {
+		currentColumn := current.columns[i]
+		if currentColumn.name != desiredColumn.name || !g.haveSameColumnDefinition(currentColumn, desiredColumn, current, desired) {
+			return false
+		}
+	}
+	return reflect.DeepEqual(tableTypePrimaryKey(current), tableTypePrimaryKey(desired))
+}
+
+// Return the columns of the primary key of a table type, given either as a column option or as a table constraint.
+func tableTypePrimaryKey(table Table) []string {
+	columns := []string{}
+	for _, column := range table.columns {
+		if column.keyOption == ColumnKeyPrimary {
+			columns = append(columns, column.name)
+		}
+	}
+	for _, index := range table.indexes {
+		if index.primary {
+			for _, column := range index.columns {
+				columns = append(columns, column.column)
+			}
+		}
+	}
+	return columns
+}
+
+func (g *Generator) generateDDLsForRecreateEnum(currentType *Type, desired *Type) []string {
+	schema, name := splitTableName(currentType.name, g.defaultSchema)
+	oldName := name + "__old"
+	ddls := []string{
+		fmt.Sprintf("ALTER TYPE %s RENAME TO %s", g.escapeTableName(currentType.name), g.escapeSQLName(oldName)),
+		desired.statement,
+	}
+	for _, table := range g.currentTables {
+		for _, column := range table.columns {
+			if normalizedTable(g.mode, strings.Trim(column.typeName, `"`), g.defaultSchema) != currentType.name {
+				continue
+			}
+			typeName := g.escapeTableName(currentType.name)
+			textType := "text"
+			if column.array {
+				typeName += "[]"
+				textType += "[]"
+			}
+			ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s USING %s::%s::%s",
+				g.escapeTableName(table.name), g.escapeSQLName(column.name), typeName, g.escapeSQLName(column.name), textType, typeName))
+		}
+	}
+	ddls = append(ddls, fmt.Sprintf("DROP TYPE %s", g.escapeTableName(schema+"."+oldName)))
+	return ddls
+}
+
+func hasRemovedEnumValues(currentValues []string, desiredValues []string) bool {
+	for _, value := range currentValues {
+		if !containsString(desiredValues, value) {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *Generator) generateDDLsForComment(desired *Comment) ([]string, error) {
 	ddls := []string{}
 
-	currentComment := findCommentByObject(g.currentComments, desired.comment.Object)
+	currentComment := findCommentByObject(g.currentComments, desired.comment)
+	if g.mode == GeneratorModeMssql {
+		// SQL Server has no COMMENT ON, so the comment is managed as the extended property MS_Description.
+		if currentComment == nil {
+			ddls = append(ddls, "EXEC sp_addextendedproperty "+extendedPropertyArgs(desired.comment.Object, &desired.comment.Comment))
+		} else if currentComment.comment.Comment != desired.comment.Comment {
+			ddls = append(ddls, "EXEC sp_updateextendedproperty "+extendedPropertyArgs(desired.comment.Object, &desired.comment.Comment))
+		}
+		return ddls, nil
+	}
+
 	if currentComment == nil || currentComment.comment.Comment != desired.comment.Comment {
 		// Comment not found, add comment.
 		ddls = append(ddls, desired.statement)
@@ -966,6 +1862,218 @@ func (g *Generator) generateDDLsForComment(desired *Comment) ([]string, error) {
 	return ddls, nil
 }
 
+// SET NOT NULL of PostgreSQL. With safe_not_null, the column is first checked by a NOT VALID CHECK, which is validated
+// in its own transaction without blocking writes, so that SET NOT NULL of PostgreSQL 12+ skips the scan under the
+// ACCESS EXCLUSIVE lock. The CHECK is dropped after that.
+func (g *Generator) generateDDLsForSetNotNull(tableName string, columnName string) []string {
+	table := g.escapeTableName(tableName)
+	column := g.escapeSQLName(columnName)
+	if !g.safeNotNull {
+		return []string{fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL", table, column)}
+	}
+
+	_, name := splitTableName(tableName, g.defaultSchema)
+	constraint := g.escapeSQLName(PostgresConstraintName(name, []string{columnName}, "not_null"))
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s IS NOT NULL) NOT VALID", table, constraint, column),
+		fmt.Sprintf("ALTER TABLE %s VALIDATE CONSTRAINT %s", table, constraint),
+		fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s SET NOT NULL", table, column),
+		fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s", table, constraint),
+	}
+}
+
+// Arguments of sp_addextendedproperty and its friends for MS_Description of "schema.table" or "schema.table.column".
+// The value is omitted for sp_dropextendedproperty.
+func extendedPropertyArgs(object string, value *string) string {
+	args := []string{"@name = N'MS_Description'"}
+	if value != nil {
+		args = append(args, "@value = "+unicodeStringConstant(*value))
+	}
+	levels := []string{"SCHEMA", "TABLE", "COLUMN"}
+	for i, name := range strings.SplitN(object, ".", 3) {
+		args = append(args,
+			fmt.Sprintf("@level%dtype = N'%s'", i, levels[i]),
+			fmt.Sprintf("@level%dname = %s", i, unicodeStringConstant(name)))
+	}
+	return strings.Join(args, ", ")
+}
+
+func unicodeStringConstant(str string) string {
+	return "N'" + strings.ReplaceAll(str, "'", "''") + "'"
+}
+
+// Whether the commented table or column is still desired, i.e. the comment isn't dropped with it.
+func (g *Generator) isCommentedObjectDesired(object string) bool {
+	parts := strings.SplitN(object, ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	table := findTableByName(g.desiredTables, parts[0]+"."+parts[1])
+	if table == nil {
+		return false
+	}
+	return len(parts) == 2 || containsString(convertColumnsToColumnNames(table.columns), parts[2])
+}
+
+func (g *Generator) generateDDLsForClusterOn(desired *ClusterOn) ([]string, error) {
+	ddls := []string{}
+
+	currentTable := findTableByName(g.currentTables, desired.tableName)
+	desiredTable := findTableByName(g.desiredTables, desired.tableName)
+	if currentTable == nil || desiredTable == nil {
+		return nil, fmt.Errorf("CLUSTER ON is performed for inexistent table '%s': '%s'", desired.tableName, desired.statement)
+	}
+
+	if currentTable.clusterOn != desired.indexName {
+		ddls = append(ddls, fmt.Sprintf("ALTER TABLE %s CLUSTER ON %s", g.escapeTableName(desired.tableName), g.escapeSQLName(desired.indexName)))
+		currentTable.clusterOn = desired.indexName
+	}
+	desiredTable.clusterOn = desired.indexName
+
+	return ddls, nil
+}
+
+// Owners are changed only for roles listed in `managed_roles`, so that roles which are not
+// managed by sqldef (e.g. superusers in a local environment) don't cause unexpected diffs.
+func (g *Generator) generateDDLsForOwner(desired *Owner) ([]string, error) {
+	ddls := []string{}
+
+	if !containsString(g.managedRoles, desired.owner) {
+		return ddls, nil
+	}
+
+	var current *string
+	if desired.objectType == "VIEW" {
+		if view := findViewByName(g.currentViews, desired.tableName); view != nil {
+			current = &view.owner
+		}
+	} else {
+		if table := findTableByName(g.currentTables, desired.tableName); table != nil {
+			current = &table.owner
+		}
+	}
+	if current == nil {
+		return nil, fmt.Errorf("%s OWNER TO is performed for a missing %s: %s", desired.objectType, strings.ToLower(desired.objectType), desired.statement)
+	}
+
+	if *current != desired.owner {
+		ddls = append(ddls, fmt.Sprintf("ALTER %s %s OWNER TO %s", desired.objectType, g.escapeTableName(desired.tableName), g.escapeSQLName(desired.owner)))
+		*current = desired.owner
+	}
+
+	return ddls, nil
+}
+
+func (g *Generator) generateDDLsForPublication(desired *Publication) ([]string, error) {
+	ddls := []string{}
+
+	if containsString(convertPublicationNames(g.desiredPublications), desired.name) {
+		return nil, fmt.Errorf("publication '%s' is doubly created: '%s'", desired.name, desired.statement)
+	}
+	g.desiredPublications = append(g.desiredPublications, desired)
+
+	currentPublication := findPublicationByName(g.currentPublications, desired.name)
+	if currentPublication == nil {
+		// Publication not found, create publication.
+		ddls = append(ddls, desired.statement)
+		return ddls, nil
+	}
+
+	if currentPublication.allTables != desired.allTables {
+		// FOR ALL TABLES cannot be changed by ALTER PUBLICATION.
+		ddls = append(ddls, fmt.Sprintf("DROP PUBLICATION %s", g.escapeSQLName(currentPublication.name)))
+		ddls = append(ddls, desired.statement)
+		return ddls, nil
+	}
+
+	for _, table := range desired.tables {
+		if !containsString(currentPublication.tables, table) {
+			ddls = append(ddls, fmt.Sprintf("ALTER PUBLICATION %s ADD TABLE %s", g.escapeSQLName(desired.name), g.escapeTableName(table)))
+		}
+	}
+	for _, table := range currentPublication.tables {
+		if !containsString(desired.tables, table) {
+			ddls = append(ddls, fmt.Sprintf("ALTER PUBLICATION %s DROP TABLE %s", g.escapeSQLName(desired.name), g.escapeTableName(table)))
+		}
+	}
+	return ddls, nil
+}
+
+// Like owners, roles are created and altered only when they are listed in `managed_roles`. Passwords are never
+// touched, and roles are never dropped because they are shared by all databases of the cluster.
+func (g *Generator) generateDDLsForRole(desired *Role) ([]string, error) {
+	ddls := []string{}
+
+	if !containsString(g.managedRoles, desired.role.Name) {
+		return ddls, nil
+	}
+
+	if findRoleByName(g.desiredRoles, desired.role.Name) != nil {
+		return nil, fmt.Errorf("role '%s' is doubly created: '%s'", desired.role.Name, desired.statement)
+	}
+	g.desiredRoles = append(g.desiredRoles, desired)
+
+	currentRole := findRoleByName(g.currentRoles, desired.role.Name)
+	if currentRole == nil {
+		// Role not found, create role.
+		ddl := fmt.Sprintf("CREATE ROLE %s", g.escapeSQLName(desired.role.Name))
+		if attributes := roleAttributeChanges(parser.Role{Inherit: true, ConnectionLimit: -1}, desired.role); len(attributes) > 0 {
+			ddl += " WITH " + strings.Join(attributes, " ")
+		}
+		ddls = append(ddls, ddl)
+		currentRole = &Role{role: parser.Role{Name: desired.role.Name}}
+		g.currentRoles = append(g.currentRoles, currentRole)
+	} else if attributes := roleAttributeChanges(currentRole.role, desired.role); len(attributes) > 0 {
+		ddls = append(ddls, fmt.Sprintf("ALTER ROLE %s WITH %s", g.escapeSQLName(desired.role.Name), strings.Join(attributes, " ")))
+	}
+
+	for _, inRole := range desired.role.InRoles {
+		if !containsString(currentRole.role.InRoles, inRole) {
+			ddls = append(ddls, fmt.Sprintf("GRANT %s TO %s", g.escapeSQLName(inRole), g.escapeSQLName(desired.role.Name)))
+		}
+	}
+	for _, inRole := range currentRole.role.InRoles {
+		if !containsString(desired.role.InRoles, inRole) {
+			ddls = append(ddls, fmt.Sprintf("REVOKE %s FROM %s", g.escapeSQLName(inRole), g.escapeSQLName(desired.role.Name)))
+		}
+	}
+	currentRole.role = desired.role
+
+	return ddls, nil
+}
+
+// Returns the attributes to change a role from `current` to `desired`, e.g. LOGIN, NOCREATEDB or CONNECTION LIMIT 10.
+func roleAttributeChanges(current parser.Role, desired parser.Role) []string {
+	flags := []struct {
+		name             string
+		current, desired bool
+	}{
+		{"LOGIN", current.Login, desired.Login},
+		{"SUPERUSER", current.Superuser, desired.Superuser},
+		{"CREATEDB", current.CreateDB, desired.CreateDB},
+		{"CREATEROLE", current.CreateRole, desired.CreateRole},
+		{"INHERIT", current.Inherit, desired.Inherit},
+		{"REPLICATION", current.Replication, desired.Replication},
+		{"BYPASSRLS", current.BypassRLS, desired.BypassRLS},
+	}
+
+	attributes := []string{}
+	for _, flag := range flags {
+		if flag.current == flag.desired {
+			continue
+		}
+		if flag.desired {
+			attributes = append(attributes, flag.name)
+		} else {
+			attributes = append(attributes, "NO"+flag.name)
+		}
+	}
+	if current.ConnectionLimit != desired.ConnectionLimit {
+		attributes = append(attributes, fmt.Sprintf("CONNECTION LIMIT %d", desired.ConnectionLimit))
+	}
+	return attributes
+}
+
 func (g *Generator) generateDDLsForExtension(desired *Extension) ([]string, error) {
 	ddls := []string{}
 
@@ -996,6 +2104,35 @@ func (g *Generator) generateDDLsForSchema(desired *Schema) ([]string, error) {
 	return ddls, nil
 }
 
+// Create the schemas of the desired tables, views, and types that neither the desired SQL nor the database has.
+func (g *Generator) generateDDLsForReferencedSchemas(desiredDDLs []DDL) []string {
+	ddls := []string{}
+	for _, ddl := range desiredDDLs {
+		var name string
+		switch desired := ddl.(type) {
+		case *CreateTable:
+			name = desired.table.name
+		case *View:
+			name = desired.name
+		case *Type:
+			name = desired.name
+		default:
+			continue
+		}
+		schemaName, _ := splitTableName(name, g.defaultSchema)
+		if schemaName == g.defaultSchema || findSchemaByName(g.currentSchemas, schemaName) != nil {
+			continue
+		}
+		schema := Schema{
+			statement: fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", g.escapeSQLName(schemaName)),
+			schema:    parser.Schema{Name: schemaName},
+		}
+		ddls = append(ddls, schema.statement)
+		g.currentSchemas = append(g.currentSchemas, &schema)
+	}
+	return ddls
+}
+
 // Even though simulated table doesn't have a foreign key, references could exist in column definitions.
 // This carefully generates DROP CONSTRAINT for such situations.
 func (g *Generator) generateDDLsForAbsentForeignKey(currentForeignKey ForeignKey, currentTable Table, desiredTable Table) []string {
@@ -1022,6 +2159,37 @@ func (g *Generator) generateDDLsForAbsentForeignKey(currentForeignKey ForeignKey
 	return ddls
 }
 
+// MySQL implicitly creates an index for a foreign key if no index is usable for it, and silently drops the index
+// when another usable index is added later. So the index of a dropped foreign key is already gone by the time of
+// DROP INDEX if the desired table has another index whose leading columns are the ones of the foreign key.
+func isImplicitlyDroppedForeignKeyIndex(currentIndex Index, currentTable Table, desiredTable Table) bool {
+	for _, foreignKey := range currentTable.foreignKeys {
+		if findForeignKeyByName(desiredTable.foreignKeys, foreignKey.constraintName) != nil ||
+			!containsString(convertForeignKeysToIndexNames([]ForeignKey{foreignKey}), currentIndex.name) ||
+			!hasLeadingIndexColumns(currentIndex, foreignKey.indexColumns) || len(currentIndex.columns) != len(foreignKey.indexColumns) {
+			continue
+		}
+		for _, desiredIndex := range desiredTable.indexes {
+			if hasLeadingIndexColumns(desiredIndex, foreignKey.indexColumns) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasLeadingIndexColumns(index Index, columns []string) bool {
+	if len(index.columns) < len(columns) {
+		return false
+	}
+	for i, column := range columns {
+		if index.columns[i].column != column {
+			return false
+		}
+	}
+	return true
+}
+
 // Even though simulated table doesn't have an index, primary or unique could exist in column definitions.
 // This carefully generates DROP INDEX for such situations.
 func (g *Generator) generateDDLsForAbsentIndex(currentIndex Index, currentTable Table, desiredTable Table) ([]string, error) {
@@ -1175,6 +2343,10 @@ func (g *Generator) generateColumnDefinition(column Column, enableUnique bool) (
 		definition += fmt.Sprintf("ON UPDATE %s ", string(column.onUpdate.raw))
 	}
 
+	if column.invisible {
+		definition += "INVISIBLE "
+	}
+
 	if column.comment != nil {
 		// TODO: Should this use StringConstant?
 		definition += fmt.Sprintf("COMMENT '%s' ", string(column.comment.raw))
@@ -1189,6 +2361,9 @@ func (g *Generator) generateColumnDefinition(column Column, enableUnique bool) (
 		if column.check.noInherit {
 			definition += "NO INHERIT "
 		}
+		if column.check.notEnforced {
+			definition += "NOT ENFORCED "
+		}
 	}
 
 	switch column.keyOption {
@@ -1238,7 +2413,10 @@ func (g *Generator) generateAddIndex(table string, index Index) string {
 
 	columns := []string{}
 	for _, indexColumn := range index.columns {
-		column := g.escapeSQLName(indexColumn.column)
+		column := indexColumn.column
+		if !strings.HasPrefix(column, "(") { // not a functional key part
+			column = g.escapeSQLName(column)
+		}
 		if indexColumn.length != nil {
 			column += fmt.Sprintf("(%d)", *indexColumn.length)
 		}
@@ -1254,7 +2432,23 @@ func (g *Generator) generateAddIndex(table string, index Index) string {
 	case GeneratorModeMssql:
 		var ddl string
 		var partition string
-		if !index.primary {
+		if index.columnStore {
+			ddl = fmt.Sprintf(
+				"CREATE%s COLUMNSTORE INDEX %s ON %s",
+				clusteredOption,
+				g.escapeSQLName(index.name),
+				g.escapeTableName(table),
+			)
+			// A clustered columnstore index has no column list, and a nonclustered one lists its columns as included.
+			if !index.clustered {
+				included := []string{}
+				for _, column := range index.included {
+					included = append(included, g.escapeSQLName(column))
+				}
+				ddl += fmt.Sprintf(" (%s)", strings.Join(included, ", "))
+			}
+			return ddl + optionDefinition
+		} else if !index.primary {
 			ddl = fmt.Sprintf(
 				"CREATE%s%s INDEX %s ON %s",
 				uniqueOption,
@@ -1297,9 +2491,20 @@ func (g *Generator) generateAddIndex(table string, index Index) string {
 		}
 		if strings.ToUpper(index.indexType) == "UNIQUE KEY" {
 			ddl += " UNIQUE"
+			if index.nullsNotDistinct {
+				ddl += " NULLS NOT DISTINCT"
+			}
+		}
+		ddl += fmt.Sprintf(" (%s)", strings.Join(columns, ", "))
+		if len(index.included) > 0 {
+			included := []string{}
+			for _, column := range index.included {
+				included = append(included, g.escapeSQLName(column))
+			}
+			ddl += fmt.Sprintf(" INCLUDE (%s)", strings.Join(included, ", "))
 		}
 		constraintOptions := g.generateConstraintOptions(index.constraintOptions)
-		ddl += fmt.Sprintf(" (%s)%s%s", strings.Join(columns, ", "), optionDefinition, constraintOptions)
+		ddl += fmt.Sprintf("%s%s", optionDefinition, constraintOptions)
 		return ddl
 	default:
 		ddl := fmt.Sprintf(
@@ -1345,6 +2550,13 @@ func (g *Generator) generateIndexOptionDefinition(indexOptions []IndexOption) st
 				options = append(options, option)
 			}
 			optionDefinition = fmt.Sprintf(" WITH (%s)", strings.Join(options, ", "))
+		case GeneratorModePostgres:
+			// Storage parameters of the index of a constraint, e.g. fillfactor and deduplicate_items
+			options := []string{}
+			for _, indexOption := range indexOptions {
+				options = append(options, fmt.Sprintf("%s = %s", indexOption.optionName, string(indexOption.value.raw)))
+			}
+			optionDefinition = fmt.Sprintf(" WITH (%s)", strings.Join(options, ", "))
 		}
 	}
 	return optionDefinition
@@ -1445,7 +2657,7 @@ func (g *Generator) escapeSQLName(name string) string {
 	case GeneratorModePostgres:
 		return fmt.Sprintf("\"%s\"", name)
 	case GeneratorModeMssql:
-		return fmt.Sprintf("[%s]", name)
+		return fmt.Sprintf("[%s]", strings.ReplaceAll(name, "]", "]]"))
 	default:
 		return fmt.Sprintf("`%s`", name)
 	}
@@ -1464,6 +2676,16 @@ func (g *Generator) notNull(column Column) bool {
 	}
 }
 
+// For Postgres, whether the column is serial, which owns a sequence named "<table>_<column>_seq".
+func isSerial(column Column) bool {
+	switch strings.ToLower(column.typeName) {
+	case "smallserial", "serial", "bigserial", "serial2", "serial4", "serial8":
+		return true
+	default:
+		return false
+	}
+}
+
 func isPrimaryKey(column Column, table Table) bool {
 	if column.keyOption == ColumnKeyPrimary {
 		return true
@@ -1496,7 +2718,7 @@ func mergeTable(table1 *Table, table2 Table) {
 	}
 }
 
-func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type, []*Comment, []*Extension, []*Schema, error) {
+func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type, []*Comment, []*Extension, []*Schema, []*Publication, []*Event, []*Role, error) {
 	var tables []*Table
 	var views []*View
 	var triggers []*Trigger
@@ -1504,6 +2726,9 @@ func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type,
 	var comments []*Comment
 	var extensions []*Extension
 	var schemas []*Schema
+	var publications []*Publication
+	var events []*Event
+	var roles []*Role
 	for _, ddl := range ddls {
 		switch stmt := ddl.(type) {
 		case *CreateTable:
@@ -1514,7 +2739,7 @@ func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type,
 			if table == nil {
 				view := findViewByName(views, stmt.tableName)
 				if view == nil {
-					return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("CREATE INDEX is performed before CREATE TABLE: %s", ddl.Statement())
+					return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("CREATE INDEX is performed before CREATE TABLE: %s", ddl.Statement())
 				}
 				// TODO: check duplicated creation
 				view.indexes = append(view.indexes, stmt.index)
@@ -1525,14 +2750,14 @@ func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type,
 		case *AddIndex:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
-				return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD INDEX is performed before CREATE TABLE: %s", ddl.Statement())
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD INDEX is performed before CREATE TABLE: %s", ddl.Statement())
 			}
 			// TODO: check duplicated creation
 			table.indexes = append(table.indexes, stmt.index)
 		case *AddPrimaryKey:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
-				return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD PRIMARY KEY is performed before CREATE TABLE: %s", ddl.Statement())
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD PRIMARY KEY is performed before CREATE TABLE: %s", ddl.Statement())
 			}
 
 			newColumns := []Column{}
@@ -1546,14 +2771,21 @@ func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type,
 		case *AddForeignKey:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
-				return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD FOREIGN KEY is performed before CREATE TABLE: %s", ddl.Statement())
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD FOREIGN KEY is performed before CREATE TABLE: %s", ddl.Statement())
 			}
 
 			table.foreignKeys = append(table.foreignKeys, stmt.foreignKey)
+		case *AddExclusion:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD EXCLUDE is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+
+			table.exclusions = append(table.exclusions, stmt.exclusion)
 		case *AddPolicy:
 			table := findTableByName(tables, stmt.tableName)
 			if table == nil {
-				return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD POLICY performed before CREATE TABLE: %s", ddl.Statement())
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ADD POLICY performed before CREATE TABLE: %s", ddl.Statement())
 			}
 
 			table.policies = append(table.policies, stmt.policy)
@@ -1563,17 +2795,92 @@ func aggregateDDLsToSchema(ddls []DDL) ([]*Table, []*View, []*Trigger, []*Type,
 			triggers = append(triggers, stmt)
 		case *Type:
 			types = append(types, stmt)
+		case *AddDomainConstraint:
+			typ := findTypeByName(types, stmt.typeName)
+			if typ == nil || typ.domain == nil {
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ALTER DOMAIN is performed before CREATE DOMAIN: %s", ddl.Statement())
+			}
+			typ.domain.checks = append(typ.domain.checks, stmt.check)
 		case *Comment:
 			comments = append(comments, stmt)
+		case *ClusterOn:
+			table := findTableByName(tables, stmt.tableName)
+			if table == nil {
+				return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("CLUSTER ON is performed before CREATE TABLE: %s", ddl.Statement())
+			}
+			table.clusterOn = stmt.indexName
+		case *Owner:
+			if stmt.objectType == "VIEW" {
+				view := findViewByName(views, stmt.tableName)
+				if view == nil {
+					return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ALTER VIEW OWNER TO is performed before CREATE VIEW: %s", ddl.Statement())
+				}
+				view.owner = stmt.owner
+			} else {
+				table := findTableByName(tables, stmt.tableName)
+				if table == nil {
+					return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("ALTER TABLE OWNER TO is performed before CREATE TABLE: %s", ddl.Statement())
+				}
+				table.owner = stmt.owner
+			}
 		case *Extension:
 			extensions = append(extensions, stmt)
 		case *Schema:
 			schemas = append(schemas, stmt)
+		case *Publication:
+			publications = append(publications, stmt)
+		case *Event:
+			events = append(events, stmt)
+		case *Role:
+			roles = append(roles, stmt)
+		case *Grant:
+			// Privileges are only reported by --report-privileges
 		default:
-			return nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("unexpected ddl type in convertDDLsToTablesAndViews: %#v", stmt)
+			return nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, fmt.Errorf("unexpected ddl type in convertDDLsToTablesAndViews: %#v", stmt)
+		}
+	}
+	return tables, views, triggers, types, comments, extensions, schemas, publications, events, roles, nil
+}
+
+// MySQL adds an invisible primary key `my_row_id` to a table without a primary key when
+// sql_generate_invisible_primary_key is enabled. Hide it from the current schema unless it's declared.
+func ignoreGeneratedInvisiblePrimaryKeys(tables []*Table, desiredDDLs []DDL) {
+	const gipkColumn = "my_row_id"
+
+	declared := map[string]bool{}
+	for _, ddl := range desiredDDLs {
+		if stmt, ok := ddl.(*CreateTable); ok && findColumnByName(stmt.table.columns, gipkColumn) != nil {
+			declared[stmt.table.name] = true
+		}
+	}
+
+	for _, table := range tables {
+		column := findColumnByName(table.columns, gipkColumn)
+		if column == nil || !column.invisible || !column.autoIncrement || declared[table.name] {
+			continue
+		}
+
+		columns := []Column{}
+		for _, c := range table.columns {
+			if c.name == gipkColumn {
+				continue
+			}
+			if c.position > column.position {
+				c.position--
+			}
+			columns = append(columns, c)
+		}
+		table.columns = columns
+
+		indexes := []Index{}
+		for _, index := range table.indexes {
+			if index.primary && len(index.columns) == 1 && index.columns[0].column == gipkColumn {
+				continue
+			}
+			indexes = append(indexes, index)
 		}
+		table.indexes = indexes
 	}
-	return tables, views, triggers, types, comments, extensions, schemas, nil
 }
 
 func findTableByName(tables []*Table, name string) *Table {
@@ -1630,6 +2937,15 @@ func findForeignKeyByName(foreignKeys []ForeignKey, constraintName string) *Fore
 	return nil
 }
 
+func findExclusionByName(exclusions []Exclusion, constraintName string) *Exclusion {
+	for _, exclusion := range exclusions {
+		if exclusion.constraintName == constraintName {
+			return &exclusion
+		}
+	}
+	return nil
+}
+
 func findPolicyByName(policies []Policy, name string) *Policy {
 	for _, policy := range policies {
 		if policy.name == name {
@@ -1666,15 +2982,39 @@ func findTypeByName(types []*Type, name string) *Type {
 	return nil
 }
 
-func findCommentByObject(comments []*Comment, object string) *Comment {
+// Objects of different types may have the same name, e.g. a column and a constraint of a table.
+func findCommentByObject(comments []*Comment, object parser.Comment) *Comment {
 	for _, comment := range comments {
-		if comment.comment.Object == object {
+		if comment.comment.Object == object.Object && commentObjectType(comment.comment) == commentObjectType(object) {
 			return comment
 		}
 	}
 	return nil
 }
 
+// "TABLE" for both OBJECT_TABLE of the parser of PostgreSQL and TABLE of the generic parser
+func commentObjectType(comment parser.Comment) string {
+	return strings.TrimPrefix(comment.ObjectType, "OBJECT_")
+}
+
+func findPublicationByName(publications []*Publication, name string) *Publication {
+	for _, publication := range publications {
+		if publication.name == name {
+			return publication
+		}
+	}
+	return nil
+}
+
+func findEventByName(events []*Event, name string) *Event {
+	for _, event := range events {
+		if event.name == name {
+			return event
+		}
+	}
+	return nil
+}
+
 func findExtensionByName(extensions []*Extension, name string) *Extension {
 	for _, extension := range extensions {
 		if extension.extension.Name == name {
@@ -1684,6 +3024,15 @@ func findExtensionByName(extensions []*Extension, name string) *Extension {
 	return nil
 }
 
+func findRoleByName(roles []*Role, name string) *Role {
+	for _, role := range roles {
+		if role.role.Name == name {
+			return role
+		}
+	}
+	return nil
+}
+
 func findSchemaByName(schemas []*Schema, name string) *Schema {
 	for _, schema := range schemas {
 		if schema.schema.Name == name {
@@ -1693,17 +3042,101 @@ func findSchemaByName(schemas []*Schema, name string) *Schema {
 	return nil
 }
 
-func (g *Generator) haveSameColumnDefinition(current Column, desired Column) bool {
+// Fill the comment, charset, and collation that the desired column doesn't specify with the current ones, so that
+// CHANGE COLUMN, e.g. only to reorder the column, doesn't drop them.
+func keepUnspecifiedAttributes(current Column, desired Column) Column {
+	if desired.comment == nil {
+		desired.comment = current.comment
+	}
+	if desired.charset == "" {
+		desired.charset = current.charset
+	}
+	if desired.collate == "" {
+		desired.collate = current.collate
+	}
+	return desired
+}
+
+func (g *Generator) haveSameColumnDefinition(current Column, desired Column, currentTable Table, desiredTable Table) bool {
 	// Not examining AUTO_INCREMENT and UNIQUE KEY because it'll be added in a later stage
 	return g.haveSameDataType(current, desired) &&
 		(current.unsigned == desired.unsigned) &&
 		((current.notNull != nil && *current.notNull) == ((desired.notNull != nil && *desired.notNull) || desired.keyOption == ColumnKeyPrimary)) && // `PRIMARY KEY` implies `NOT NULL`
 		(current.timezone == desired.timezone) &&
 		// (current.check == desired.check) && /* workaround. CHECK handling in general should be improved later */
-		(desired.charset == "" || current.charset == desired.charset) && // detect change column only when set explicitly. TODO: can we calculate implicit charset?
-		(desired.collate == "" || current.collate == desired.collate) && // detect change column only when set explicitly. TODO: can we calculate implicit collate?
+		haveSameCharsetAndCollation(current, desired, currentTable, desiredTable) &&
 		reflect.DeepEqual(current.onUpdate, desired.onUpdate) &&
-		reflect.DeepEqual(current.comment, desired.comment)
+		reflect.DeepEqual(current.comment, desired.comment) &&
+		(current.invisible == desired.invisible)
+}
+
+// Compare the charset and collation in effect, i.e. those of the column or else the defaults of the table, since MySQL
+// doesn't dump the ones that are the same as the table's. A change is detected only when the desired column sets them.
+func haveSameCharsetAndCollation(current Column, desired Column, currentTable Table, desiredTable Table) bool {
+	currentCharset := columnCharset(current, currentTable)
+	if desired.charset != "" && currentCharset != columnCharset(desired, desiredTable) {
+		return false
+	}
+	if desired.collate != "" {
+		currentCollate := columnCollate(current, currentTable)
+		if currentCollate == "" {
+			// The default collation of the charset, which depends on the server. Assume that it's not changed.
+			return currentCharset == collationCharset(desired.collate)
+		}
+		return currentCollate == strings.ToLower(desired.collate)
+	}
+	return true
+}
+
+func columnCharset(column Column, table Table) string {
+	if column.charset != "" {
+		return normalizeCharset(column.charset)
+	} else if column.collate != "" {
+		return collationCharset(column.collate)
+	} else if charset := tableOption(table, "default charset", "charset", "default character set", "character set"); charset != "" {
+		return normalizeCharset(charset)
+	} else if collate := tableOption(table, "collate", "default collate"); collate != "" {
+		return collationCharset(collate)
+	}
+	return ""
+}
+
+// Return "" when the column uses the default collation of its charset.
+func columnCollate(column Column, table Table) string {
+	if column.collate != "" {
+		return strings.ToLower(column.collate)
+	}
+	collate := tableOption(table, "collate", "default collate")
+	if collate != "" && collationCharset(collate) == columnCharset(column, table) {
+		return strings.ToLower(collate)
+	}
+	return ""
+}
+
+// A collation is named after its charset, e.g. utf8mb4_0900_ai_ci.
+func collationCharset(collate string) string {
+	charset, _, _ := strings.Cut(collate, "_")
+	return normalizeCharset(charset)
+}
+
+// utf8 is an alias of utf8mb3, which is dumped as utf8mb3 since MySQL 8.0.30.
+func normalizeCharset(charset string) string {
+	charset = strings.ToLower(charset)
+	if charset == "utf8" {
+		return "utf8mb3"
+	}
+	return charset
+}
+
+func tableOption(table Table, names ...string) string {
+	for key, value := range table.options {
+		for _, name := range names {
+			if strings.EqualFold(key, name) {
+				return value
+			}
+		}
+	}
+	return ""
 }
 
 func (g *Generator) areSameGenerated(generatedA, generatedB *Generated) bool {
@@ -1713,8 +3146,7 @@ func (g *Generator) areSameGenerated(generatedA, generatedB *Generated) bool {
 	if generatedA == nil || generatedB == nil {
 		return false
 	}
-	// TODO: Difference between bracketed and unbracketed, as Expr values are not fully comparable.
-	return (generatedA.expr == generatedB.expr || generatedA.expr == "("+generatedB.expr+")") &&
+	return parser.String(generatedA.normalizedExpr) == parser.String(generatedB.normalizedExpr) &&
 		generatedA.generatedType == generatedB.generatedType
 }
 
@@ -1758,14 +3190,155 @@ func areSameCheckDefinition(checkA *CheckDefinition, checkB *CheckDefinition) bo
 		checkA.noInherit == checkB.noInherit
 }
 
-func areSameIdentityDefinition(identityA *Identity, identityB *Identity) bool {
-	if identityA == nil && identityB == nil {
+// The options of the sequence are compared only for Postgres, and only when the desired column specifies them, so that
+// the options set outside the desired SQL are kept.
+func (g *Generator) areSameIdentityDefinition(current Column, desired Column) bool {
+	if current.identity == nil && desired.identity == nil {
 		return true
 	}
-	if identityA == nil || identityB == nil {
+	if current.identity == nil || desired.identity == nil {
+		return false
+	}
+	if current.identity.behavior != desired.identity.behavior || current.identity.notForReplication != desired.identity.notForReplication {
 		return false
 	}
-	return identityA.behavior == identityB.behavior && identityA.notForReplication == identityB.notForReplication
+	return g.mode != GeneratorModePostgres || desired.sequence == nil || getIdentitySequenceOptions(current) == getIdentitySequenceOptions(desired)
+}
+
+// The options of the sequence of a Postgres identity column, with the omitted ones filled by their defaults.
+type identitySequenceOptions struct {
+	startWith   int64
+	incrementBy int64
+	minValue    int64
+	maxValue    int64
+	cache       int64
+	cycle       bool
+}
+
+func getIdentitySequenceOptions(column Column) identitySequenceOptions {
+	var typeMin, typeMax int64
+	switch postgresIntegerType(column.typeName) {
+	case "smallint":
+		typeMin, typeMax = math.MinInt16, math.MaxInt16
+	case "bigint":
+		typeMin, typeMax = math.MinInt64, math.MaxInt64
+	default:
+		typeMin, typeMax = math.MinInt32, math.MaxInt32
+	}
+
+	options := identitySequenceOptions{incrementBy: 1, cache: 1}
+	sequence := column.sequence
+	if sequence == nil {
+		sequence = &Sequence{}
+	}
+	if sequence.IncrementBy != nil {
+		options.incrementBy = int64(*sequence.IncrementBy)
+	}
+	if options.incrementBy > 0 {
+		options.minValue, options.maxValue = 1, typeMax
+	} else {
+		options.minValue, options.maxValue = typeMin, -1
+	}
+	if sequence.MinValue != nil {
+		options.minValue = int64(*sequence.MinValue)
+	}
+	if sequence.MaxValue != nil {
+		options.maxValue = int64(*sequence.MaxValue)
+	}
+	if options.incrementBy > 0 {
+		options.startWith = options.minValue
+	} else {
+		options.startWith = options.maxValue
+	}
+	if sequence.StartWith != nil {
+		options.startWith = int64(*sequence.StartWith)
+	}
+	if sequence.Cache != nil {
+		options.cache = int64(*sequence.Cache)
+	}
+	options.cycle = sequence.Cycle
+	return options
+}
+
+// Generate the clauses of ALTER COLUMN to change the sequence of an identity column whose desired options are given.
+// A new START WITH takes effect only on the next RESTART, so it restarts the sequence only with restart_identity, since
+// restarting a sequence in use would generate the values taken already.
+func (g *Generator) generateIdentitySequenceAlters(currentColumn Column, desiredColumn Column) []string {
+	if desiredColumn.sequence == nil {
+		return nil
+	}
+	current := getIdentitySequenceOptions(currentColumn)
+	desired := getIdentitySequenceOptions(desiredColumn)
+
+	var clauses []string
+	if current.startWith != desired.startWith {
+		clauses = append(clauses, fmt.Sprintf("SET START WITH %d", desired.startWith))
+		if g.restartIdentity {
+			clauses = append(clauses, fmt.Sprintf("RESTART WITH %d", desired.startWith))
+		}
+	}
+	if current.incrementBy != desired.incrementBy {
+		clauses = append(clauses, fmt.Sprintf("SET INCREMENT BY %d", desired.incrementBy))
+	}
+	if current.minValue != desired.minValue {
+		clauses = append(clauses, fmt.Sprintf("SET MINVALUE %d", desired.minValue))
+	}
+	if current.maxValue != desired.maxValue {
+		clauses = append(clauses, fmt.Sprintf("SET MAXVALUE %d", desired.maxValue))
+	}
+	if current.cache != desired.cache {
+		clauses = append(clauses, fmt.Sprintf("SET CACHE %d", desired.cache))
+	}
+	if current.cycle != desired.cycle {
+		if desired.cycle {
+			clauses = append(clauses, "SET CYCLE")
+		} else {
+			clauses = append(clauses, "SET NO CYCLE")
+		}
+	}
+	return clauses
+}
+
+// A serial column owns a sequence and uses it by its default, so it becomes an identity column by dropping the default,
+// adding the identity, whose sequence takes over the value of the owned sequence, and dropping the owned sequence.
+// The owned sequence is renamed first since the sequence of the identity gets the same name "<table>_<column>_seq".
+func (g *Generator) generateDDLsForSerialToIdentity(tableName string, columnName string, addIdentity string) []string {
+	schema, table := splitTableName(tableName, g.defaultSchema)
+	sequence := schema + "." + PostgresConstraintName(table, []string{columnName}, "seq")
+	serialSequence := PostgresConstraintName(table, []string{columnName}, "serial_seq")
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s DROP DEFAULT", g.escapeTableName(tableName), g.escapeSQLName(columnName)),
+		fmt.Sprintf("ALTER SEQUENCE %s RENAME TO %s", g.escapeTableName(sequence), g.escapeSQLName(serialSequence)),
+		addIdentity,
+		fmt.Sprintf("SELECT setval(%s, last_value, is_called) FROM %s", StringConstant(g.escapeTableName(sequence)), g.escapeTableName(schema+"."+serialSequence)),
+		fmt.Sprintf("DROP SEQUENCE %s", g.escapeTableName(schema+"."+serialSequence)),
+	}
+}
+
+// The integer type of a serial type, e.g. "integer" for serial
+func serialIntegerType(typeName string) string {
+	switch strings.ToLower(typeName) {
+	case "smallserial", "serial2":
+		return "smallint"
+	case "bigserial", "serial8":
+		return "bigint"
+	default:
+		return "integer"
+	}
+}
+
+// Normalize the aliases of the integer types of Postgres, e.g. "integer" for int4
+func postgresIntegerType(typeName string) string {
+	switch typeName = strings.ToLower(typeName); typeName {
+	case "int2":
+		return "smallint"
+	case "int", "int4":
+		return "integer"
+	case "int8":
+		return "bigint"
+	default:
+		return typeName
+	}
 }
 
 func (g *Generator) areSameDefaultValue(currentDefault *DefaultDefinition, desiredDefault *DefaultDefinition) bool {
@@ -1777,6 +3350,12 @@ func (g *Generator) areSameDefaultValue(currentDefault *DefaultDefinition, desir
 	if desiredDefault != nil && !isNullValue(desiredDefault.value) {
 		desiredVal = desiredDefault.value
 	}
+	if g.mode == GeneratorModeMysql {
+		// SHOW CREATE TABLE shows CURRENT_DATE as curdate(), for example.
+		if currentFunc, desiredFunc := mysqlDefaultFunction(currentDefault), mysqlDefaultFunction(desiredDefault); currentFunc != "" || desiredFunc != "" {
+			return currentFunc == desiredFunc
+		}
+	}
 	if !g.areSameValue(currentVal, desiredVal) {
 		return false
 	}
@@ -1792,6 +3371,42 @@ func (g *Generator) areSameDefaultValue(currentDefault *DefaultDefinition, desir
 	return strings.ToLower(currentExprSchema) == strings.ToLower(desiredExprSchema) && strings.ToLower(currentExpr) == strings.ToLower(desiredExpr)
 }
 
+// For MSSQL, compare the names of default constraints. A default without a name is named like
+// DF__users__age__3B75D760 by the database, so such a name is the same as no name.
+func areSameDefaultConstraintName(currentDefault *DefaultDefinition, desiredDefault *DefaultDefinition) bool {
+	if currentDefault == nil || desiredDefault == nil {
+		return true
+	}
+	if desiredDefault.constraintName == "" {
+		return currentDefault.constraintName == "" || strings.HasPrefix(strings.ToUpper(currentDefault.constraintName), "DF__")
+	}
+	return strings.EqualFold(currentDefault.constraintName, desiredDefault.constraintName)
+}
+
+// Synonyms of MySQL functions that can be a default value, normalized to the names shown by SHOW CREATE TABLE.
+var mysqlDefaultFunctionSynonyms = map[string]string{
+	"current_date":   "curdate()",
+	"current_date()": "curdate()",
+	"curdate()":      "curdate()",
+	"current_time":   "curtime()",
+	"current_time()": "curtime()",
+	"curtime()":      "curtime()",
+}
+
+// Return the normalized function of a MySQL default value, or "" if it's not a date or time function.
+func mysqlDefaultFunction(defaultDefinition *DefaultDefinition) string {
+	if defaultDefinition == nil {
+		return ""
+	}
+	var function string
+	if defaultDefinition.value != nil && defaultDefinition.value.valueType == ValueTypeValArg {
+		function = string(defaultDefinition.value.raw)
+	} else {
+		function = defaultDefinition.expression
+	}
+	return mysqlDefaultFunctionSynonyms[strings.ToLower(strings.ReplaceAll(function, " ", ""))]
+}
+
 func (g *Generator) areSameValue(current, desired *Value) bool {
 	if current == nil && desired == nil {
 		return true
@@ -1823,7 +3438,63 @@ func (g *Generator) areSameValue(current, desired *Value) bool {
 	return currentRaw == desiredRaw
 }
 
-func areSameTriggerDefinition(triggerA, triggerB *Trigger) bool {
+// STARTS defaults to the time of creation, and an expression of AT/STARTS/ENDS is stored as the time it was evaluated.
+// So they are compared only when a literal time is given in the desired schema.
+func areSameEvents(current, desired *Event) bool {
+	if desired.at != "" {
+		if current.at == "" || (isStringLiteral(desired.at) && desired.at != current.at) {
+			return false
+		}
+	} else {
+		if current.every == "" || normalizeEventInterval(current.every) != normalizeEventInterval(desired.every) {
+			return false
+		}
+		if isStringLiteral(desired.starts) && desired.starts != current.starts {
+			return false
+		}
+		if (desired.ends == "") != (current.ends == "") || (isStringLiteral(desired.ends) && desired.ends != current.ends) {
+			return false
+		}
+	}
+	if current.preserve != desired.preserve || current.status != desired.status {
+		return false
+	}
+	if strings.Trim(current.comment, "'") != strings.Trim(desired.comment, "'") {
+		return false
+	}
+	if !isSameDefiner(current.definer, desired.definer) {
+		return false
+	}
+	return normalizeTriggerBody(GeneratorModeMysql, current.body) == normalizeTriggerBody(GeneratorModeMysql, desired.body)
+}
+
+// A definer is compared only when it's declared in the desired schema, so that an object created by another user
+// doesn't cause a diff. CURRENT_USER isn't compared either since it depends on who applies the schema.
+func isSameDefiner(current, desired string) bool {
+	return desired == "" || strings.EqualFold(desired, "CURRENT_USER") || strings.EqualFold(current, desired)
+}
+
+func formatDefiner(definer string) string {
+	if definer == "" {
+		return ""
+	}
+	return fmt.Sprintf("DEFINER=%s ", definer)
+}
+
+// Normalize `'1' day` into `1 DAY`
+func normalizeEventInterval(interval string) string {
+	i := strings.LastIndex(interval, " ")
+	if i < 0 {
+		return interval
+	}
+	return strings.Trim(interval[:i], "'") + " " + strings.ToUpper(interval[i+1:])
+}
+
+func isStringLiteral(expr string) bool {
+	return len(expr) >= 2 && expr[0] == '\'' && expr[len(expr)-1] == '\''
+}
+
+func areSameTriggerDefinition(mode GeneratorMode, triggerA, triggerB *Trigger) bool {
 	if triggerA.time != triggerB.time {
 		return false
 	}
@@ -1838,19 +3509,54 @@ func areSameTriggerDefinition(triggerA, triggerB *Trigger) bool {
 	if triggerA.tableName != triggerB.tableName {
 		return false
 	}
+	if triggerA.level != triggerB.level {
+		return false
+	}
+	if !isSameDefiner(triggerA.definer, triggerB.definer) {
+		return false
+	}
+	if normalizeTriggerBody(mode, triggerA.condition) != normalizeTriggerBody(mode, triggerB.condition) {
+		return false
+	}
 	if len(triggerA.body) != len(triggerB.body) {
 		return false
 	}
 	for i := 0; i < len(triggerA.body); i++ {
-		bodyA := strings.ToLower(strings.Replace(triggerA.body[i], " ", "", -1))
-		bodyB := strings.ToLower(strings.Replace(triggerB.body[i], " ", "", -1))
-		if bodyA != bodyB {
+		if normalizeTriggerBody(mode, triggerA.body[i]) != normalizeTriggerBody(mode, triggerB.body[i]) {
 			return false
 		}
 	}
 	return true
 }
 
+// Trigger body statements, which are the parsed statements printed by the parser, are compared by their tokens, ignoring
+// the casing and the quoting of keywords and identifiers and the whitespace between the tokens, e.g. "a+b" and
+// "A + [b]", but keeping string literals as they are. The parsed nodes keep the casing and the quoting of identifiers as
+// written, so they can't be compared as they are.
+func normalizeTriggerBody(mode GeneratorMode, body string) string {
+	var tokens []string
+	tokenizer := parser.NewTokenizer(body, parserModeOf(mode))
+	for {
+		typ, val := tokenizer.Scan()
+		switch {
+		case typ == 0:
+			return strings.Join(tokens, " ")
+		case typ == parser.COMMENT:
+		case typ == parser.STRING:
+			tokens = append(tokens, "'"+strings.ReplaceAll(string(val), "'", "''")+"'")
+		case (typ == parser.INTEGRAL || typ == parser.FLOAT) && strings.HasPrefix(string(val), "-"):
+			// "-1" is a token only when the minus is followed by the digit.
+			tokens = append(tokens, "-", string(val[1:]))
+		case val != nil:
+			tokens = append(tokens, strings.ToLower(string(val)))
+		case typ < 256:
+			tokens = append(tokens, string(rune(typ)))
+		default:
+			tokens = append(tokens, fmt.Sprintf("<%d>", typ))
+		}
+	}
+}
+
 func isNullValue(value *Value) bool {
 	return value != nil && value.valueType == ValueTypeValArg && string(value.raw) == "null"
 }
@@ -1896,19 +3602,31 @@ func (g *Generator) areSameIndexes(indexA Index, indexB Index) bool {
 		}
 		// TODO: check length?
 		if g.normalizeIndexColumn(indexA.columns[i].column) != g.normalizeIndexColumn(indexB.columns[i].column) ||
-			indexAColumn.direction != indexB.columns[i].direction {
+			indexAColumn.direction != indexB.columns[i].direction ||
+			indexAColumn.operatorClass != indexB.columns[i].operatorClass {
 			return false
 		}
 	}
 	if indexA.where != indexB.where {
 		return false
 	}
+	if indexA.columnStore != indexB.columnStore {
+		return false
+	}
+	if indexA.nullsNotDistinct != indexB.nullsNotDistinct {
+		return false
+	}
 
+	// Unlike key columns, the order of included columns is insignificant and not preserved by MSSQL catalogs.
 	if len(indexA.included) != len(indexB.included) {
 		return false
 	}
-	for i, indexAIncluded := range indexA.included {
-		if indexAIncluded != indexB.included[i] {
+	includedA := append([]string{}, indexA.included...)
+	includedB := append([]string{}, indexB.included...)
+	sort.Strings(includedA)
+	sort.Strings(includedB)
+	for i, indexAIncluded := range includedA {
+		if indexAIncluded != includedB[i] {
 			return false
 		}
 	}
@@ -1924,6 +3642,10 @@ func (g *Generator) areSameIndexes(indexA Index, indexB Index) bool {
 			indexBOptions = []IndexOption{{optionName: "using", value: &Value{valueType: ValueTypeStr, raw: []byte("btree"), strVal: "btree"}}}
 		}
 	}
+	// Postgres: storage parameters in WITH, which are reset to the defaults when they're removed
+	if g.mode == GeneratorModePostgres && len(indexAOptions) != len(indexBOptions) {
+		return false
+	}
 	for _, optionB := range indexBOptions {
 		if optionA := findIndexOptionByName(indexAOptions, optionB.optionName); optionA != nil {
 			if !g.areSameValue(optionA.value, optionB.value) {
@@ -1934,9 +3656,10 @@ func (g *Generator) areSameIndexes(indexA Index, indexB Index) bool {
 		}
 	}
 
-	// Specific to unique constraints
+	// Specific to unique constraints. In Postgres, a unique constraint is the same as a unique index of the same
+	// columns and predicate, so the constraint and the index can be declared for each other unless it's deferrable.
 	if indexA.constraint != indexB.constraint {
-		return false
+		return g.mode == GeneratorModePostgres && indexA.unique && !isDeferrableIndex(indexA) && !isDeferrableIndex(indexB)
 	}
 	if (indexA.constraintOptions != nil) != (indexB.constraintOptions != nil) {
 		return false
@@ -1953,6 +3676,21 @@ func (g *Generator) areSameIndexes(indexA Index, indexB Index) bool {
 	return true
 }
 
+func haveSameSQLite3TableOptions(tableA Table, tableB Table) bool {
+	for _, option := range []string{"STRICT", "WITHOUT ROWID"} {
+		_, okA := tableA.options[option]
+		_, okB := tableB.options[option]
+		if okA != okB {
+			return false
+		}
+	}
+	return true
+}
+
+func isDeferrableIndex(index Index) bool {
+	return index.constraintOptions != nil && index.constraintOptions.deferrable
+}
+
 // jsonb_extract_path_text(col, ARRAY['foo', 'bar']) => jsonb_extract_path_text(col, 'foo', 'bar')
 func (g *Generator) normalizeIndexColumn(column string) string {
 	column = strings.ToLower(column)
@@ -1988,6 +3726,36 @@ func (g *Generator) areSameForeignKeys(foreignKeyA ForeignKey, foreignKeyB Forei
 	return true
 }
 
+func areSameExclusions(exclusionA, exclusionB Exclusion) bool {
+	if !strings.EqualFold(exclusionA.indexType, exclusionB.indexType) {
+		return false
+	}
+	if len(exclusionA.exclusions) != len(exclusionB.exclusions) {
+		return false
+	}
+	for i := range exclusionA.exclusions {
+		pairA, pairB := exclusionA.exclusions[i], exclusionB.exclusions[i]
+		if pairA.column != pairB.column || pairA.operator != pairB.operator {
+			return false
+		}
+		if !strings.EqualFold(pairA.opClass, pairB.opClass) {
+			return false
+		}
+	}
+	if normalizeUsing(exclusionA.where) != normalizeUsing(exclusionB.where) {
+		return false
+	}
+	deferrableA, initiallyDeferredA := false, false
+	if exclusionA.constraintOptions != nil {
+		deferrableA, initiallyDeferredA = exclusionA.constraintOptions.deferrable, exclusionA.constraintOptions.initiallyDeferred
+	}
+	deferrableB, initiallyDeferredB := false, false
+	if exclusionB.constraintOptions != nil {
+		deferrableB, initiallyDeferredB = exclusionB.constraintOptions.deferrable, exclusionB.constraintOptions.initiallyDeferred
+	}
+	return deferrableA == deferrableB && initiallyDeferredA == initiallyDeferredB
+}
+
 func areSamePolicies(policyA, policyB Policy) bool {
 	if strings.ToLower(policyA.scope) != strings.ToLower(policyB.scope) {
 		return false
@@ -1998,7 +3766,7 @@ func areSamePolicies(policyA, policyB Policy) bool {
 	if normalizeUsing(policyA.using) != normalizeUsing(policyB.using) {
 		return fmt.Sprintf("(%s)", policyA.using) == policyB.using
 	}
-	if strings.ToLower(policyA.withCheck) != strings.ToLower(policyB.withCheck) {
+	if normalizeUsing(policyA.withCheck) != normalizeUsing(policyB.withCheck) {
 		return fmt.Sprintf("(%s)", policyA.withCheck) == policyB.withCheck
 	}
 	if len(policyA.roles) != len(policyB.roles) {
@@ -2063,6 +3831,24 @@ func convertForeignKeysToConstraintNames(foreignKeys []ForeignKey) []string {
 	return constraintNames
 }
 
+func convertDDLsToTableNames(ddls []DDL) []string {
+	tableNames := []string{}
+	for _, ddl := range ddls {
+		if createTable, ok := ddl.(*CreateTable); ok {
+			tableNames = append(tableNames, createTable.table.name)
+		}
+	}
+	return tableNames
+}
+
+func convertExclusionsToConstraintNames(exclusions []Exclusion) []string {
+	constraintNames := []string{}
+	for _, exclusion := range exclusions {
+		constraintNames = append(constraintNames, exclusion.constraintName)
+	}
+	return constraintNames
+}
+
 func convertForeignKeysToIndexNames(foreignKeys []ForeignKey) []string {
 	indexNames := []string{}
 	for _, foreignKey := range foreignKeys {
@@ -2099,6 +3885,14 @@ func convertViewNames(views []*View) []string {
 	return viewNames
 }
 
+func convertPublicationNames(publications []*Publication) []string {
+	names := make([]string, len(publications))
+	for i, publication := range publications {
+		names[i] = publication.name
+	}
+	return names
+}
+
 func convertExtensionNames(extensions []*Extension) []string {
 	extensionNames := make([]string, len(extensions))
 	for i, extension := range extensions {
@@ -2116,6 +3910,16 @@ func containsString(strs []string, str string) bool {
 	return false
 }
 
+func removeViewByName(views []*View, name string) []*View {
+	ret := []*View{}
+	for _, view := range views {
+		if view.name != name {
+			ret = append(ret, view)
+		}
+	}
+	return ret
+}
+
 func removeTableByName(tables []*Table, name string) []*Table {
 	removed := false
 	ret := []*Table{}
@@ -2189,6 +3993,10 @@ func (g *Generator) generateDefaultDefinition(defaultDefinition DefaultDefinitio
 				return "DEFAULT b'0'", nil
 			}
 		case ValueTypeValArg: // NULL, CURRENT_TIMESTAMP, ...
+			if g.mode == GeneratorModeMysql && mysqlDefaultFunction(&defaultDefinition) != "" {
+				// CURRENT_DATE and CURRENT_TIME are allowed only as an expression default value
+				return fmt.Sprintf("DEFAULT (%s)", string(defaultVal.raw)), nil
+			}
 			return fmt.Sprintf("DEFAULT %s", string(defaultVal.raw)), nil
 		default:
 			return "", fmt.Errorf("unsupported default value type (valueType: '%d')", defaultVal.valueType)
@@ -2234,6 +4042,16 @@ func FilterTables(ddls []DDL, config database.GeneratorConfig) []DDL {
 			tables = append(tables, stmt.foreignKey.referenceName)
 		case *AddIndex:
 			tables = append(tables, stmt.tableName)
+		case *AddExclusion:
+			tables = append(tables, stmt.tableName)
+		case *ClusterOn:
+			tables = append(tables, stmt.tableName)
+		case *Owner:
+			if stmt.objectType == "TABLE" {
+				tables = append(tables, stmt.tableName)
+			}
+		case *Grant:
+			tables = append(tables, stmt.tables...)
 		}
 
 		if skipTables(tables, config) {
@@ -2281,6 +4099,25 @@ func splitTableName(table string, defaultSchema string) (string, string) {
 	}
 }
 
+// The table name of ALTER TABLE without the backquotes, e.g. "users" or "db.users".
+var alterTableNamePattern = regexp.MustCompile("^ALTER TABLE ((?:`[^`]*`|[^\\s`.]+)(?:\\.(?:`[^`]*`|[^\\s`.]+))?)")
+
+func alterTableName(ddl string) string {
+	match := alterTableNamePattern.FindStringSubmatch(ddl)
+	if match == nil {
+		return ""
+	}
+	return strings.ReplaceAll(match[1], "`", "")
+}
+
+// Return the value of the table in values, or defaultValue when the table has none.
+func tableOrDefault(values map[string]string, table string, defaultValue string) string {
+	if value, ok := values[table]; ok {
+		return value
+	}
+	return defaultValue
+}
+
 func isValidAlgorithm(algorithm string) bool {
 	switch strings.ToUpper(algorithm) {
 	case "INPLACE", "COPY", "INSTANT":
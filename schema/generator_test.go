@@ -1,8 +1,12 @@
 package schema
 
 import (
+	"strings"
 	"testing"
+	"unicode/utf8"
 
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,3 +21,215 @@ func TestStringConstantContainingSingleQuote(t *testing.T) {
 	assert.Equal(t, StringConstant("''"), "''''''")
 	assert.Equal(t, StringConstant("'example'"), "'''example'''")
 }
+
+func TestAlterTableName(t *testing.T) {
+	assert.Equal(t, "users", alterTableName("ALTER TABLE `users` ADD COLUMN `age` int"))
+	assert.Equal(t, "db.users", alterTableName("ALTER TABLE `db`.`users` DROP COLUMN `age`"))
+	assert.Equal(t, "users", alterTableName("ALTER TABLE users ADD INDEX idx (age)"))
+	assert.Equal(t, "", alterTableName("CREATE TABLE users (id int)"))
+}
+
+func TestIgnoreColumnOrder(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModeMysql)
+	current := "CREATE TABLE users (id int NOT NULL, name varchar(40), age int);"
+	desired := "CREATE TABLE users (name varchar(40), email varchar(255), id int NOT NULL, age int);"
+
+	ddls, err := GenerateIdempotentDDLs(GeneratorModeMysql, sqlParser, desired, current, database.GeneratorConfig{}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `users` CHANGE COLUMN `name` `name` varchar(40) FIRST",
+		"ALTER TABLE `users` ADD COLUMN `email` varchar(255) AFTER `name`",
+	}, ddls)
+
+	ddls, err = GenerateIdempotentDDLs(GeneratorModeMysql, sqlParser, desired, current, database.GeneratorConfig{IgnoreColumnOrder: true}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ALTER TABLE `users` ADD COLUMN `email` varchar(255)"}, ddls)
+}
+
+func TestAlterColumnTypeUsedByViews(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModePostgres)
+	current := `CREATE TABLE users (id bigint NOT NULL, name varchar(40), age int);
+CREATE VIEW user_names AS SELECT users.id, users.name FROM users;
+CREATE VIEW user_ages AS SELECT users.id, users.age FROM users;
+CREATE VIEW all_users AS SELECT * FROM users;
+CREATE VIEW positive_user_ids AS SELECT u.id FROM user_names AS u WHERE u.id > 0;
+CREATE VIEW obsoleted AS SELECT users.name FROM users;`
+	desired := `CREATE TABLE users (id bigint NOT NULL, name text, age int);
+CREATE VIEW user_names AS SELECT users.id, users.name FROM users;
+CREATE VIEW user_ages AS SELECT users.id, users.age FROM users;
+CREATE VIEW all_users AS SELECT * FROM users;
+CREATE VIEW positive_user_ids AS SELECT u.id FROM user_names AS u WHERE u.id > 0;`
+
+	ddls, err := GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`DROP VIEW "public"."positive_user_ids"`,
+		`DROP VIEW "public"."obsoleted"`,
+		`DROP VIEW "public"."all_users"`,
+		`DROP VIEW "public"."user_names"`,
+		`ALTER TABLE "public"."users" ALTER COLUMN "name" TYPE text`,
+		"CREATE VIEW user_names AS SELECT users.id, users.name FROM users",
+		"CREATE VIEW all_users AS SELECT * FROM users",
+		"CREATE VIEW positive_user_ids AS SELECT u.id FROM user_names AS u WHERE u.id > 0",
+	}, ddls)
+}
+
+func TestIdentitySequenceOptions(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModePostgres)
+	current := "CREATE TABLE voltages (volt bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY (START WITH 10 CACHE 5));"
+
+	// The options omitted from the desired SQL are kept
+	ddls, err := GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, "CREATE TABLE voltages (volt bigint GENERATED BY DEFAULT AS IDENTITY);", current, database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, ddls)
+
+	desired := "CREATE TABLE voltages (volt bigint GENERATED BY DEFAULT AS IDENTITY (START WITH 100 MAXVALUE 9223372036854775807 CACHE 5));"
+	ddls, err = GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`ALTER TABLE "public"."voltages" ALTER COLUMN "volt" SET START WITH 100`}, ddls)
+
+	ddls, err = GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{RestartIdentity: true}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`ALTER TABLE "public"."voltages" ALTER COLUMN "volt" SET START WITH 100 RESTART WITH 100`}, ddls)
+}
+
+func TestSerialToIdentity(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModePostgres)
+	current := "CREATE TABLE users (id serial NOT NULL);"
+	desired := "CREATE TABLE users (id int GENERATED BY DEFAULT AS IDENTITY);"
+
+	ddls, err := GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`ALTER TABLE "public"."users" ALTER COLUMN "id" DROP DEFAULT`,
+		`ALTER SEQUENCE "public"."users_id_seq" RENAME TO "users_id_serial_seq"`,
+		`ALTER TABLE "public"."users" ALTER COLUMN "id" ADD GENERATED BY DEFAULT AS IDENTITY`,
+		`SELECT setval('"public"."users_id_seq"', last_value, is_called) FROM "public"."users_id_serial_seq"`,
+		`DROP SEQUENCE "public"."users_id_serial_seq"`,
+	}, ddls)
+
+	_, err = GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, current, desired, database.GeneratorConfig{}, "public")
+	assert.ErrorContains(t, err, "ALTER COLUMN can't make a serial column")
+}
+
+func TestDomainConstraints(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModePostgres)
+	// As dumped by psqldef: the constraints are added by ALTER DOMAIN with the names chosen by Postgres
+	current := `CREATE DOMAIN "public"."positive" AS integer NOT NULL;
+ALTER DOMAIN "public"."positive" ADD CONSTRAINT "positive_check" CHECK (VALUE > 0);
+ALTER DOMAIN "public"."positive" ADD CONSTRAINT "Small" CHECK (VALUE < 100) NOT VALID;`
+
+	ddls, err := GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, current, "", database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`CREATE DOMAIN "public"."positive" AS integer NOT NULL`,
+		`ALTER DOMAIN "public"."positive" ADD CONSTRAINT "positive_check" CHECK (VALUE > 0)`,
+		`ALTER DOMAIN "public"."positive" ADD CONSTRAINT "Small" CHECK (VALUE < 100) NOT VALID`,
+	}, ddls)
+
+	// Unchanged constraints are untouched, regardless of quotes and parentheses
+	desired := `CREATE DOMAIN positive AS int NOT NULL CHECK ((value > 0));
+ALTER DOMAIN positive ADD CONSTRAINT "Small" CHECK (value < 100) NOT VALID;`
+	ddls, err = GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{}, ddls)
+
+	// NOT VALID is validated when the desired constraint doesn't have it
+	desired = `CREATE DOMAIN positive AS int NOT NULL CHECK (value > 0);
+ALTER DOMAIN positive ADD CONSTRAINT "Small" CHECK (value < 100);`
+	ddls, err = GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{`ALTER DOMAIN "public"."positive" VALIDATE CONSTRAINT "Small"`}, ddls)
+
+	// Changed constraints are recreated, and obsoleted ones are dropped
+	desired = `CREATE DOMAIN positive AS int DEFAULT 1 CONSTRAINT positive_check CHECK (value >= 1);
+ALTER DOMAIN positive ADD CONSTRAINT tiny CHECK (value < 10) NOT VALID;`
+	ddls, err = GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		`ALTER DOMAIN "public"."positive" SET DEFAULT 1`,
+		`ALTER DOMAIN "public"."positive" DROP NOT NULL`,
+		`ALTER DOMAIN "public"."positive" DROP CONSTRAINT "positive_check"`,
+		`ALTER DOMAIN "public"."positive" ADD CONSTRAINT "positive_check" CHECK (VALUE >= 1)`,
+		`ALTER DOMAIN "public"."positive" ADD CONSTRAINT "tiny" CHECK (VALUE < 10) NOT VALID`,
+		`ALTER DOMAIN "public"."positive" DROP CONSTRAINT "Small"`,
+	}, ddls)
+
+	_, err = GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, "CREATE DOMAIN positive AS bigint;", current, database.GeneratorConfig{}, "public")
+	assert.ErrorContains(t, err, "the data type of a domain can't be changed")
+}
+
+func TestNormalizeTriggerBody(t *testing.T) {
+	assert.Equal(t,
+		normalizeTriggerBody(GeneratorModeMssql, "insert into logs select id+1, 'a b' from inserted"),
+		normalizeTriggerBody(GeneratorModeMssql, "INSERT INTO [LOGS]\n  SELECT [ID] + 1, 'a b' FROM [inserted]"),
+	)
+	assert.Equal(t,
+		normalizeTriggerBody(GeneratorModeMysql, "SET NEW.total = NEW.price-1"),
+		normalizeTriggerBody(GeneratorModeMysql, "set new.total = new.price - 1"),
+	)
+	// String literals are compared as they are
+	assert.NotEqual(t,
+		normalizeTriggerBody(GeneratorModeMssql, "insert into logs select 'a b'"),
+		normalizeTriggerBody(GeneratorModeMssql, "insert into logs select 'A B'"),
+	)
+}
+
+func TestPostgresConstraintName(t *testing.T) {
+	assert.Equal(t, "users_name_key", PostgresConstraintName("users", []string{"name"}, "key"))
+	assert.Equal(t, "posts_user_id_tag_id_fkey", PostgresConstraintName("posts", []string{"user_id", "tag_id"}, "fkey"))
+	assert.Equal(t,
+		"aaaaaaaaaaaaaaaaaaaaaaaaaaaa_bbbbbbbbbbbbbbbbbbbbbbbbbbbb_check",
+		PostgresConstraintName(strings.Repeat("a", 40), []string{strings.Repeat("b", 40)}, "check"),
+	)
+	// A multibyte character is dropped as a whole instead of being split
+	name := PostgresConstraintName(strings.Repeat("あ", 20), []string{"id"}, "fkey")
+	assert.Equal(t, strings.Repeat("あ", 18)+"_id_fkey", name)
+	assert.True(t, utf8.ValidString(name))
+}
+
+func TestRenameMssql(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModeMssql)
+	current := "CREATE TABLE old_users (id int NOT NULL, old_name nvarchar(40));"
+	desired := "CREATE TABLE users (id int NOT NULL, name nvarchar(40));"
+	config := database.GeneratorConfig{
+		RenamedTables:  map[string]string{"users": "old_users"},
+		RenamedColumns: map[string]map[string]string{"users": {"name": "old_name"}},
+	}
+
+	ddls, err := GenerateIdempotentDDLs(GeneratorModeMssql, sqlParser, desired, current, config, "dbo")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"EXEC sp_rename N'[dbo].[old_users]', N'users'",
+		"EXEC sp_rename N'[dbo].[users].[old_name]', N'name', 'COLUMN'",
+	}, ddls)
+
+	// sp_rename can't move a table to another schema
+	config.RenamedTables = map[string]string{"sales.users": "old_users"}
+	_, err = GenerateIdempotentDDLs(GeneratorModeMssql, sqlParser, "CREATE TABLE sales.users (id int NOT NULL);", current, config, "dbo")
+	assert.ErrorContains(t, err, "renaming table 'dbo.old_users' to another schema is not supported")
+}
+
+func TestIndexNameCollidingWithRelation(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModePostgres)
+	current := `CREATE TABLE users (id bigserial NOT NULL, name text);
+CREATE TABLE users_name_idx (id bigint);`
+	desired := current + "\nCREATE INDEX users_name_idx ON users (name);"
+
+	// An index named like an existing table fails on apply
+	_, err := GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{}, "public")
+	assert.ErrorContains(t, err, "index 'users_name_idx' of table 'public.users' collides with table 'public.users_name_idx' in the same schema")
+
+	desired = current + "\nCREATE INDEX users_id_seq ON users (id);"
+	_, err = GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{}, "public")
+	assert.ErrorContains(t, err, "index 'users_id_seq' of table 'public.users' collides with the sequence of column 'id' of table 'public.users' in the same schema")
+
+	// PostgreSQL suffixes an omitted name with a number instead
+	desired = current + "\nCREATE INDEX ON users (name);"
+	ddls, err := GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current, database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CREATE INDEX ON users (name)"}, ddls)
+	ddls, err = GenerateIdempotentDDLs(GeneratorModePostgres, sqlParser, desired, current+"\nCREATE INDEX users_name_idx1 ON users (name);", database.GeneratorConfig{}, "public")
+	assert.NoError(t, err)
+	assert.Empty(t, ddls)
+}
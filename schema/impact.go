@@ -0,0 +1,89 @@
+package schema
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Impact is an estimate of how a DDL affects a live table, shown by --impact.
+type Impact struct {
+	Lock          string
+	ExclusiveLock bool
+	TableRewrite  bool
+	TableScan     bool
+	Warning       string
+}
+
+type impactRule struct {
+	pattern *regexp.Regexp
+	impact  Impact
+}
+
+func newImpactRule(pattern string, impact Impact) impactRule {
+	return impactRule{pattern: regexp.MustCompile(`(?is)` + pattern), impact: impact}
+}
+
+// The first matching rule wins, so more specific patterns come first.
+var impactRules = map[GeneratorMode][]impactRule{
+	GeneratorModePostgres: {
+		newImpactRule(`^CREATE (UNIQUE )?INDEX CONCURRENTLY `, Impact{Lock: "SHARE UPDATE EXCLUSIVE", TableScan: true}),
+		newImpactRule(`^CREATE (UNIQUE )?INDEX `, Impact{Lock: "SHARE", TableScan: true}),
+		newImpactRule(`^DROP INDEX CONCURRENTLY `, Impact{Lock: "SHARE UPDATE EXCLUSIVE"}),
+		newImpactRule(`^(DROP TABLE|DROP INDEX|DROP MATERIALIZED VIEW|TRUNCATE) `, Impact{Lock: "ACCESS EXCLUSIVE", ExclusiveLock: true}),
+		newImpactRule(`^ALTER TABLE .* ALTER COLUMN .* TYPE `, Impact{Lock: "ACCESS EXCLUSIVE", ExclusiveLock: true, TableRewrite: true}),
+		newImpactRule(`^ALTER TABLE .* ALTER COLUMN .* SET NOT NULL`, Impact{Lock: "ACCESS EXCLUSIVE", ExclusiveLock: true, TableScan: true}),
+		newImpactRule(`^ALTER TABLE .* ADD COLUMN .* DEFAULT (random|gen_random_uuid|uuid_generate_v4|clock_timestamp|timeofday|nextval)\(`, Impact{Lock: "ACCESS EXCLUSIVE", ExclusiveLock: true, TableRewrite: true}),
+		newImpactRule(`^ALTER TABLE .* ADD COLUMN .* DEFAULT `, Impact{Lock: "ACCESS EXCLUSIVE", ExclusiveLock: true}),
+		newImpactRule(`^ALTER TABLE .* ADD COLUMN .* NOT NULL`, Impact{Lock: "ACCESS EXCLUSIVE", ExclusiveLock: true, Warning: "fails on a non-empty table unless it has a default"}),
+		newImpactRule(`^ALTER TABLE .* ADD CONSTRAINT .* FOREIGN KEY .* NOT VALID`, Impact{Lock: "SHARE ROW EXCLUSIVE"}),
+		newImpactRule(`^ALTER TABLE .* ADD CONSTRAINT .* FOREIGN KEY `, Impact{Lock: "SHARE ROW EXCLUSIVE", TableScan: true}),
+		newImpactRule(`^ALTER TABLE .* ADD CONSTRAINT .* CHECK .* NOT VALID`, Impact{Lock: "ACCESS EXCLUSIVE", ExclusiveLock: true}),
+		newImpactRule(`^ALTER TABLE .* VALIDATE CONSTRAINT `, Impact{Lock: "SHARE UPDATE EXCLUSIVE", TableScan: true}),
+		newImpactRule(`^ALTER TABLE .* ADD (CONSTRAINT .* )?(PRIMARY KEY|UNIQUE|CHECK|EXCLUDE) `, Impact{Lock: "ACCESS EXCLUSIVE", ExclusiveLock: true, TableScan: true}),
+		newImpactRule(`^ALTER TABLE `, Impact{Lock: "ACCESS EXCLUSIVE", ExclusiveLock: true}),
+	},
+	GeneratorModeMysql: {
+		newImpactRule(`^ALTER TABLE .* ALGORITHM=INSTANT`, Impact{}),
+		newImpactRule(`^ALTER TABLE .* (CHANGE|MODIFY) COLUMN `, Impact{Lock: "SHARED", TableRewrite: true}),
+		newImpactRule(`^ALTER TABLE .* (ADD|DROP) PRIMARY KEY`, Impact{Lock: "SHARED", TableRewrite: true}),
+		newImpactRule(`^(DROP TABLE|TRUNCATE|ALTER TABLE .* RENAME) `, Impact{Lock: "EXCLUSIVE", ExclusiveLock: true}),
+	},
+	GeneratorModeSQLite3: {
+		newImpactRule(`^(DROP TABLE|ALTER TABLE) `, Impact{Lock: "EXCLUSIVE", ExclusiveLock: true}),
+	},
+	GeneratorModeMssql: {
+		newImpactRule(`^ALTER TABLE .* ALTER COLUMN `, Impact{Lock: "SCH-M", ExclusiveLock: true, TableRewrite: true}),
+		newImpactRule(`^CREATE .*INDEX .* ONLINE = ON`, Impact{}),
+		newImpactRule(`^CREATE .*INDEX `, Impact{Lock: "SHARE", TableScan: true}),
+		newImpactRule(`^(DROP TABLE|DROP INDEX|ALTER TABLE) `, Impact{Lock: "SCH-M", ExclusiveLock: true}),
+		newImpactRule(`^DROP TYPE `, Impact{Warning: "fails while a procedure or function has a parameter of the type"}),
+	},
+}
+
+// AnalyzeImpact estimates the locks and table rewrites of a DDL generated by GenerateIdempotentDDLs.
+// It doesn't know the table size or the server version, so the result is a hint for reviewers rather than a guarantee.
+func AnalyzeImpact(mode GeneratorMode, ddl string) Impact {
+	ddl = strings.TrimSpace(ddl)
+	for _, rule := range impactRules[mode] {
+		if rule.pattern.MatchString(ddl) {
+			return rule.impact
+		}
+	}
+	return Impact{}
+}
+
+func (i Impact) String() string {
+	var notes []string
+	if i.Lock != "" {
+		notes = append(notes, i.Lock+" lock")
+	}
+	if i.TableRewrite {
+		notes = append(notes, "table rewrite")
+	} else if i.TableScan {
+		notes = append(notes, "full table scan")
+	}
+	if i.Warning != "" {
+		notes = append(notes, i.Warning)
+	}
+	return strings.Join(notes, ", ")
+}
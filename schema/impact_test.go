@@ -0,0 +1,25 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalyzeImpactPostgres(t *testing.T) {
+	assert.Equal(t, "ACCESS EXCLUSIVE lock, table rewrite", AnalyzeImpact(GeneratorModePostgres, `ALTER TABLE "public"."users" ALTER COLUMN "age" TYPE bigint`).String())
+	assert.Equal(t, "ACCESS EXCLUSIVE lock, full table scan", AnalyzeImpact(GeneratorModePostgres, `ALTER TABLE "public"."users" ALTER COLUMN "name" SET NOT NULL`).String())
+	assert.Equal(t, "ACCESS EXCLUSIVE lock, fails on a non-empty table unless it has a default", AnalyzeImpact(GeneratorModePostgres, `ALTER TABLE "public"."users" ADD COLUMN "name" text NOT NULL`).String())
+	assert.Equal(t, "ACCESS EXCLUSIVE lock", AnalyzeImpact(GeneratorModePostgres, `ALTER TABLE "public"."users" ADD COLUMN "name" text NOT NULL DEFAULT ''`).String())
+	assert.Equal(t, "ACCESS EXCLUSIVE lock, table rewrite", AnalyzeImpact(GeneratorModePostgres, `ALTER TABLE "public"."users" ADD COLUMN "token" uuid DEFAULT gen_random_uuid()`).String())
+	assert.Equal(t, "SHARE lock, full table scan", AnalyzeImpact(GeneratorModePostgres, `CREATE INDEX index_name ON users (name)`).String())
+	assert.Equal(t, "SHARE UPDATE EXCLUSIVE lock, full table scan", AnalyzeImpact(GeneratorModePostgres, `CREATE INDEX CONCURRENTLY index_name ON users (name)`).String())
+	assert.Equal(t, "SHARE UPDATE EXCLUSIVE lock, full table scan", AnalyzeImpact(GeneratorModePostgres, `ALTER TABLE "public"."users" VALIDATE CONSTRAINT "users_name_not_null"`).String())
+	assert.Equal(t, "", AnalyzeImpact(GeneratorModePostgres, `CREATE TABLE users (id bigint)`).String())
+}
+
+func TestAnalyzeImpactMysql(t *testing.T) {
+	assert.Equal(t, Impact{Lock: "SHARED", TableRewrite: true}, AnalyzeImpact(GeneratorModeMysql, "ALTER TABLE `users` CHANGE COLUMN `age` `age` bigint"))
+	assert.Equal(t, Impact{}, AnalyzeImpact(GeneratorModeMysql, "ALTER TABLE `users` ADD COLUMN `name` text, ALGORITHM=INSTANT"))
+	assert.Equal(t, Impact{Lock: "EXCLUSIVE", ExclusiveLock: true}, AnalyzeImpact(GeneratorModeMysql, "DROP TABLE `users`"))
+}
@@ -0,0 +1,156 @@
+package schema
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/sqldef/sqldef/database"
+)
+
+// The JSON document of GenerateInspection. The names of the objects are qualified by their schemas as sqldef compares
+// them, e.g. "public.users" for Postgres and "users" for MySQL.
+type inspection struct {
+	Tables []inspectedTable `json:"tables"`
+	Views  []string         `json:"views"`
+	Types  []inspectedType  `json:"types"`
+}
+
+type inspectedTable struct {
+	Name        string                `json:"name"`
+	Comment     string                `json:"comment,omitempty"`
+	Columns     []inspectedColumn     `json:"columns"`
+	PrimaryKey  []string              `json:"primary_key"`
+	Indexes     []inspectedIndex      `json:"indexes"`
+	ForeignKeys []inspectedForeignKey `json:"foreign_keys"`
+}
+
+type inspectedColumn struct {
+	Name          string  `json:"name"`
+	Type          string  `json:"type"`
+	Nullable      bool    `json:"nullable"`
+	Default       *string `json:"default"`
+	Unique        bool    `json:"unique"`
+	AutoIncrement bool    `json:"auto_increment"`
+	Comment       string  `json:"comment,omitempty"`
+}
+
+type inspectedIndex struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Unique  bool     `json:"unique"`
+	Primary bool     `json:"primary"`
+	Where   string   `json:"where,omitempty"`
+}
+
+type inspectedForeignKey struct {
+	Name              string   `json:"name"`
+	Columns           []string `json:"columns"`
+	ReferencedTable   string   `json:"referenced_table"`
+	ReferencedColumns []string `json:"referenced_columns"`
+	OnDelete          string   `json:"on_delete,omitempty"`
+	OnUpdate          string   `json:"on_update,omitempty"`
+}
+
+type inspectedType struct {
+	Name       string            `json:"name"`
+	EnumValues []string          `json:"enum_values,omitempty"`
+	Attributes []inspectedColumn `json:"attributes,omitempty"`
+}
+
+// GenerateInspection renders the tables, views, and types of the schema as a JSON document for other tools, e.g. code
+// generators and data catalogs, which then don't need to parse the SQL of each dialect by themselves.
+func GenerateInspection(mode GeneratorMode, sqlParser database.Parser, sql string, config database.GeneratorConfig, defaultSchema string) (string, error) {
+	ddls, err := ParseDDLs(mode, sqlParser, sql, defaultSchema)
+	if err != nil {
+		return "", err
+	}
+	ddls = FilterTables(ddls, config)
+
+	tables, views, _, types, comments, _, _, _, _, _, err := aggregateDDLsToSchema(ddls)
+	if err != nil {
+		return "", err
+	}
+	g := Generator{mode: mode, defaultSchema: defaultSchema}
+
+	result := inspection{Tables: []inspectedTable{}, Views: []string{}, Types: []inspectedType{}}
+	for _, table := range tables {
+		inspected := inspectedTable{
+			Name:        table.name,
+			Comment:     tableComment(*table, comments),
+			Columns:     []inspectedColumn{},
+			PrimaryKey:  []string{},
+			Indexes:     []inspectedIndex{},
+			ForeignKeys: []inspectedForeignKey{},
+		}
+		for _, column := range table.columns {
+			inspectedColumn := g.inspectColumn(column, *table)
+			inspectedColumn.Comment = columnComment(*table, column, comments)
+			inspected.Columns = append(inspected.Columns, inspectedColumn)
+			if column.keyOption == ColumnKeyPrimary {
+				inspected.PrimaryKey = append(inspected.PrimaryKey, column.name)
+			}
+		}
+		for _, index := range table.indexes {
+			columns := []string{}
+			for _, indexColumn := range index.columns {
+				columns = append(columns, indexColumn.column)
+			}
+			if index.primary {
+				inspected.PrimaryKey = columns
+			}
+			inspected.Indexes = append(inspected.Indexes, inspectedIndex{
+				Name:    index.name,
+				Columns: columns,
+				Unique:  index.unique || index.primary,
+				Primary: index.primary,
+				Where:   index.where,
+			})
+		}
+		for _, foreignKey := range table.foreignKeys {
+			inspected.ForeignKeys = append(inspected.ForeignKeys, inspectedForeignKey{
+				Name:              foreignKey.constraintName,
+				Columns:           foreignKey.indexColumns,
+				ReferencedTable:   foreignKey.referenceName,
+				ReferencedColumns: foreignKey.referenceColumns,
+				OnDelete:          strings.ToUpper(foreignKey.onDelete),
+				OnUpdate:          strings.ToUpper(foreignKey.onUpdate),
+			})
+		}
+		result.Tables = append(result.Tables, inspected)
+	}
+
+	for _, view := range views {
+		result.Views = append(result.Views, view.name)
+	}
+
+	for _, typ := range types {
+		inspected := inspectedType{Name: typ.name, EnumValues: typ.enumValues}
+		for _, attribute := range typ.attributes {
+			inspected.Attributes = append(inspected.Attributes, g.inspectColumn(attribute, Table{}))
+		}
+		result.Types = append(result.Types, inspected)
+	}
+
+	out, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(out) + "\n", nil
+}
+
+func (g *Generator) inspectColumn(column Column, table Table) inspectedColumn {
+	inspected := inspectedColumn{
+		Name:          column.name,
+		Type:          generateDataType(column),
+		Nullable:      !g.notNull(column) && !isPrimaryKey(column, table),
+		Unique:        column.keyOption.isUnique(),
+		AutoIncrement: column.autoIncrement || column.identity != nil || column.typeName == "serial" || column.typeName == "bigserial" || column.typeName == "smallserial",
+	}
+	if column.defaultDef != nil {
+		if def, err := g.generateDefaultDefinition(*column.defaultDef); err == nil {
+			defaultValue := strings.TrimPrefix(def, "DEFAULT ")
+			inspected.Default = &defaultValue
+		}
+	}
+	return inspected
+}
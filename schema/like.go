@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// expandCreateTableLike expands `CREATE TABLE ... LIKE other` of MySQL in the desired DDLs into the definition of the
+// other table, so that the copy is compared like a table defined explicitly. The other table is looked up from the
+// desired tables defined before it, and then from the current tables. Like MySQL, foreign keys aren't copied and the
+// generated names of check constraints are renamed for the new table. The statement is kept to create the table.
+func expandCreateTableLike(desiredDDLs []DDL, currentDDLs []DDL) ([]DDL, error) {
+	var result []DDL
+	var tables []*Table
+	for _, ddl := range desiredDDLs {
+		stmt, ok := ddl.(*CreateTable)
+		if !ok {
+			result = append(result, ddl)
+			continue
+		}
+
+		if stmt.like != "" {
+			source := findTableByName(tables, stmt.like)
+			if source == nil {
+				source = findCurrentTable(currentDDLs, stmt.like)
+			}
+			if source == nil {
+				return nil, fmt.Errorf("CREATE TABLE %s LIKE %s refers to a table which is neither defined before it nor existing: '%s'",
+					stmt.table.name, stmt.like, stmt.statement)
+			}
+			stmt = &CreateTable{statement: stmt.statement, table: copyTableLike(*source, stmt.table.name)}
+		}
+		result = append(result, stmt)
+		table := stmt.table // copy table
+		tables = append(tables, &table)
+	}
+	return result, nil
+}
+
+func findCurrentTable(currentDDLs []DDL, name string) *Table {
+	for _, ddl := range currentDDLs {
+		if stmt, ok := ddl.(*CreateTable); ok && stmt.table.name == name {
+			return &stmt.table
+		}
+	}
+	return nil
+}
+
+func copyTableLike(source Table, name string) Table {
+	checkPrefix := source.name + "_chk_"
+	renameCheck := func(check CheckDefinition) *CheckDefinition {
+		if strings.HasPrefix(check.constraintName, checkPrefix) {
+			check.constraintName = name + "_chk_" + strings.TrimPrefix(check.constraintName, checkPrefix)
+		}
+		return &check
+	}
+
+	table := Table{
+		name:    name,
+		indexes: append([]Index{}, source.indexes...),
+		options: map[string]string{},
+	}
+	for _, column := range source.columns {
+		if column.check != nil {
+			column.check = renameCheck(*column.check)
+		}
+		table.columns = append(table.columns, column)
+	}
+	for _, check := range source.checks {
+		table.checks = append(table.checks, *renameCheck(check))
+	}
+	for key, value := range source.options {
+		table.options[key] = value
+	}
+	return table
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateTableLike(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModeMysql)
+	users := "CREATE TABLE users (\n" +
+		"  id bigint NOT NULL PRIMARY KEY,\n" +
+		"  name varchar(20) NOT NULL,\n" +
+		"  CONSTRAINT users_chk_1 CHECK (name <> '')\n" +
+		");\n"
+	current := users +
+		"CREATE TABLE users_archive (\n" +
+		"  id bigint NOT NULL PRIMARY KEY,\n" +
+		"  name varchar(20) NOT NULL,\n" +
+		"  CONSTRAINT users_archive_chk_1 CHECK (name <> '')\n" +
+		");\n"
+
+	ddls, err := GenerateIdempotentDDLs(GeneratorModeMysql, sqlParser, users+"CREATE TABLE users_archive LIKE users;", current, database.GeneratorConfig{}, "")
+	assert.NoError(t, err)
+	assert.Empty(t, ddls)
+
+	// The definition of users is expanded from the desired one, which is defined before it.
+	desired := "CREATE TABLE users (id bigint NOT NULL PRIMARY KEY, name varchar(40) NOT NULL, CONSTRAINT users_chk_1 CHECK (name <> ''));\n" +
+		"CREATE TABLE users_archive LIKE users;"
+	ddls, err = GenerateIdempotentDDLs(GeneratorModeMysql, sqlParser, desired, current, database.GeneratorConfig{}, "")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"ALTER TABLE `users` CHANGE COLUMN `name` `name` varchar(40) NOT NULL",
+		"ALTER TABLE `users_archive` CHANGE COLUMN `name` `name` varchar(40) NOT NULL",
+	}, ddls)
+
+	_, err = GenerateIdempotentDDLs(GeneratorModeMysql, sqlParser, "CREATE TABLE users_archive LIKE users;", "", database.GeneratorConfig{}, "")
+	assert.EqualError(t, err, "CREATE TABLE users_archive LIKE users refers to a table which is neither defined before it nor existing: 'CREATE TABLE users_archive LIKE users'")
+}
+
+func TestCreateTableSelect(t *testing.T) {
+	_, err := ParseDDLs(GeneratorModeMysql, database.NewParser(parser.ParserModeMysql), "CREATE TABLE users_archive AS SELECT * FROM users;", "")
+	assert.EqualError(t, err, "CREATE TABLE ... SELECT is not supported since its columns depend on the query, so define them explicitly: 'CREATE TABLE users_archive AS SELECT * FROM users'")
+}
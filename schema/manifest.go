@@ -0,0 +1,139 @@
+package schema
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Manifest is a desired schema declared in YAML or JSON instead of SQL, e.g. generated by another tool.
+type Manifest struct {
+	Tables []ManifestTable `yaml:"tables"`
+}
+
+type ManifestTable struct {
+	Name        string               `yaml:"name"`
+	Columns     []ManifestColumn     `yaml:"columns"`
+	PrimaryKey  []string             `yaml:"primary_key"`
+	Indexes     []ManifestIndex      `yaml:"indexes"`
+	ForeignKeys []ManifestForeignKey `yaml:"foreign_keys"`
+}
+
+type ManifestColumn struct {
+	Name    string  `yaml:"name"`
+	Type    string  `yaml:"type"`
+	NotNull bool    `yaml:"not_null"`
+	Default *string `yaml:"default"` // an SQL expression, e.g. '''draft''' for a string
+}
+
+type ManifestIndex struct {
+	Name    string   `yaml:"name"`
+	Columns []string `yaml:"columns"`
+	Unique  bool     `yaml:"unique"`
+}
+
+type ManifestForeignKey struct {
+	Name             string   `yaml:"name"`
+	Columns          []string `yaml:"columns"`
+	ReferenceTable   string   `yaml:"reference_table"`
+	ReferenceColumns []string `yaml:"reference_columns"`
+	OnDelete         string   `yaml:"on_delete"`
+	OnUpdate         string   `yaml:"on_update"`
+}
+
+// IsManifestFile returns true if the desired schema file is a manifest, judging from its extension.
+func IsManifestFile(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasSuffix(lower, ".yml") || strings.HasSuffix(lower, ".yaml") || strings.HasSuffix(lower, ".json")
+}
+
+// ManifestToSQL translates a manifest in YAML or JSON into the CREATE TABLE and CREATE INDEX statements of the mode,
+// which are parsed in the same way as a desired schema written in SQL.
+func ManifestToSQL(mode GeneratorMode, buf []byte) (string, error) {
+	var manifest Manifest
+	dec := yaml.NewDecoder(bytes.NewReader(buf)) // JSON is parsed as YAML
+	dec.KnownFields(true)
+	if err := dec.Decode(&manifest); err != nil {
+		return "", err
+	}
+
+	g := Generator{mode: mode}
+	var result strings.Builder
+	for _, table := range manifest.Tables {
+		if table.Name == "" {
+			return "", fmt.Errorf("a table of the manifest has no name")
+		}
+		if len(table.Columns) == 0 {
+			return "", fmt.Errorf("table '%s' of the manifest has no columns", table.Name)
+		}
+
+		var definitions []string
+		for _, column := range table.Columns {
+			if column.Name == "" || column.Type == "" {
+				return "", fmt.Errorf("a column of table '%s' of the manifest has no name or type", table.Name)
+			}
+			definition := fmt.Sprintf("%s %s", g.escapeSQLName(column.Name), column.Type)
+			if column.NotNull {
+				definition += " NOT NULL"
+			}
+			if column.Default != nil {
+				definition += " DEFAULT " + *column.Default
+			}
+			definitions = append(definitions, definition)
+		}
+		if len(table.PrimaryKey) > 0 {
+			definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", g.escapeManifestNames(table.PrimaryKey)))
+		}
+		for _, foreignKey := range table.ForeignKeys {
+			if len(foreignKey.Columns) == 0 || foreignKey.ReferenceTable == "" || len(foreignKey.ReferenceColumns) == 0 {
+				return "", fmt.Errorf("a foreign key of table '%s' of the manifest needs columns, reference_table, and reference_columns", table.Name)
+			}
+			var definition string
+			if foreignKey.Name != "" {
+				definition = fmt.Sprintf("CONSTRAINT %s ", g.escapeSQLName(foreignKey.Name))
+			}
+			definition += fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)", g.escapeManifestNames(foreignKey.Columns),
+				g.escapeManifestTableName(foreignKey.ReferenceTable), g.escapeManifestNames(foreignKey.ReferenceColumns))
+			if foreignKey.OnDelete != "" {
+				definition += " ON DELETE " + strings.ToUpper(foreignKey.OnDelete)
+			}
+			if foreignKey.OnUpdate != "" {
+				definition += " ON UPDATE " + strings.ToUpper(foreignKey.OnUpdate)
+			}
+			definitions = append(definitions, definition)
+		}
+		fmt.Fprintf(&result, "CREATE TABLE %s (\n  %s\n);\n", g.escapeManifestTableName(table.Name), strings.Join(definitions, ",\n  "))
+
+		for _, index := range table.Indexes {
+			if index.Name == "" || len(index.Columns) == 0 {
+				return "", fmt.Errorf("an index of table '%s' of the manifest has no name or columns", table.Name)
+			}
+			unique := ""
+			if index.Unique {
+				unique = "UNIQUE "
+			}
+			fmt.Fprintf(&result, "CREATE %sINDEX %s ON %s (%s);\n", unique, g.escapeSQLName(index.Name),
+				g.escapeManifestTableName(table.Name), g.escapeManifestNames(index.Columns))
+		}
+	}
+	return result.String(), nil
+}
+
+// Unlike escapeTableName, an unqualified name is left unqualified to be in the default schema of the database.
+func (g *Generator) escapeManifestTableName(name string) string {
+	parts := strings.Split(name, ".")
+	for i, part := range parts {
+		parts[i] = g.escapeSQLName(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+func (g *Generator) escapeManifestNames(names []string) string {
+	escaped := make([]string, len(names))
+	for i, name := range names {
+		escaped[i] = g.escapeSQLName(name)
+	}
+	return strings.Join(escaped, ", ")
+}
@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestManifestToSQL(t *testing.T) {
+	manifest := `
+tables:
+  - name: users
+    columns:
+      - name: id
+        type: bigint
+        not_null: true
+      - name: role
+        type: varchar(20)
+        default: "'active'"
+    primary_key: [id]
+    indexes:
+      - name: index_users_role
+        columns: [role]
+  - name: posts
+    columns:
+      - {name: id, type: bigint, not_null: true}
+      - {name: user_id, type: bigint}
+    primary_key: [id]
+    foreign_keys:
+      - name: posts_user_id_fkey
+        columns: [user_id]
+        reference_table: users
+        reference_columns: [id]
+        on_delete: cascade
+`
+	sql, err := ManifestToSQL(GeneratorModeMysql, []byte(manifest))
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE TABLE `users` (\n"+
+		"  `id` bigint NOT NULL,\n"+
+		"  `role` varchar(20) DEFAULT 'active',\n"+
+		"  PRIMARY KEY (`id`)\n"+
+		");\n"+
+		"CREATE INDEX `index_users_role` ON `users` (`role`);\n"+
+		"CREATE TABLE `posts` (\n"+
+		"  `id` bigint NOT NULL,\n"+
+		"  `user_id` bigint,\n"+
+		"  PRIMARY KEY (`id`),\n"+
+		"  CONSTRAINT `posts_user_id_fkey` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE\n"+
+		");\n", sql)
+
+	// The translated schema is the same as the one written in SQL
+	ddls, err := GenerateIdempotentDDLs(GeneratorModeMysql, database.NewParser(parser.ParserModeMysql), sql,
+		"CREATE TABLE users (id bigint NOT NULL, role varchar(20) DEFAULT 'active', PRIMARY KEY (id));\n"+
+			"CREATE INDEX index_users_role ON users (role);\n"+
+			"CREATE TABLE posts (id bigint NOT NULL, user_id bigint, PRIMARY KEY (id), "+
+			"CONSTRAINT posts_user_id_fkey FOREIGN KEY (user_id) REFERENCES users (id) ON DELETE CASCADE);\n", database.GeneratorConfig{}, "")
+	assert.NoError(t, err)
+	assert.Empty(t, ddls)
+
+	sql, err = ManifestToSQL(GeneratorModePostgres, []byte(`{"tables": [{"name": "app.users", "columns": [{"name": "id", "type": "integer"}]}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "CREATE TABLE \"app\".\"users\" (\n  \"id\" integer\n);\n", sql)
+
+	_, err = ManifestToSQL(GeneratorModeMysql, []byte("tables:\n  - name: users\n    colums: []\n"))
+	assert.Error(t, err)
+	_, err = ManifestToSQL(GeneratorModeMysql, []byte("tables:\n  - name: users\n"))
+	assert.EqualError(t, err, "table 'users' of the manifest has no columns")
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"regexp"
+	"strings"
+)
+
+// The table and the clauses of ALTER TABLE generated for MySQL
+var mysqlAlterTable = regexp.MustCompile("(?s)^ALTER TABLE (`[^`]*`(?:\\.`[^`]*`)?) (.+)$")
+
+// mergeAlterTables combines the ALTER TABLEs of each table into the first one, so that MySQL rebuilds the table once.
+// An ALTER TABLE is merged over the ALTER TABLEs of other tables, but not over other DDLs, which may depend on the order.
+// Foreign keys, renames, and partitions are left as separate statements, since they may depend on other tables or can't
+// be combined with other clauses.
+func mergeAlterTables(ddls []string) []string {
+	var result []string
+	mergeable := map[string]int{} // table -> index of its ALTER TABLE in result
+	for _, ddl := range ddls {
+		match := mysqlAlterTable.FindStringSubmatch(ddl)
+		if match == nil || !isMergeableAlter(match[2]) {
+			mergeable = map[string]int{}
+			result = append(result, ddl)
+			continue
+		}
+		if i, ok := mergeable[match[1]]; ok {
+			result[i] += ", " + match[2]
+			continue
+		}
+		mergeable[match[1]] = len(result)
+		result = append(result, ddl)
+	}
+	return result
+}
+
+func isMergeableAlter(clauses string) bool {
+	clauses = strings.ToUpper(clauses)
+	for _, keyword := range []string{"FOREIGN KEY", "RENAME TO ", "RENAME AS ", "PARTITION"} {
+		if strings.Contains(clauses, keyword) {
+			return false
+		}
+	}
+	return true
+}
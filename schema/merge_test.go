@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeAlterTables(t *testing.T) {
+	assert.Equal(t, []string{
+		"ALTER TABLE `users` ADD COLUMN `name` text AFTER `id`, DROP COLUMN `age`, ADD INDEX `index_name` (`name`(10))",
+		"ALTER TABLE `posts` ADD COLUMN `title` text AFTER `id`",
+	}, mergeAlterTables([]string{
+		"ALTER TABLE `users` ADD COLUMN `name` text AFTER `id`",
+		"ALTER TABLE `posts` ADD COLUMN `title` text AFTER `id`",
+		"ALTER TABLE `users` DROP COLUMN `age`",
+		"ALTER TABLE `users` ADD INDEX `index_name` (`name`(10))",
+	}))
+
+	// Nothing is merged over other DDLs, and foreign keys are left as they are.
+	assert.Equal(t, []string{
+		"ALTER TABLE `users` ADD COLUMN `name` text AFTER `id`",
+		"CREATE TABLE `posts` (`id` int, `user_id` int)",
+		"ALTER TABLE `users` DROP COLUMN `age`",
+		"ALTER TABLE `posts` ADD CONSTRAINT `posts_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)",
+		"ALTER TABLE `users` ADD INDEX `index_name` (`name`(10))",
+	}, mergeAlterTables([]string{
+		"ALTER TABLE `users` ADD COLUMN `name` text AFTER `id`",
+		"CREATE TABLE `posts` (`id` int, `user_id` int)",
+		"ALTER TABLE `users` DROP COLUMN `age`",
+		"ALTER TABLE `posts` ADD CONSTRAINT `posts_ibfk_1` FOREIGN KEY (`user_id`) REFERENCES `users` (`id`)",
+		"ALTER TABLE `users` ADD INDEX `index_name` (`name`(10))",
+	}))
+}
@@ -0,0 +1,46 @@
+package schema
+
+// MergeDDLs merges the DDLs of --overlay over the base desired DDLs. An overlay DDL replaces the base DDL defining the
+// same object, e.g. the same table or the index of the same name on the same table, and the others are appended.
+// When an object is defined more than once, the last definition wins.
+func MergeDDLs(baseDDLs []DDL, overlayDDLs []DDL) []DDL {
+	var result []DDL
+	positions := map[string]int{}
+	for _, ddl := range append(baseDDLs, overlayDDLs...) {
+		object := overlayIdentity(ddl)
+		if i, ok := positions[object]; ok {
+			result[i] = ddl
+		} else {
+			positions[object] = len(result)
+			result = append(result, ddl)
+		}
+	}
+	return result
+}
+
+// Return the identity of the object defined by the DDL, which is its kind and name. A constraint or an index without a
+// name, and a GRANT, which only adds privileges to the others, are identified by the whole statement instead, so that
+// they're replaced only by the same statement.
+func overlayIdentity(ddl DDL) string {
+	switch stmt := ddl.(type) {
+	case *CreateIndex:
+		if stmt.index.name == "" {
+			return normalizeStatement(ddl.Statement())
+		}
+	case *AddIndex:
+		if stmt.index.name == "" {
+			return normalizeStatement(ddl.Statement())
+		}
+	case *AddForeignKey:
+		if stmt.foreignKey.constraintName == "" {
+			return normalizeStatement(ddl.Statement())
+		}
+	case *AddExclusion:
+		if stmt.exclusion.constraintName == "" {
+			return normalizeStatement(ddl.Statement())
+		}
+	case *Grant:
+		return normalizeStatement(ddl.Statement())
+	}
+	return DescribeDDL(ddl)
+}
@@ -0,0 +1,36 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeDDLs(t *testing.T) {
+	sqlParser := database.NewParser(parser.ParserModePostgres)
+	base, err := ParseDDLs(GeneratorModePostgres, sqlParser, "CREATE TABLE public.users (id integer, name text);\n"+
+		"CREATE TABLE public.posts (id integer, user_id integer, editor_id integer);\n"+
+		"ALTER TABLE public.posts ADD FOREIGN KEY (user_id) REFERENCES public.users (id);\n", "public")
+	assert.NoError(t, err)
+	overlay, err := ParseDDLs(GeneratorModePostgres, sqlParser, "CREATE TABLE public.users (id integer, name text, email text);\n"+
+		"ALTER TABLE public.posts ADD FOREIGN KEY (editor_id) REFERENCES public.users (id);\n", "public")
+	assert.NoError(t, err)
+	base = append(base, &Grant{statement: "GRANT SELECT ON TABLE public.users TO app", privileges: []string{"SELECT"}, tables: []string{"public.users"}, grantees: []string{"app"}})
+	overlay = append(overlay, &Grant{statement: "GRANT INSERT ON TABLE public.users TO app", privileges: []string{"INSERT"}, tables: []string{"public.users"}, grantees: []string{"app"}})
+
+	var statements []string
+	for _, ddl := range MergeDDLs(base, overlay) {
+		statements = append(statements, ddl.Statement())
+	}
+	// The table is replaced, while the foreign keys without names and the GRANTs are kept side by side
+	assert.Equal(t, []string{
+		overlay[0].Statement(),
+		base[1].Statement(),
+		base[2].Statement(),
+		base[3].Statement(),
+		overlay[1].Statement(),
+		overlay[2].Statement(),
+	}, statements)
+}
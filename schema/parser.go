@@ -4,8 +4,11 @@ package schema
 
 import (
 	"fmt"
+	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/sqldef/sqldef/database"
 	"github.com/sqldef/sqldef/parser"
@@ -25,22 +28,115 @@ func ParseDDLs(mode GeneratorMode, sqlParser database.Parser, sql string, defaul
 		if err != nil {
 			return result, err
 		}
+		if drop, ok := parsed.(*Drop); ok {
+			result = applyDrop(result, *drop)
+			continue
+		}
 		result = append(result, parsed)
 	}
 	return result, nil
 }
 
+// Remove the DDLs of the objects dropped by the DROP, which may not have been declared, e.g. DROP TABLE IF EXISTS
+// before the CREATE TABLE of a schema dump. Dropping a table removes its indexes, constraints, and so on as well.
+func applyDrop(ddls []DDL, drop Drop) []DDL {
+	var result []DDL
+	for _, ddl := range ddls {
+		if !isDropped(ddl, drop) {
+			result = append(result, ddl)
+		}
+	}
+	return result
+}
+
+func isDropped(ddl DDL, drop Drop) bool {
+	switch drop.objectType {
+	case "TABLE":
+		var tableName string
+		switch stmt := ddl.(type) {
+		case *CreateTable:
+			tableName = stmt.table.name
+		case *CreateIndex:
+			tableName = stmt.tableName
+		case *AddIndex:
+			tableName = stmt.tableName
+		case *AddPrimaryKey:
+			tableName = stmt.tableName
+		case *AddForeignKey:
+			tableName = stmt.tableName
+		case *AddExclusion:
+			tableName = stmt.tableName
+		case *AddPolicy:
+			tableName = stmt.tableName
+		case *Trigger:
+			tableName = stmt.tableName
+		case *ClusterOn:
+			tableName = stmt.tableName
+		default:
+			return false
+		}
+		return containsString(drop.names, tableName)
+	case "VIEW":
+		view, ok := ddl.(*View)
+		return ok && containsString(drop.names, view.name)
+	case "INDEX":
+		var tableName, indexName string
+		switch stmt := ddl.(type) {
+		case *CreateIndex:
+			tableName, indexName = stmt.tableName, stmt.index.name
+		case *AddIndex:
+			tableName, indexName = stmt.tableName, stmt.index.name
+		default:
+			return false
+		}
+		return containsString(drop.names, indexName) && (drop.tableName == "" || drop.tableName == tableName)
+	default:
+		return false
+	}
+}
+
+// The mode of the tokenizer of the parser for the mode of the generator, e.g. to tell a string from an identifier.
+func parserModeOf(mode GeneratorMode) parser.ParserMode {
+	switch mode {
+	case GeneratorModePostgres:
+		return parser.ParserModePostgres
+	case GeneratorModeSQLite3:
+		return parser.ParserModeSQLite3
+	case GeneratorModeMssql:
+		return parser.ParserModeMssql
+	default:
+		return parser.ParserModeMysql
+	}
+}
+
 // Parse DDL like `CREATE TABLE` or `ALTER TABLE`.
 // This doesn't support destructive DDL like `DROP TABLE`.
 func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSchema string) (DDL, error) {
 	switch stmt := stmt.(type) {
 	case *parser.DDL:
 		if stmt.Action == parser.CreateTable {
+			if stmt.Select != nil {
+				return nil, fmt.Errorf("CREATE TABLE ... SELECT is not supported since its columns depend on the query, so define them explicitly: '%s'", ddl)
+			}
+			if stmt.Like != nil {
+				if mode != GeneratorModeMysql {
+					return nil, fmt.Errorf("CREATE TABLE ... LIKE is supported only by MySQL: '%s'", ddl)
+				}
+				// The table is expanded from the definition of the other one in generating DDLs.
+				return &CreateTable{
+					statement: ddl,
+					table:     Table{name: normalizedTableName(mode, stmt.NewName, defaultSchema)},
+					like:      normalizedTableName(mode, *stmt.Like, defaultSchema),
+				}, nil
+			}
 			// TODO: handle other create DDL as error?
 			table, err := parseTable(mode, stmt, defaultSchema)
 			if err != nil {
 				return nil, err
 			}
+			if stmt.TableSpec.Virtual {
+				table.virtual = parseVirtualTableModule(ddl)
+			}
 			return &CreateTable{
 				statement: ddl,
 				table:     table,
@@ -107,6 +203,12 @@ func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSche
 					constraintOptions: constraintOptions,
 				},
 			}, nil
+		} else if stmt.Action == parser.AddExclusion {
+			return &AddExclusion{
+				statement: ddl,
+				tableName: normalizedTableName(mode, stmt.Table, defaultSchema),
+				exclusion: parseExclusion(stmt.Exclusion),
+			}, nil
 		} else if stmt.Action == parser.CreatePolicy {
 			scope := make([]string, len(stmt.Policy.To))
 			for i, to := range stmt.Policy.To {
@@ -114,10 +216,10 @@ func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSche
 			}
 			var using, withCheck string
 			if stmt.Policy.Using != nil {
-				using = parser.String(stmt.Policy.Using.Expr)
+				using = parser.String(normalizePolicyExpr(stmt.Policy.Using.Expr, defaultSchema))
 			}
 			if stmt.Policy.WithCheck != nil {
-				withCheck = parser.String(stmt.Policy.WithCheck.Expr)
+				withCheck = parser.String(normalizePolicyExpr(stmt.Policy.WithCheck.Expr, defaultSchema))
 			}
 			return &AddPolicy{
 				statement: ddl,
@@ -138,38 +240,172 @@ func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSche
 					columns = append(columns, parser.String(s))
 				}
 			}
+			tables, references := viewReferences(mode, stmt.View.Definition, defaultSchema)
 			return &View{
 				statement:    ddl,
 				viewType:     strings.ToUpper(stmt.View.Type),
 				securityType: strings.ToUpper(stmt.View.SecurityType),
+				definer:      stmt.View.Definer,
 				name:         normalizedTableName(mode, stmt.View.Name, defaultSchema),
 				definition:   parser.String(stmt.View.Definition),
 				columns:      columns,
+				tables:       tables,
+				references:   references,
 			}, nil
 		} else if stmt.Action == parser.CreateTrigger {
 			body := []string{}
 			for _, triggerStatement := range stmt.Trigger.Body {
 				body = append(body, parser.String(triggerStatement))
 			}
+			if function, ok := stmt.Trigger.Function.(*parser.FuncExpr); ok {
+				if mode == GeneratorModePostgres && function.Qualifier.String() == "" {
+					qualified := *function
+					qualified.Qualifier = parser.NewTableIdent(defaultSchema)
+					function = &qualified
+				}
+				body = append(body, "EXECUTE FUNCTION "+parser.String(function))
+			}
+
+			tableName := stmt.Trigger.TableName.Name.String()
+			level := stmt.Trigger.Level
+			if mode == GeneratorModePostgres {
+				tableName = normalizedTableName(mode, stmt.Trigger.TableName, defaultSchema)
+				if level == "" {
+					level = "STATEMENT" // the default of PostgreSQL
+				}
+			}
+
+			var condition string
+			if stmt.Trigger.When != nil {
+				when := stmt.Trigger.When
+				for {
+					parenExpr, ok := when.(*parser.ParenExpr)
+					if !ok {
+						break
+					}
+					when = parenExpr.Expr
+				}
+				condition = parser.String(when)
+			}
 
 			return &Trigger{
 				statement: ddl,
 				name:      stmt.Trigger.Name.String(),
-				tableName: stmt.Trigger.TableName.Name.String(),
+				tableName: tableName,
 				time:      stmt.Trigger.Time,
+				definer:   stmt.Trigger.Definer,
 				event:     stmt.Trigger.Event,
+				level:     level,
+				condition: condition,
 				body:      body,
 			}, nil
+		} else if stmt.Action == parser.CreateEvent {
+			return parseEvent(ddl, stmt.Event)
 		} else if stmt.Action == parser.CreateType {
+			var attributes []Column
+			for _, attribute := range stmt.Type.Attributes {
+				attributes = append(attributes, Column{
+					name:     attribute.Name.String(),
+					typeName: attribute.Type.Type,
+					array:    castBool(attribute.Type.Array),
+					length:   parseValue(attribute.Type.Length),
+					scale:    parseValue(attribute.Type.Scale),
+					timezone: castBool(attribute.Type.Timezone),
+					collate:  attribute.Type.Collate,
+				})
+			}
+			var table *Table
+			if stmt.Type.TableSpec != nil {
+				parsed, err := parseTable(mode, &parser.DDL{Action: parser.CreateTable, NewName: stmt.Type.Name, TableSpec: stmt.Type.TableSpec}, defaultSchema)
+				if err != nil {
+					return nil, err
+				}
+				table = &parsed
+			}
+			var domain *Domain
+			if stmt.Type.Domain {
+				domain = &Domain{
+					baseType: Column{
+						typeName:   stmt.Type.Type.Type,
+						array:      castBool(stmt.Type.Type.Array),
+						length:     parseValue(stmt.Type.Type.Length),
+						scale:      parseValue(stmt.Type.Type.Scale),
+						timezone:   castBool(stmt.Type.Type.Timezone),
+						collate:    stmt.Type.Type.Collate,
+						notNull:    castBoolPtr(stmt.Type.Type.NotNull),
+						defaultDef: parseDefaultDefinition(stmt.Type.Type.Default),
+					},
+				}
+				for _, check := range stmt.Type.Checks {
+					domain.checks = append(domain.checks, parseDomainCheck(stmt.Type.Name, check, defaultSchema))
+				}
+			}
 			return &Type{
 				name:       normalizedTableName(mode, stmt.Type.Name, defaultSchema),
 				statement:  ddl,
 				enumValues: stmt.Type.Type.EnumValues,
+				attributes: attributes,
+				table:      table,
+				domain:     domain,
+			}, nil
+		} else if stmt.Action == parser.AddDomainConstraint {
+			return &AddDomainConstraint{
+				statement: ddl,
+				typeName:  normalizedTableName(mode, stmt.Type.Name, defaultSchema),
+				check:     parseDomainCheck(stmt.Type.Name, stmt.Type.Checks[0], defaultSchema),
 			}, nil
 		} else if stmt.Action == parser.CommentOn {
+			comment := *stmt.Comment
+			switch mode {
+			case GeneratorModeMssql, GeneratorModePostgres:
+				comment.Object = normalizedCommentObject(comment, defaultSchema)
+			case GeneratorModeMysql, GeneratorModeSQLite3:
+				return nil, fmt.Errorf("COMMENT ON is not supported by this database: '%s'", ddl)
+			}
 			return &Comment{
 				statement: ddl,
-				comment:   *stmt.Comment,
+				comment:   comment,
+			}, nil
+		} else if stmt.Action == parser.ClusterOn {
+			return &ClusterOn{
+				statement: ddl,
+				tableName: normalizedTableName(mode, stmt.Table, defaultSchema),
+				indexName: stmt.IndexSpec.Name.String(),
+			}, nil
+		} else if stmt.Action == parser.AlterOwner {
+			return &Owner{
+				statement:  ddl,
+				objectType: stmt.Owner.ObjectType,
+				tableName:  normalizedTableName(mode, stmt.Table, defaultSchema),
+				owner:      stmt.Owner.Role,
+			}, nil
+		} else if stmt.Action == parser.CreatePublication {
+			tables := []string{}
+			for _, table := range stmt.Publication.Tables {
+				tables = append(tables, normalizedTableName(mode, table, defaultSchema))
+			}
+			return &Publication{
+				statement: ddl,
+				name:      stmt.Publication.Name.String(),
+				tables:    tables,
+				allTables: stmt.Publication.AllTables,
+			}, nil
+		} else if stmt.Action == parser.CreateRole {
+			return &Role{
+				statement: ddl,
+				role:      *stmt.Role,
+			}, nil
+		} else if stmt.Action == parser.GrantOn {
+			tables := []string{}
+			for _, table := range stmt.Grant.Tables {
+				tables = append(tables, normalizedTableName(mode, table, defaultSchema))
+			}
+			return &Grant{
+				statement:   ddl,
+				privileges:  stmt.Grant.Privileges,
+				tables:      tables,
+				grantees:    stmt.Grant.Grantees,
+				grantOption: stmt.Grant.GrantOption,
 			}, nil
 		} else if stmt.Action == parser.CreateExtension {
 			return &Extension{
@@ -181,6 +417,24 @@ func parseDDL(mode GeneratorMode, ddl string, stmt parser.Statement, defaultSche
 				statement: ddl,
 				schema:    *stmt.Schema,
 			}, nil
+		} else if stmt.Action == parser.DropTable || stmt.Action == parser.DropView || stmt.Action == parser.DropIndex {
+			drop := &Drop{statement: ddl}
+			for _, name := range stmt.Drop.Names {
+				if stmt.Action == parser.DropIndex {
+					drop.objectType = "INDEX"
+					drop.names = append(drop.names, name.Name.String())
+				} else {
+					drop.objectType = "TABLE"
+					if stmt.Action == parser.DropView {
+						drop.objectType = "VIEW"
+					}
+					drop.names = append(drop.names, normalizedTableName(mode, name, defaultSchema))
+				}
+			}
+			if stmt.Table.Name.String() != "" {
+				drop.tableName = normalizedTableName(mode, stmt.Table, defaultSchema)
+			}
+			return drop, nil
 		} else {
 			return nil, fmt.Errorf(
 				"unsupported type of DDL action '%d': %s",
@@ -223,6 +477,8 @@ func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Tab
 			identity:      parseIdentity(parsedCol.Type.Identity),
 			sequence:      parseIdentitySequence(parsedCol.Type.Identity),
 			generated:     parseGenerated(parsedCol.Type.Generated),
+			invisible:     parsedCol.Type.Invisible,
+			nonClustered:  castBool(parsedCol.Type.NonClustered),
 		}
 		if parsedCol.Type.Check != nil {
 			column.check = &CheckDefinition{
@@ -230,8 +486,24 @@ func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Tab
 				constraintName:    parser.String(parsedCol.Type.Check.ConstraintName),
 				notForReplication: parsedCol.Type.Check.NotForReplication,
 				noInherit:         castBool(parsedCol.Type.Check.NoInherit),
+				notEnforced:       parsedCol.Type.Check.NotEnforced,
 			}
 		}
+		// PostgreSQL turns an inline `REFERENCES other(id)` into a table-level foreign key, so manage it in the same way.
+		if mode == GeneratorModePostgres && parsedCol.Type.References != "" && len(parsedCol.Type.ReferenceNames) > 0 {
+			referenceColumns := []string{}
+			for _, referenceName := range parsedCol.Type.ReferenceNames {
+				referenceColumns = append(referenceColumns, referenceName.String())
+			}
+			foreignKeys = append(foreignKeys, ForeignKey{
+				indexColumns:     []string{column.name},
+				referenceName:    column.references,
+				referenceColumns: referenceColumns,
+				onDelete:         parsedCol.Type.ReferenceOnDelete.String(),
+				onUpdate:         parsedCol.Type.ReferenceOnUpdate.String(),
+			})
+			column.references = ""
+		}
 		columns = append(columns, column)
 	}
 
@@ -245,9 +517,10 @@ func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Tab
 			indexColumns = append(
 				indexColumns,
 				IndexColumn{
-					column:    column.Column.String(),
-					length:    length,
-					direction: column.Direction,
+					column:        indexColumnName(column),
+					length:        length,
+					direction:     column.Direction,
+					operatorClass: column.OperatorClass,
 				},
 			)
 		}
@@ -282,15 +555,23 @@ func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Tab
 			}
 		}
 
+		var includedColumns []string
+		for _, includedColumn := range indexDef.Included {
+			includedColumns = append(includedColumns, includedColumn.String())
+		}
+
 		index := Index{
-			name:      name,
-			indexType: indexDef.Info.Type,
-			columns:   indexColumns,
-			primary:   indexDef.Info.Primary,
-			unique:    indexDef.Info.Unique,
-			clustered: bool(indexDef.Info.Clustered),
-			options:   indexOptions,
-			partition: indexPartition,
+			name:             name,
+			indexType:        indexDef.Info.Type,
+			columns:          indexColumns,
+			primary:          indexDef.Info.Primary,
+			unique:           indexDef.Info.Unique,
+			included:         includedColumns,
+			nullsNotDistinct: indexDef.NullsNotDistinct,
+			clustered:        bool(indexDef.Info.Clustered),
+			columnStore:      indexDef.Info.ColumnStore,
+			options:          indexOptions,
+			partition:        indexPartition,
 
 			// FIXME: existence of constraintOptions doesn't mean it's a
 			// constraint but other parts of the code doesn't mark it as a
@@ -307,6 +588,8 @@ func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Tab
 			constraintName:    parser.String(checkDef.ConstraintName),
 			notForReplication: checkDef.NotForReplication,
 			noInherit:         castBool(checkDef.NoInherit),
+			notValid:          checkDef.NotValid,
+			notEnforced:       checkDef.NotEnforced,
 		}
 		checks = append(checks, check)
 	}
@@ -344,6 +627,20 @@ func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Tab
 		foreignKeys = append(foreignKeys, foreignKey)
 	}
 
+	// Name unnamed foreign keys as the server does, so that they can be compared with the dumped ones by name.
+	if mode == GeneratorModePostgres {
+		for i, foreignKey := range foreignKeys {
+			if foreignKey.constraintName == "" {
+				foreignKeys[i].constraintName = PostgresConstraintName(stmt.NewName.Name.String(), foreignKey.indexColumns, "fkey")
+			}
+		}
+	}
+
+	var inherits []string
+	for _, parent := range stmt.TableSpec.Inherits {
+		inherits = append(inherits, normalizedTableName(mode, parent, defaultSchema))
+	}
+
 	return Table{
 		name:        normalizedTableName(mode, stmt.NewName, defaultSchema),
 		columns:     columns,
@@ -351,9 +648,126 @@ func parseTable(mode GeneratorMode, stmt *parser.DDL, defaultSchema string) (Tab
 		checks:      checks,
 		foreignKeys: foreignKeys,
 		options:     stmt.TableSpec.Options,
+		unlogged:    stmt.TableSpec.Unlogged,
+		inherits:    inherits,
 	}, nil
 }
 
+var virtualTableModulePattern = regexp.MustCompile("(?is)^\\s*CREATE\\s+VIRTUAL\\s+TABLE\\s+(?:IF\\s+NOT\\s+EXISTS\\s+)?(?:\"[^\"]*\"|`[^`]*`|\\[[^\\]]*\\]|'[^']*'|[^\\s(]+)\\s+USING\\s+(\\w+)(.*?)\\s*;?\\s*$")
+var virtualTableArgumentSpaces = regexp.MustCompile(`\s*([(),=])\s*|\s+`)
+
+// Return the module and its arguments of CREATE VIRTUAL TABLE, which are compared as written except for the case of
+// the module name and spaces, since each module parses its arguments in its own way.
+func parseVirtualTableModule(ddl string) string {
+	match := virtualTableModulePattern.FindStringSubmatch(ddl)
+	if match == nil {
+		return ddl
+	}
+	arguments := virtualTableArgumentSpaces.ReplaceAllStringFunc(strings.TrimSpace(match[2]), func(spaces string) string {
+		if trimmed := strings.TrimSpace(spaces); trimmed != "" {
+			return trimmed
+		}
+		return " "
+	})
+	return strings.ToLower(match[1]) + arguments
+}
+
+func parseExclusion(exclusion *parser.ExclusionDefinition) Exclusion {
+	pairs := []ExclusionPair{}
+	for _, pair := range exclusion.Exclusions {
+		pairs = append(pairs, ExclusionPair{
+			column:   pair.Column.String(),
+			opClass:  pair.OpClass,
+			operator: pair.Operator,
+		})
+	}
+
+	where := ""
+	if exclusion.Where != nil && exclusion.Where.Type == parser.WhereStr {
+		expr := exclusion.Where.Expr
+		// remove root paren expression
+		if parenExpr, ok := expr.(*parser.ParenExpr); ok {
+			expr = parenExpr.Expr
+		}
+		where = parser.String(expr)
+	}
+
+	var constraintOptions *ConstraintOptions
+	if exclusion.ConstraintOptions != nil {
+		constraintOptions = &ConstraintOptions{
+			deferrable:        exclusion.ConstraintOptions.Deferrable,
+			initiallyDeferred: exclusion.ConstraintOptions.InitiallyDeferred,
+		}
+	}
+
+	return Exclusion{
+		constraintName:    exclusion.ConstraintName.String(),
+		indexType:         exclusion.IndexType,
+		exclusions:        pairs,
+		where:             where,
+		constraintOptions: constraintOptions,
+	}
+}
+
+// Return the tables and the column names referenced by a view definition, which are used to find the views
+// depending on a column. It doesn't resolve which table each column belongs to, so the result is conservative.
+func viewReferences(mode GeneratorMode, definition parser.SelectStatement, defaultSchema string) ([]string, []string) {
+	tables, references := []string{}, []string{}
+	var walk func(value reflect.Value)
+	walk = func(value reflect.Value) {
+		switch value.Kind() {
+		case reflect.Pointer, reflect.Interface:
+			if !value.IsNil() {
+				walk(value.Elem())
+			}
+			return
+		case reflect.Slice, reflect.Array:
+			for i := 0; i < value.Len(); i++ {
+				walk(value.Index(i))
+			}
+			return
+		case reflect.Struct:
+		default:
+			return
+		}
+
+		if value.CanInterface() {
+			switch node := value.Interface().(type) {
+			case parser.AliasedTableExpr:
+				if tableName, ok := node.Expr.(parser.TableName); ok {
+					tables = append(tables, normalizedTableName(mode, tableName, defaultSchema))
+				}
+			case parser.ColName:
+				references = append(references, node.Name.String())
+			case parser.StarExpr:
+				references = append(references, "*")
+			}
+		}
+		for i := 0; i < value.NumField(); i++ {
+			walk(value.Field(i))
+		}
+	}
+	walk(reflect.ValueOf(definition))
+	return tables, references
+}
+
+// Return the column name of an index column, or the parenthesized expression of a MySQL functional key part.
+// Redundant parentheses around the expression are removed since SHOW CREATE TABLE adds them.
+func indexColumnName(column parser.IndexColumn) string {
+	if column.Expression == nil {
+		return column.Column.String()
+	}
+	expr := column.Expression
+	for {
+		parenExpr, ok := expr.(*parser.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = parenExpr.Expr
+	}
+	return "(" + parser.String(expr) + ")"
+}
+
 func parseIndex(stmt *parser.DDL) (Index, error) {
 	if stmt.IndexSpec == nil {
 		return Index{}, fmt.Errorf("stmt.IndexSpec was null on parseIndex: %#v", stmt)
@@ -368,9 +782,10 @@ func parseIndex(stmt *parser.DDL) (Index, error) {
 		indexColumns = append(
 			indexColumns,
 			IndexColumn{
-				column:    column.Column.String(),
-				length:    length,
-				direction: column.Direction,
+				column:        indexColumnName(column),
+				length:        length,
+				direction:     column.Direction,
+				operatorClass: column.OperatorClass,
 			},
 		)
 	}
@@ -416,12 +831,14 @@ func parseIndex(stmt *parser.DDL) (Index, error) {
 	}
 
 	name := stmt.IndexSpec.Name.String()
+	nameGenerated := false
 	if name == "" {
 		name = stmt.Table.Name.String()
 		for _, indexColumn := range indexColumns {
 			name += fmt.Sprintf("_%s", indexColumn.column)
 		}
 		name += "_idx"
+		nameGenerated = true
 	}
 	return Index{
 		name:              name,
@@ -432,10 +849,13 @@ func parseIndex(stmt *parser.DDL) (Index, error) {
 		constraint:        stmt.IndexSpec.Constraint,
 		constraintOptions: constraintOptions,
 		clustered:         stmt.IndexSpec.Clustered,
+		columnStore:       stmt.IndexSpec.ColumnStore,
 		where:             where,
 		included:          includedColumns,
+		nullsNotDistinct:  stmt.IndexSpec.NullsNotDistinct,
 		options:           indexOptions,
 		partition:         indexParition,
+		nameGenerated:     nameGenerated,
 	}, nil
 }
 
@@ -592,9 +1012,183 @@ func parseGenerated(genc *parser.GeneratedColumn) *Generated {
 		typ = GeneratedTypeStored
 	}
 	return &Generated{
-		expr:          parser.String(genc.Expr),
-		generatedType: typ,
+		expr:           parser.String(genc.Expr),
+		normalizedExpr: normalizeGeneratedExpr(unparenthesize(genc.Expr)),
+		generatedType:  typ,
+	}
+}
+
+// Rewrite a generation expression into a canonical form, so that the expression in the desired SQL compares equal to the
+// one MySQL or MariaDB dumps, e.g. `a->>'$.b'` and `json_unquote(json_extract(`a`,'$.b'))`, or `a + b * 2` and
+// `(a + (b * 2))`. Parentheses which don't change the evaluation order are removed, `->` and `->>` are expanded into
+// the functions, and function and column names are lowercased.
+func normalizeGeneratedExpr(expr parser.Expr) parser.Expr {
+	switch expr := expr.(type) {
+	case *parser.ParenExpr:
+		inner := normalizeGeneratedExpr(expr.Expr)
+		switch inner.(type) {
+		case *parser.ColName, *parser.SQLVal, *parser.NullVal, *parser.BoolVal, *parser.FuncExpr, *parser.CastExpr, *parser.ConvertExpr, *parser.ParenExpr:
+			return inner
+		}
+		return &parser.ParenExpr{Expr: inner}
+	case *parser.BinaryExpr:
+		switch expr.Operator {
+		case parser.JSONExtractOp:
+			return normalizeGeneratedExpr(jsonFuncExpr("json_extract", expr.Left, expr.Right))
+		case parser.JSONUnquoteExtractOp:
+			return normalizeGeneratedExpr(jsonFuncExpr("json_unquote", jsonFuncExpr("json_extract", expr.Left, expr.Right)))
+		}
+		operator := strings.ToLower(expr.Operator)
+		left := normalizeGeneratedExpr(expr.Left)
+		if inner, ok := unparenthesize(left).(*parser.BinaryExpr); ok && binaryOperatorPrecedence[inner.Operator] >= binaryOperatorPrecedence[operator] {
+			left = inner // left-associative
+		}
+		right := normalizeGeneratedExpr(expr.Right)
+		if inner, ok := unparenthesize(right).(*parser.BinaryExpr); ok && binaryOperatorPrecedence[inner.Operator] > binaryOperatorPrecedence[operator] {
+			right = inner
+		}
+		return &parser.BinaryExpr{Operator: operator, Left: left, Right: right}
+	case *parser.UnaryExpr:
+		return &parser.UnaryExpr{Operator: expr.Operator, Expr: normalizeGeneratedExpr(expr.Expr)}
+	case *parser.FuncExpr:
+		normalized := *expr
+		normalized.Name = parser.NewColIdent(strings.ToLower(expr.Name.String()))
+		normalized.Exprs = make(parser.SelectExprs, len(expr.Exprs))
+		for i, arg := range expr.Exprs {
+			if aliased, ok := arg.(*parser.AliasedExpr); ok {
+				arg = &parser.AliasedExpr{Expr: unparenthesize(normalizeGeneratedExpr(aliased.Expr)), As: aliased.As}
+			}
+			normalized.Exprs[i] = arg
+		}
+		return &normalized
+	case *parser.ColName:
+		normalized := *expr
+		normalized.Name = parser.NewColIdent(strings.ToLower(expr.Name.String()))
+		return &normalized
+	case *parser.CastExpr:
+		return &parser.CastExpr{Expr: normalizeGeneratedExpr(expr.Expr), Type: expr.Type}
+	case *parser.ConvertExpr:
+		return &parser.ConvertExpr{Expr: normalizeGeneratedExpr(expr.Expr), Type: expr.Type}
+	default:
+		return expr
+	}
+}
+
+// Operators binding tighter have larger numbers, following https://dev.mysql.com/doc/refman/8.0/en/operator-precedence.html
+var binaryOperatorPrecedence = map[string]int{
+	parser.BitOrStr:      1,
+	parser.BitAndStr:     2,
+	parser.ShiftLeftStr:  3,
+	parser.ShiftRightStr: 3,
+	parser.PlusStr:       4,
+	parser.MinusStr:      4,
+	parser.MultStr:       5,
+	parser.DivStr:        5,
+	parser.IntDivStr:     5,
+	parser.ModStr:        5,
+	parser.BitXorStr:     6,
+}
+
+func jsonFuncExpr(name string, args ...parser.Expr) *parser.FuncExpr {
+	exprs := make(parser.SelectExprs, len(args))
+	for i, arg := range args {
+		exprs[i] = &parser.AliasedExpr{Expr: arg}
 	}
+	return &parser.FuncExpr{Name: parser.NewColIdent(name), Exprs: exprs}
+}
+
+func unparenthesize(expr parser.Expr) parser.Expr {
+	for {
+		paren, ok := expr.(*parser.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = paren.Expr
+	}
+}
+
+// Interpret the clauses between ON SCHEDULE and DO of CREATE EVENT.
+func parseEvent(ddl string, event *parser.Event) (*Event, error) {
+	result := &Event{
+		statement: ddl,
+		name:      event.Name.String(),
+		definer:   event.Definer,
+		status:    "ENABLE",
+		body:      parser.String(event.Body),
+	}
+
+	clauses := event.Clauses
+	for len(clauses) > 0 {
+		keyword := strings.ToLower(clauses[0])
+		// An expression lasts until the next clause keyword
+		end := 1
+		for end < len(clauses) && !isEventClauseKeyword(clauses[end]) {
+			end++
+		}
+		args := clauses[1:end]
+		clauses = clauses[end:]
+
+		switch keyword {
+		case "at":
+			result.at = joinEventTokens(args)
+		case "every":
+			result.every = joinEventTokens(args)
+		case "starts":
+			result.starts = joinEventTokens(args)
+		case "ends":
+			result.ends = joinEventTokens(args)
+		case "enable":
+			result.status = "ENABLE"
+		case "disable":
+			result.status = "DISABLE"
+			// DISABLE ON SLAVE
+			if len(clauses) >= 2 && strings.ToLower(clauses[0]) == "on" && strings.ToLower(clauses[1]) == "slave" {
+				result.status = "DISABLE ON SLAVE"
+				clauses = clauses[2:]
+			}
+		case "comment":
+			if len(args) != 1 {
+				return nil, fmt.Errorf("unexpected COMMENT of event '%s': %s", result.name, ddl)
+			}
+			result.comment = args[0]
+		case "on":
+			// ON COMPLETION [NOT] PRESERVE
+			option := strings.ToLower(strings.Join(args, " "))
+			if option == "completion preserve" {
+				result.preserve = true
+			} else if option != "completion not preserve" {
+				return nil, fmt.Errorf("unexpected ON %s of event '%s': %s", strings.Join(args, " "), result.name, ddl)
+			}
+		default:
+			return nil, fmt.Errorf("unexpected '%s' in the schedule of event '%s': %s", keyword, result.name, ddl)
+		}
+	}
+
+	if (result.at == "") == (result.every == "") {
+		return nil, fmt.Errorf("either AT or EVERY must be specified for event '%s': %s", result.name, ddl)
+	}
+	return result, nil
+}
+
+func isEventClauseKeyword(token string) bool {
+	switch strings.ToLower(token) {
+	case "at", "every", "starts", "ends", "enable", "disable", "comment", "on":
+		return true
+	default:
+		return false
+	}
+}
+
+// Join tokens of an event schedule back into an expression, e.g. CURRENT_TIMESTAMP + INTERVAL 1 DAY.
+func joinEventTokens(tokens []string) string {
+	var expr strings.Builder
+	for i, token := range tokens {
+		if i > 0 && token != ")" && token != "(" && tokens[i-1] != "(" {
+			expr.WriteString(" ")
+		}
+		expr.WriteString(token)
+	}
+	return expr.String()
 }
 
 // Qualify Postgres/Mssql schema
@@ -610,6 +1204,82 @@ func normalizedTableName(mode GeneratorMode, tableName parser.TableName, default
 	return table
 }
 
+// Qualify the table of COMMENT ON of Mssql with the default schema, e.g. "users.id" to "dbo.users.id".
+// Qualify the object of COMMENT ON with the default schema, e.g. "public.users.id" for COLUMN users.id.
+// The object of FUNCTION has its argument types, e.g. "public.f(int4, text)", whose name is qualified.
+func normalizedCommentObject(comment parser.Comment, defaultSchema string) string {
+	name, args := comment.Object, ""
+	if i := strings.Index(name, "("); i >= 0 {
+		name, args = name[:i], name[i:]
+	}
+
+	// The number of the parts of the name without its schema, e.g. 2 for "table.column"
+	var unqualifiedParts int
+	switch strings.TrimPrefix(comment.ObjectType, "OBJECT_") {
+	case "TABLE", "INDEX", "TYPE", "FUNCTION":
+		unqualifiedParts = 1
+	case "COLUMN", "TABCONSTRAINT":
+		unqualifiedParts = 2
+	}
+	if len(strings.Split(name, ".")) == unqualifiedParts {
+		return defaultSchema + "." + name + args
+	}
+	return comment.Object
+}
+
+// A CHECK of a domain, whose expression is normalized as Postgres dumps it. A constraint without a name is named
+// "<domain>_check" by Postgres.
+func parseDomainCheck(domainName parser.TableName, check *parser.CheckDefinition, defaultSchema string) CheckDefinition {
+	name := check.ConstraintName.String()
+	if name == "" {
+		name = domainName.Name.String() + "_check"
+	}
+	return CheckDefinition{
+		definition:     parser.String(normalizePolicyExpr(check.Where.Expr, defaultSchema)),
+		constraintName: name,
+		notValid:       check.NotValid,
+	}
+}
+
+// PostgresConstraintName is a port of ChooseConstraintName and makeObjectName of PostgreSQL: "<table>_<columns>_<label>",
+// where the longer of the table name and the column names is truncated first so that the name fits in NAMEDATALEN - 1
+// (63) bytes. Like pg_mbcliplen, a multibyte character is never split by the truncation.
+func PostgresConstraintName(tableName string, columnNames []string, label string) string {
+	const maxNameLength = 63
+	columns := ""
+	for _, columnName := range columnNames {
+		if columns != "" {
+			columns += "_"
+		}
+		columns += columnName
+		if len(columns) >= maxNameLength {
+			break
+		}
+	}
+
+	available := maxNameLength - len(label) - 2
+	tableLength, columnsLength := len(tableName), len(columns)
+	for tableLength+columnsLength > available {
+		if tableLength > columnsLength {
+			tableLength--
+		} else {
+			columnsLength--
+		}
+	}
+	return fmt.Sprintf("%s_%s_%s", clipString(tableName, tableLength), clipString(columns, columnsLength), label)
+}
+
+// Return the longest prefix of the string within the bytes, which doesn't end in the middle of a character.
+func clipString(str string, length int) string {
+	if length >= len(str) {
+		return str
+	}
+	for length > 0 && !utf8.RuneStart(str[length]) {
+		length--
+	}
+	return str[:length]
+}
+
 func normalizedTable(mode GeneratorMode, tableName string, defaultSchema string) string {
 	switch mode {
 	case GeneratorModePostgres, GeneratorModeMssql:
@@ -620,6 +1290,63 @@ func normalizedTable(mode GeneratorMode, tableName string, defaultSchema string)
 	}
 }
 
+// Rewrite a policy expression into the form Postgres dumps it, so that `public.f(x)::int` and `(f(x))::integer` compare equal.
+// Redundant parentheses are removed, functions in the default schema or pg_catalog lose their qualifier,
+// string literals lose their text casts, and cast types are resolved from their aliases.
+func normalizePolicyExpr(expr parser.Expr, defaultSchema string) parser.Expr {
+	switch expr := expr.(type) {
+	case *parser.ParenExpr:
+		return normalizePolicyExpr(expr.Expr, defaultSchema)
+	case *parser.AndExpr:
+		return &parser.AndExpr{Left: normalizePolicyExpr(expr.Left, defaultSchema), Right: normalizePolicyExpr(expr.Right, defaultSchema)}
+	case *parser.OrExpr:
+		return &parser.OrExpr{Left: normalizePolicyExpr(expr.Left, defaultSchema), Right: normalizePolicyExpr(expr.Right, defaultSchema)}
+	case *parser.NotExpr:
+		return &parser.NotExpr{Expr: normalizePolicyExpr(expr.Expr, defaultSchema)}
+	case *parser.IsExpr:
+		return &parser.IsExpr{Operator: expr.Operator, Expr: normalizePolicyExpr(expr.Expr, defaultSchema)}
+	case *parser.UnaryExpr:
+		return &parser.UnaryExpr{Operator: expr.Operator, Expr: normalizePolicyExpr(expr.Expr, defaultSchema)}
+	case *parser.BinaryExpr:
+		return &parser.BinaryExpr{Operator: expr.Operator, Left: normalizePolicyExpr(expr.Left, defaultSchema), Right: normalizePolicyExpr(expr.Right, defaultSchema)}
+	case *parser.ComparisonExpr:
+		normalized := *expr
+		normalized.Left = normalizePolicyExpr(expr.Left, defaultSchema)
+		normalized.Right = normalizePolicyExpr(expr.Right, defaultSchema)
+		return &normalized
+	case *parser.FuncExpr:
+		normalized := *expr
+		if qualifier := expr.Qualifier.String(); qualifier == defaultSchema || qualifier == "pg_catalog" {
+			normalized.Qualifier = parser.NewTableIdent("")
+		}
+		normalized.Name = parser.NewColIdent(strings.ToLower(expr.Name.String()))
+		normalized.Exprs = make(parser.SelectExprs, len(expr.Exprs))
+		for i, arg := range expr.Exprs {
+			if aliased, ok := arg.(*parser.AliasedExpr); ok {
+				arg = &parser.AliasedExpr{Expr: normalizePolicyExpr(aliased.Expr, defaultSchema), As: aliased.As}
+			}
+			normalized.Exprs[i] = arg
+		}
+		return &normalized
+	case *parser.CollateExpr:
+		return &parser.CollateExpr{Expr: normalizePolicyExpr(expr.Expr, defaultSchema), Charset: expr.Charset}
+	case *parser.CastExpr:
+		typeName := strings.ToLower(expr.Type.Type)
+		if alias, ok := dataTypeAliases[typeName]; ok {
+			typeName = alias
+		}
+		inner := normalizePolicyExpr(expr.Expr, defaultSchema)
+		if val, ok := inner.(*parser.SQLVal); ok && val.Type == parser.StrVal && (typeName == "text" || typeName == "character varying") {
+			return inner
+		}
+		convertType := *expr.Type
+		convertType.Type = typeName
+		return &parser.CastExpr{Expr: inner, Type: &convertType}
+	default:
+		return expr
+	}
+}
+
 // Replace pseudo collation "binary" with "{charset}_bin"
 func normalizeCollate(collate string, table parser.TableSpec) string {
 	if collate == "binary" {
@@ -0,0 +1,205 @@
+package schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GeneratePrivilegeReport renders the table privileges of each role in the current and desired schemas as a matrix for
+// security reviews. psqldef never applies GRANT or REVOKE, so the drift column only shows the privileges that the
+// desired GRANTs add (+) to or remove (-) from the current ones. A privilege granted WITH GRANT OPTION is suffixed by *
+// as psql does, and +SELECT* or -SELECT* of a privilege in both schemas means the grant option is added or removed.
+// The GRANTs and REVOKEs to resolve the drift follow the matrix for the reviewers to run. Only the tables and views of
+// the desired schema are reported, and only the roles of managedRoles when it's given.
+func GeneratePrivilegeReport(desiredDDLs []DDL, currentDDLs []DDL, managedRoles []string) string {
+	managedTables := map[string]bool{}
+	for _, ddl := range desiredDDLs {
+		switch stmt := ddl.(type) {
+		case *CreateTable:
+			managedTables[stmt.table.name] = true
+		case *View:
+			managedTables[stmt.name] = true
+		case *Grant:
+			for _, table := range stmt.tables {
+				managedTables[table] = true
+			}
+		}
+	}
+
+	desired := collectPrivileges(desiredDDLs)
+	current := collectPrivileges(currentDDLs)
+
+	type roleTable struct{ role, table string }
+	keys := map[roleTable]bool{}
+	for _, privileges := range []map[string]map[string]map[string]bool{desired, current} {
+		for role, tables := range privileges {
+			if len(managedRoles) > 0 && role != "PUBLIC" && !containsString(managedRoles, role) {
+				continue
+			}
+			for table := range tables {
+				if managedTables[table] {
+					keys[roleTable{role, table}] = true
+				}
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return "-- No privilege is granted on the desired tables --\n"
+	}
+
+	var sorted []roleTable
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].role != sorted[j].role {
+			return sorted[i].role < sorted[j].role
+		}
+		return sorted[i].table < sorted[j].table
+	})
+
+	g := &Generator{mode: GeneratorModePostgres}
+	rows := [][]string{{"ROLE", "TABLE", "CURRENT", "DESIRED", "DRIFT"}}
+	var ddls []string
+	drifted := 0
+	for _, key := range sorted {
+		currentPrivileges := current[key.role][key.table]
+		desiredPrivileges := desired[key.role][key.table]
+		var drift []string
+		for _, privilege := range sortedPrivileges(desiredPrivileges) {
+			grantable, ok := currentPrivileges[privilege]
+			if !ok || (desiredPrivileges[privilege] && !grantable) {
+				drift = append(drift, "+"+formatPrivilege(privilege, desiredPrivileges[privilege]))
+			}
+		}
+		for _, privilege := range sortedPrivileges(currentPrivileges) {
+			grantable, ok := desiredPrivileges[privilege]
+			if !ok || (currentPrivileges[privilege] && !grantable) {
+				drift = append(drift, "-"+formatPrivilege(privilege, currentPrivileges[privilege]))
+			}
+		}
+		if len(drift) > 0 {
+			drifted++
+		}
+		ddls = append(ddls, g.generatePrivilegeDDLs(key.role, key.table, currentPrivileges, desiredPrivileges)...)
+		rows = append(rows, []string{key.role, key.table, formatPrivileges(currentPrivileges), formatPrivileges(desiredPrivileges), strings.Join(drift, " ")})
+	}
+
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], len(cell))
+		}
+	}
+	var report strings.Builder
+	for _, row := range rows {
+		var line strings.Builder
+		for i, cell := range row {
+			fmt.Fprintf(&line, "%-*s  ", widths[i], cell)
+		}
+		report.WriteString(strings.TrimRight(line.String(), " ") + "\n")
+	}
+	fmt.Fprintf(&report, "-- %d of %d role and table pairs have drifted privileges --\n", drifted, len(sorted))
+	if len(ddls) > 0 {
+		report.WriteString("-- GRANTs and REVOKEs to resolve the drift, which are not run --\n")
+		for _, ddl := range ddls {
+			report.WriteString(ddl + ";\n")
+		}
+	}
+	return report.String()
+}
+
+// Return the GRANTs and REVOKEs that change the current privileges of a role on a table to the desired ones. Only the
+// grant option is revoked by REVOKE GRANT OPTION FOR when the privilege itself is still desired.
+func (g *Generator) generatePrivilegeDDLs(role string, table string, currentPrivileges map[string]bool, desiredPrivileges map[string]bool) []string {
+	var grants, grantsWithOption, revokes, grantOptionRevokes []string
+	for _, privilege := range sortedPrivileges(desiredPrivileges) {
+		grantable, ok := currentPrivileges[privilege]
+		if desiredPrivileges[privilege] && !grantable {
+			grantsWithOption = append(grantsWithOption, privilege)
+		} else if !ok {
+			grants = append(grants, privilege)
+		}
+	}
+	for _, privilege := range sortedPrivileges(currentPrivileges) {
+		grantable, ok := desiredPrivileges[privilege]
+		if !ok {
+			revokes = append(revokes, privilege)
+		} else if currentPrivileges[privilege] && !grantable {
+			grantOptionRevokes = append(grantOptionRevokes, privilege)
+		}
+	}
+
+	grantee := role
+	if role != "PUBLIC" {
+		grantee = g.escapeSQLName(role)
+	}
+	on := "ON TABLE " + g.escapeTableName(table)
+	var ddls []string
+	if len(grants) > 0 {
+		ddls = append(ddls, fmt.Sprintf("GRANT %s %s TO %s", strings.Join(grants, ", "), on, grantee))
+	}
+	if len(grantsWithOption) > 0 {
+		ddls = append(ddls, fmt.Sprintf("GRANT %s %s TO %s WITH GRANT OPTION", strings.Join(grantsWithOption, ", "), on, grantee))
+	}
+	if len(revokes) > 0 {
+		ddls = append(ddls, fmt.Sprintf("REVOKE %s %s FROM %s", strings.Join(revokes, ", "), on, grantee))
+	}
+	if len(grantOptionRevokes) > 0 {
+		ddls = append(ddls, fmt.Sprintf("REVOKE GRANT OPTION FOR %s %s FROM %s", strings.Join(grantOptionRevokes, ", "), on, grantee))
+	}
+	return ddls
+}
+
+// Return role -> table -> privilege -> whether it's granted WITH GRANT OPTION of the GRANTs
+func collectPrivileges(ddls []DDL) map[string]map[string]map[string]bool {
+	privileges := map[string]map[string]map[string]bool{}
+	for _, ddl := range ddls {
+		grant, ok := ddl.(*Grant)
+		if !ok {
+			continue
+		}
+		for _, role := range grant.grantees {
+			if privileges[role] == nil {
+				privileges[role] = map[string]map[string]bool{}
+			}
+			for _, table := range grant.tables {
+				if privileges[role][table] == nil {
+					privileges[role][table] = map[string]bool{}
+				}
+				for _, privilege := range grant.privileges {
+					privileges[role][table][privilege] = privileges[role][table][privilege] || grant.grantOption
+				}
+			}
+		}
+	}
+	return privileges
+}
+
+func sortedPrivileges(privileges map[string]bool) []string {
+	var result []string
+	for privilege := range privileges {
+		result = append(result, privilege)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func formatPrivileges(privileges map[string]bool) string {
+	if len(privileges) == 0 {
+		return "-"
+	}
+	var result []string
+	for _, privilege := range sortedPrivileges(privileges) {
+		result = append(result, formatPrivilege(privilege, privileges[privilege]))
+	}
+	return strings.Join(result, ", ")
+}
+
+func formatPrivilege(privilege string, grantOption bool) string {
+	if grantOption {
+		return privilege + "*"
+	}
+	return privilege
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGeneratePrivilegeReport(t *testing.T) {
+	desired := []DDL{
+		&CreateTable{table: Table{name: "public.users"}},
+		&CreateTable{table: Table{name: "public.logs"}},
+		&Grant{privileges: []string{"SELECT", "INSERT"}, tables: []string{"public.users", "public.logs"}, grantees: []string{"app"}},
+		&Grant{privileges: []string{"SELECT"}, tables: []string{"public.users"}, grantees: []string{"readonly"}},
+	}
+	current := []DDL{
+		&Grant{privileges: []string{"SELECT", "INSERT"}, tables: []string{"public.users"}, grantees: []string{"app"}},
+		&Grant{privileges: []string{"INSERT"}, tables: []string{"public.logs"}, grantees: []string{"app"}},
+		&Grant{privileges: []string{"SELECT", "DELETE"}, tables: []string{"public.users"}, grantees: []string{"readonly"}},
+		&Grant{privileges: []string{"SELECT"}, tables: []string{"public.unmanaged"}, grantees: []string{"app"}},
+	}
+
+	assert.Equal(t, ""+
+		"ROLE      TABLE         CURRENT         DESIRED         DRIFT\n"+
+		"app       public.logs   INSERT          INSERT, SELECT  +SELECT\n"+
+		"app       public.users  INSERT, SELECT  INSERT, SELECT\n"+
+		"readonly  public.users  DELETE, SELECT  SELECT          -DELETE\n"+
+		"-- 2 of 3 role and table pairs have drifted privileges --\n"+
+		"-- GRANTs and REVOKEs to resolve the drift, which are not run --\n"+
+		"GRANT SELECT ON TABLE \"public\".\"logs\" TO \"app\";\n"+
+		"REVOKE DELETE ON TABLE \"public\".\"users\" FROM \"readonly\";\n",
+		GeneratePrivilegeReport(desired, current, nil))
+
+	assert.Equal(t, ""+
+		"ROLE      TABLE         CURRENT         DESIRED  DRIFT\n"+
+		"readonly  public.users  DELETE, SELECT  SELECT   -DELETE\n"+
+		"-- 1 of 1 role and table pairs have drifted privileges --\n"+
+		"-- GRANTs and REVOKEs to resolve the drift, which are not run --\n"+
+		"REVOKE DELETE ON TABLE \"public\".\"users\" FROM \"readonly\";\n",
+		GeneratePrivilegeReport(desired, current, []string{"readonly"}))
+
+	desired = []DDL{
+		&CreateTable{table: Table{name: "public.users"}},
+		&Grant{privileges: []string{"SELECT"}, tables: []string{"public.users"}, grantees: []string{"app"}, grantOption: true},
+		&Grant{privileges: []string{"INSERT"}, tables: []string{"public.users"}, grantees: []string{"app"}},
+		&Grant{privileges: []string{"SELECT", "UPDATE"}, tables: []string{"public.users"}, grantees: []string{"readonly"}},
+	}
+	current = []DDL{
+		&Grant{privileges: []string{"SELECT", "INSERT"}, tables: []string{"public.users"}, grantees: []string{"app"}},
+		&Grant{privileges: []string{"SELECT", "DELETE"}, tables: []string{"public.users"}, grantees: []string{"readonly"}, grantOption: true},
+	}
+	assert.Equal(t, ""+
+		"ROLE      TABLE         CURRENT           DESIRED          DRIFT\n"+
+		"app       public.users  INSERT, SELECT    INSERT, SELECT*  +SELECT*\n"+
+		"readonly  public.users  DELETE*, SELECT*  SELECT, UPDATE   +UPDATE -DELETE* -SELECT*\n"+
+		"-- 2 of 2 role and table pairs have drifted privileges --\n"+
+		"-- GRANTs and REVOKEs to resolve the drift, which are not run --\n"+
+		"GRANT SELECT ON TABLE \"public\".\"users\" TO \"app\" WITH GRANT OPTION;\n"+
+		"GRANT UPDATE ON TABLE \"public\".\"users\" TO \"readonly\";\n"+
+		"REVOKE DELETE ON TABLE \"public\".\"users\" FROM \"readonly\";\n"+
+		"REVOKE GRANT OPTION FOR SELECT ON TABLE \"public\".\"users\" FROM \"readonly\";\n",
+		GeneratePrivilegeReport(desired, current, nil))
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"github.com/sqldef/sqldef/database"
+)
+
+// Phases of GenerateIdempotentDDLsWithProgress, reported in this order.
+const (
+	ProgressParseDesired  = "parse_desired"
+	ProgressParseCurrent  = "parse_current"
+	ProgressAggregate     = "aggregate"
+	ProgressCompareTables = "compare_tables"
+	ProgressCleanUp       = "clean_up"
+)
+
+// Progress is reported at the beginning of each phase, and after each desired table is compared in
+// ProgressCompareTables, so that a front-end can show the progress of a diff of a huge schema.
+type Progress struct {
+	Phase string
+	Done  int // the number of the compared tables, only in ProgressCompareTables
+	Total int // the number of the desired tables, only in ProgressCompareTables
+}
+
+// Same as GenerateIdempotentDDLs, but call the progress function on the progress of the diff. The function is called
+// synchronously, so it should return quickly, e.g. by sending the progress to a buffered channel.
+func GenerateIdempotentDDLsWithProgress(mode GeneratorMode, sqlParser database.Parser, desiredSQL string, currentSQL string, config database.GeneratorConfig, defaultSchema string, progress func(Progress)) ([]string, error) {
+	reportProgress(progress, Progress{Phase: ProgressParseDesired})
+	desiredDDLs, err := ParseDDLs(mode, sqlParser, desiredSQL, defaultSchema)
+	if err != nil {
+		return nil, err
+	}
+	desiredDDLs = FilterTables(desiredDDLs, config)
+
+	reportProgress(progress, Progress{Phase: ProgressParseCurrent})
+	currentDDLs, err := ParseDDLs(mode, sqlParser, currentSQL, defaultSchema)
+	if err != nil {
+		return nil, err
+	}
+	currentDDLs = FilterTables(currentDDLs, config)
+
+	return generateIdempotentDDLsFromParsed(mode, desiredDDLs, currentDDLs, config, defaultSchema, progress)
+}
+
+func reportProgress(progress func(Progress), p Progress) {
+	if progress != nil {
+		progress(p)
+	}
+}
+
+func countTables(ddls []DDL) int {
+	count := 0
+	for _, ddl := range ddls {
+		if _, ok := ddl.(*CreateTable); ok {
+			count++
+		}
+	}
+	return count
+}
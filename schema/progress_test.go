@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"testing"
+
+	"github.com/sqldef/sqldef/database"
+	"github.com/sqldef/sqldef/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateIdempotentDDLsWithProgress(t *testing.T) {
+	desiredSQL := "CREATE TABLE users (id bigint);\nCREATE INDEX index_users_id ON users (id);\nCREATE TABLE posts (id bigint);\n"
+	currentSQL := "CREATE TABLE users (id bigint);\n"
+
+	var progresses []Progress
+	ddls, err := GenerateIdempotentDDLsWithProgress(GeneratorModeSQLite3, database.NewParser(parser.ParserModeSQLite3), desiredSQL, currentSQL, database.GeneratorConfig{}, "", func(progress Progress) {
+		progresses = append(progresses, progress)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CREATE TABLE posts (id bigint)", "CREATE INDEX index_users_id ON users (id)"}, ddls)
+	assert.Equal(t, []Progress{
+		{Phase: ProgressParseDesired},
+		{Phase: ProgressParseCurrent},
+		{Phase: ProgressAggregate},
+		{Phase: ProgressCompareTables, Done: 0, Total: 2},
+		{Phase: ProgressCompareTables, Done: 1, Total: 2},
+		{Phase: ProgressCompareTables, Done: 2, Total: 2},
+		{Phase: ProgressCleanUp},
+	}, progresses)
+}
@@ -0,0 +1,97 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// applyReferenceSchemas makes the objects in reference_schemas of --config read-only. Their definitions in the
+// desired DDLs are replaced with the current ones, so that foreign keys and views can depend on them while they're
+// never created, altered, or dropped. A foreign key referring to a table missing in a reference schema is an error.
+func applyReferenceSchemas(desiredDDLs []DDL, currentDDLs []DDL, referenceSchemas []string) ([]DDL, error) {
+	if len(referenceSchemas) == 0 {
+		return desiredDDLs, nil
+	}
+
+	var result []DDL
+	for _, ddl := range desiredDDLs {
+		if !inReferenceSchemas(ddl, referenceSchemas) {
+			result = append(result, ddl)
+		}
+	}
+
+	referenceTables := map[string]bool{}
+	for _, ddl := range currentDDLs {
+		if inReferenceSchemas(ddl, referenceSchemas) {
+			result = append(result, ddl)
+			if stmt, ok := ddl.(*CreateTable); ok {
+				referenceTables[stmt.table.name] = true
+			}
+		}
+	}
+
+	for _, ddl := range result {
+		var tableName string
+		var foreignKeys []ForeignKey
+		switch stmt := ddl.(type) {
+		case *CreateTable:
+			tableName, foreignKeys = stmt.table.name, stmt.table.foreignKeys
+		case *AddForeignKey:
+			tableName, foreignKeys = stmt.tableName, []ForeignKey{stmt.foreignKey}
+		}
+		for _, foreignKey := range foreignKeys {
+			if schema := objectSchema(foreignKey.referenceName); containsString(referenceSchemas, schema) && !referenceTables[foreignKey.referenceName] {
+				return nil, fmt.Errorf("foreign key %s on %s refers to %s, which doesn't exist in the reference schema %s",
+					foreignKey.constraintName, tableName, foreignKey.referenceName, schema)
+			}
+		}
+	}
+	return result, nil
+}
+
+func inReferenceSchemas(ddl DDL, referenceSchemas []string) bool {
+	var name string
+	switch stmt := ddl.(type) {
+	case *CreateTable:
+		name = stmt.table.name
+	case *CreateIndex:
+		name = stmt.tableName
+	case *AddIndex:
+		name = stmt.tableName
+	case *AddPrimaryKey:
+		name = stmt.tableName
+	case *AddForeignKey:
+		name = stmt.tableName
+	case *AddExclusion:
+		name = stmt.tableName
+	case *AddPolicy:
+		name = stmt.tableName
+	case *ClusterOn:
+		name = stmt.tableName
+	case *Owner:
+		name = stmt.tableName
+	case *View:
+		name = stmt.name
+	case *Trigger:
+		name = stmt.tableName
+	case *Type:
+		name = stmt.name
+	case *AddDomainConstraint:
+		name = stmt.typeName
+	case *Comment:
+		name = stmt.comment.Object
+	case *Schema:
+		return containsString(referenceSchemas, stmt.schema.Name)
+	default:
+		return false
+	}
+	return containsString(referenceSchemas, objectSchema(name))
+}
+
+// Return the schema of a qualified object name, or "" if it's unqualified.
+func objectSchema(name string) string {
+	if schema, _, ok := strings.Cut(name, "."); ok {
+		return schema
+	}
+	return ""
+}
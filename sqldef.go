@@ -1,35 +1,136 @@
 package sqldef
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/sqldef/sqldef/database"
 	"github.com/sqldef/sqldef/schema"
 )
 
 type Options struct {
-	DesiredDDLs     string
-	CurrentFile     string
-	DryRun          bool
-	Export          bool
-	EnableDropTable bool
-	BeforeApply     string
-	Config          database.GeneratorConfig
+	DesiredDDLs      string
+	OverlayDDLs      string
+	CurrentFile      string
+	DryRun           bool
+	Impact           bool
+	Pretty           bool // group --dry-run DDLs by object with colors and a summary
+	Export           bool
+	ChangedSince     string
+	Fingerprint      bool
+	EnableDropTable  bool
+	MergeAlters      bool     // combine the ALTER TABLEs of each table into one, only for MySQL
+	OnlyTables       []string // apply only the DDLs touching the tables matching one of these regexps
+	BeforeApply      string
+	SignPlan         string
+	VerifyPlan       string
+	VerifyKey        string // the trusted public key which must have signed the artifact of VerifyPlan
+	DownOutput       string
+	SavePlan         string
+	ComparePlan      string
+	DocOutput        string
+	SkipFailed       bool
+	Timeout          time.Duration // cancel each DDL running longer than this, not the whole run, 0 for no limit
+	ApplyLock        bool          // take an advisory lock while applying so that concurrent runs apply one after another
+	WaitTimeout      time.Duration // give up waiting for the lock of ApplyLock after this, 0 for no limit
+	Stats            string
+	Snapshot         string // write the exported schema to this file in dependency order
+	Restore          bool   // apply the desired schema only to an empty database, in dependency order
+	Docs             bool   // print Markdown documentation of the exported schema instead of its DDLs
+	Inspect          bool   // print the exported schema as a JSON document instead of its DDLs
+	Destroy          bool   // drop all the managed objects instead of applying the desired schema
+	ReportPrivileges bool   // print the current and desired table privileges of each role instead of applying the desired schema
+	Quiet            bool   // don't write informational messages to stderr
+	Verbose          bool   // write what each phase did to stderr as well
+	DatabaseName     string // shown in the notification to notify_webhook of --config
+	Config           database.GeneratorConfig
 }
 
 // Main function shared by all commands
 func Run(generatorMode schema.GeneratorMode, db database.Database, sqlParser database.Parser, options *Options) {
+	if options.Quiet && options.Verbose {
+		log.Fatal("--quiet and --verbose can't be used together")
+	} else if options.Quiet {
+		database.SetVerbosity(database.VerbosityQuiet)
+	} else if options.Verbose {
+		database.SetVerbosity(database.VerbosityVerbose)
+	}
+	if options.Timeout < 0 {
+		log.Fatalf("--timeout must be positive but got '%s'", options.Timeout)
+	}
+	if options.WaitTimeout < 0 {
+		log.Fatalf("--wait-timeout must be positive but got '%s'", options.WaitTimeout)
+	} else if options.WaitTimeout > 0 && !options.ApplyLock {
+		log.Fatal("--wait-timeout can be used only with --apply-lock")
+	}
+	if options.Destroy {
+		if options.Export || options.Restore {
+			log.Fatal("--destroy can't be used with --export or restore")
+		}
+		options.EnableDropTable = true // --destroy itself asks for the drops
+	}
+
+	stats := newStats()
+	if len(options.Stats) > 0 && !isValidStatsFormat(options.Stats) {
+		log.Fatalf("--stats must be 'text' or 'json' but got '%s'", options.Stats)
+	}
+	writeStats := func() {
+		if len(options.Stats) > 0 {
+			if err := stats.write(os.Stderr, options.Stats); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+	var notify func(failure string) // posts to notify_webhook of --config once the DDLs are planned
+	// os.Exit and log.Fatal don't run deferred calls, so the failure is notified and the stats are written explicitly
+	// before exiting with them.
+	finish := func(failure string) {
+		if notify != nil {
+			notify(failure)
+		}
+		writeStats()
+	}
+	exit := func(code int) {
+		finish(fmt.Sprintf("exited with status %d", code))
+		os.Exit(code)
+	}
+	fatal := func(v ...any) {
+		finish(fmt.Sprint(v...))
+		log.Fatal(v...)
+	}
+	fatalf := func(format string, v ...any) {
+		finish(fmt.Sprintf(format, v...))
+		log.Fatalf(format, v...)
+	}
+	defer finish("")
+
+	runStart := time.Now()
+	start := runStart
 	currentDDLs, err := db.DumpDDLs()
 	if err != nil {
-		log.Fatalf("Error on DumpDDLs: %s", err)
+		fatalf("Error on DumpDDLs: %s", err)
 	}
+	stats.record("dump", start)
+	database.Verbosef("-- Dumped the current schema in %s --\n", time.Since(start))
 
 	defaultSchema := db.GetDefaultSchema()
 
+	var dumpChecksum string
+	if options.ApplyLock {
+		dumpChecksum, err = schemaChecksum(generatorMode, sqlParser, currentDDLs, defaultSchema)
+		if err != nil {
+			fatal(err)
+		}
+	}
+
 	var ddlSuffix string
 	if generatorMode == schema.GeneratorModeMssql {
 		ddlSuffix = "GO\n"
@@ -37,47 +138,481 @@ func Run(generatorMode schema.GeneratorMode, db database.Database, sqlParser dat
 		ddlSuffix = ""
 	}
 
+	if options.Pretty && !options.DryRun && len(options.CurrentFile) == 0 {
+		fatal("--pretty can be used only with --dry-run")
+	}
+	if len(options.ChangedSince) > 0 && !options.Export {
+		fatal("--changed-since can be used only with --export")
+	}
+	if options.Fingerprint && (!options.Export || len(options.ChangedSince) > 0) {
+		fatal("--fingerprint can be used only with --export and without --changed-since")
+	}
+	if len(options.Snapshot) > 0 && (!options.Export || len(options.ChangedSince) > 0 || options.Fingerprint) {
+		fatal("a snapshot can be taken only with --export and without --changed-since or --fingerprint")
+	}
+	if options.Docs && options.Inspect {
+		fatal("docs and inspect can't be run together")
+	} else if (options.Docs || options.Inspect) && (!options.Export || len(options.ChangedSince) > 0 || options.Fingerprint || len(options.Snapshot) > 0) {
+		fatal("docs and inspect can be run only with --export and without --changed-since, --fingerprint, or a snapshot")
+	}
+	if options.ReportPrivileges && (generatorMode != schema.GeneratorModePostgres || options.Export || options.Destroy) {
+		fatal("--report-privileges is supported only by psqldef without --export or --destroy")
+	}
+	if len(options.Config.Timeouts) > 0 && generatorMode != schema.GeneratorModePostgres {
+		fatal("timeouts of --config is supported only by psqldef")
+	}
+	if len(options.Config.TransactionMode) > 0 && generatorMode != schema.GeneratorModePostgres {
+		fatal("transaction_mode of --config is supported only by psqldef")
+	}
+	if options.Config.TransactionMode == database.TransactionModeAll && options.Config.MaxBatchBytes > 0 {
+		fatal("transaction_mode 'all' can't be used with max_batch_bytes, which splits the transaction")
+	}
+	if len(options.Config.ReferenceSchemas) > 0 && generatorMode != schema.GeneratorModePostgres {
+		fatal("reference_schemas of --config is supported only by psqldef")
+	}
+	if len(options.Config.TypeConversions) > 0 && generatorMode != schema.GeneratorModePostgres {
+		fatal("type_conversions of --config is supported only by psqldef")
+	}
+	if options.Config.SafeNotNull && generatorMode != schema.GeneratorModePostgres {
+		fatal("safe_not_null of --config is supported only by psqldef")
+	}
+	if options.Config.RestartIdentity && generatorMode != schema.GeneratorModePostgres {
+		fatal("restart_identity of --config is supported only by psqldef")
+	}
+	if len(options.Config.Auth) > 0 && generatorMode != schema.GeneratorModePostgres {
+		fatal("auth of --config is supported only by psqldef")
+	}
+	if options.Config.AutoCreateSchema && generatorMode != schema.GeneratorModePostgres {
+		fatal("auto_create_schema of --config is supported only by psqldef")
+	}
+	if (len(options.Config.SslMode) > 0 || len(options.Config.SslCa) > 0 || len(options.Config.SslCert) > 0 || len(options.Config.SslKey) > 0) && generatorMode != schema.GeneratorModeMysql {
+		fatal("ssl_mode, ssl_ca, ssl_cert, and ssl_key of --config are supported only by mysqldef")
+	}
+	if options.Config.IgnoreColumnOrder && generatorMode != schema.GeneratorModeMysql {
+		fatal("ignore_column_order of --config is supported only by mysqldef")
+	}
+	if options.Config.SSHTunnel != nil && generatorMode == schema.GeneratorModeSQLite3 {
+		fatal("ssh_tunnel of --config is not supported by sqlite3def")
+	}
+	if options.Config.DisableDDLTriggers && generatorMode != schema.GeneratorModeMssql {
+		fatal("disable_ddl_triggers of --config is supported only by mssqldef")
+	}
+	if options.Config.ContainedDatabase != nil && generatorMode != schema.GeneratorModeMssql {
+		fatal("contained_database of --config is supported only by mssqldef")
+	}
+	if (options.Config.StripAutoIncrement || options.Config.StripDefiner || options.Config.ExportDefiner || options.Config.CanonicalizeDefaults) && generatorMode != schema.GeneratorModeMysql {
+		fatal("export_strip_auto_increment, export_strip_definer, export_definer, and export_canonicalize_defaults of --config are supported only by mysqldef")
+	}
+	if len(options.Config.OverrideDefiner) > 0 && generatorMode != schema.GeneratorModeMysql {
+		fatal("override_definer of --config is supported only by mysqldef")
+	}
+	if len(options.Config.OverrideDefiner) > 0 {
+		currentDDLs = schema.OverrideDefiners(currentDDLs, options.Config.OverrideDefiner)
+		options.DesiredDDLs = schema.OverrideDefiners(options.DesiredDDLs, options.Config.OverrideDefiner)
+	}
+	// DEFINER clauses are dumped to compare them with the desired ones, but they depend on the user that created the
+	// objects, so they're exported only on request.
+	if options.Export && generatorMode == schema.GeneratorModeMysql &&
+		(options.Config.StripDefiner || (!options.Config.ExportDefiner && len(options.Config.OverrideDefiner) == 0)) {
+		currentDDLs = schema.StripDefiners(currentDDLs)
+	}
+	if len(options.Config.AuditTable) > 0 {
+		// The audit table isn't in the desired schema, so keep it from being exported or dropped.
+		options.Config.SkipTables = append(options.Config.SkipTables, auditTablePattern(generatorMode, options.Config.AuditTable, defaultSchema))
+	}
+
+	if options.Export && len(options.Snapshot) > 0 {
+		ddls, err := schema.ParseDDLs(generatorMode, sqlParser, currentDDLs, defaultSchema)
+		if err != nil {
+			fatal(err)
+		}
+		ddls = schema.SortTablesByDependencies(schema.FilterTables(ddls, options.Config))
+		if err := os.WriteFile(options.Snapshot, []byte(joinDDLs(ddls, ddlSuffix)), 0644); err != nil {
+			fatal(err)
+		}
+		database.Infof("-- Saved %d statements to %s --\n", len(ddls), options.Snapshot)
+		return
+	}
+
+	if options.Export && (options.Docs || options.Inspect) {
+		generate := schema.GenerateDocument
+		if options.Inspect {
+			generate = schema.GenerateInspection
+		}
+		report, err := generate(generatorMode, sqlParser, currentDDLs, options.Config, defaultSchema)
+		if err != nil {
+			fatal(err)
+		}
+		fmt.Print(report)
+		return
+	}
+
 	if options.Export {
 		if currentDDLs == "" {
-			fmt.Printf("-- No table exists --\n")
+			database.Infof("-- No table exists --\n")
 		} else {
+			start := time.Now()
 			ddls, err := schema.ParseDDLs(generatorMode, sqlParser, currentDDLs, defaultSchema)
 			if err != nil {
-				log.Fatal(err)
+				fatal(err)
 			}
 			ddls = schema.FilterTables(ddls, options.Config)
+			stats.record("parse", start)
+			stats.currentObjects = len(ddls)
+
+			var unchanged []schema.DDL
+			if len(options.ChangedSince) > 0 {
+				snapshot, err := ReadFile(options.ChangedSince)
+				if err != nil {
+					fatal(err)
+				}
+				snapshotDDLs, err := schema.ParseDDLs(generatorMode, sqlParser, snapshot, defaultSchema)
+				if err != nil {
+					fatal(err)
+				}
+				ddls, unchanged = schema.SplitChangedDDLs(ddls, snapshotDDLs)
+			}
+
+			statements := make([]string, len(ddls))
 			for i, ddl := range ddls {
+				if options.Config.ExplicitNotNull {
+					statements[i] = schema.ExplicitNotNull(ddl)
+				} else {
+					statements[i] = ddl.Statement()
+				}
+				statements[i] = schema.NormalizeExport(ddl, statements[i], options.Config)
+			}
+			if options.Fingerprint {
+				statements = schema.FingerprintDDLs(generatorMode, ddls, statements, defaultSchema)
+			}
+			for i, statement := range statements {
 				if i > 0 {
 					fmt.Println()
 				}
-				fmt.Printf("%s;\n", ddl.Statement())
+				fmt.Printf("%s;\n", statement)
 				fmt.Print(ddlSuffix)
 			}
+			if len(options.ChangedSince) > 0 {
+				if len(ddls) > 0 {
+					fmt.Println()
+				}
+				fmt.Printf("-- Unchanged since %s: %d --\n", options.ChangedSince, len(unchanged))
+				for _, ddl := range unchanged {
+					fmt.Printf("-- %s\n", schema.DescribeDDL(ddl))
+				}
+			}
+		}
+		return
+	}
+
+	start = time.Now()
+	desiredSchema, err := schema.ParseDDLs(generatorMode, sqlParser, options.DesiredDDLs, defaultSchema)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		exit(1)
+	}
+	if len(options.OverlayDDLs) > 0 {
+		overlaySchema, err := schema.ParseDDLs(generatorMode, sqlParser, options.OverlayDDLs, defaultSchema)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exit(1)
+		}
+		// Filter the overlay before merging it, so that it doesn't bring the skipped tables back into the merged SQL.
+		overlaySchema = schema.FilterTables(overlaySchema, options.Config)
+		desiredSchema = schema.MergeDDLs(desiredSchema, overlaySchema)
+		// --down-output and --doc-output read the desired SQL, so let them see the merged one as well.
+		options.DesiredDDLs = joinDDLs(desiredSchema, ddlSuffix)
+	}
+	desiredSchema = schema.FilterTables(desiredSchema, options.Config)
+	currentSchema, err := schema.ParseDDLs(generatorMode, sqlParser, currentDDLs, defaultSchema)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		exit(1)
+	}
+	currentSchema = schema.FilterTables(currentSchema, options.Config)
+	if options.ReportPrivileges {
+		// A current SQL file has the GRANTs in itself, while the dump of a database doesn't.
+		if db.DB() != nil {
+			grants, err := dumpTablePrivileges(db)
+			if err != nil {
+				fatal(err)
+			}
+			grantDDLs, err := schema.ParseDDLs(generatorMode, sqlParser, grants, defaultSchema)
+			if err != nil {
+				fatal(err)
+			}
+			currentSchema = append(currentSchema, schema.FilterTables(grantDDLs, options.Config)...)
 		}
+		fmt.Print(schema.GeneratePrivilegeReport(desiredSchema, currentSchema, options.Config.ManagedRoles))
 		return
 	}
+	if options.Restore {
+		if len(currentSchema) > 0 {
+			fatalf("a snapshot can be restored only to an empty database, but %d objects exist", len(currentSchema))
+		}
+		desiredSchema = schema.SortTablesByDependencies(desiredSchema)
+	}
+	stats.record("parse", start)
+	stats.desiredObjects = len(desiredSchema)
+	stats.currentObjects = len(currentSchema)
+	database.Verbosef("-- Parsed %d desired and %d current objects --\n", len(desiredSchema), len(currentSchema))
 
-	ddls, err := schema.GenerateIdempotentDDLs(generatorMode, sqlParser, options.DesiredDDLs, currentDDLs, options.Config, defaultSchema)
+	start = time.Now()
+	options.Config.EnableDrop = options.EnableDropTable
+	options.Config.MergeAlters = options.MergeAlters
+	var ddls []string
+	if options.Destroy {
+		ddls, err = schema.GenerateDestroyDDLs(generatorMode, currentSchema, defaultSchema)
+	} else {
+		ddls, err = schema.GenerateIdempotentDDLsFromParsed(generatorMode, desiredSchema, currentSchema, options.Config, defaultSchema)
+	}
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+		exit(1)
+	}
+	if len(options.OnlyTables) > 0 {
+		filtered := schema.FilterDDLsByTables(ddls, options.OnlyTables, currentSchema)
+		if len(filtered) < len(ddls) {
+			database.Infof("-- Skipped %d DDLs not touching --only-table --\n", len(ddls)-len(filtered))
+		}
+		ddls = filtered
+	}
+	stats.record("diff", start)
+	stats.countDDLs(ddls, options.EnableDropTable)
+	database.Verbosef("-- Generated %d DDLs in %s --\n", len(ddls), time.Since(start))
+
+	if len(options.Config.NotifyWebhook) > 0 {
+		plannedDDLs := ddls
+		dryRun := options.DryRun || len(options.CurrentFile) > 0
+		notify = func(failure string) {
+			notification := newNotification(options.DatabaseName, plannedDDLs, options.EnableDropTable, dryRun, time.Since(runStart), failure)
+			if err := postNotification(options.Config.NotifyWebhook, notification); err != nil {
+				fmt.Fprintf(os.Stderr, "-- Failed to notify the webhook: %s --\n", err)
+			}
+		}
+	}
+
+	if forbidden := database.FindForbiddenDDLs(ddls, options.Config.ForbiddenDDL, options.EnableDropTable); len(forbidden) > 0 {
+		showForbiddenDDLs(forbidden)
+		exit(1)
 	}
+
+	if len(options.SignPlan) > 0 {
+		if !options.DryRun && len(options.CurrentFile) == 0 {
+			fatal("--sign-plan can be used only with --dry-run")
+		}
+		artifact, err := SignPlan(formatPlan(ddls, options.EnableDropTable, options.BeforeApply, ddlSuffix), options.SignPlan)
+		if err != nil {
+			fatal(err)
+		}
+		fmt.Print(artifact)
+		return
+	}
+	if len(options.VerifyPlan) > 0 {
+		if len(options.VerifyKey) == 0 {
+			fatal("--verify-plan needs --verify-key of the public key trusted to sign the plan")
+		}
+		err := VerifyPlan(formatPlan(ddls, options.EnableDropTable, options.BeforeApply, ddlSuffix), options.VerifyPlan, options.VerifyKey)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exit(1)
+		}
+	}
+
+	if len(options.DownOutput) > 0 {
+		// Swap the current and desired schemas to generate DDLs that bring the applied schema back.
+		downDDLs, err := schema.GenerateIdempotentDDLs(generatorMode, sqlParser, currentDDLs, options.DesiredDDLs, options.Config, defaultSchema)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			exit(1)
+		}
+		if len(options.OnlyTables) > 0 {
+			downDDLs = schema.FilterDDLsByTables(downDDLs, options.OnlyTables, desiredSchema)
+		}
+		if err := os.WriteFile(options.DownOutput, []byte(formatPlan(downDDLs, true, "", ddlSuffix)), 0644); err != nil {
+			fatal(err)
+		}
+	}
+
+	if len(options.DocOutput) > 0 {
+		doc, err := schema.GenerateDocument(generatorMode, sqlParser, options.DesiredDDLs, options.Config, defaultSchema)
+		if err != nil {
+			fatal(err)
+		}
+		if err := os.MkdirAll(options.DocOutput, 0755); err != nil {
+			fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(options.DocOutput, "schema.md"), []byte(doc), 0644); err != nil {
+			fatal(err)
+		}
+	}
+
+	if len(options.ComparePlan) > 0 {
+		// Compare before saving so that the same file can be compared and then updated.
+		report, err := ComparePlan(formatPlan(ddls, options.EnableDropTable, options.BeforeApply, ddlSuffix), ddls, options.ComparePlan)
+		if err != nil {
+			fatal(err)
+		}
+		fmt.Print(report)
+	}
+	if len(options.SavePlan) > 0 {
+		if err := SavePlan(formatPlan(ddls, options.EnableDropTable, options.BeforeApply, ddlSuffix), ddls, options.SavePlan); err != nil {
+			fatal(err)
+		}
+	}
+
 	if len(ddls) == 0 {
-		fmt.Println("-- Nothing is modified --")
+		database.Infof("-- Nothing is modified --\n")
 		return
 	}
 
+	// Fail before showing or applying the DDLs, since they can't be applied as they are.
+	if generatorMode == schema.GeneratorModePostgres && db.DB() != nil {
+		dependencies, err := findFunctionsUsingAlteredColumns(db, ddls)
+		if err != nil {
+			fatal(err)
+		}
+		if len(dependencies) > 0 {
+			showFunctionsUsingAlteredColumns(dependencies)
+			exit(1)
+		}
+	}
+
 	if options.DryRun || len(options.CurrentFile) > 0 {
-		showDDLs(ddls, options.EnableDropTable, options.BeforeApply, ddlSuffix)
+		if options.Pretty {
+			showDDLsPretty(generatorMode, ddls, currentSchema, options.EnableDropTable, options.BeforeApply, options.Impact)
+		} else if options.Impact {
+			showDDLsWithImpact(generatorMode, ddls, options.EnableDropTable, options.BeforeApply, ddlSuffix)
+		} else {
+			showDDLs(ddls, options.EnableDropTable, options.BeforeApply, ddlSuffix)
+		}
+		return
+	}
+
+	// On Ctrl-C, cancel the running DDL and roll back the transaction instead of leaving it open on the server.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if options.ApplyLock {
+		release, err := acquireApplyLock(ctx, db, generatorMode, options.WaitTimeout)
+		if err != nil {
+			fatal(err)
+		}
+		defer release()
+
+		// Another run holding the lock may have changed the schema, so apply the DDLs only if the plan is still based on it.
+		lockedDDLs, err := db.DumpDDLs()
+		if err != nil {
+			fatalf("Error on DumpDDLs: %s", err)
+		}
+		checksum, err := schemaChecksum(generatorMode, sqlParser, lockedDDLs, defaultSchema)
+		if err != nil {
+			fatal(err)
+		}
+		if checksum != dumpChecksum {
+			fatalf("the schema was changed by another run while waiting for --apply-lock (checksum %s, planned against %s), so run again to plan against it", checksum, dumpChecksum)
+		}
+	}
+
+	appliedDDLs := ddls
+	var enableDDLTriggers string
+	if options.Config.DisableDDLTriggers {
+		var disableDDLTriggers string
+		disableDDLTriggers, enableDDLTriggers, err = ddlTriggerStatements(db)
+		if err != nil {
+			fatal(err)
+		}
+		if len(disableDDLTriggers) > 0 {
+			ddls = append(append([]string{disableDDLTriggers}, ddls...), enableDDLTriggers)
+		}
+	}
+
+	runOptions := database.RunDDLsOptions{
+		EnableDropTable:  options.EnableDropTable,
+		BeforeApply:      options.BeforeApply,
+		DDLSuffix:        ddlSuffix,
+		MaxBatchBytes:    options.Config.MaxBatchBytes,
+		TransactionMode:  options.Config.TransactionMode,
+		StatementTimeout: options.Timeout,
+		AlterFallbacks:   options.Config.AlterFallbacks,
+	}
+	start = time.Now()
+	if options.SkipFailed {
+		failures, err := database.RunDDLsSkippingFailures(ctx, db, ddls, runOptions)
+		if err != nil {
+			restoreDDLTriggers(db, enableDDLTriggers)
+		}
+		if err != nil && ctx.Err() != nil {
+			fatalf("Interrupted: %s", err)
+		} else if err != nil {
+			fatal(err)
+		}
+		stats.record("execute", start)
+		if len(failures) > 0 {
+			showFailures(failures)
+			exit(1)
+		}
 		return
 	}
 
-	err = database.RunDDLs(db, ddls, options.EnableDropTable, options.BeforeApply, ddlSuffix)
+	ddls = database.InsertTimeouts(ddls, options.Config.Timeouts, options.EnableDropTable)
+	err = database.RunDDLs(ctx, db, ddls, runOptions)
 	if err != nil {
-		log.Fatal(err)
+		restoreDDLTriggers(db, enableDDLTriggers)
+	}
+	if err != nil && ctx.Err() != nil {
+		fatalf("Interrupted, and rolled back the DDLs which were not committed: %s", err)
+	} else if err != nil {
+		fatal(err)
+	}
+	stats.record("execute", start)
+	database.Verbosef("-- Applied %d DDLs in %s --\n", len(ddls), time.Since(start))
+
+	if len(options.Config.AuditTable) > 0 {
+		var audited []string
+		for _, ddl := range appliedDDLs {
+			if options.EnableDropTable || !strings.Contains(ddl, "DROP TABLE") {
+				audited = append(audited, ddl)
+			}
+		}
+		if err := writeAuditLog(db, generatorMode, options.Config, audited, options.DesiredDDLs); err != nil {
+			fatalf("Error on writing the audit log: %s", err)
+		}
+	}
+}
+
+func joinDDLs(ddls []schema.DDL, ddlSuffix string) string {
+	var result strings.Builder
+	for _, ddl := range ddls {
+		fmt.Fprintf(&result, "%s;\n%s", ddl.Statement(), ddlSuffix)
+	}
+	return result.String()
+}
+
+func showFailures(failures []database.DDLFailure) {
+	fmt.Fprintf(os.Stderr, "-- Failed DDLs: %d --\n", len(failures))
+	for _, failure := range failures {
+		fmt.Fprintf(os.Stderr, "DDL: %s;\n", failure.DDL)
+		fmt.Fprintf(os.Stderr, "Error: %s\n", failure.Err)
+		fmt.Fprintf(os.Stderr, "Remediation: %s\n", failure.Remediation)
+	}
+}
+
+func showForbiddenDDLs(forbidden []database.ForbiddenDDL) {
+	fmt.Fprintf(os.Stderr, "-- Forbidden DDLs: %d --\n", len(forbidden))
+	for _, ddl := range forbidden {
+		fmt.Fprintf(os.Stderr, "%s: %s;\n", ddl.Class, ddl.DDL)
 	}
 }
 
+func showFunctionsUsingAlteredColumns(dependencies []string) {
+	fmt.Fprintf(os.Stderr, "-- Functions using the columns whose type is altered: %d --\n", len(dependencies))
+	for _, dependency := range dependencies {
+		fmt.Fprintln(os.Stderr, dependency)
+	}
+	fmt.Fprintln(os.Stderr, "-- sqldef doesn't manage functions, so drop them before applying and create them again after it --")
+}
+
 func ParseFiles(files []string) []string {
 	if len(files) == 0 {
 		panic("ParseFiles got empty files") // assume default:"-"
@@ -108,6 +643,25 @@ func ReadFiles(filepaths []string) (string, error) {
 	return result.String(), nil
 }
 
+// ReadDesiredFiles is the same as ReadFiles, except that a manifest file in YAML or JSON is translated into SQL.
+func ReadDesiredFiles(filepaths []string, generatorMode schema.GeneratorMode) (string, error) {
+	var result strings.Builder
+	for _, filepath := range filepaths {
+		f, err := ReadFile(filepath)
+		if err != nil {
+			return "", err
+		}
+		if schema.IsManifestFile(filepath) {
+			f, err = schema.ManifestToSQL(generatorMode, []byte(f))
+			if err != nil {
+				return "", fmt.Errorf("%s: %s", filepath, err)
+			}
+		}
+		result.WriteString(f)
+	}
+	return result.String(), nil
+}
+
 func ReadFile(filepath string) (string, error) {
 	var err error
 	var buf []byte
@@ -130,18 +684,38 @@ func ReadFile(filepath string) (string, error) {
 }
 
 func showDDLs(ddls []string, enableDropTable bool, beforeApply string, ddlSuffix string) {
+	fmt.Println("-- dry run --")
+	fmt.Print(formatPlan(ddls, enableDropTable, beforeApply, ddlSuffix))
+}
+
+// Same as showDDLs, but annotate each DDL with its estimated locks and table rewrites, followed by a warning summary.
+func showDDLsWithImpact(generatorMode schema.GeneratorMode, ddls []string, enableDropTable bool, beforeApply string, ddlSuffix string) {
 	fmt.Println("-- dry run --")
 	if len(beforeApply) > 0 {
 		fmt.Println(beforeApply)
 	}
+	var exclusiveLocks, tableRewrites int
 	for _, ddl := range ddls {
 		if !enableDropTable && strings.Contains(ddl, "DROP TABLE") {
 			fmt.Printf("-- Skipped: %s;\n", ddl)
 			continue
 		}
+		impact := schema.AnalyzeImpact(generatorMode, ddl)
+		if impact.ExclusiveLock {
+			exclusiveLocks++
+		}
+		if impact.TableRewrite {
+			tableRewrites++
+		}
+		if notes := impact.String(); notes != "" {
+			fmt.Printf("-- Impact: %s\n", notes)
+		}
 		fmt.Printf("%s;\n", ddl)
 		fmt.Print(ddlSuffix)
 	}
+	if exclusiveLocks > 0 || tableRewrites > 0 {
+		fmt.Printf("-- Warning: %d statement(s) take an exclusive lock, %d statement(s) rewrite a table --\n", exclusiveLocks, tableRewrites)
+	}
 }
 
 func ParseSkipTables(skipFile string) []string {
@@ -0,0 +1,105 @@
+package sqldef
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Stats is the timing summary of a run, reported by --stats.
+type Stats struct {
+	phases         []phaseDuration
+	desiredObjects int
+	currentObjects int
+	ddls           map[string]int
+}
+
+type phaseDuration struct {
+	name     string
+	duration time.Duration
+}
+
+var ddlCategoryPattern = regexp.MustCompile(`(?is)^(CREATE|ALTER|DROP|COMMENT ON|GRANT|REVOKE)\s+(?:OR REPLACE\s+|UNIQUE\s+|MATERIALIZED\s+|CLUSTERED\s+|NONCLUSTERED\s+|COLUMNSTORE\s+)*(\w+)`)
+
+func isValidStatsFormat(format string) bool {
+	return format == "text" || format == "json"
+}
+
+func newStats() *Stats {
+	return &Stats{ddls: map[string]int{}}
+}
+
+// Record the time elapsed since start as the duration of the phase.
+func (s *Stats) record(phase string, start time.Time) {
+	s.phases = append(s.phases, phaseDuration{name: phase, duration: time.Since(start)})
+}
+
+// Count the DDLs by their category, e.g. "create_table". DROP TABLEs that are not executed are counted as "skipped".
+func (s *Stats) countDDLs(ddls []string, enableDropTable bool) {
+	for _, ddl := range ddls {
+		if !enableDropTable && strings.Contains(ddl, "DROP TABLE") {
+			s.ddls["skipped"]++
+			continue
+		}
+		s.ddls[statsDDLCategory(ddl)]++
+	}
+}
+
+func statsDDLCategory(ddl string) string {
+	ddl = strings.TrimSpace(ddl)
+	if match := ddlCategoryPattern.FindStringSubmatch(ddl); match != nil {
+		return strings.ToLower(strings.ReplaceAll(match[1], " ", "_") + "_" + match[2])
+	}
+	if fields := strings.Fields(ddl); len(fields) > 0 {
+		return strings.ToLower(fields[0])
+	}
+	return "unknown"
+}
+
+func (s *Stats) ddlCategories() []string {
+	categories := make([]string, 0, len(s.ddls))
+	for category := range s.ddls {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return categories
+}
+
+// Write the stats in the Prometheus text exposition format, or as a JSON object.
+func (s *Stats) write(w io.Writer, format string) error {
+	if format == "json" {
+		phases := map[string]float64{}
+		for _, phase := range s.phases {
+			phases[phase.name] = phase.duration.Seconds()
+		}
+		out, err := json.Marshal(map[string]any{
+			"phase_duration_seconds": phases,
+			"objects":                map[string]int{"desired": s.desiredObjects, "current": s.currentObjects},
+			"ddls":                   s.ddls,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(out))
+		return err
+	}
+
+	var b strings.Builder
+	b.WriteString("# TYPE sqldef_phase_duration_seconds gauge\n")
+	for _, phase := range s.phases {
+		fmt.Fprintf(&b, "sqldef_phase_duration_seconds{phase=%q} %g\n", phase.name, phase.duration.Seconds())
+	}
+	b.WriteString("# TYPE sqldef_objects gauge\n")
+	fmt.Fprintf(&b, "sqldef_objects{schema=\"desired\"} %d\n", s.desiredObjects)
+	fmt.Fprintf(&b, "sqldef_objects{schema=\"current\"} %d\n", s.currentObjects)
+	b.WriteString("# TYPE sqldef_ddls gauge\n")
+	for _, category := range s.ddlCategories() {
+		fmt.Fprintf(&b, "sqldef_ddls{category=%q} %d\n", category, s.ddls[category])
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,111 @@
+package sqldef
+
+import (
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/sqldef/sqldef/database"
+)
+
+// How often --watch looks at the files, and how long they must stay unchanged before a run, so that a run doesn't
+// see a file which is still being saved or only some of the files saved together.
+var (
+	watchInterval = 200 * time.Millisecond
+	watchDebounce = 300 * time.Millisecond
+)
+
+// Watch runs the command once and then every time the given files, or the files in the given directories, change,
+// until it's interrupted. Each run is the same command without --watch in another process, so that an error, e.g.
+// of a file saved halfway, is shown without stopping the watch. It never returns.
+func Watch(paths []string) {
+	for _, path := range paths {
+		if path == "-" {
+			log.Fatal("--watch can't read the desired SQL from stdin, so give it by --file")
+		}
+	}
+	executable, err := os.Executable()
+	if err != nil {
+		log.Fatal(err)
+	}
+	var args []string
+	for _, arg := range os.Args[1:] {
+		if arg != "--watch" {
+			args = append(args, arg)
+		}
+	}
+
+	last := statWatchedFiles(paths)
+	runWatched(executable, args)
+	database.Infof("-- Watching %d files for changes --\n", len(last))
+
+	var changedAt time.Time
+	for {
+		time.Sleep(watchInterval)
+		files := statWatchedFiles(paths)
+		if changed := changedWatchedFiles(last, files); len(changed) > 0 {
+			last = files
+			changedAt = time.Now()
+			database.Verbosef("-- Changed: %v --\n", changed)
+			continue
+		}
+		if !changedAt.IsZero() && time.Since(changedAt) >= watchDebounce {
+			changedAt = time.Time{}
+			database.Infof("-- Changes detected at %s --\n", time.Now().Format("15:04:05"))
+			runWatched(executable, args)
+		}
+	}
+}
+
+func runWatched(executable string, args []string) {
+	cmd := exec.Command(executable, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "-- Failed: %s --\n", err)
+	}
+}
+
+type watchedFile struct {
+	size    int64
+	modTime time.Time
+}
+
+// Stat the files, walking into the directories. A file which can't be stat-ed, e.g. being replaced, is skipped.
+func statWatchedFiles(paths []string) map[string]watchedFile {
+	files := map[string]watchedFile{}
+	for _, path := range paths {
+		_ = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return nil
+			}
+			if info, err := entry.Info(); err == nil {
+				files[file] = watchedFile{size: info.Size(), modTime: info.ModTime()}
+			}
+			return nil
+		})
+	}
+	return files
+}
+
+// Return the files added, changed, or removed since the last stat.
+func changedWatchedFiles(last map[string]watchedFile, files map[string]watchedFile) []string {
+	var changed []string
+	for file, stat := range files {
+		if lastStat, ok := last[file]; !ok || lastStat.size != stat.size || !lastStat.modTime.Equal(stat.modTime) {
+			changed = append(changed, file)
+		}
+	}
+	for file := range last {
+		if _, ok := files[file]; !ok {
+			changed = append(changed, file)
+		}
+	}
+	sort.Strings(changed)
+	return changed
+}